@@ -48,6 +48,16 @@ app.kubernetes.io/name: {{ include "gode.name" . }}
 app.kubernetes.io/instance: {{ .Release.Name }}
 {{- end }}
 
+{{/*
+Worker selector labels. The worker pods use their own name so that neither
+the API deployment nor the service selects them.
+*/}}
+{{- define "gode.worker.selectorLabels" -}}
+app.kubernetes.io/name: {{ include "gode.name" . }}-worker
+app.kubernetes.io/instance: {{ .Release.Name }}
+app.kubernetes.io/component: worker
+{{- end }}
+
 {{/*
 Service account name.
 */}}
@@ -231,3 +241,136 @@ JWT secret key.
 {{- print "JWT_SECRET" }}
 {{- end }}
 {{- end }}
+
+{{/*
+Environment shared by the API and the worker containers: store, Redis,
+observability, executor and DE settings.
+*/}}
+{{- define "gode.env" -}}
+# Database
+- name: DB_TYPE
+  value: "postgres"
+- name: DB_HOST
+  value: {{ include "gode.postgresql.host" . | quote }}
+- name: DB_PORT
+  value: {{ include "gode.postgresql.port" . | quote }}
+- name: DB_NAME
+  value: {{ include "gode.postgresql.database" . | quote }}
+- name: DB_USER
+  value: {{ include "gode.postgresql.username" . | quote }}
+- name: DB_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: {{ include "gode.postgresql.secretName" . }}
+      key: {{ include "gode.postgresql.secretKey" . }}
+# Redis
+- name: REDIS_HOST
+  value: {{ include "gode.redis.host" . | quote }}
+- name: REDIS_PORT
+  value: {{ include "gode.redis.port" . | quote }}
+- name: REDIS_DB
+  value: {{ include "gode.redis.db" . | quote }}
+{{- $redisSecretName := include "gode.redis.secretName" . }}
+{{- if $redisSecretName }}
+- name: REDIS_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: {{ $redisSecretName }}
+      key: {{ include "gode.redis.secretKey" . }}
+{{- end }}
+{{- if not .Values.redis.enabled }}
+- name: REDIS_TLS_ENABLED
+  value: {{ .Values.externalRedis.tls.enabled | quote }}
+{{- if .Values.externalRedis.tls.enabled }}
+- name: REDIS_TLS_INSECURE_SKIP_VERIFY
+  value: {{ .Values.externalRedis.tls.insecureSkipVerify | quote }}
+{{- if .Values.externalRedis.tls.certFile }}
+- name: REDIS_TLS_CERT_FILE
+  value: {{ .Values.externalRedis.tls.certFile | quote }}
+{{- end }}
+{{- if .Values.externalRedis.tls.keyFile }}
+- name: REDIS_TLS_KEY_FILE
+  value: {{ .Values.externalRedis.tls.keyFile | quote }}
+{{- end }}
+{{- end }}
+{{- end }}
+# Observability
+- name: GODE_METRICS_ENABLED
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: METRICS_ENABLED
+- name: GODE_METRICS_TYPE
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: METRICS_TYPE
+- name: GODE_TRACING_ENABLED
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: TRACING_ENABLED
+- name: GODE_SLO_ENABLED
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: SLO_ENABLED
+# Tracing
+- name: TRACING_EXPORTER
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: TRACING_EXPORTER
+- name: OTLP_ENDPOINT
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: OTLP_ENDPOINT
+- name: TRACE_SAMPLE_RATIO
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: TRACE_SAMPLE_RATIO
+# Executor
+- name: GODE_EXECUTOR_MAX_WORKERS
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: EXECUTOR_MAX_WORKERS
+- name: GODE_EXECUTOR_QUEUE_SIZE
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: EXECUTOR_QUEUE_SIZE
+- name: GODE_EXECUTOR_EXECUTION_TTL
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: EXECUTOR_EXECUTION_TTL
+- name: GODE_EXECUTOR_RESULT_TTL
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: EXECUTOR_RESULT_TTL
+- name: GODE_EXECUTOR_PROGRESS_TTL
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: EXECUTOR_PROGRESS_TTL
+# DE algorithm
+- name: GODE_DE_PARETO_CHANNEL_LIMITER
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: DE_PARETO_CHANNEL_LIMITER
+- name: GODE_DE_MAX_CHANNEL_LIMITER
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: DE_MAX_CHANNEL_LIMITER
+- name: GODE_DE_RESULT_LIMITER
+  valueFrom:
+    configMapKeyRef:
+      name: {{ include "gode.fullname" . }}-config
+      key: DE_RESULT_LIMITER
+{{- end }}
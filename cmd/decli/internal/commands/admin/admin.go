@@ -0,0 +1,64 @@
+// Package admincmd provides CLI commands for the operators of a server.
+package admincmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/state"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	cfg *config.Config
+	db  state.Operations
+)
+
+// adminCmd encapsulates the admin operations.
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "encapsulates admin operations, they require the admin scope",
+	RunE:  func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+}
+
+// RegisterCommands adds the subset of commands into the provided cobra.Command
+func RegisterCommands(root *cobra.Command) { root.AddCommand(adminCmd) }
+
+// SetupConfig sets the config of this package.
+func SetupConfig(rootCfg *config.Config) { cfg = rootCfg }
+
+// SetupStateHandler sets the state handler of this package
+func SetupStateHandler(rootDB state.Operations) { db = rootDB }
+
+func getClientAndContext(
+	ctx context.Context,
+) (
+	context.Context,
+	api.AdminServiceClient,
+	*grpc.ClientConn,
+	error,
+) {
+	authToken, err := db.GetAuthToken()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		"authorization": []string{fmt.Sprintf("Bearer %s", authToken)},
+	})
+
+	conn, err := grpc.NewClient(
+		cfg.Server.GRPCAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client := api.NewAdminServiceClient(conn)
+	return ctx, client, conn, nil
+}
@@ -0,0 +1,160 @@
+package admincmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	executionsUser         string
+	executionsStatus       string
+	executionsExperimentID string
+	executionsLimit        int32
+	executionsOffset       int32
+	cancelExecutionID      string
+	purgeOlderThan         time.Duration
+)
+
+// executionsCmd lists the executions of every user of the tenant.
+var executionsCmd = &cobra.Command{
+	Use:   "executions",
+	Short: "List the executions of every user of the tenant",
+	Long: `List the executions of every user of the tenant, newest first.
+Optionally filter by user, status (pending, running, completed, failed,
+cancelled) or experiment.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		req := &api.AdminListExecutionsRequest{
+			UserId:       executionsUser,
+			ExperimentId: executionsExperimentID,
+			Limit:        executionsLimit,
+			Offset:       executionsOffset,
+		}
+		if executionsStatus != "" {
+			statusValue, ok := api.ExecutionStatus_value["EXECUTION_STATUS_"+strings.ToUpper(executionsStatus)]
+			if !ok {
+				return fmt.Errorf("invalid status: %s (valid: pending, running, completed, failed, cancelled)", executionsStatus)
+			}
+			req.Status = api.ExecutionStatus(statusValue)
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.ListExecutions(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list executions: %w", err)
+		}
+
+		if len(resp.Executions) == 0 {
+			fmt.Println("No executions found.")
+			return nil
+		}
+
+		fmt.Printf("\nShowing %d of %d execution(s):\n\n", len(resp.Executions), resp.TotalCount)
+		for _, exec := range resp.Executions {
+			fmt.Printf("%s (%s)\n", exec.Id, exec.UserId)
+			fmt.Printf("   Status: %s\n", exec.Status.String())
+			fmt.Printf("   Created: %s\n", exec.CreatedAt.AsTime().Format("2006-01-02 15:04:05"))
+			if exec.Error != "" {
+				fmt.Printf("   Error: %s\n", exec.Error)
+			}
+		}
+		if resp.HasMore {
+			fmt.Printf("\nUse --offset %d to see more\n", resp.Offset+resp.Limit)
+		}
+		return nil
+	},
+}
+
+// cancelCmd cancels the execution of any user.
+var cancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel the execution of any user of the tenant",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if cancelExecutionID == "" {
+			return fmt.Errorf("--execution-id is required")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		_, err = client.CancelExecution(ctx, &api.AdminCancelExecutionRequest{
+			ExecutionId: cancelExecutionID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to cancel execution: %w", err)
+		}
+
+		fmt.Printf("Cancellation requested for execution: %s\n", cancelExecutionID)
+		return nil
+	},
+}
+
+// purgeCmd deletes the old finished executions of the tenant.
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Delete the finished executions of the tenant older than a duration",
+	Long: `Delete the completed, failed and cancelled executions of every user of the
+tenant last updated before --older-than, with their results and checkpoints.
+Purged results cannot be recovered.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if purgeOlderThan <= 0 {
+			return fmt.Errorf("--older-than must be a positive duration")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.PurgeResults(ctx, &api.PurgeResultsRequest{
+			OlderThan: durationpb.New(purgeOlderThan),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to purge results: %w", err)
+		}
+
+		fmt.Printf("Purged %d execution(s)\n", resp.PurgedExecutions)
+		return nil
+	},
+}
+
+func init() {
+	adminCmd.AddCommand(executionsCmd, cancelCmd, purgeCmd)
+	fs := executionsCmd.Flags()
+	fs.StringVar(&executionsUser, "user", "", "filter by user")
+	fs.StringVar(&executionsStatus, "status", "", "filter by status (pending, running, completed, failed, cancelled)")
+	fs.StringVar(&executionsExperimentID, "experiment-id", "", "filter by experiment")
+	fs.Int32Var(&executionsLimit, "limit", 50, "page size (max 100)")
+	fs.Int32Var(&executionsOffset, "offset", 0, "starting position")
+
+	cancelCmd.Flags().StringVar(&cancelExecutionID, "execution-id", "", "execution ID to cancel")
+
+	purgeCmd.Flags().DurationVar(&purgeOlderThan, "older-than", 0, "minimum age of the purged executions, e.g. 720h (required)")
+	_ = purgeCmd.MarkFlagRequired("older-than")
+}
@@ -0,0 +1,109 @@
+package admincmd
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	usersLimit  int32
+	usersOffset int32
+	scopesUser  string
+	scopesList  []string
+)
+
+// usersCmd lists the users of the tenant.
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "List the users of the tenant with their scopes",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.ListUsers(ctx, &api.AdminListUsersRequest{
+			Limit:  usersLimit,
+			Offset: usersOffset,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+
+		if len(resp.Users) == 0 {
+			fmt.Println("No users found.")
+			return nil
+		}
+
+		fmt.Printf("\nShowing %d of %d user(s):\n\n", len(resp.Users), resp.TotalCount)
+		for _, usr := range resp.Users {
+			fmt.Printf("%s <%s>\n", usr.Ids.GetUsername(), usr.Email)
+			fmt.Printf("   Scopes: %s\n", formatScopes(usr.Scopes))
+		}
+		if resp.HasMore {
+			fmt.Printf("\nUse --offset %d to see more\n", resp.Offset+resp.Limit)
+		}
+		return nil
+	},
+}
+
+// scopesCmd replaces the scopes of a user.
+var scopesCmd = &cobra.Command{
+	Use:   "scopes",
+	Short: "Replace the scopes of a user",
+	Long: `Replace the scopes of a user, they apply to the tokens issued from the next
+login or token refresh of the user. Without --scope the user gets the default
+scopes back.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if scopesUser == "" {
+			return fmt.Errorf("--username is required")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{
+			Username: scopesUser,
+			Scopes:   scopesList,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update scopes: %w", err)
+		}
+
+		fmt.Printf("Scopes of %s: %s\n", resp.User.Ids.GetUsername(), formatScopes(resp.User.Scopes))
+		return nil
+	},
+}
+
+// formatScopes names the scopes of a user, empty scopes are the default ones.
+func formatScopes(scopes []string) string {
+	if len(scopes) == 0 {
+		return "default"
+	}
+	return strings.Join(scopes, ", ")
+}
+
+func init() {
+	adminCmd.AddCommand(usersCmd, scopesCmd)
+	usersCmd.Flags().Int32Var(&usersLimit, "limit", 50, "page size (max 100)")
+	usersCmd.Flags().Int32Var(&usersOffset, "offset", 0, "starting position")
+
+	scopesCmd.Flags().StringVar(&scopesUser, "username", "", "user to update")
+	scopesCmd.Flags().StringSliceVar(&scopesList, "scope", nil, "scope of the user, repeated or comma separated")
+}
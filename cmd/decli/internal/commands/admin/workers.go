@@ -0,0 +1,42 @@
+package admincmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+// workersCmd describes the worker pool of the server.
+var workersCmd = &cobra.Command{
+	Use:   "workers",
+	Short: "Describe the worker pool of the server and the job queue",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.GetWorkerPool(ctx, &api.GetWorkerPoolRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get worker pool: %w", err)
+		}
+
+		fmt.Printf("Worker: %s\n", resp.WorkerId)
+		fmt.Printf("   Active workers: %d of %d\n", resp.ActiveWorkers, resp.MaxWorkers)
+		fmt.Printf("Queue (every server):\n")
+		fmt.Printf("   Queued jobs: %d\n", resp.QueuedJobs)
+		fmt.Printf("   Claimed jobs: %d\n", resp.ClaimedJobs)
+		return nil
+	},
+}
+
+func init() {
+	adminCmd.AddCommand(workersCmd)
+}
@@ -0,0 +1,183 @@
+package authcmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	apiKeyName      string
+	apiKeyScopes    []string
+	apiKeyExpiresIn time.Duration
+	apiKeyID        string
+)
+
+// apiKeysCmd encapsulates the API key operations.
+var apiKeysCmd = &cobra.Command{
+	Use:   "api-keys",
+	Short: "Manage the API keys of the logged in user",
+	Long: `Manage the API keys of the logged in user. Keys authenticate automation and
+CI clients through --api-key or $DECLI_API_KEY, they cannot manage keys
+themselves.`,
+	RunE: func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+}
+
+// apiKeysCreateCmd issues an API key.
+var apiKeysCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API key with a subset of the scopes of the user",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if apiKeyName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if len(apiKeyScopes) == 0 {
+			return fmt.Errorf("--scope is required")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		req := &api.CreateAPIKeyRequest{Name: apiKeyName, Scopes: apiKeyScopes}
+		if apiKeyExpiresIn > 0 {
+			req.ExpiresIn = durationpb.New(apiKeyExpiresIn)
+		}
+		resp, err := client.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to create api key: %w", err)
+		}
+
+		fmt.Printf("Created API key %s (%s)\n", resp.ApiKey.Id, resp.ApiKey.Name)
+		fmt.Printf("   Scopes:  %s\n", strings.Join(resp.ApiKey.Scopes, ", "))
+		fmt.Printf("   Expires: %s\n", formatExpiry(resp.ApiKey))
+		fmt.Printf("\n%s\n\nStore the key now, it cannot be shown again.\n", resp.Key)
+		return nil
+	},
+}
+
+// apiKeysListCmd lists the API keys of the user.
+var apiKeysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the API keys of the user",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.List(ctx, &api.ListAPIKeysRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to list api keys: %w", err)
+		}
+
+		if len(resp.ApiKeys) == 0 {
+			fmt.Println("No API keys found.")
+			return nil
+		}
+
+		fmt.Printf("\nFound %d API key(s):\n\n", len(resp.ApiKeys))
+		for _, key := range resp.ApiKeys {
+			fmt.Printf("%s  %s  %s...\n", key.Id, key.Name, key.Prefix)
+			fmt.Printf("   Scopes:  %s\n", strings.Join(key.Scopes, ", "))
+			fmt.Printf("   Created: %s\n", key.CreatedAt.AsTime().Format(time.RFC3339))
+			fmt.Printf("   Expires: %s\n", formatExpiry(key))
+		}
+		return nil
+	},
+}
+
+// apiKeysRevokeCmd revokes an API key.
+var apiKeysRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke an API key, requests made with it are rejected from then on",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if apiKeyID == "" {
+			return fmt.Errorf("--id is required")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		if _, err := client.Revoke(ctx, &api.RevokeAPIKeyRequest{Id: apiKeyID}); err != nil {
+			return fmt.Errorf("failed to revoke api key: %w", err)
+		}
+
+		fmt.Printf("Revoked API key %s\n", apiKeyID)
+		return nil
+	},
+}
+
+// formatExpiry returns when the key expires.
+func formatExpiry(key *api.APIKey) string {
+	if key.ExpiresAt == nil {
+		return "never"
+	}
+	return key.ExpiresAt.AsTime().Format(time.RFC3339)
+}
+
+func getClientAndContext(
+	ctx context.Context,
+) (
+	context.Context,
+	api.APIKeyServiceClient,
+	*grpc.ClientConn,
+	error,
+) {
+	authToken, err := db.GetAuthToken()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.MD{
+		"authorization": []string{fmt.Sprintf("Bearer %s", authToken)},
+	})
+
+	conn, err := grpc.NewClient(
+		cfg.Server.GRPCAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client := api.NewAPIKeyServiceClient(conn)
+	return ctx, client, conn, nil
+}
+
+func init() {
+	authCmd.AddCommand(apiKeysCmd)
+	apiKeysCmd.AddCommand(apiKeysCreateCmd, apiKeysListCmd, apiKeysRevokeCmd)
+
+	apiKeysCreateCmd.Flags().StringVar(&apiKeyName, "name", "", "name telling the key apart")
+	apiKeysCreateCmd.Flags().StringSliceVar(&apiKeyScopes, "scope", nil, "scope of the key, repeated or comma separated")
+	apiKeysCreateCmd.Flags().DurationVar(&apiKeyExpiresIn, "expires-in", 0, "lifetime of the key, zero for a key that does not expire")
+
+	apiKeysRevokeCmd.Flags().StringVar(&apiKeyID, "id", "", "key to revoke")
+}
@@ -1,24 +1,51 @@
 package authcmd
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/utils"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-var password string
+var (
+	password string
+	sso      bool
+)
 
 // loginCmd encapsulates the login related operations
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Log in the user's account",
+	Long: `Log in the user's account with its password, or save the API key given by
+--api-key or $` + utils.APIKeyEnv + ` when no username is given. With --sso the login
+goes through the single sign-on provider of the server, completed in a browser.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		if sso {
+			if username != "" {
+				return errors.New("--sso and --username cannot be used together")
+			}
+			return ssoLogin(cmd)
+		}
+
+		if username == "" {
+			apiKey := utils.APIKey(cmd)
+			if apiKey == "" {
+				return errors.New("either --username or --api-key is required")
+			}
+			if !auth.IsAPIKey(apiKey) {
+				return fmt.Errorf("api keys start with %q", auth.APIKeyPrefix)
+			}
+			return db.SaveAuthToken(apiKey)
+		}
+
 		// Use provided password or prompt for it
 		if password == "" {
 			var err error
@@ -61,13 +88,37 @@ var loginCmd = &cobra.Command{
 	},
 }
 
+// ssoLogin logs in through the device flow and saves the access token.
+func ssoLogin(cmd *cobra.Command) error {
+	conn, err := grpc.NewClient(
+		cfg.Server.GRPCAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := conn.Close(); cerr != nil {
+			slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+		}
+	}()
+
+	accessToken, err := deviceLogin(cmd.Context(), api.NewOIDCServiceClient(conn), cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+	if err := db.SaveAuthToken(accessToken); err != nil {
+		return err
+	}
+	fmt.Println("Logged in successfully")
+	return nil
+}
+
 func init() {
 	// Flags
 	loginCmd.Flags().StringVar(&username, "username", "", "user's name")
 	loginCmd.Flags().StringVar(&password, "password", "", "user's password (optional, will prompt if not provided)")
-
-	// Requirements
-	_ = loginCmd.MarkFlagRequired("username")
+	loginCmd.Flags().BoolVar(&sso, "sso", false, "log in through the single sign-on provider of the server")
 
 	// Commands
 	authCmd.AddCommand(loginCmd)
@@ -1,13 +1,21 @@
 package authcmd
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/state"
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/utils"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // mockStateOps implements state.Operations for testing.
@@ -52,6 +60,125 @@ func TestLoginCommand(t *testing.T) {
 		assert.Equal(t, "password", flag.Name)
 		assert.Equal(t, "", flag.DefValue)
 	})
+
+	t.Run("has sso flag", func(t *testing.T) {
+		flag := loginCmd.Flags().Lookup("sso")
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+
+	t.Run("saves the api key without username", func(t *testing.T) {
+		username = ""
+		t.Setenv(utils.APIKeyEnv, "gode_secret")
+		mock := &mockStateOps{}
+		db = mock
+
+		require.NoError(t, loginCmd.RunE(loginCmd, nil))
+		assert.Equal(t, "gode_secret", mock.token)
+	})
+
+	t.Run("rejects values that are not api keys", func(t *testing.T) {
+		username = ""
+		t.Setenv(utils.APIKeyEnv, "eyJhbGciOiJIUzI1NiJ9.e30.sig")
+		mock := &mockStateOps{}
+		db = mock
+
+		assert.Error(t, loginCmd.RunE(loginCmd, nil))
+		assert.Empty(t, mock.token)
+	})
+
+	t.Run("requires username or api key", func(t *testing.T) {
+		username = ""
+		t.Setenv(utils.APIKeyEnv, "")
+		db = &mockStateOps{}
+
+		assert.Error(t, loginCmd.RunE(loginCmd, nil))
+	})
+}
+
+// fakeOIDCClient answers the polls of a device login with polls in order.
+type fakeOIDCClient struct {
+	api.OIDCServiceClient
+	polls    []*api.PollOIDCDeviceLoginResponse
+	pollErr  error
+	polled   int
+	interval int64
+}
+
+func (c *fakeOIDCClient) StartDeviceLogin(
+	context.Context, *api.StartOIDCDeviceLoginRequest, ...grpc.CallOption,
+) (*api.StartOIDCDeviceLoginResponse, error) {
+	return &api.StartOIDCDeviceLoginResponse{
+		DeviceCode:      "device-code",
+		UserCode:        "ABCD-EFGH",
+		VerificationUri: "https://idp.example.com/activate",
+		Interval:        c.interval,
+	}, nil
+}
+
+func (c *fakeOIDCClient) PollDeviceLogin(
+	_ context.Context, req *api.PollOIDCDeviceLoginRequest, _ ...grpc.CallOption,
+) (*api.PollOIDCDeviceLoginResponse, error) {
+	if req.DeviceCode != "device-code" {
+		return nil, status.Error(codes.InvalidArgument, "unknown device code")
+	}
+	if c.polled == len(c.polls) {
+		return nil, c.pollErr
+	}
+	c.polled++
+	return c.polls[c.polled-1], nil
+}
+
+func TestDeviceLogin(t *testing.T) {
+	var waits []time.Duration
+	pollAfter = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+	t.Cleanup(func() { pollAfter = time.After })
+
+	t.Run("polls until the login completes", func(t *testing.T) {
+		waits = nil
+		client := &fakeOIDCClient{
+			interval: 5,
+			polls: []*api.PollOIDCDeviceLoginResponse{
+				{Pending: true},
+				{Pending: true, SlowDown: true},
+				{Login: &api.AuthServiceLoginResponse{AccessToken: "access-token"}},
+			},
+		}
+		var out bytes.Buffer
+
+		token, err := deviceLogin(context.Background(), client, &out)
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", token)
+		assert.Equal(t, 3, client.polled)
+		assert.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second}, waits)
+		assert.Contains(t, out.String(), "https://idp.example.com/activate")
+		assert.Contains(t, out.String(), "ABCD-EFGH")
+	})
+
+	t.Run("login denied", func(t *testing.T) {
+		client := &fakeOIDCClient{
+			interval: 5,
+			polls:    []*api.PollOIDCDeviceLoginResponse{{Pending: true}},
+			pollErr:  status.Error(codes.PermissionDenied, "access denied by the user"),
+		}
+
+		_, err := deviceLogin(context.Background(), client, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "access denied by the user")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		pollAfter = func(time.Duration) <-chan time.Time { return nil }
+
+		_, err := deviceLogin(ctx, &fakeOIDCClient{interval: 5}, &bytes.Buffer{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
 }
 
 func TestRegisterCommand(t *testing.T) {
@@ -124,6 +251,7 @@ func TestAuthCommand(t *testing.T) {
 		assert.True(t, commandNames["login"], "login command should be registered")
 		assert.True(t, commandNames["register"], "register command should be registered")
 		assert.True(t, commandNames["logout"], "logout command should be registered")
+		assert.True(t, commandNames["api-keys"], "api-keys command should be registered")
 	})
 }
 
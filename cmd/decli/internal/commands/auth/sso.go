@@ -0,0 +1,61 @@
+package authcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+)
+
+// slowDownStep is added to the polling interval when the provider asks the
+// client to slow down.
+const slowDownStep = 5 * time.Second
+
+// pollAfter waits between polls of a device login, replaced in tests.
+var pollAfter = time.After
+
+// deviceLogin logs in through the device flow of the identity provider of the
+// server: the user opens the verification URI in any browser while the login
+// is polled, and the access token is returned once the user completes it.
+func deviceLogin(ctx context.Context, client api.OIDCServiceClient, out io.Writer) (string, error) {
+	start, err := client.StartDeviceLogin(ctx, &api.StartOIDCDeviceLoginRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to start single sign-on: %w", err)
+	}
+
+	if start.VerificationUriComplete != "" {
+		_, _ = fmt.Fprintf(out, "Open %s to log in and check that it shows the code %s\n",
+			start.VerificationUriComplete, start.UserCode)
+	} else {
+		_, _ = fmt.Fprintf(out, "Open %s to log in and enter the code %s\n",
+			start.VerificationUri, start.UserCode)
+	}
+	_, _ = fmt.Fprintln(out, "Waiting for the login to complete...")
+
+	interval := time.Duration(start.Interval) * time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-pollAfter(interval):
+		}
+
+		resp, err := client.PollDeviceLogin(ctx, &api.PollOIDCDeviceLoginRequest{DeviceCode: start.DeviceCode})
+		if err != nil {
+			return "", fmt.Errorf("single sign-on failed: %w", err)
+		}
+		if resp.SlowDown {
+			interval += slowDownStep
+		}
+		if resp.Pending {
+			continue
+		}
+		if resp.Login == nil {
+			return "", errors.New("single sign-on failed: no tokens in the response")
+		}
+		return resp.Login.AccessToken, nil
+	}
+}
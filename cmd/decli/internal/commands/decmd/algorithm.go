@@ -0,0 +1,98 @@
+package decmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+// addAlgorithmFlags registers the parameters of every algorithm, CR, F and P
+// are shared by all of them.
+func addAlgorithmFlags(cmd *cobra.Command, cfg *config.DEConfig) {
+	fs := cmd.Flags()
+	fs.Float32Var(&cfg.GDE3.CR, "cr", 0.5, "value of the CR constant")
+	fs.Float32Var(&cfg.GDE3.F, "f", 0.5, "value of the F constant")
+	fs.Float32Var(&cfg.GDE3.P, "p", 0.5, "value of the P constant")
+
+	fs.StringVar(&cfg.GDE3.Adaptation, "adaptation", "", "gde3: F and CR adaptation, fixed, jade or shade (default: fixed)")
+	fs.Float32Var(&cfg.GDE3.LearningRate, "learning-rate", 0, "gde3: jade learning rate of the parameter means (default: server default)")
+	fs.Int64Var(&cfg.GDE3.MemorySize, "memory-size", 0, "gde3: shade success-history entries (default: server default)")
+
+	fs.Int64Var(&cfg.MOEAD.NeighborhoodSize, "neighborhood-size", 0, "moead: closest subproblems used for mating (default: server default)")
+	fs.StringVar(&cfg.MOEAD.Decomposition, "decomposition", "", "moead: decomposition, tchebycheff or pbi (default: tchebycheff)")
+	fs.Float32Var(&cfg.MOEAD.Theta, "theta", 0, "moead: penalty of the pbi decomposition (default: server default)")
+	fs.Float32Var(&cfg.MOEAD.Delta, "delta", 0, "moead: probability of mating within the neighbourhood (default: server default)")
+	fs.Int64Var(&cfg.MOEAD.MaxReplacements, "max-replacements", 0, "moead: subproblems a single offspring may replace (default: server default)")
+}
+
+// setAlgorithmConfig fills the algorithm specific configuration of pb.
+// Algorithms the CLI does not know about are sent without one and left for
+// the server to judge.
+func setAlgorithmConfig(pb *api.DEConfig, algorithm string, cfg config.DEConfig) error {
+	switch algorithm {
+	case "gde3":
+		adaptation, err := parseAdaptation(cfg.GDE3.Adaptation)
+		if err != nil {
+			return err
+		}
+		pb.AlgorithmConfig = &api.DEConfig_Gde3{Gde3: &api.GDE3Config{
+			Cr:           cfg.GDE3.CR,
+			F:            cfg.GDE3.F,
+			P:            cfg.GDE3.P,
+			Adaptation:   adaptation,
+			LearningRate: cfg.GDE3.LearningRate,
+			MemorySize:   cfg.GDE3.MemorySize,
+		}}
+	case "moead":
+		decomposition, err := parseDecomposition(cfg.MOEAD.Decomposition)
+		if err != nil {
+			return err
+		}
+		pb.AlgorithmConfig = &api.DEConfig_Moead{Moead: &api.MOEADConfig{
+			Cr:               cfg.GDE3.CR,
+			F:                cfg.GDE3.F,
+			P:                cfg.GDE3.P,
+			NeighborhoodSize: cfg.MOEAD.NeighborhoodSize,
+			Decomposition:    decomposition,
+			Theta:            cfg.MOEAD.Theta,
+			Delta:            cfg.MOEAD.Delta,
+			MaxReplacements:  cfg.MOEAD.MaxReplacements,
+		}}
+	case "nsga2":
+		pb.AlgorithmConfig = &api.DEConfig_Nsga2{Nsga2: &api.NSGA2Config{
+			Cr: cfg.GDE3.CR,
+			F:  cfg.GDE3.F,
+			P:  cfg.GDE3.P,
+		}}
+	}
+	return nil
+}
+
+// parseDecomposition converts a decomposition name such as "pbi" to its
+// proto value, an empty name leaves the choice to the server.
+func parseDecomposition(name string) (api.Decomposition, error) {
+	if name == "" {
+		return api.Decomposition_DECOMPOSITION_UNSPECIFIED, nil
+	}
+	value, ok := api.Decomposition_value["DECOMPOSITION_"+strings.ToUpper(name)]
+	if !ok || value == int32(api.Decomposition_DECOMPOSITION_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid decomposition %q (valid: tchebycheff, pbi)", name)
+	}
+	return api.Decomposition(value), nil
+}
+
+// parseAdaptation converts an adaptation name such as "shade" to its proto
+// value, an empty name leaves the choice to the server.
+func parseAdaptation(name string) (api.ParameterAdaptation, error) {
+	if name == "" {
+		return api.ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED, nil
+	}
+	value, ok := api.ParameterAdaptation_value["PARAMETER_ADAPTATION_"+strings.ToUpper(name)]
+	if !ok || value == int32(api.ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid adaptation %q (valid: fixed, jade, shade)", name)
+	}
+	return api.ParameterAdaptation(value), nil
+}
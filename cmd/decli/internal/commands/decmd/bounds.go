@@ -0,0 +1,86 @@
+package decmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+)
+
+// boundsValue is a repeatable flag where each value is the "floor:ceil"
+// domain of the next dimension.
+type boundsValue struct {
+	bounds *[]config.Bounds
+}
+
+func newBoundsValue(bounds *[]config.Bounds) *boundsValue {
+	return &boundsValue{bounds: bounds}
+}
+
+func (b *boundsValue) String() string {
+	if b.bounds == nil {
+		return ""
+	}
+	parts := make([]string, len(*b.bounds))
+	for i, bound := range *b.bounds {
+		parts[i] = formatBound(bound)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (b *boundsValue) Set(value string) error {
+	for part := range strings.SplitSeq(value, ",") {
+		bound, err := parseBound(part)
+		if err != nil {
+			return err
+		}
+		*b.bounds = append(*b.bounds, bound)
+	}
+	return nil
+}
+
+func (b *boundsValue) Type() string {
+	return "floor:ceil"
+}
+
+// parseBound parses a single "floor:ceil" pair.
+func parseBound(s string) (config.Bounds, error) {
+	floorStr, ceilStr, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return config.Bounds{}, fmt.Errorf("invalid bounds %q: expected floor:ceil", s)
+	}
+
+	floor, err := strconv.ParseFloat(floorStr, 64)
+	if err != nil {
+		return config.Bounds{}, fmt.Errorf("invalid floor in bounds %q: %w", s, err)
+	}
+
+	ceil, err := strconv.ParseFloat(ceilStr, 64)
+	if err != nil {
+		return config.Bounds{}, fmt.Errorf("invalid ceil in bounds %q: %w", s, err)
+	}
+
+	if floor >= ceil {
+		return config.Bounds{}, fmt.Errorf("invalid bounds %q: floor must be less than ceil", s)
+	}
+
+	return config.Bounds{Floor: floor, Ceil: ceil}, nil
+}
+
+func formatBound(b config.Bounds) string {
+	return strconv.FormatFloat(b.Floor, 'g', -1, 64) + ":" + strconv.FormatFloat(b.Ceil, 'g', -1, 64)
+}
+
+// boundsToPB converts the configured bounds, nil lets the server decide.
+func boundsToPB(bounds []config.Bounds) []*api.Bounds {
+	if len(bounds) == 0 {
+		return nil
+	}
+	pb := make([]*api.Bounds, len(bounds))
+	for i, b := range bounds {
+		pb[i] = &api.Bounds{Floor: b.Floor, Ceil: b.Ceil}
+	}
+	return pb
+}
@@ -0,0 +1,45 @@
+package decmd
+
+import (
+	"errors"
+
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+// customProblemFlags describe a custom problem named after --problem.
+type customProblemFlags struct {
+	Objectives  []string
+	Constraints []string
+	Description string
+	Save        bool
+}
+
+// addCustomProblemFlags registers the flags of an expression-based custom
+// problem.
+func addCustomProblemFlags(cmd *cobra.Command, f *customProblemFlags) {
+	fs := cmd.Flags()
+	fs.StringArrayVar(&f.Objectives, "objective", nil, "custom problem objective over x[i] to minimize, repeat once per objective")
+	fs.StringArrayVar(&f.Constraints, "constraint", nil, "custom problem constraint g(x) satisfied when g(x) <= 0, repeat once per constraint")
+	fs.StringVar(&f.Description, "description", "", "description of the custom problem")
+	fs.BoolVar(&f.Save, "save-problem", false, "save the custom problem to run it later by name")
+}
+
+// toPB converts the flags to a custom problem named name, nil when no
+// objective was given. The bounds of the run become the problem's bounds.
+func (f customProblemFlags) toPB(name string, bounds []config.Bounds) (*api.CustomProblem, error) {
+	if len(f.Objectives) == 0 {
+		if len(f.Constraints) > 0 || f.Description != "" || f.Save {
+			return nil, errors.New("custom problems need at least one --objective")
+		}
+		return nil, nil
+	}
+	return &api.CustomProblem{
+		Name:        name,
+		Description: f.Description,
+		Objectives:  f.Objectives,
+		Constraints: f.Constraints,
+		Bounds:      boundsToPB(bounds),
+	}, nil
+}
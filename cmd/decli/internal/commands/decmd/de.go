@@ -20,7 +20,7 @@ var deCmd = &cobra.Command{
 }
 
 // RegisterCommands adds the subset of commands into the provided cobra.Command
-func RegisterCommands(root *cobra.Command) { root.AddCommand(deCmd) }
+func RegisterCommands(root *cobra.Command) { root.AddCommand(deCmd, experimentCmd) }
 
 // SetupConfig sets the config of this package.
 func SetupConfig(rootCfg *config.Config) { cfg = rootCfg }
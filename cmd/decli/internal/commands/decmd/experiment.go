@@ -0,0 +1,280 @@
+package decmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	experimentFile         string
+	experimentFormat       string
+	experimentOutputFile   string
+	experimentParallel     int
+	experimentPollInterval time.Duration
+	experimentAlpha        float64
+)
+
+// experimentCmd encapsulates the experiment operations.
+var experimentCmd = &cobra.Command{
+	Use:   "experiment",
+	Short: "encapsulates experiments comparing DE configurations",
+	RunE:  func(cmd *cobra.Command, _ []string) error { return cmd.Help() },
+}
+
+// experimentRunCmd runs every execution of an experiment matrix and reports
+// the statistical comparison of their indicators.
+var experimentRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run an experiment matrix and report the statistical comparison of its configurations",
+	Long: `Run every combination of the algorithms, variants, parameter grid and problems
+listed in an experiment matrix, repeated with independent seeds, and report the
+indicators of each configuration.
+
+Every configuration is compared with the first one by a Wilcoxon rank-sum test
+on each problem, and all of them by a Friedman test over the problems.
+
+Example matrix:
+
+  name: gde3-crossovers
+  algorithms: [gde3]
+  variants: [rand1, best1]
+  problems: [zdt1, zdt2, zdt3]
+  repeats: 10
+  seed: 1
+  indicators: [hypervolume, igd]
+  de:
+    generations: 200
+    gde3: {cr: 0.9, f: 0.5, p: 0.1}
+  grid:
+    crossover: [binomial, exponential]
+    gde3.f: [0.5, 0.8]
+
+Named experiments reuse the executions they already submitted when run again.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if _, ok := experimentWriters[experimentFormat]; !ok {
+			return fmt.Errorf("invalid format: %s (valid: markdown, csv, latex)", experimentFormat)
+		}
+		if experimentParallel < 1 {
+			return fmt.Errorf("--parallel must be positive")
+		}
+
+		matrix, err := loadExperimentMatrix(experimentFile)
+		if err != nil {
+			return err
+		}
+		configurations, err := matrix.configurations()
+		if err != nil {
+			return err
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		runs := matrix.runs(configurations)
+		slog.Info("Running experiment",
+			"configurations", len(configurations),
+			"problems", len(matrix.Problems),
+			"executions", len(runs))
+		results := runExperiment(ctx, client, matrix, configurations, runs, experimentParallel, experimentPollInterval)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		report, err := newExperimentReport(matrix, configurations, results, experimentAlpha)
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = cmd.OutOrStdout()
+		if experimentOutputFile != "" {
+			f, err := os.OpenFile(experimentOutputFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				return fmt.Errorf("failed to create report file: %w", err)
+			}
+			defer func() { _ = f.Close() }()
+			out = f
+		}
+		if err := experimentWriters[experimentFormat](out, report); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		if experimentOutputFile != "" {
+			fmt.Printf("Report saved to: %s\n", experimentOutputFile)
+		}
+		if len(report.failures) > 0 {
+			return fmt.Errorf("%d of %d executions failed", len(report.failures), len(runs))
+		}
+		return nil
+	},
+}
+
+func init() {
+	experimentCmd.AddCommand(experimentRunCmd)
+	fs := experimentRunCmd.Flags()
+
+	fs.StringVarP(&experimentFile, "file", "f", "", "experiment matrix YAML file (required)")
+	_ = experimentRunCmd.MarkFlagRequired("file")
+
+	fs.StringVar(&experimentFormat, "format", "markdown", "report format: markdown, csv or latex")
+	fs.StringVarP(&experimentOutputFile, "output", "o", "", "report file (default: stdout)")
+	fs.IntVar(&experimentParallel, "parallel", 4, "executions submitted at once")
+	fs.DurationVar(&experimentPollInterval, "poll-interval", 2*time.Second, "interval between execution status checks")
+	fs.Float64Var(&experimentAlpha, "alpha", 0.05, "significance level of the statistical tests")
+}
+
+// experimentResult is the outcome of an experiment run.
+type experimentResult struct {
+	Run         experimentRun
+	ExecutionID string
+	Err         error
+	// Front holds the objectives of the Pareto front found.
+	Front      [][]float64
+	Indicators *api.Indicators
+}
+
+// runExperiment executes runs with at most parallel of them at once. Runs
+// that fail are recorded in their result without stopping the others.
+func runExperiment(
+	ctx context.Context,
+	client api.DifferentialEvolutionServiceClient,
+	matrix *experimentMatrix,
+	configurations []experimentConfiguration,
+	runs []experimentRun,
+	parallel int,
+	pollInterval time.Duration,
+) []experimentResult {
+	results := make([]experimentResult, len(runs))
+	queue := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		finished int
+	)
+	for range min(parallel, len(runs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				results[i] = executeExperimentRun(ctx, client, matrix, configurations[runs[i].Configuration], runs[i], pollInterval)
+
+				mu.Lock()
+				finished++
+				logger := slog.With("progress", fmt.Sprintf("%d/%d", finished, len(runs)),
+					"problem", runs[i].Problem,
+					"configuration", configurations[runs[i].Configuration].Label,
+					"repeat", runs[i].Repeat)
+				mu.Unlock()
+				if results[i].Err != nil {
+					logger.Warn("Experiment execution failed", slog.String("error", results[i].Err.Error()))
+				} else {
+					logger.Info("Experiment execution completed", "execution_id", results[i].ExecutionID)
+				}
+			}
+		}()
+	}
+
+	for i := range runs {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+	return results
+}
+
+// executeExperimentRun submits run and waits for its results.
+func executeExperimentRun(
+	ctx context.Context,
+	client api.DifferentialEvolutionServiceClient,
+	matrix *experimentMatrix,
+	cfg experimentConfiguration,
+	run experimentRun,
+	pollInterval time.Duration,
+) experimentResult {
+	result := experimentResult{Run: run}
+
+	de := cfg.DE
+	de.Seed = matrix.seed(run.Repeat)
+	deConfig, err := newDEConfig(cfg.Algorithm, de)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	key, err := matrix.idempotencyKey(cfg, run)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	resp, err := client.RunAsync(ctx, &api.RunAsyncRequest{
+		Algorithm:      cfg.Algorithm,
+		Variant:        cfg.Variant,
+		Problem:        run.Problem,
+		DeConfig:       deConfig,
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("failed to submit execution: %w", err)
+		return result
+	}
+	result.ExecutionID = resp.ExecutionId
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		statusResp, err := client.GetExecutionStatus(ctx, &api.GetExecutionStatusRequest{
+			ExecutionId: result.ExecutionID,
+		})
+		if err != nil {
+			result.Err = fmt.Errorf("failed to get execution status: %w", err)
+			return result
+		}
+
+		switch statusResp.Execution.Status {
+		case api.ExecutionStatus_EXECUTION_STATUS_COMPLETED:
+			resultsResp, err := client.GetExecutionResults(ctx, &api.GetExecutionResultsRequest{
+				ExecutionId: result.ExecutionID,
+			})
+			if err != nil {
+				result.Err = fmt.Errorf("failed to get results: %w", err)
+				return result
+			}
+			for _, v := range resultsResp.GetPareto().GetVectors() {
+				result.Front = append(result.Front, v.Objectives)
+			}
+			result.Indicators = resultsResp.Indicators
+			return result
+
+		case api.ExecutionStatus_EXECUTION_STATUS_FAILED:
+			result.Err = fmt.Errorf("execution failed: %s", statusResp.Execution.Error)
+			return result
+
+		case api.ExecutionStatus_EXECUTION_STATUS_CANCELLED:
+			result.Err = fmt.Errorf("execution was cancelled")
+			return result
+		}
+
+		select {
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			return result
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package decmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+// experimentIndicators are the indicators an experiment can compare, mapped
+// to whether larger values are better.
+var experimentIndicators = map[string]bool{
+	"hypervolume": true,
+	"igd":         false,
+	"igd_plus":    false,
+	"gd":          false,
+	"spread":      false,
+	"spacing":     false,
+}
+
+// experimentMatrix is the YAML definition of an experiment. Every algorithm
+// runs with every variant, every point of the parameter grid and on every
+// problem, repeats times.
+type experimentMatrix struct {
+	Name       string   `yaml:"name"`
+	Algorithms []string `yaml:"algorithms"`
+	Variants   []string `yaml:"variants"`
+	Problems   []string `yaml:"problems"`
+	// Repeats is the number of independent runs of each configuration on
+	// each problem, repeat r being seeded with Seed+r.
+	Repeats int   `yaml:"repeats"`
+	Seed    int64 `yaml:"seed"` // zero starts from 1
+	// DE is the configuration shared by every run, unset values use the
+	// defaults of the run command.
+	DE config.DEConfig `yaml:"de"`
+	// Grid maps paths of DE, such as gde3.cr, to the values to try.
+	Grid map[string][]any `yaml:"grid"`
+	// Indicators are compared in the report, hypervolume by default.
+	Indicators []string `yaml:"indicators"`
+	// ReferencePoints are the hypervolume reference points of problems, by
+	// default the nadir point of every front found for the problem plus a
+	// margin.
+	ReferencePoints map[string][]float64 `yaml:"reference_points"`
+}
+
+// experimentConfiguration is an algorithm and variant with a point of the
+// parameter grid.
+type experimentConfiguration struct {
+	Label     string
+	Algorithm string
+	Variant   string
+	DE        config.DEConfig
+}
+
+// experimentRun is a single execution of an experiment.
+type experimentRun struct {
+	Configuration int // index within the configurations
+	Problem       string
+	Repeat        int
+}
+
+// loadExperimentMatrix reads and validates the matrix in path.
+func loadExperimentMatrix(path string) (*experimentMatrix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read experiment matrix: %w", err)
+	}
+	return parseExperimentMatrix(data)
+}
+
+// parseExperimentMatrix decodes and validates a matrix, filling its defaults.
+func parseExperimentMatrix(data []byte) (*experimentMatrix, error) {
+	m := &experimentMatrix{DE: defaultExperimentDEConfig()}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(m); err != nil {
+		return nil, fmt.Errorf("invalid experiment matrix: %w", err)
+	}
+
+	switch {
+	case len(m.Algorithms) == 0:
+		return nil, errors.New("experiment matrix needs at least one algorithm")
+	case len(m.Variants) == 0:
+		return nil, errors.New("experiment matrix needs at least one variant")
+	case len(m.Problems) == 0:
+		return nil, errors.New("experiment matrix needs at least one problem")
+	case m.Repeats < 1:
+		return nil, errors.New("experiment matrix repeats must be positive")
+	}
+	if m.Seed == 0 {
+		m.Seed = 1
+	}
+	if len(m.Indicators) == 0 {
+		m.Indicators = []string{"hypervolume"}
+	}
+	for _, name := range m.Indicators {
+		if _, ok := experimentIndicators[name]; !ok {
+			return nil, fmt.Errorf(
+				"invalid indicator %q (valid: hypervolume, igd, igd_plus, gd, spread, spacing)", name,
+			)
+		}
+	}
+	for path, values := range m.Grid {
+		if len(values) == 0 {
+			return nil, fmt.Errorf("grid parameter %q has no values", path)
+		}
+	}
+	return m, nil
+}
+
+// defaultExperimentDEConfig matches the flag defaults of the run command.
+func defaultExperimentDEConfig() config.DEConfig {
+	return config.DEConfig{
+		Executions:     1,
+		Generations:    100,
+		PopulationSize: 100,
+		DimensionsSize: 30,
+		ObjectivesSize: 2,
+		GDE3:           config.GDE3Config{CR: 0.5, F: 0.5, P: 0.5},
+	}
+}
+
+// configurations expands the algorithms, variants and parameter grid, the
+// first configuration being the baseline of the comparisons.
+func (m *experimentMatrix) configurations() ([]experimentConfiguration, error) {
+	paths := make([]string, 0, len(m.Grid))
+	for path := range m.Grid {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	// Cartesian product of the grid, the last path varying fastest
+	points := [][]any{{}}
+	for _, path := range paths {
+		var next [][]any
+		for _, point := range points {
+			for _, value := range m.Grid[path] {
+				next = append(next, append(slices.Clone(point), value))
+			}
+		}
+		points = next
+	}
+
+	var configurations []experimentConfiguration
+	for _, algorithm := range m.Algorithms {
+		for _, variant := range m.Variants {
+			for _, point := range points {
+				cfg := m.DE
+				label := []string{algorithm + "/" + variant}
+				for i, path := range paths {
+					var err error
+					if cfg, err = setDEConfigPath(cfg, path, point[i]); err != nil {
+						return nil, err
+					}
+					label = append(label, fmt.Sprintf("%s=%v", path, point[i]))
+				}
+				configurations = append(configurations, experimentConfiguration{
+					Label:     strings.Join(label, " "),
+					Algorithm: algorithm,
+					Variant:   variant,
+					DE:        cfg,
+				})
+			}
+		}
+	}
+	return configurations, nil
+}
+
+// runs lists every execution of the experiment, grouped by problem.
+func (m *experimentMatrix) runs(configurations []experimentConfiguration) []experimentRun {
+	runs := make([]experimentRun, 0, len(m.Problems)*len(configurations)*m.Repeats)
+	for _, problem := range m.Problems {
+		for i := range configurations {
+			for repeat := range m.Repeats {
+				runs = append(runs, experimentRun{Configuration: i, Problem: problem, Repeat: repeat})
+			}
+		}
+	}
+	return runs
+}
+
+// seed returns the seed of the given repeat, shared by every configuration
+// so they start from the same populations.
+func (m *experimentMatrix) seed(repeat int) int64 { return m.Seed + int64(repeat) }
+
+// idempotencyKey identifies a run of a named experiment, so running the
+// matrix again reuses the executions it already submitted. Unnamed
+// experiments always submit new executions.
+func (m *experimentMatrix) idempotencyKey(cfg experimentConfiguration, run experimentRun) (string, error) {
+	if m.Name == "" {
+		return "", nil
+	}
+	de, err := yaml.Marshal(cfg.DE)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n%s\n%d\n", m.Name, cfg.Algorithm, cfg.Variant, run.Problem, m.seed(run.Repeat))
+	hash.Write(de)
+	return "experiment-" + hex.EncodeToString(hash.Sum(nil))[:32], nil
+}
+
+// setDEConfigPath returns cfg with the value at the dot separated YAML path
+// replaced, such as gde3.cr or crossover.
+func setDEConfigPath(cfg config.DEConfig, path string, value any) (config.DEConfig, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return cfg, err
+	}
+	tree := map[string]any{}
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return cfg, err
+	}
+
+	keys := strings.Split(path, ".")
+	node := tree
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			return cfg, fmt.Errorf("invalid grid parameter %q: %q is not a section", path, key)
+		}
+		node = child
+	}
+	node[keys[len(keys)-1]] = value
+
+	if data, err = yaml.Marshal(tree); err != nil {
+		return cfg, err
+	}
+	var updated config.DEConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&updated); err != nil {
+		return cfg, fmt.Errorf("invalid grid parameter %q: %w", path, err)
+	}
+	return updated, nil
+}
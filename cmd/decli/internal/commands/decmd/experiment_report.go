@@ -0,0 +1,454 @@
+package decmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/stats"
+)
+
+// Outcomes of comparing a configuration with the baseline.
+const (
+	outcomeBaseline = "baseline"
+	outcomeBetter   = "better"
+	outcomeWorse    = "worse"
+	outcomeSimilar  = "similar"
+)
+
+// experimentReport compares the indicators of the configurations of an
+// experiment.
+type experimentReport struct {
+	name           string
+	alpha          float64
+	configurations []string
+	comparisons    []indicatorComparison
+	rankings       []friedmanRanking
+	// failures describes the executions left out of the report.
+	failures []string
+}
+
+// indicatorComparison compares the configurations on a problem for a
+// single indicator, against the first configuration.
+type indicatorComparison struct {
+	problem   string
+	indicator string
+	summaries []stats.Summary
+	// pValues of the rank-sum test against the baseline, NaN when it could
+	// not be run.
+	pValues  []float64
+	outcomes []string
+}
+
+// friedmanRanking ranks the configurations over every problem for a single
+// indicator, rank 1 being the best.
+type friedmanRanking struct {
+	indicator string
+	result    stats.FriedmanResult
+	// note explains why the test was not run or which problems it skipped.
+	note string
+}
+
+// newExperimentReport computes the indicators of every successful result.
+// The hypervolume is recomputed with a reference point shared by every run
+// of a problem, as the one chosen by the server depends on each front.
+func newExperimentReport(
+	matrix *experimentMatrix,
+	configurations []experimentConfiguration,
+	results []experimentResult,
+	alpha float64,
+) (*experimentReport, error) {
+	report := &experimentReport{name: matrix.Name, alpha: alpha}
+	for _, cfg := range configurations {
+		report.configurations = append(report.configurations, cfg.Label)
+	}
+
+	// values[indicator][problem][configuration]
+	values := map[string][][][]float64{}
+	for _, indicator := range matrix.Indicators {
+		values[indicator] = make([][][]float64, len(matrix.Problems))
+		for p := range matrix.Problems {
+			values[indicator][p] = make([][]float64, len(configurations))
+		}
+	}
+
+	for p, problem := range matrix.Problems {
+		var (
+			points   [][]float64
+			problemR []experimentResult
+		)
+		for _, result := range results {
+			if result.Run.Problem != problem {
+				continue
+			}
+			if result.Err != nil {
+				report.failures = append(report.failures, fmt.Sprintf("%s on %s, repeat %d: %v",
+					configurations[result.Run.Configuration].Label, problem, result.Run.Repeat, result.Err))
+				continue
+			}
+			points = append(points, result.Front...)
+			problemR = append(problemR, result)
+		}
+
+		ref := matrix.ReferencePoints[problem]
+		if len(ref) == 0 {
+			ref = indicators.ReferencePoint(points, indicators.DefaultReferenceMargin)
+		} else if len(points) > 0 && len(ref) != len(points[0]) {
+			return nil, fmt.Errorf("reference point of %s has %d objectives, its fronts %d",
+				problem, len(ref), len(points[0]))
+		}
+
+		for _, result := range problemR {
+			for _, indicator := range matrix.Indicators {
+				if value, ok := experimentIndicatorValue(indicator, result, ref); ok {
+					cells := values[indicator][p]
+					cells[result.Run.Configuration] = append(cells[result.Run.Configuration], value)
+				}
+			}
+		}
+	}
+
+	for _, indicator := range matrix.Indicators {
+		for p, problem := range matrix.Problems {
+			report.comparisons = append(report.comparisons,
+				compareConfigurations(problem, indicator, values[indicator][p], alpha))
+		}
+		report.rankings = append(report.rankings,
+			rankConfigurations(indicator, matrix.Problems, values[indicator]))
+	}
+	return report, nil
+}
+
+// experimentIndicatorValue returns the value of indicator for result, false
+// when the server did not compute it.
+func experimentIndicatorValue(indicator string, result experimentResult, ref []float64) (float64, bool) {
+	if indicator == "hypervolume" {
+		front := make([]models.Vector, len(result.Front))
+		for i, objs := range result.Front {
+			front[i] = models.Vector{Objectives: objs}
+		}
+		return indicators.Compute(front, indicators.WithReferencePoint(ref)).Hypervolume, true
+	}
+
+	values := result.Indicators
+	if values == nil {
+		return 0, false
+	}
+	var value *float64
+	switch indicator {
+	case "igd":
+		value = values.Igd
+	case "igd_plus":
+		value = values.IgdPlus
+	case "gd":
+		value = values.Gd
+	case "spread":
+		value = &values.Spread
+	case "spacing":
+		value = &values.Spacing
+	}
+	if value == nil {
+		return 0, false
+	}
+	return *value, true
+}
+
+// compareConfigurations summarizes samples, one per configuration, and
+// tests each of them against the first.
+func compareConfigurations(problem, indicator string, samples [][]float64, alpha float64) indicatorComparison {
+	c := indicatorComparison{
+		problem:   problem,
+		indicator: indicator,
+		summaries: make([]stats.Summary, len(samples)),
+		pValues:   make([]float64, len(samples)),
+		outcomes:  make([]string, len(samples)),
+	}
+	higherIsBetter := experimentIndicators[indicator]
+	for i, sample := range samples {
+		c.summaries[i] = stats.Summarize(sample)
+		c.pValues[i] = math.NaN()
+		if i == 0 {
+			c.outcomes[i] = outcomeBaseline
+			continue
+		}
+
+		test, err := stats.WilcoxonRankSum(sample, samples[0])
+		if err != nil {
+			continue
+		}
+		c.pValues[i] = test.P
+		switch {
+		case test.P >= alpha:
+			c.outcomes[i] = outcomeSimilar
+		case (test.Z > 0) == higherIsBetter:
+			c.outcomes[i] = outcomeBetter
+		default:
+			c.outcomes[i] = outcomeWorse
+		}
+	}
+	return c
+}
+
+// rankConfigurations runs the Friedman test on the mean indicator values of
+// the configurations, each problem being a block. Problems some
+// configuration has no value for are skipped.
+func rankConfigurations(indicator string, problems []string, values [][][]float64) friedmanRanking {
+	ranking := friedmanRanking{indicator: indicator}
+	higherIsBetter := experimentIndicators[indicator]
+
+	var (
+		blocks  [][]float64
+		skipped []string
+	)
+	for p, samples := range values {
+		block := make([]float64, len(samples))
+		complete := true
+		for i, sample := range samples {
+			if len(sample) == 0 {
+				complete = false
+				break
+			}
+			block[i] = stats.Summarize(sample).Mean
+			if higherIsBetter {
+				// Rank the largest values first
+				block[i] = -block[i]
+			}
+		}
+		if !complete {
+			skipped = append(skipped, problems[p])
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+
+	result, err := stats.Friedman(blocks)
+	switch {
+	case err != nil:
+		ranking.note = fmt.Sprintf("not run: %v", err)
+	case len(skipped) > 0:
+		ranking.note = "skipped problems with failed configurations: " + strings.Join(skipped, ", ")
+	}
+	ranking.result = result
+	return ranking
+}
+
+// experimentWriters render a report in each supported format.
+var experimentWriters = map[string]func(io.Writer, *experimentReport) error{
+	"markdown": writeExperimentMarkdown,
+	"csv":      writeExperimentCSV,
+	"latex":    writeExperimentLaTeX,
+}
+
+// reportTable is a table of the report, shared by the Markdown and LaTeX
+// formats.
+type reportTable struct {
+	title  string
+	header []string
+	rows   [][]string
+	note   string
+}
+
+// tables lays out the report, marks are +, - and ~ for configurations
+// significantly better, worse or alike the baseline.
+func (r *experimentReport) tables() []reportTable {
+	var tables []reportTable
+	for _, c := range r.comparisons {
+		direction := "lower is better"
+		if experimentIndicators[c.indicator] {
+			direction = "higher is better"
+		}
+		table := reportTable{
+			title:  fmt.Sprintf("%s: %s (%s)", c.problem, c.indicator, direction),
+			header: []string{"Configuration", "N", "Mean", "Median", "Std", "p-value", "vs baseline"},
+		}
+		for i, label := range r.configurations {
+			s := c.summaries[i]
+			row := []string{label, strconv.Itoa(s.N), "-", "-", "-", formatPValue(c.pValues[i]), outcomeMark(c.outcomes[i])}
+			if s.N > 0 {
+				row[2], row[3], row[4] = formatValue(s.Mean), formatValue(s.Median), formatValue(s.StdDev)
+			}
+			table.rows = append(table.rows, row)
+		}
+		tables = append(tables, table)
+	}
+
+	for _, ranking := range r.rankings {
+		table := reportTable{
+			title:  "Friedman test: " + ranking.indicator,
+			header: []string{"Configuration", "Mean rank"},
+			note:   ranking.note,
+		}
+		if len(ranking.result.MeanRanks) == 0 {
+			tables = append(tables, table)
+			continue
+		}
+		for i, label := range r.configurations {
+			table.rows = append(table.rows, []string{label, fmt.Sprintf("%.2f", ranking.result.MeanRanks[i])})
+		}
+		summary := fmt.Sprintf("chi-square = %.4f, df = %d, p-value = %s",
+			ranking.result.Statistic, ranking.result.DF, formatPValue(ranking.result.P))
+		table.note = strings.TrimSuffix(summary+"; "+ranking.note, "; ")
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func writeExperimentMarkdown(w io.Writer, r *experimentReport) error {
+	var b strings.Builder
+	title := "Experiment"
+	if r.name != "" {
+		title += " " + r.name
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "Configurations are compared with %s by a Wilcoxon rank-sum test at alpha = %g: "+
+		"+ better, - worse, ~ no significant difference.\n", r.configurations[0], r.alpha)
+
+	for _, table := range r.tables() {
+		fmt.Fprintf(&b, "\n## %s\n\n", table.title)
+		if len(table.rows) > 0 {
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(table.header, " | "))
+			b.WriteString("|" + strings.Repeat(" --- |", len(table.header)) + "\n")
+			for _, row := range table.rows {
+				fmt.Fprintf(&b, "| %s |\n", strings.Join(row, " | "))
+			}
+		}
+		if table.note != "" {
+			if len(table.rows) > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "%s\n", table.note)
+		}
+	}
+
+	if len(r.failures) > 0 {
+		b.WriteString("\n## Failed executions\n\n")
+		for _, failure := range r.failures {
+			fmt.Fprintf(&b, "- %s\n", failure)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeExperimentCSV writes a row per problem, indicator and configuration,
+// the Friedman columns repeating the test of the indicator.
+func writeExperimentCSV(w io.Writer, r *experimentReport) error {
+	rankings := map[string]friedmanRanking{}
+	for _, ranking := range r.rankings {
+		rankings[ranking.indicator] = ranking
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"problem", "indicator", "configuration", "n", "mean", "median", "std", "min", "max",
+		"p_value", "vs_baseline", "friedman_mean_rank", "friedman_p_value",
+	}); err != nil {
+		return err
+	}
+	for _, c := range r.comparisons {
+		ranking := rankings[c.indicator]
+		for i, label := range r.configurations {
+			s := c.summaries[i]
+			row := []string{c.problem, c.indicator, label, strconv.Itoa(s.N), "", "", "", "", "", "", c.outcomes[i], "", ""}
+			if s.N > 0 {
+				row[4], row[5], row[6] = csvFloat(s.Mean), csvFloat(s.Median), csvFloat(s.StdDev)
+				row[7], row[8] = csvFloat(s.Min), csvFloat(s.Max)
+			}
+			if !math.IsNaN(c.pValues[i]) {
+				row[9] = csvFloat(c.pValues[i])
+			}
+			if len(ranking.result.MeanRanks) > 0 {
+				row[11], row[12] = csvFloat(ranking.result.MeanRanks[i]), csvFloat(ranking.result.P)
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeExperimentLaTeX(w io.Writer, r *experimentReport) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%% Configurations are compared with %s by a Wilcoxon rank-sum test at alpha = %g.\n",
+		r.configurations[0], r.alpha)
+	for _, failure := range r.failures {
+		fmt.Fprintf(&b, "%% Failed execution: %s\n", failure)
+	}
+
+	for _, table := range r.tables() {
+		caption := latexEscape(table.title)
+		if table.note != "" {
+			caption += ". " + latexEscape(table.note)
+		}
+		if len(table.rows) == 0 {
+			fmt.Fprintf(&b, "\n%% %s\n", caption)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n\\begin{table}[htbp]\n\\centering\n\\caption{%s}\n", caption)
+		fmt.Fprintf(&b, "\\begin{tabular}{l%s}\n\\hline\n", strings.Repeat("r", len(table.header)-1))
+		b.WriteString(latexRow(table.header) + "\\hline\n")
+		for _, row := range table.rows {
+			b.WriteString(latexRow(row))
+		}
+		b.WriteString("\\hline\n\\end{tabular}\n\\end{table}\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func latexRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = latexEscape(cell)
+	}
+	return strings.Join(escaped, " & ") + " \\\\\n"
+}
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `$\sim$`,
+	`^`, `\textasciicircum{}`,
+)
+
+func latexEscape(s string) string { return latexReplacer.Replace(s) }
+
+func outcomeMark(outcome string) string {
+	switch outcome {
+	case outcomeBetter:
+		return "+"
+	case outcomeWorse:
+		return "-"
+	case outcomeSimilar:
+		return "~"
+	}
+	return outcome
+}
+
+func formatValue(v float64) string { return strconv.FormatFloat(v, 'g', 4, 64) }
+
+func formatPValue(p float64) string {
+	switch {
+	case math.IsNaN(p):
+		return "-"
+	case p < 1e-4:
+		return "<0.0001"
+	}
+	return fmt.Sprintf("%.4f", p)
+}
+
+func csvFloat(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
@@ -0,0 +1,370 @@
+package decmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const testExperimentMatrix = `
+name: crossovers
+algorithms: [gde3]
+variants: [rand1, best1]
+problems: [zdt1, zdt2]
+repeats: 3
+seed: 10
+indicators: [hypervolume, igd]
+de:
+  generations: 50
+  gde3: {cr: 0.9, f: 0.5, p: 0.1}
+grid:
+  gde3.f: [0.5, 0.8]
+  crossover: [binomial, exponential]
+`
+
+func TestParseExperimentMatrix(t *testing.T) {
+	t.Run("fills defaults", func(t *testing.T) {
+		m, err := parseExperimentMatrix([]byte("algorithms: [gde3]\nvariants: [rand1]\nproblems: [zdt1]\nrepeats: 2\n"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), m.Seed)
+		assert.Equal(t, []string{"hypervolume"}, m.Indicators)
+		assert.Equal(t, defaultExperimentDEConfig(), m.DE)
+	})
+
+	t.Run("keeps defaults of unset DE values", func(t *testing.T) {
+		m, err := parseExperimentMatrix([]byte(testExperimentMatrix))
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), m.DE.Generations)
+		assert.Equal(t, int64(100), m.DE.PopulationSize)
+		assert.Equal(t, float32(0.1), m.DE.GDE3.P)
+	})
+
+	tests := []struct {
+		name   string
+		matrix string
+		errMsg string
+	}{
+		{"no algorithms", "variants: [rand1]\nproblems: [zdt1]\nrepeats: 1", "algorithm"},
+		{"no variants", "algorithms: [gde3]\nproblems: [zdt1]\nrepeats: 1", "variant"},
+		{"no problems", "algorithms: [gde3]\nvariants: [rand1]\nrepeats: 1", "problem"},
+		{"no repeats", "algorithms: [gde3]\nvariants: [rand1]\nproblems: [zdt1]", "repeats"},
+		{"unknown indicator", "algorithms: [gde3]\nvariants: [rand1]\nproblems: [zdt1]\nrepeats: 1\nindicators: [epsilon]", "indicator"},
+		{"empty grid", "algorithms: [gde3]\nvariants: [rand1]\nproblems: [zdt1]\nrepeats: 1\ngrid: {crossover: []}", "no values"},
+		{"unknown field", "algorithms: [gde3]\nvariants: [rand1]\nproblems: [zdt1]\nrepeats: 1\nrepeat: 2", "repeat"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseExperimentMatrix([]byte(tt.matrix))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestExperimentMatrix_Configurations(t *testing.T) {
+	m, err := parseExperimentMatrix([]byte(testExperimentMatrix))
+	require.NoError(t, err)
+
+	configurations, err := m.configurations()
+	require.NoError(t, err)
+	require.Len(t, configurations, 8)
+
+	first := configurations[0]
+	assert.Equal(t, "gde3/rand1 crossover=binomial gde3.f=0.5", first.Label)
+	assert.Equal(t, "binomial", first.DE.Crossover)
+	assert.Equal(t, float32(0.5), first.DE.GDE3.F)
+	assert.Equal(t, float32(0.9), first.DE.GDE3.CR)
+	assert.Equal(t, int64(50), first.DE.Generations)
+
+	assert.Equal(t, "gde3/rand1 crossover=binomial gde3.f=0.8", configurations[1].Label)
+	assert.Equal(t, float32(0.8), configurations[1].DE.GDE3.F)
+	assert.Equal(t, "gde3/best1 crossover=exponential gde3.f=0.8", configurations[7].Label)
+	assert.Equal(t, "best1", configurations[7].Variant)
+	// The base configuration is left untouched
+	assert.Empty(t, m.DE.Crossover)
+
+	runs := m.runs(configurations)
+	assert.Len(t, runs, 2*8*3)
+	assert.Equal(t, experimentRun{Configuration: 0, Problem: "zdt1", Repeat: 0}, runs[0])
+	assert.Equal(t, experimentRun{Configuration: 7, Problem: "zdt2", Repeat: 2}, runs[len(runs)-1])
+
+	t.Run("invalid grid paths", func(t *testing.T) {
+		for _, path := range []string{"gde3.unknown", "crossover.type", "unknown"} {
+			m := &experimentMatrix{
+				Algorithms: []string{"gde3"},
+				Variants:   []string{"rand1"},
+				DE:         defaultExperimentDEConfig(),
+				Grid:       map[string][]any{path: {1}},
+			}
+			_, err := m.configurations()
+			assert.Error(t, err, path)
+		}
+	})
+}
+
+func TestExperimentMatrix_IdempotencyKey(t *testing.T) {
+	m, err := parseExperimentMatrix([]byte(testExperimentMatrix))
+	require.NoError(t, err)
+	configurations, err := m.configurations()
+	require.NoError(t, err)
+
+	run := experimentRun{Problem: "zdt1"}
+	key, err := m.idempotencyKey(configurations[0], run)
+	require.NoError(t, err)
+	again, err := m.idempotencyKey(configurations[0], run)
+	require.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	other, err := m.idempotencyKey(configurations[1], run)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	repeat, err := m.idempotencyKey(configurations[0], experimentRun{Problem: "zdt1", Repeat: 1})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, repeat)
+
+	m.Name = ""
+	unnamed, err := m.idempotencyKey(configurations[0], run)
+	require.NoError(t, err)
+	assert.Empty(t, unnamed)
+}
+
+// fakeExperimentClient completes every execution after a status check,
+// failing the ones of failVariant. Better variants find fronts closer to
+// the origin.
+type fakeExperimentClient struct {
+	api.DifferentialEvolutionServiceClient
+
+	failVariant string
+
+	mu       sync.Mutex
+	requests map[string]*api.RunAsyncRequest
+	polled   map[string]bool
+}
+
+func (f *fakeExperimentClient) RunAsync(
+	_ context.Context, in *api.RunAsyncRequest, _ ...grpc.CallOption,
+) (*api.RunAsyncResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.requests == nil {
+		f.requests, f.polled = map[string]*api.RunAsyncRequest{}, map[string]bool{}
+	}
+	id := fmt.Sprintf("exec-%d", len(f.requests))
+	f.requests[id] = in
+	return &api.RunAsyncResponse{ExecutionId: id}, nil
+}
+
+func (f *fakeExperimentClient) GetExecutionStatus(
+	_ context.Context, in *api.GetExecutionStatusRequest, _ ...grpc.CallOption,
+) (*api.GetExecutionStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	execution := &api.Execution{Id: in.ExecutionId, Status: api.ExecutionStatus_EXECUTION_STATUS_RUNNING}
+	switch {
+	case !f.polled[in.ExecutionId]:
+		f.polled[in.ExecutionId] = true
+	case f.requests[in.ExecutionId].Variant == f.failVariant:
+		execution.Status = api.ExecutionStatus_EXECUTION_STATUS_FAILED
+		execution.Error = "boom"
+	default:
+		execution.Status = api.ExecutionStatus_EXECUTION_STATUS_COMPLETED
+	}
+	return &api.GetExecutionStatusResponse{Execution: execution}, nil
+}
+
+func (f *fakeExperimentClient) GetExecutionResults(
+	_ context.Context, in *api.GetExecutionResultsRequest, _ ...grpc.CallOption,
+) (*api.GetExecutionResultsResponse, error) {
+	f.mu.Lock()
+	req := f.requests[in.ExecutionId]
+	f.mu.Unlock()
+
+	// Seeds add noise, the best1 variant shifts the front towards the origin
+	offset := 1 + float64(req.DeConfig.GetSeed())/100
+	if req.Variant == "best1" {
+		offset -= 0.5
+	}
+	igd := offset
+	return &api.GetExecutionResultsResponse{
+		Pareto: &api.Pareto{Vectors: []*api.Vector{
+			{Objectives: []float64{offset, offset + 1}},
+			{Objectives: []float64{offset + 1, offset}},
+		}},
+		Indicators: &api.Indicators{Igd: &igd, Spacing: 0.5},
+	}, nil
+}
+
+func TestRunExperiment(t *testing.T) {
+	m, err := parseExperimentMatrix([]byte(
+		"name: test\nalgorithms: [gde3]\nvariants: [rand1, best1, worst]\nproblems: [zdt1]\nrepeats: 8\nseed: 5\n",
+	))
+	require.NoError(t, err)
+	configurations, err := m.configurations()
+	require.NoError(t, err)
+	runs := m.runs(configurations)
+
+	client := &fakeExperimentClient{failVariant: "worst"}
+	results := runExperiment(context.Background(), client, m, configurations, runs, 3, time.Millisecond)
+	require.Len(t, results, len(runs))
+
+	for i, result := range results {
+		assert.Equal(t, runs[i], result.Run)
+		req := client.requests[result.ExecutionID]
+		require.NotNil(t, req)
+		assert.Equal(t, configurations[runs[i].Configuration].Variant, req.Variant)
+		assert.Equal(t, m.seed(runs[i].Repeat), req.DeConfig.GetSeed())
+		assert.NotEmpty(t, req.IdempotencyKey)
+		assert.NotNil(t, req.DeConfig.GetGde3())
+
+		if req.Variant == "worst" {
+			assert.ErrorContains(t, result.Err, "boom")
+			continue
+		}
+		require.NoError(t, result.Err)
+		assert.Len(t, result.Front, 2)
+		assert.NotNil(t, result.Indicators)
+	}
+
+	t.Run("stops submitting when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results := runExperiment(ctx, &fakeExperimentClient{}, m, configurations, runs, 2, time.Millisecond)
+		submitted := 0
+		for _, result := range results {
+			if result.ExecutionID != "" || result.Err != nil {
+				submitted++
+			}
+		}
+		assert.Less(t, submitted, len(runs))
+	})
+}
+
+func TestExperimentReport(t *testing.T) {
+	m, err := parseExperimentMatrix([]byte(
+		"name: test_report\nalgorithms: [gde3]\nvariants: [rand1, best1, worst]\nproblems: [zdt1, zdt2]\n" +
+			"repeats: 8\nindicators: [hypervolume, igd, gd]\n",
+	))
+	require.NoError(t, err)
+	configurations, err := m.configurations()
+	require.NoError(t, err)
+	runs := m.runs(configurations)
+	results := runExperiment(context.Background(), &fakeExperimentClient{failVariant: "worst"},
+		m, configurations, runs, 4, time.Millisecond)
+
+	report, err := newExperimentReport(m, configurations, results, 0.05)
+	require.NoError(t, err)
+	assert.Len(t, report.failures, 2*8)
+	require.Len(t, report.comparisons, 3*2)
+
+	hypervolume := report.comparisons[0]
+	assert.Equal(t, "zdt1", hypervolume.problem)
+	assert.Equal(t, "hypervolume", hypervolume.indicator)
+	assert.Equal(t, 8, hypervolume.summaries[0].N)
+	assert.Equal(t, []string{outcomeBaseline, outcomeBetter, ""}, hypervolume.outcomes)
+	assert.Less(t, hypervolume.pValues[1], 0.05)
+	assert.Zero(t, hypervolume.summaries[2].N)
+
+	igd := report.comparisons[2]
+	assert.Equal(t, "igd", igd.indicator)
+	assert.Equal(t, outcomeBetter, igd.outcomes[1])
+	assert.Less(t, igd.summaries[1].Mean, igd.summaries[0].Mean)
+
+	// The server did not compute the GD of any run
+	gd := report.comparisons[4]
+	assert.Equal(t, "gd", gd.indicator)
+	assert.Zero(t, gd.summaries[0].N)
+
+	// Every problem misses the failed configuration
+	require.Len(t, report.rankings, 3)
+	assert.Contains(t, report.rankings[0].note, "not run")
+
+	t.Run("shared reference point", func(t *testing.T) {
+		m.ReferencePoints = map[string][]float64{"zdt1": {10, 10}, "zdt2": {10, 10, 10}}
+		_, err := newExperimentReport(m, configurations, results, 0.05)
+		assert.ErrorContains(t, err, "zdt2")
+		m.ReferencePoints = nil
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, writeExperimentMarkdown(&b, report))
+		out := b.String()
+		assert.Contains(t, out, "# Experiment test_report")
+		assert.Contains(t, out, "## zdt1: hypervolume (higher is better)")
+		assert.Contains(t, out, "## zdt2: igd (lower is better)")
+		assert.Contains(t, out, "| Configuration | N | Mean | Median | Std | p-value | vs baseline |")
+		assert.Contains(t, out, "| gde3/rand1 | 8 |")
+		assert.Contains(t, out, "| gde3/worst | 0 | - | - | - | - |  |")
+		assert.Contains(t, out, "## Friedman test: hypervolume")
+		assert.Contains(t, out, "## Failed executions")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, writeExperimentCSV(&b, report))
+		rows, err := csv.NewReader(&b).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 1+3*2*3)
+		assert.Equal(t, "problem", rows[0][0])
+		assert.Equal(t, []string{"zdt1", "hypervolume", "gde3/best1"}, rows[2][:3])
+		assert.Equal(t, "8", rows[2][3])
+		assert.Equal(t, outcomeBetter, rows[2][10])
+	})
+
+	t.Run("latex", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, writeExperimentLaTeX(&b, report))
+		out := b.String()
+		assert.Contains(t, out, `\begin{tabular}{lrrrrrr}`)
+		assert.Contains(t, out, `\caption{zdt1: hypervolume (higher is better)}`)
+		assert.Contains(t, out, `gde3/rand1 & 8 &`)
+		assert.Contains(t, out, "% Failed execution: gde3/worst on zdt1")
+		assert.Equal(t, strings.Count(out, `\begin{table}`), strings.Count(out, `\end{table}`))
+	})
+}
+
+func TestExperimentReport_Friedman(t *testing.T) {
+	m := &experimentMatrix{Problems: []string{"a", "b", "c"}, Indicators: []string{"hypervolume", "spacing"}}
+	configurations := []experimentConfiguration{{Label: "first"}, {Label: "second"}}
+	var results []experimentResult
+	for _, problem := range m.Problems {
+		for cfg, offset := range []float64{1, 0} {
+			results = append(results, experimentResult{
+				Run:        experimentRun{Configuration: cfg, Problem: problem},
+				Front:      [][]float64{{offset, offset + 1}, {offset + 1, offset}},
+				Indicators: &api.Indicators{Spacing: offset},
+			})
+		}
+	}
+	results = append(results, experimentResult{
+		Run: experimentRun{Configuration: 1, Problem: "c"},
+		Err: errors.New("lost"),
+	})
+
+	report, err := newExperimentReport(m, configurations, results, 0.05)
+	require.NoError(t, err)
+	require.Len(t, report.rankings, 2)
+	// The second configuration is best on every problem for both indicators
+	for _, ranking := range report.rankings {
+		assert.Equal(t, []float64{2, 1}, ranking.result.MeanRanks, ranking.indicator)
+		assert.InDelta(t, 3, ranking.result.Statistic, 1e-9)
+		assert.Empty(t, ranking.note)
+	}
+	assert.Len(t, report.failures, 1)
+
+	var b bytes.Buffer
+	require.NoError(t, writeExperimentMarkdown(&b, report))
+	assert.Contains(t, b.String(), "chi-square = 3.0000, df = 1, p-value = 0.0833")
+}
@@ -9,7 +9,8 @@ import (
 )
 
 var (
-	listStatus string
+	listStatus       string
+	listExperimentID string
 )
 
 // listCmd lists all executions for the current user.
@@ -17,7 +18,8 @@ var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all executions for the current user",
 	Long: `Retrieve a list of all executions submitted by the current user.
-Optionally filter by status (pending, running, completed, failed, cancelled).`,
+Optionally filter by status (pending, running, completed, failed, cancelled)
+or by experiment.`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		ctx, client, conn, err := getClientAndContext(cmd.Context())
 		if err != nil {
@@ -29,7 +31,7 @@ Optionally filter by status (pending, running, completed, failed, cancelled).`,
 			}
 		}()
 
-		req := &api.ListExecutionsRequest{}
+		req := &api.ListExecutionsRequest{ExperimentId: listExperimentID}
 
 		// Parse and set status filter if provided
 		if listStatus != "" {
@@ -78,4 +80,5 @@ Optionally filter by status (pending, running, completed, failed, cancelled).`,
 func init() {
 	deCmd.AddCommand(listCmd)
 	listCmd.Flags().StringVar(&listStatus, "status", "", "filter by status (pending, running, completed, failed, cancelled)")
+	listCmd.Flags().StringVar(&listExperimentID, "experiment-id", "", "filter by experiment")
 }
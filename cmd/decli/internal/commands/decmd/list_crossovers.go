@@ -0,0 +1,51 @@
+package decmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// listCrossoversCmd list all available crossover operators.
+var listCrossoversCmd = &cobra.Command{
+	Use:   "list-crossovers",
+	Short: "List available crossover operators",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		res, err := client.ListSupportedCrossovers(ctx, &emptypb.Empty{})
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+		if _, err := fmt.Fprintln(w, "Name\tDescription"); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, "----\t----------- "); err != nil {
+			return err
+		}
+		for _, v := range res.GetCrossovers() {
+			if _, err := fmt.Fprintf(w, "%s\t%s\n", v.Name, v.Description); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	deCmd.AddCommand(listCrossoversCmd)
+}
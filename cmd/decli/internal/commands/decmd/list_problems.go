@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -38,7 +39,11 @@ var listProblemsCmd = &cobra.Command{
 			return err
 		}
 		for _, p := range res.GetProblems() {
-			if _, err := fmt.Fprintf(w, "%s\t%s\n", p.Name, p.Description); err != nil {
+			description := p.Description
+			if cp := p.GetCustomProblem(); cp != nil {
+				description = strings.TrimSpace("(custom) " + strings.Join(cp.Objectives, "; ") + " " + description)
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\n", p.Name, description); err != nil {
 				return err
 			}
 		}
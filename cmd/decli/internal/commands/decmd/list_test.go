@@ -63,6 +63,30 @@ func TestListVariantsCommand(t *testing.T) {
 	})
 }
 
+func TestListCrossoversCommand(t *testing.T) {
+	t.Run("command exists", func(t *testing.T) {
+		assert.NotNil(t, listCrossoversCmd)
+		assert.Equal(t, "list-crossovers", listCrossoversCmd.Use)
+		assert.Contains(t, listCrossoversCmd.Short, "crossover")
+	})
+
+	t.Run("has RunE function", func(t *testing.T) {
+		assert.NotNil(t, listCrossoversCmd.RunE)
+	})
+}
+
+func TestListRepairsCommand(t *testing.T) {
+	t.Run("command exists", func(t *testing.T) {
+		assert.NotNil(t, listRepairsCmd)
+		assert.Equal(t, "list-repairs", listRepairsCmd.Use)
+		assert.Contains(t, listRepairsCmd.Short, "repair")
+	})
+
+	t.Run("has RunE function", func(t *testing.T) {
+		assert.NotNil(t, listRepairsCmd.RunE)
+	})
+}
+
 func TestDECommand(t *testing.T) {
 	t.Run("de command exists", func(t *testing.T) {
 		assert.NotNil(t, deCmd)
@@ -86,6 +110,8 @@ func TestDECommand(t *testing.T) {
 		assert.True(t, commandNames["list-algorithms"], "list-algorithms should be registered")
 		assert.True(t, commandNames["list-problems"], "list-problems should be registered")
 		assert.True(t, commandNames["list-variants"], "list-variants should be registered")
+		assert.True(t, commandNames["list-crossovers"], "list-crossovers should be registered")
+		assert.True(t, commandNames["list-repairs"], "list-repairs should be registered")
 		assert.True(t, commandNames["run"], "run should be registered")
 		assert.True(t, commandNames["run-async"], "run-async should be registered")
 		assert.True(t, commandNames["status"], "status should be registered")
@@ -126,7 +152,13 @@ func TestRunCommand(t *testing.T) {
 			"dimensions-size": "30",
 			"objectives-size": "2",
 			"floor-limiter":   "0",
-			"ceil-limiter":    "1",
+			"ceil-limiter":    "0",
+			"bounds":          "",
+			"seed":            "0",
+			"crossover":       "",
+			"repair":          "",
+			"result-limit":    "0",
+			"pareto-archive":  "false",
 		}
 		for name, defValue := range deFlags {
 			flag := runCmd.Flags().Lookup(name)
@@ -143,6 +175,14 @@ func TestRunCommand(t *testing.T) {
 			assert.Equal(t, "0.5", flag.DefValue, "flag %s default", name)
 		}
 	})
+
+	t.Run("has MOEA/D config flags", func(t *testing.T) {
+		moeadFlags := []string{"neighborhood-size", "decomposition", "theta", "delta", "max-replacements"}
+		for _, name := range moeadFlags {
+			require.NotNil(t, runCmd.Flags().Lookup(name), "flag %s should exist", name)
+			require.NotNil(t, runAsyncCmd.Flags().Lookup(name), "flag %s should exist", name)
+		}
+	})
 }
 
 func TestRunAsyncCommand(t *testing.T) {
@@ -173,6 +213,11 @@ func TestRunAsyncCommand(t *testing.T) {
 			"population-size": "100",
 			"dimensions-size": "30",
 			"objectives-size": "2",
+			"seed":            "0",
+			"crossover":       "",
+			"repair":          "",
+			"result-limit":    "0",
+			"pareto-archive":  "false",
 		}
 		for name, defValue := range deFlags {
 			flag := runAsyncCmd.Flags().Lookup(name)
@@ -296,6 +341,12 @@ func TestResultsCommand(t *testing.T) {
 		assert.Equal(t, "summary", flag.DefValue)
 	})
 
+	t.Run("has archive flag", func(t *testing.T) {
+		flag := resultsCmd.Flags().Lookup("archive")
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+
 	t.Run("requires execution-id", func(t *testing.T) {
 		resultsExecutionID = ""
 		err := resultsCmd.RunE(resultsCmd, nil)
@@ -409,13 +460,68 @@ func TestFormatResultsSummary(t *testing.T) {
 	})
 }
 
+func TestOptionalSeed(t *testing.T) {
+	assert.Nil(t, optionalSeed(0), "zero lets the server pick the seed")
+	require.NotNil(t, optionalSeed(42))
+	assert.Equal(t, int64(42), *optionalSeed(42))
+}
+
+func TestReferenceFrontCommand(t *testing.T) {
+	t.Run("command exists", func(t *testing.T) {
+		assert.NotNil(t, referenceFrontCmd)
+		assert.Equal(t, "reference-front", referenceFrontCmd.Use)
+		assert.NotEmpty(t, referenceFrontCmd.Short)
+		assert.NotEmpty(t, referenceFrontCmd.Long)
+	})
+
+	t.Run("has flags with defaults", func(t *testing.T) {
+		flags := map[string]string{
+			"problem":    "",
+			"objectives": "0",
+			"points":     "0",
+			"output":     "",
+			"format":     "csv",
+		}
+		for name, defValue := range flags {
+			flag := referenceFrontCmd.Flags().Lookup(name)
+			require.NotNil(t, flag, "flag %s should exist", name)
+			assert.Equal(t, defValue, flag.DefValue, "flag %s default", name)
+		}
+	})
+
+	t.Run("requires problem", func(t *testing.T) {
+		referenceFrontProblem = ""
+		err := referenceFrontCmd.RunE(referenceFrontCmd, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "--problem is required")
+	})
+}
+
+func TestFormatReferenceFrontCSV(t *testing.T) {
+	t.Run("empty front", func(t *testing.T) {
+		out, err := formatReferenceFrontCSV(nil)
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("one row per vector", func(t *testing.T) {
+		out, err := formatReferenceFrontCSV([]*api.Vector{
+			{Objectives: []float64{0, 1}},
+			{Objectives: []float64{0.25, 0.5}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "f1,f2\n0,1\n0.25,0.5\n", out)
+	})
+}
+
 func TestRegisterCommands(t *testing.T) {
 	root := &cobra.Command{Use: "test"}
 	RegisterCommands(root)
 
 	commands := root.Commands()
-	assert.Len(t, commands, 1)
+	require.Len(t, commands, 2)
 	assert.Equal(t, "de", commands[0].Use)
+	assert.Equal(t, "experiment", commands[1].Use)
 }
 
 func TestSetupConfig(t *testing.T) {
@@ -458,6 +564,17 @@ func TestDisplayProgress(t *testing.T) {
 		// Should not panic with defaults
 		displayProgress(progress)
 	})
+
+	t.Run("adaptive parameter means", func(t *testing.T) {
+		progress := &api.StreamProgressResponse{
+			CurrentGeneration: 10,
+			TotalGenerations:  100,
+			TotalExecutions:   1,
+			ControlParameters: &api.ControlParameters{MeanF: 0.6, MeanCr: 0.4},
+		}
+		// Should not panic
+		displayProgress(progress)
+	})
 }
 
 func TestGetClientAndContext(t *testing.T) {
@@ -484,3 +601,152 @@ func TestGetClientAndContext(t *testing.T) {
 		defer func() { _ = conn.Close() }()
 	})
 }
+
+func TestBoundsFlag(t *testing.T) {
+	t.Run("repeated and comma separated values append", func(t *testing.T) {
+		var bounds []config.Bounds
+		v := newBoundsValue(&bounds)
+		require.NoError(t, v.Set("0:1"))
+		require.NoError(t, v.Set("-5:5,-5.5:5.5"))
+		assert.Equal(t, []config.Bounds{{Floor: 0, Ceil: 1}, {Floor: -5, Ceil: 5}, {Floor: -5.5, Ceil: 5.5}}, bounds)
+		assert.Equal(t, "0:1,-5:5,-5.5:5.5", v.String())
+	})
+
+	t.Run("rejects malformed values", func(t *testing.T) {
+		for _, value := range []string{"1", "a:1", "0:b", "1:1", "2:1"} {
+			var bounds []config.Bounds
+			assert.Error(t, newBoundsValue(&bounds).Set(value), value)
+		}
+	})
+}
+
+func TestBoundsToPB(t *testing.T) {
+	assert.Nil(t, boundsToPB(nil), "no bounds lets the server decide")
+
+	pb := boundsToPB([]config.Bounds{{Floor: 0, Ceil: 1}, {Floor: -5, Ceil: 5}})
+	require.Len(t, pb, 2)
+	assert.Equal(t, -5.0, pb[1].GetFloor())
+	assert.Equal(t, 5.0, pb[1].GetCeil())
+}
+
+func TestCustomProblemFlags(t *testing.T) {
+	t.Run("registered on run and run-async", func(t *testing.T) {
+		for _, name := range []string{"objective", "constraint", "description", "save-problem"} {
+			require.NotNil(t, runCmd.Flags().Lookup(name), "flag %s should exist", name)
+			require.NotNil(t, runAsyncCmd.Flags().Lookup(name), "flag %s should exist", name)
+		}
+	})
+
+	t.Run("no objectives runs a registered problem", func(t *testing.T) {
+		pb, err := customProblemFlags{}.toPB("zdt1", nil)
+		require.NoError(t, err)
+		assert.Nil(t, pb)
+
+		_, err = customProblemFlags{Save: true}.toPB("zdt1", nil)
+		assert.Error(t, err)
+		_, err = customProblemFlags{Constraints: []string{"x[0]"}}.toPB("zdt1", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("converts the expressions and bounds", func(t *testing.T) {
+		f := customProblemFlags{
+			Objectives:  []string{"x[0]^2", "(x[0]-2)^2"},
+			Constraints: []string{"x[0] - 1"},
+			Description: "Schaffer N.1",
+		}
+		pb, err := f.toPB("schaffer", []config.Bounds{{Floor: -10, Ceil: 10}})
+		require.NoError(t, err)
+		assert.Equal(t, "schaffer", pb.GetName())
+		assert.Equal(t, "Schaffer N.1", pb.GetDescription())
+		assert.Equal(t, f.Objectives, pb.GetObjectives())
+		assert.Equal(t, f.Constraints, pb.GetConstraints())
+		require.Len(t, pb.GetBounds(), 1)
+		assert.Equal(t, 10.0, pb.GetBounds()[0].GetCeil())
+	})
+}
+
+func TestSetAlgorithmConfig(t *testing.T) {
+	cfg := config.DEConfig{
+		GDE3:  config.GDE3Config{CR: 0.9, F: 0.5, P: 0.1},
+		MOEAD: config.MOEADConfig{NeighborhoodSize: 10, Decomposition: "PBI", Theta: 3},
+	}
+
+	t.Run("gde3", func(t *testing.T) {
+		pb := &api.DEConfig{}
+		require.NoError(t, setAlgorithmConfig(pb, "gde3", cfg))
+		assert.InDelta(t, 0.9, pb.GetGde3().GetCr(), 1e-6)
+		assert.Equal(t, api.ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED, pb.GetGde3().GetAdaptation())
+	})
+
+	t.Run("gde3 with shade", func(t *testing.T) {
+		adaptive := cfg
+		adaptive.GDE3.Adaptation = "shade"
+		adaptive.GDE3.MemorySize = 10
+		pb := &api.DEConfig{}
+		require.NoError(t, setAlgorithmConfig(pb, "gde3", adaptive))
+		assert.Equal(t, api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE, pb.GetGde3().GetAdaptation())
+		assert.Equal(t, int64(10), pb.GetGde3().GetMemorySize())
+
+		adaptive.GDE3.Adaptation = "self"
+		assert.Error(t, setAlgorithmConfig(&api.DEConfig{}, "gde3", adaptive))
+	})
+
+	t.Run("moead", func(t *testing.T) {
+		pb := &api.DEConfig{}
+		require.NoError(t, setAlgorithmConfig(pb, "moead", cfg))
+		moead := pb.GetMoead()
+		require.NotNil(t, moead)
+		assert.InDelta(t, 0.5, moead.GetF(), 1e-6)
+		assert.Equal(t, int64(10), moead.GetNeighborhoodSize())
+		assert.Equal(t, api.Decomposition_DECOMPOSITION_PBI, moead.GetDecomposition())
+	})
+
+	t.Run("nsga2", func(t *testing.T) {
+		pb := &api.DEConfig{}
+		require.NoError(t, setAlgorithmConfig(pb, "nsga2", cfg))
+		assert.InDelta(t, 0.1, pb.GetNsga2().GetP(), 1e-6)
+	})
+
+	t.Run("unknown algorithm is sent without configuration", func(t *testing.T) {
+		pb := &api.DEConfig{}
+		require.NoError(t, setAlgorithmConfig(pb, "other", cfg))
+		assert.Nil(t, pb.AlgorithmConfig)
+	})
+
+	t.Run("invalid decomposition", func(t *testing.T) {
+		bad := cfg
+		bad.MOEAD.Decomposition = "weighted"
+		assert.Error(t, setAlgorithmConfig(&api.DEConfig{}, "moead", bad))
+	})
+}
+
+func TestParseDecomposition(t *testing.T) {
+	for name, want := range map[string]api.Decomposition{
+		"":            api.Decomposition_DECOMPOSITION_UNSPECIFIED,
+		"tchebycheff": api.Decomposition_DECOMPOSITION_TCHEBYCHEFF,
+		"pbi":         api.Decomposition_DECOMPOSITION_PBI,
+	} {
+		got, err := parseDecomposition(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := parseDecomposition("unspecified")
+	assert.Error(t, err)
+}
+
+func TestParseAdaptation(t *testing.T) {
+	for name, want := range map[string]api.ParameterAdaptation{
+		"":      api.ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED,
+		"fixed": api.ParameterAdaptation_PARAMETER_ADAPTATION_FIXED,
+		"JADE":  api.ParameterAdaptation_PARAMETER_ADAPTATION_JADE,
+		"shade": api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE,
+	} {
+		got, err := parseAdaptation(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := parseAdaptation("unspecified")
+	assert.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package decmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	referenceFrontProblem    string
+	referenceFrontObjectives int64
+	referenceFrontPoints     int64
+	referenceFrontOutputFile string
+	referenceFrontFormat     string
+)
+
+// referenceFrontCmd retrieves the true Pareto front of a benchmark problem.
+var referenceFrontCmd = &cobra.Command{
+	Use:   "reference-front",
+	Short: "Retrieve the reference Pareto front of a problem",
+	Long: `Retrieve points sampled from the true Pareto front of a benchmark problem.
+The front can be displayed as JSON or CSV, or saved to a file to compare
+against the results of an execution.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if referenceFrontProblem == "" {
+			return fmt.Errorf("--problem is required")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		resp, err := client.GetReferenceFront(ctx, &api.GetReferenceFrontRequest{
+			Problem:        referenceFrontProblem,
+			ObjectivesSize: referenceFrontObjectives,
+			Points:         referenceFrontPoints,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get reference front: %w", err)
+		}
+
+		var output string
+		switch referenceFrontFormat {
+		case "json":
+			data, err := json.MarshalIndent(resp.Vectors, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal reference front to JSON: %w", err)
+			}
+			output = string(data)
+
+		case "csv":
+			output, err = formatReferenceFrontCSV(resp.Vectors)
+			if err != nil {
+				return fmt.Errorf("failed to format reference front as CSV: %w", err)
+			}
+
+		default:
+			return fmt.Errorf("invalid format: %s (valid: json, csv)", referenceFrontFormat)
+		}
+
+		if referenceFrontOutputFile != "" {
+			if err := os.WriteFile(referenceFrontOutputFile, []byte(output), 0600); err != nil {
+				return fmt.Errorf("failed to write reference front to file: %w", err)
+			}
+			slog.Info("Reference front written to file", "file", referenceFrontOutputFile)
+			fmt.Printf("Reference front saved to: %s\n", referenceFrontOutputFile)
+		} else {
+			fmt.Println(output)
+		}
+
+		return nil
+	},
+}
+
+// formatReferenceFrontCSV writes one row per vector with a column per
+// objective.
+func formatReferenceFrontCSV(vectors []*api.Vector) (string, error) {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+
+	if len(vectors) > 0 {
+		header := make([]string, len(vectors[0].Objectives))
+		for i := range header {
+			header[i] = fmt.Sprintf("f%d", i+1)
+		}
+		if err := w.Write(header); err != nil {
+			return "", err
+		}
+	}
+
+	for _, v := range vectors {
+		row := make([]string, len(v.Objectives))
+		for i, obj := range v.Objectives {
+			row[i] = strconv.FormatFloat(obj, 'g', -1, 64)
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return sb.String(), w.Error()
+}
+
+func init() {
+	deCmd.AddCommand(referenceFrontCmd)
+	referenceFrontCmd.Flags().StringVar(&referenceFrontProblem, "problem", "", "problem to get the reference front for")
+	referenceFrontCmd.Flags().Int64Var(&referenceFrontObjectives, "objectives", 0, "number of objectives (default: the problem's own)")
+	referenceFrontCmd.Flags().Int64Var(&referenceFrontPoints, "points", 0, "approximate number of points (default: server default)")
+	referenceFrontCmd.Flags().StringVar(&referenceFrontOutputFile, "output", "", "output file path (default: stdout)")
+	referenceFrontCmd.Flags().StringVar(&referenceFrontFormat, "format", "csv", "output format (json, csv)")
+}
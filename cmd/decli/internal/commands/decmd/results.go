@@ -15,6 +15,7 @@ var (
 	resultsExecutionID string
 	resultsOutputFile  string
 	resultsFormat      string
+	resultsArchive     bool
 )
 
 // resultsCmd retrieves the Pareto set results for a completed execution.
@@ -45,7 +46,11 @@ Results can be displayed as JSON or saved to a file.`,
 			return fmt.Errorf("failed to get results: %w", err)
 		}
 
-		if resp.Pareto == nil {
+		pareto := resp.Pareto
+		if resultsArchive {
+			pareto = resp.ParetoArchive
+		}
+		if pareto == nil {
 			return fmt.Errorf("no results available for execution %s", resultsExecutionID)
 		}
 
@@ -53,14 +58,14 @@ Results can be displayed as JSON or saved to a file.`,
 		var output string
 		switch resultsFormat {
 		case "json":
-			data, err := json.MarshalIndent(resp.Pareto, "", "  ")
+			data, err := json.MarshalIndent(pareto, "", "  ")
 			if err != nil {
 				return fmt.Errorf("failed to marshal results to JSON: %w", err)
 			}
 			output = string(data)
 
 		case "summary":
-			output = formatResultsSummary(resp.Pareto)
+			output = formatResultsSummary(pareto)
 
 		default:
 			return fmt.Errorf("invalid format: %s (valid: json, summary)", resultsFormat)
@@ -115,4 +120,5 @@ func init() {
 	resultsCmd.Flags().StringVar(&resultsExecutionID, "execution-id", "", "execution ID to get results for")
 	resultsCmd.Flags().StringVar(&resultsOutputFile, "output", "", "output file path (default: stdout)")
 	resultsCmd.Flags().StringVar(&resultsFormat, "format", "summary", "output format (json, summary)")
+	resultsCmd.Flags().BoolVar(&resultsArchive, "archive", false, "show the pareto archive of the execution instead of its final Pareto set")
 }
@@ -0,0 +1,62 @@
+package decmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	resumeExecutionID string
+	resumeGenerations int64
+)
+
+// resumeCmd resumes an execution from its last checkpoint.
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume an execution from its last checkpoint",
+	Long: `Resume a failed, timed-out or cancelled execution from its last checkpoint.
+Use --generations to extend the generation budget of the run, which also
+continues completed executions.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if resumeExecutionID == "" {
+			return fmt.Errorf("--execution-id is required")
+		}
+		if resumeGenerations < 0 {
+			return fmt.Errorf("--generations cannot be negative")
+		}
+
+		ctx, client, conn, err := getClientAndContext(cmd.Context())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := conn.Close(); cerr != nil {
+				slog.Warn("Failed to close connection", slog.String("error", cerr.Error()))
+			}
+		}()
+
+		slog.Info("Requesting resume", "execution_id", resumeExecutionID, "generations", resumeGenerations)
+
+		_, err = client.ResumeExecution(ctx, &api.ResumeExecutionRequest{
+			ExecutionId: resumeExecutionID,
+			Generations: resumeGenerations,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to resume execution: %w", err)
+		}
+
+		fmt.Printf("\nResumed execution: %s\n", resumeExecutionID)
+		fmt.Printf("\nUse 'decli de status --execution-id %s' to check progress\n", resumeExecutionID)
+
+		return nil
+	},
+}
+
+func init() {
+	deCmd.AddCommand(resumeCmd)
+	resumeCmd.Flags().StringVar(&resumeExecutionID, "execution-id", "", "execution ID to resume")
+	resumeCmd.Flags().Int64Var(&resumeGenerations, "generations", 0, "new generation budget of the run (0 = keep the configured one)")
+}
@@ -11,7 +11,8 @@ import (
 )
 
 var (
-	run config.RunConfig
+	run       config.RunConfig
+	runCustom customProblemFlags
 )
 
 // runCmd submits an async execution and polls until completion.
@@ -32,26 +33,24 @@ For async operations, use 'run-async' instead.`,
 			}
 		}()
 
+		deConfig, err := newDEConfig(run.Algorithm, run.DeConfig)
+		if err != nil {
+			return err
+		}
+		customProblem, err := runCustom.toPB(run.Problem, run.DeConfig.Bounds)
+		if err != nil {
+			return err
+		}
+
 		// Submit async execution
 		slog.Info("Submitting execution request...")
 		asyncResp, err := client.RunAsync(ctx, &api.RunAsyncRequest{
-			Algorithm: run.Algorithm,
-			Variant:   run.Variant,
-			Problem:   run.Problem,
-			DeConfig: &api.DEConfig{
-				Executions:     run.DeConfig.Executions,
-				Generations:    run.DeConfig.Generations,
-				PopulationSize: run.DeConfig.PopulationSize,
-				DimensionsSize: run.DeConfig.DimensionsSize,
-				ObjectivesSize: run.DeConfig.ObjectivesSize,
-				FloorLimiter:   run.DeConfig.FloorLimiter,
-				CeilLimiter:    run.DeConfig.CeilLimiter,
-				AlgorithmConfig: &api.DEConfig_Gde3{Gde3: &api.GDE3Config{
-					Cr: run.DeConfig.GDE3.CR,
-					F:  run.DeConfig.GDE3.F,
-					P:  run.DeConfig.GDE3.P,
-				}},
-			},
+			Algorithm:         run.Algorithm,
+			Variant:           run.Variant,
+			Problem:           run.Problem,
+			DeConfig:          deConfig,
+			CustomProblem:     customProblem,
+			SaveCustomProblem: runCustom.Save,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to submit execution: %w", err)
@@ -128,10 +127,58 @@ func init() {
 	fs.Int64Var(&run.DeConfig.PopulationSize, "population-size", 100, "size of the initial population")
 	fs.Int64Var(&run.DeConfig.DimensionsSize, "dimensions-size", 30, "amount of elements in a Vector")
 	fs.Int64Var(&run.DeConfig.ObjectivesSize, "objectives-size", 2, "amount of objectives in a Vector")
-	fs.Float32Var(&run.DeConfig.FloorLimiter, "floor-limiter", 0.0, "minimum value for a Vector's element")
-	fs.Float32Var(&run.DeConfig.CeilLimiter, "ceil-limiter", 1.0, "maximum value for a Vector's element")
+	fs.Float32Var(&run.DeConfig.FloorLimiter, "floor-limiter", 0.0, "minimum value for every Vector element (default: the problem's bounds)")
+	fs.Float32Var(&run.DeConfig.CeilLimiter, "ceil-limiter", 0.0, "maximum value for every Vector element (default: the problem's bounds)")
+	fs.Var(newBoundsValue(&run.DeConfig.Bounds), "bounds", "floor:ceil of each dimension, repeat once per dimension (overrides the limiters)")
+	fs.Int64Var(&run.DeConfig.Seed, "seed", 0, "random seed to reproduce a run (default: picked by the server)")
+	fs.StringVar(&run.DeConfig.Crossover, "crossover", "", "crossover operator, see list-crossovers (default: binomial)")
+	fs.StringVar(&run.DeConfig.Repair, "repair", "", "boundary repair strategy, see list-repairs (default: clamp)")
+	fs.Int64Var(&run.DeConfig.ResultLimit, "result-limit", 0, "size of the merged Pareto set (default: server default)")
+	fs.BoolVar(&run.DeConfig.ParetoArchive.Enabled, "pareto-archive", false, "keep an external archive of every non-dominated solution found")
+	fs.Int64Var(&run.DeConfig.ParetoArchive.MaxSize, "pareto-archive-max-size", 0, "maximum size of the pareto archive, the most crowded solutions are pruned (default: unbounded)")
+	fs.Float64Var(&run.DeConfig.ParetoArchive.Epsilon, "pareto-archive-epsilon", 0, "epsilon-dominance box size of the pareto archive (default: plain dominance)")
+
+	addAlgorithmFlags(runCmd, &run.DeConfig)
+	addCustomProblemFlags(runCmd, &runCustom)
+}
+
+// newDEConfig converts cfg to the configuration sent along with a run of
+// algorithm.
+func newDEConfig(algorithm string, cfg config.DEConfig) (*api.DEConfig, error) {
+	pb := &api.DEConfig{
+		Executions:     cfg.Executions,
+		Generations:    cfg.Generations,
+		PopulationSize: cfg.PopulationSize,
+		DimensionsSize: cfg.DimensionsSize,
+		ObjectivesSize: cfg.ObjectivesSize,
+		FloorLimiter:   cfg.FloorLimiter,
+		CeilLimiter:    cfg.CeilLimiter,
+		Seed:           optionalSeed(cfg.Seed),
+		Bounds:         boundsToPB(cfg.Bounds),
+		Crossover:      cfg.Crossover,
+		Repair:         cfg.Repair,
+		ResultLimit:    cfg.ResultLimit,
+		ParetoArchive:  paretoArchiveToPB(cfg.ParetoArchive),
+	}
+	if err := setAlgorithmConfig(pb, algorithm, cfg); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// optionalSeed returns nil for a zero seed so the server picks one.
+func optionalSeed(seed int64) *int64 {
+	if seed == 0 {
+		return nil
+	}
+	return &seed
+}
 
-	fs.Float32Var(&run.DeConfig.GDE3.CR, "cr", 0.5, "value of the CR constant")
-	fs.Float32Var(&run.DeConfig.GDE3.F, "f", 0.5, "value of the F constant")
-	fs.Float32Var(&run.DeConfig.GDE3.P, "p", 0.5, "value of the P constant")
+// paretoArchiveToPB returns nil unless the archive is enabled, either
+// explicitly or by configuring it.
+func paretoArchiveToPB(cfg config.ParetoArchiveConfig) *api.ParetoArchiveConfig {
+	if !cfg.Enabled && cfg.MaxSize == 0 && cfg.Epsilon == 0 {
+		return nil
+	}
+	return &api.ParetoArchiveConfig{MaxSize: cfg.MaxSize, Epsilon: cfg.Epsilon}
 }
@@ -10,7 +10,9 @@ import (
 )
 
 var (
-	runAsync config.RunConfig
+	runAsync             config.RunConfig
+	runAsyncCustom       customProblemFlags
+	runAsyncExperimentID string
 )
 
 // runAsyncCmd submits an async execution and returns immediately with execution ID.
@@ -31,26 +33,25 @@ For synchronous operation (submit + wait), use 'run' instead.`,
 			}
 		}()
 
+		deConfig, err := newDEConfig(runAsync.Algorithm, runAsync.DeConfig)
+		if err != nil {
+			return err
+		}
+		customProblem, err := runAsyncCustom.toPB(runAsync.Problem, runAsync.DeConfig.Bounds)
+		if err != nil {
+			return err
+		}
+
 		// Submit async execution
 		slog.Info("Submitting async execution request...")
 		resp, err := client.RunAsync(ctx, &api.RunAsyncRequest{
-			Algorithm: runAsync.Algorithm,
-			Variant:   runAsync.Variant,
-			Problem:   runAsync.Problem,
-			DeConfig: &api.DEConfig{
-				Executions:     runAsync.DeConfig.Executions,
-				Generations:    runAsync.DeConfig.Generations,
-				PopulationSize: runAsync.DeConfig.PopulationSize,
-				DimensionsSize: runAsync.DeConfig.DimensionsSize,
-				ObjectivesSize: runAsync.DeConfig.ObjectivesSize,
-				FloorLimiter:   runAsync.DeConfig.FloorLimiter,
-				CeilLimiter:    runAsync.DeConfig.CeilLimiter,
-				AlgorithmConfig: &api.DEConfig_Gde3{Gde3: &api.GDE3Config{
-					Cr: runAsync.DeConfig.GDE3.CR,
-					F:  runAsync.DeConfig.GDE3.F,
-					P:  runAsync.DeConfig.GDE3.P,
-				}},
-			},
+			Algorithm:         runAsync.Algorithm,
+			Variant:           runAsync.Variant,
+			Problem:           runAsync.Problem,
+			DeConfig:          deConfig,
+			CustomProblem:     customProblem,
+			SaveCustomProblem: runAsyncCustom.Save,
+			ExperimentId:      runAsyncExperimentID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to submit execution: %w", err)
@@ -84,10 +85,18 @@ func init() {
 	fs.Int64Var(&runAsync.DeConfig.PopulationSize, "population-size", 100, "size of the initial population")
 	fs.Int64Var(&runAsync.DeConfig.DimensionsSize, "dimensions-size", 30, "amount of elements in a Vector")
 	fs.Int64Var(&runAsync.DeConfig.ObjectivesSize, "objectives-size", 2, "amount of objectives in a Vector")
-	fs.Float32Var(&runAsync.DeConfig.FloorLimiter, "floor-limiter", 0.0, "minimum value for a Vector's element")
-	fs.Float32Var(&runAsync.DeConfig.CeilLimiter, "ceil-limiter", 1.0, "maximum value for a Vector's element")
+	fs.Float32Var(&runAsync.DeConfig.FloorLimiter, "floor-limiter", 0.0, "minimum value for every Vector element (default: the problem's bounds)")
+	fs.Float32Var(&runAsync.DeConfig.CeilLimiter, "ceil-limiter", 0.0, "maximum value for every Vector element (default: the problem's bounds)")
+	fs.Var(newBoundsValue(&runAsync.DeConfig.Bounds), "bounds", "floor:ceil of each dimension, repeat once per dimension (overrides the limiters)")
+	fs.Int64Var(&runAsync.DeConfig.Seed, "seed", 0, "random seed to reproduce a run (default: picked by the server)")
+	fs.StringVar(&runAsync.DeConfig.Crossover, "crossover", "", "crossover operator, see list-crossovers (default: binomial)")
+	fs.StringVar(&runAsync.DeConfig.Repair, "repair", "", "boundary repair strategy, see list-repairs (default: clamp)")
+	fs.Int64Var(&runAsync.DeConfig.ResultLimit, "result-limit", 0, "size of the merged Pareto set (default: server default)")
+	fs.BoolVar(&runAsync.DeConfig.ParetoArchive.Enabled, "pareto-archive", false, "keep an external archive of every non-dominated solution found")
+	fs.Int64Var(&runAsync.DeConfig.ParetoArchive.MaxSize, "pareto-archive-max-size", 0, "maximum size of the pareto archive, the most crowded solutions are pruned (default: unbounded)")
+	fs.Float64Var(&runAsync.DeConfig.ParetoArchive.Epsilon, "pareto-archive-epsilon", 0, "epsilon-dominance box size of the pareto archive (default: plain dominance)")
+	fs.StringVar(&runAsyncExperimentID, "experiment-id", "", "experiment to add the execution to")
 
-	fs.Float32Var(&runAsync.DeConfig.GDE3.CR, "cr", 0.5, "value of the CR constant")
-	fs.Float32Var(&runAsync.DeConfig.GDE3.F, "f", 0.5, "value of the F constant")
-	fs.Float32Var(&runAsync.DeConfig.GDE3.P, "p", 0.5, "value of the P constant")
+	addAlgorithmFlags(runAsyncCmd, &runAsync.DeConfig)
+	addCustomProblemFlags(runAsyncCmd, &runAsyncCustom)
 }
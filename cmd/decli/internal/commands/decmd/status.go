@@ -46,6 +46,7 @@ var statusCmd = &cobra.Command{
 			fmt.Printf("Generations: %d\n", execution.Config.Generations)
 			fmt.Printf("Population Size: %d\n", execution.Config.PopulationSize)
 			fmt.Printf("Executions: %d\n", execution.Config.Executions)
+			fmt.Printf("Seed: %d\n", execution.Seed)
 		}
 		fmt.Printf("Created At: %s\n", execution.CreatedAt.AsTime().Format("2006-01-02 15:04:05"))
 		fmt.Printf("Updated At: %s\n", execution.UpdatedAt.AsTime().Format("2006-01-02 15:04:05"))
@@ -62,6 +63,9 @@ var statusCmd = &cobra.Command{
 			fmt.Printf("\nProgress:\n")
 			fmt.Printf("  Generation: %d/%d\n", resp.Progress.CurrentGeneration, resp.Progress.TotalGenerations)
 			fmt.Printf("  Executions: %d/%d\n", resp.Progress.CompletedExecutions, resp.Progress.TotalExecutions)
+			if params := resp.Progress.GetControlParameters(); params != nil {
+				fmt.Printf("  Mean F/CR: %.3f/%.3f\n", params.GetMeanF(), params.GetMeanCr())
+			}
 		}
 
 		switch execution.Status {
@@ -74,6 +74,9 @@ func displayProgress(progress *api.StreamProgressResponse) {
 		progress.GetTotalGenerations(),
 		progress.GetCompletedExecutions(),
 		progress.GetTotalExecutions())
+	if params := progress.GetControlParameters(); params != nil {
+		fmt.Printf(" [F %.3f CR %.3f]", params.GetMeanF(), params.GetMeanCr())
+	}
 
 	// Add newline if execution is complete
 	if progress.GetCompletedExecutions() == progress.GetTotalExecutions() &&
@@ -6,11 +6,13 @@ import (
 	_ "net/http/pprof" // Register pprof HTTP handlers
 	"os"
 
+	admincmd "github.com/nicholaspcr/GoDE/cmd/decli/internal/commands/admin"
 	authcmd "github.com/nicholaspcr/GoDE/cmd/decli/internal/commands/auth"
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/commands/decmd"
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/config"
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/state"
 	"github.com/nicholaspcr/GoDE/cmd/decli/internal/state/sqlite"
+	"github.com/nicholaspcr/GoDE/cmd/decli/internal/utils"
 	sharedCfg "github.com/nicholaspcr/GoDE/internal/config"
 	"github.com/nicholaspcr/GoDE/internal/log"
 	"github.com/spf13/cobra"
@@ -67,6 +69,9 @@ server.
 		if err != nil {
 			return err
 		}
+		if apiKey := utils.APIKey(cmd); apiKey != "" {
+			db = state.WithAPIKey(db, apiKey)
+		}
 
 		// NOTE: this function call has to be on the end of the PersistentPreRun.
 		setupCommands()
@@ -88,6 +93,9 @@ func setupCommands() {
 
 	decmd.SetupConfig(cfg)
 	decmd.SetupStateHandler(db)
+
+	admincmd.SetupConfig(cfg)
+	admincmd.SetupStateHandler(db)
 }
 
 func init() {
@@ -95,8 +103,12 @@ func init() {
 	rootCmd.PersistentFlags().String(
 		"config", "", "config file (default is $HOME/.decli.yaml)",
 	)
+	rootCmd.PersistentFlags().String(
+		"api-key", "", "API key used in place of the login token (default is $"+utils.APIKeyEnv+")",
+	)
 
 	// Commands
 	authcmd.RegisterCommands(rootCmd)
 	decmd.RegisterCommands(rootCmd)
+	admincmd.RegisterCommands(rootCmd)
 }
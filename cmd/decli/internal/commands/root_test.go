@@ -23,6 +23,12 @@ func TestRootCommand(t *testing.T) {
 		assert.Equal(t, "", flag.DefValue)
 	})
 
+	t.Run("has api-key flag", func(t *testing.T) {
+		flag := rootCmd.PersistentFlags().Lookup("api-key")
+		require.NotNil(t, flag)
+		assert.Equal(t, "", flag.DefValue)
+	})
+
 	t.Run("has subcommands", func(t *testing.T) {
 		commands := rootCmd.Commands()
 		assert.NotEmpty(t, commands)
@@ -35,6 +41,8 @@ func TestRootCommand(t *testing.T) {
 		assert.True(t, names["config"], "should have 'config' subcommand")
 		assert.True(t, names["auth"], "should have 'auth' subcommand")
 		assert.True(t, names["de"], "should have 'de' subcommand")
+		assert.True(t, names["experiment"], "should have 'experiment' subcommand")
+		assert.True(t, names["admin"], "should have 'admin' subcommand")
 	})
 
 	t.Run("RunE returns help", func(t *testing.T) {
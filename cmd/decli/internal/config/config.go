@@ -26,21 +26,60 @@ type (
 
 	// DEConfig contains Differential Evolution algorithm configuration.
 	DEConfig struct {
-		Executions     int64      `json:"executions" yaml:"executions"`
-		Generations    int64      `json:"generations" yaml:"generations"`
-		PopulationSize int64      `json:"population_size" yaml:"population_size"`
-		DimensionsSize int64      `json:"dimensions_size" yaml:"dimensions_size"`
-		ObjectivesSize int64      `json:"objectives_size" yaml:"objectives_size"`
-		FloorLimiter   float32    `json:"floor_limiter" yaml:"floor_limiter"`
-		CeilLimiter    float32    `json:"ceil_limiter" yaml:"ceil_limiter"`
-		GDE3           GDE3Config `json:"gde3" yaml:"gde3"`
+		Executions     int64       `json:"executions" yaml:"executions"`
+		Generations    int64       `json:"generations" yaml:"generations"`
+		PopulationSize int64       `json:"population_size" yaml:"population_size"`
+		DimensionsSize int64       `json:"dimensions_size" yaml:"dimensions_size"`
+		ObjectivesSize int64       `json:"objectives_size" yaml:"objectives_size"`
+		FloorLimiter   float32     `json:"floor_limiter" yaml:"floor_limiter"`
+		CeilLimiter    float32     `json:"ceil_limiter" yaml:"ceil_limiter"`
+		Seed           int64       `json:"seed" yaml:"seed"` // zero lets the server pick one
+		Bounds         []Bounds    `json:"bounds" yaml:"bounds"`
+		Crossover      string      `json:"crossover" yaml:"crossover"`       // empty uses binomial
+		Repair         string      `json:"repair" yaml:"repair"`             // empty uses clamp
+		ResultLimit    int64       `json:"result_limit" yaml:"result_limit"` // zero uses the server default
+		GDE3           GDE3Config  `json:"gde3" yaml:"gde3"`
+		MOEAD          MOEADConfig `json:"moead" yaml:"moead"`
+
+		ParetoArchive ParetoArchiveConfig `json:"pareto_archive" yaml:"pareto_archive"`
+	}
+
+	// ParetoArchiveConfig configures the external archive of non-dominated
+	// solutions, which is only kept when enabled.
+	ParetoArchiveConfig struct {
+		Enabled bool    `json:"enabled" yaml:"enabled"`
+		MaxSize int64   `json:"max_size" yaml:"max_size"` // zero keeps it unbounded
+		Epsilon float64 `json:"epsilon" yaml:"epsilon"`   // zero uses plain dominance
+	}
+
+	// Bounds is the domain of a single decision variable.
+	Bounds struct {
+		Floor float64 `json:"floor" yaml:"floor"`
+		Ceil  float64 `json:"ceil" yaml:"ceil"`
 	}
 
-	// GDE3Config contains GDE3-specific algorithm parameters.
+	// GDE3Config contains GDE3-specific algorithm parameters. Its CR, F and P
+	// are also used by the other DE algorithms.
 	GDE3Config struct {
 		CR float32 `json:"cr" yaml:"cr"`
 		F  float32 `json:"f" yaml:"f"`
 		P  float32 `json:"p" yaml:"p"`
+
+		// Adaptation is fixed, jade or shade, with the adaptive schemes CR
+		// and F are the initial means. Zero values use the server defaults.
+		Adaptation   string  `json:"adaptation" yaml:"adaptation"`
+		LearningRate float32 `json:"learning_rate" yaml:"learning_rate"`
+		MemorySize   int64   `json:"memory_size" yaml:"memory_size"`
+	}
+
+	// MOEADConfig contains the MOEA/D decomposition parameters, zero values
+	// use the server defaults.
+	MOEADConfig struct {
+		NeighborhoodSize int64   `json:"neighborhood_size" yaml:"neighborhood_size"`
+		Decomposition    string  `json:"decomposition" yaml:"decomposition"`
+		Theta            float32 `json:"theta" yaml:"theta"`
+		Delta            float32 `json:"delta" yaml:"delta"`
+		MaxReplacements  int64   `json:"max_replacements" yaml:"max_replacements"`
 	}
 
 	// LogConfig is a set of values that are necessary to configure the logger.
@@ -0,0 +1,15 @@
+package state
+
+// WithAPIKey returns ops authenticating with the API key in place of the
+// token saved by the login command. The saved token is left untouched.
+func WithAPIKey(ops Operations, apiKey string) Operations {
+	return &apiKeyOperations{Operations: ops, apiKey: apiKey}
+}
+
+type apiKeyOperations struct {
+	Operations
+	apiKey string
+}
+
+// GetAuthToken returns the API key.
+func (o *apiKeyOperations) GetAuthToken() (string, error) { return o.apiKey, nil }
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// APIKeyEnv is the environment variable read for the API key when the
+// api-key flag is not set.
+const APIKeyEnv = "DECLI_API_KEY"
+
+// APIKey returns the API key given to the command by the api-key flag or the
+// APIKeyEnv environment variable, empty when neither is set.
+func APIKey(cmd *cobra.Command) string {
+	if apiKey, _ := cmd.Flags().GetString("api-key"); apiKey != "" {
+		return apiKey
+	}
+	return os.Getenv(APIKeyEnv)
+}
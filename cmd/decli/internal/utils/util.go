@@ -13,6 +13,7 @@ import (
 	currenttobest "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best"
 	"github.com/nicholaspcr/GoDE/pkg/variants/pbest"
 	"github.com/nicholaspcr/GoDE/pkg/variants/rand"
+	randtobest "github.com/nicholaspcr/GoDE/pkg/variants/rand-to-best"
 )
 
 var (
@@ -50,6 +51,10 @@ var (
 		"best/2":            best.Best2(),
 		"pbest/1":           pbest.Pbest(),
 		"current-to-best/1": currenttobest.CurrToBest1(),
+
+		"current-to-pbest/1": pbest.CurrToPBest1(),
+		"rand-to-best/1":     randtobest.RandToBest1(),
+		"rand/1/either-or":   rand.Rand1EitherOr(),
 	}
 )
 
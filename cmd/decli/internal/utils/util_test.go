@@ -53,6 +53,9 @@ func TestGetVariantByName(t *testing.T) {
 			"best/1", "best/2",
 			"pbest/1",
 			"current-to-best/1",
+			"current-to-pbest/1",
+			"rand-to-best/1",
+			"rand/1/either-or",
 		}
 		for _, name := range validNames {
 			v, err := GetVariantByName(name)
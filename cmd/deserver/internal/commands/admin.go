@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/storefactory"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	grantUsername string
+	grantTenant   string
+	grantScopes   []string
+)
+
+// adminCmd handles the operations of the server operators
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Server administration commands",
+	Long:  `Administer the server directly through its store, without the API`,
+}
+
+// adminGrantCmd replaces the scopes of a user
+var adminGrantCmd = &cobra.Command{
+	Use:   "grant",
+	Short: "Replace the scopes of a user",
+	Long: `Replace the scopes of a user directly in the store. It bootstraps the first
+administrator of a tenant, who then manages the others with 'decli admin'.
+The scopes apply from the next login or token refresh of the user.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if grantUsername == "" {
+			return fmt.Errorf("--username is required")
+		}
+		if err := tenant.ValidateID(grantTenant); err != nil {
+			return err
+		}
+		scopes, err := auth.ParseScopes(grantScopes)
+		if err != nil {
+			return err
+		}
+
+		st, err := storefactory.New(cmd.Context(), cfg.Store)
+		if err != nil {
+			return err
+		}
+
+		if err := grantUserScopes(cmd.Context(), st, grantTenant, grantUsername, scopes); err != nil {
+			return err
+		}
+
+		slog.Info("Scopes granted",
+			slog.String("tenant", grantTenant),
+			slog.String("username", grantUsername),
+			slog.Any("scopes", scopes),
+		)
+		return nil
+	},
+}
+
+// userScopesStore is the subset of the store updating user scopes.
+type userScopesStore interface {
+	GetUser(context.Context, *api.UserIDs) (*api.User, error)
+	UpdateUser(context.Context, *api.User, ...string) error
+}
+
+// grantUserScopes replaces the scopes of a user of the tenant.
+func grantUserScopes(
+	ctx context.Context, st userScopesStore, tenantID, username string, scopes []auth.Scope,
+) error {
+	ctx = tenant.WithID(ctx, tenantID)
+	usr, err := st.GetUser(ctx, &api.UserIDs{Username: username})
+	if err != nil {
+		return fmt.Errorf("failed to get user %q: %w", username, err)
+	}
+
+	usr.Scopes = make([]string, len(scopes))
+	for i, scope := range scopes {
+		usr.Scopes[i] = string(scope)
+	}
+	if err := st.UpdateUser(ctx, usr, "scopes"); err != nil {
+		return fmt.Errorf("failed to update user %q: %w", username, err)
+	}
+	return nil
+}
+
+func init() {
+	adminGrantCmd.Flags().StringVar(&grantUsername, "username", "", "user to update")
+	adminGrantCmd.Flags().StringVar(&grantTenant, "tenant", tenant.DefaultID, "tenant of the user")
+	adminGrantCmd.Flags().StringSliceVar(&grantScopes, "scope", []string{string(auth.ScopeAdmin)},
+		"scope of the user, repeated or comma separated")
+
+	adminCmd.AddCommand(adminGrantCmd)
+
+	rootCmd.AddCommand(adminCmd)
+}
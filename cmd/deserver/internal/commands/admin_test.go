@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/store/mock"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAdminCommand(t *testing.T) {
+	t.Run("has grant subcommand", func(t *testing.T) {
+		require.Len(t, adminCmd.Commands(), 1)
+		assert.Equal(t, "grant", adminCmd.Commands()[0].Use)
+		assert.NotNil(t, adminGrantCmd.RunE)
+	})
+
+	t.Run("grants admin by default", func(t *testing.T) {
+		flag := adminGrantCmd.Flags().Lookup("scope")
+		require.NotNil(t, flag)
+		assert.Equal(t, "[admin]", flag.DefValue)
+	})
+
+	t.Run("requires username", func(t *testing.T) {
+		grantUsername = ""
+		err := adminGrantCmd.RunE(adminGrantCmd, nil)
+		assert.ErrorContains(t, err, "--username is required")
+	})
+}
+
+func TestGrantUserScopes(t *testing.T) {
+	var updated *api.User
+	var updatedTenant string
+	st := &mock.MockStore{
+		GetUserFn: func(ctx context.Context, ids *api.UserIDs) (*api.User, error) {
+			if ids.Username != "alice" {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &api.User{Ids: ids}, nil
+		},
+		UpdateUserFn: func(ctx context.Context, usr *api.User, fields ...string) error {
+			assert.Equal(t, []string{"scopes"}, fields)
+			updated = usr
+			updatedTenant = tenant.IDFromContext(ctx)
+			return nil
+		},
+	}
+
+	err := grantUserScopes(context.Background(), st, "lab", "alice", []auth.Scope{auth.ScopeAdmin, auth.ScopeDERead})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "de:read"}, updated.Scopes)
+	assert.Equal(t, "lab", updatedTenant)
+
+	err = grantUserScopes(context.Background(), st, tenant.DefaultID, "bob", []auth.Scope{auth.ScopeAdmin})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
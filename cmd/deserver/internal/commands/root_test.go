@@ -35,6 +35,7 @@ func TestRootCommand(t *testing.T) {
 		assert.True(t, names["start"], "should have 'start' subcommand")
 		assert.True(t, names["config"], "should have 'config' subcommand")
 		assert.True(t, names["migrate"], "should have 'migrate' subcommand")
+		assert.True(t, names["worker"], "should have 'worker' subcommand")
 	})
 
 	t.Run("RunE returns help", func(t *testing.T) {
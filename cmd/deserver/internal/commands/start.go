@@ -8,16 +8,25 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var startAPIOnly bool
+
 // StartCmd runs the server
 var StartCmd = &cobra.Command{
 	Use:     "start",
 	Aliases: []string{"run"},
 	Short:   "starts a server that implements the API services",
 	Long: `starts a server that implements the services described in the
-proto files. Requests can be made via gRPC or HTTP.`,
+proto files. Requests can be made via gRPC or HTTP.
+
+With --api-only the server never runs executions itself, they are left in
+the job queue for the 'deserver worker' processes sharing its store.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		if startAPIOnly {
+			cfg.Server.APIOnly = true
+		}
+
 		// Validate server configuration
 		if err := cfg.Server.Validate(); err != nil {
 			return fmt.Errorf("invalid server configuration: %w", err)
@@ -39,4 +48,5 @@ proto files. Requests can be made via gRPC or HTTP.`,
 
 func init() {
 	rootCmd.AddCommand(StartCmd)
+	StartCmd.Flags().BoolVar(&startAPIOnly, "api-only", false, "serve the API without running executions (same as API_ONLY=true)")
 }
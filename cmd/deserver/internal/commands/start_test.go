@@ -5,6 +5,7 @@ import (
 
 	deconfig "github.com/nicholaspcr/GoDE/cmd/deserver/internal/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStartCommand(t *testing.T) {
@@ -23,6 +24,12 @@ func TestStartCommand(t *testing.T) {
 		assert.NotNil(t, StartCmd.RunE)
 	})
 
+	t.Run("has api-only flag", func(t *testing.T) {
+		flag := StartCmd.Flags().Lookup("api-only")
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+
 	t.Run("fails with invalid server config", func(t *testing.T) {
 		cfg = deconfig.Default()
 		// Default config has empty JWTSecret which fails validation
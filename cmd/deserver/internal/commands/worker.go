@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/nicholaspcr/GoDE/internal/server"
+	"github.com/nicholaspcr/GoDE/internal/storefactory"
+	"github.com/spf13/cobra"
+)
+
+// WorkerCmd runs a compute worker
+var WorkerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "starts a worker that runs the queued executions",
+	Long: `starts a worker that claims the executions queued in the shared store,
+runs them and reports their progress and results back to the store. Workers
+serve no API, only the /health and /readiness endpoints on the HTTP port, so
+they scale independently of the API servers started with 'start --api-only'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		// Validate worker configuration
+		if err := cfg.Server.ValidateWorker(); err != nil {
+			return fmt.Errorf("invalid worker configuration: %w", err)
+		}
+
+		st, err := storefactory.New(ctx, cfg.Store)
+		if err != nil {
+			return err
+		}
+
+		srv, err := server.NewWorker(cfg.Server, server.WithStore(st))
+		if err != nil {
+			return err
+		}
+
+		return srv.Start(ctx)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(WorkerCmd)
+}
@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+
+	deconfig "github.com/nicholaspcr/GoDE/cmd/deserver/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerCommand(t *testing.T) {
+	t.Run("command exists", func(t *testing.T) {
+		assert.NotNil(t, WorkerCmd)
+		assert.Equal(t, "worker", WorkerCmd.Use)
+		assert.NotEmpty(t, WorkerCmd.Short)
+		assert.NotEmpty(t, WorkerCmd.Long)
+	})
+
+	t.Run("has RunE function", func(t *testing.T) {
+		assert.NotNil(t, WorkerCmd.RunE)
+	})
+
+	t.Run("fails with invalid worker config", func(t *testing.T) {
+		cfg = deconfig.Default()
+		cfg.Server.Executor.MaxWorkers = 0
+		err := WorkerCmd.RunE(WorkerCmd, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid worker configuration")
+	})
+}
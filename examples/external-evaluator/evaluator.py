@@ -0,0 +1,50 @@
+#!/usr/bin/env python3
+"""Example GoDE external evaluator.
+
+Evaluates ZDT1 with the constraint sum(x) <= 1 for the batches of decision
+vectors the server sends on stdin, one JSON request per line, answering each
+with one JSON response line on stdout. Replace evaluate() with a call into
+your own simulator.
+"""
+
+import json
+import math
+import sys
+
+
+def evaluate(x, objectives):
+    """Returns the objectives and constraint violations of the vector x."""
+    if objectives != 2:
+        raise ValueError(f"zdt1 has 2 objectives, got {objectives}")
+    if len(x) < 2:
+        raise ValueError("zdt1 needs at least 2 dimensions")
+
+    g = 1 + 9 * sum(x[1:]) / (len(x) - 1)
+    f1 = x[0]
+    f2 = g * (1 - math.sqrt(f1 / g))
+
+    # Violations are the amount by which each constraint is exceeded,
+    # the server treats anything below zero as satisfied.
+    violation = sum(x) - 1
+    return [f1, f2], [violation]
+
+
+def main():
+    for line in sys.stdin:
+        request = json.loads(line)
+        response = {"id": request["id"], "objectives": [], "constraints": []}
+        try:
+            for x in request["vectors"]:
+                objs, cons = evaluate(x, request["objectives"])
+                response["objectives"].append(objs)
+                response["constraints"].append(cons)
+        except Exception as exc:  # report the failure instead of exiting
+            print(f"batch {request['id']} failed: {exc}", file=sys.stderr)
+            response = {"id": request["id"], "error": str(exc)}
+
+        sys.stdout.write(json.dumps(response) + "\n")
+        sys.stdout.flush()
+
+
+if __name__ == "__main__":
+    main()
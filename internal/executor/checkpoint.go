@@ -0,0 +1,153 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+)
+
+const defaultCheckpointInterval = 100
+
+// ErrNotResumable is returned when resuming an execution that is still
+// pending or running, or a completed one without more generations to run.
+var ErrNotResumable = errors.New("execution cannot be resumed")
+
+// encodeCheckpoint serializes a checkpoint with gob, which unlike JSON keeps
+// the infinite crowding distances of the boundary vectors.
+func encodeCheckpoint(checkpoint *de.Checkpoint) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(checkpoint); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeCheckpoint(data []byte) (*de.Checkpoint, error) {
+	var checkpoint de.Checkpoint
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// checkpointCallback saves the checkpoints of an execution in the store. The
+// checkpoint of a cancelled execution is saved as well, so the store calls
+// ignore the cancellation of ctx.
+func (e *Executor) checkpointCallback(ctx context.Context, executionID string) de.CheckpointCallback {
+	ctx = context.WithoutCancel(ctx)
+	return func(checkpoint *de.Checkpoint) {
+		data, err := encodeCheckpoint(checkpoint)
+		if err == nil {
+			err = e.store.SaveCheckpoint(ctx, &store.Checkpoint{
+				ExecutionID:     executionID,
+				ExecutionNumber: checkpoint.Execution,
+				Generation:      checkpoint.Generation,
+				Data:            data,
+			})
+		}
+		if err != nil {
+			slog.Warn("failed to save checkpoint",
+				slog.String("execution_id", executionID),
+				slog.Int("execution", checkpoint.Execution),
+				slog.Int("generation", checkpoint.Generation),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// loadCheckpoints returns the checkpoints an execution resumes from. The
+// executions whose checkpoint cannot be decoded start from scratch.
+func (e *Executor) loadCheckpoints(ctx context.Context, executionID string) ([]*de.Checkpoint, error) {
+	stored, err := e.store.ListCheckpoints(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checkpoints: %w", err)
+	}
+
+	checkpoints := make([]*de.Checkpoint, 0, len(stored))
+	for _, s := range stored {
+		checkpoint, err := decodeCheckpoint(s.Data)
+		if err != nil {
+			slog.Warn("discarding undecodable checkpoint",
+				slog.String("execution_id", executionID),
+				slog.Int("execution", s.ExecutionNumber),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints, nil
+}
+
+// ResumeExecution continues a failed or cancelled execution from its last
+// checkpoints. generations, when larger than the configured generations,
+// extends the budget of the run, which also resumes completed executions.
+// Zero keeps the configured generations.
+func (e *Executor) ResumeExecution(ctx context.Context, executionID, userID string, generations int64) error {
+	execution, err := e.store.GetExecution(ctx, executionID, userID)
+	if err != nil {
+		return err
+	}
+
+	current := execution.Config.GetGenerations()
+	if generations != 0 && generations < current {
+		return fmt.Errorf("%w: generations %d is below the %d already configured", ErrNotResumable, generations, current)
+	}
+
+	switch execution.Status {
+	case store.ExecutionStatusFailed, store.ExecutionStatusCancelled:
+	case store.ExecutionStatusCompleted:
+		if generations <= current {
+			return fmt.Errorf("%w: completed executions only resume with more than %d generations", ErrNotResumable, current)
+		}
+	default:
+		return fmt.Errorf("%w: execution is %s", ErrNotResumable, execution.Status)
+	}
+
+	config := execution.Config
+	if generations > current {
+		config = proto.Clone(config).(*api.DEConfig)
+		config.Generations = generations
+	}
+
+	if err := e.checkExecutionQuota(ctx); err != nil {
+		return err
+	}
+
+	if err := e.store.RestartExecution(ctx, executionID, config); err != nil {
+		return fmt.Errorf("failed to restart execution: %w", err)
+	}
+	if err := e.store.EnqueueJob(ctx, &store.Job{
+		ExecutionID:         executionID,
+		TenantID:            tenant.IDFromContext(ctx),
+		UserID:              execution.UserID,
+		MaxExecutionSeconds: execution.MaxExecutionSeconds,
+	}); err != nil {
+		if updateErr := e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, "failed to enqueue execution"); updateErr != nil {
+			slog.Error("failed to update execution status after enqueue failure",
+				slog.String("execution_id", executionID),
+				slog.Any("update_error", updateErr),
+			)
+		}
+		return fmt.Errorf("failed to enqueue execution: %w", err)
+	}
+	e.queue.notify()
+
+	slog.Info("resuming execution",
+		slog.String("execution_id", executionID),
+		slog.Int64("generations", config.GetGenerations()),
+	)
+	return nil
+}
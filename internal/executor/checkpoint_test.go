@@ -0,0 +1,162 @@
+package executor
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+)
+
+func TestEncodeCheckpoint(t *testing.T) {
+	checkpoint := &de.Checkpoint{
+		Execution:  1,
+		Generation: 5,
+		Population: []models.Vector{
+			{Elements: []float64{0.1, 0.2}, Objectives: []float64{1, 2}, CrowdingDistance: math.Inf(1)},
+		},
+		MaxObjectives: []float64{3, 4},
+		Random:        de.RandomState{Seed: 7, Draws: 42},
+		State:         map[string][]float64{"mu_f": {0.5}},
+	}
+
+	data, err := encodeCheckpoint(checkpoint)
+	require.NoError(t, err)
+
+	decoded, err := decodeCheckpoint(data)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint, decoded)
+
+	_, err = decodeCheckpoint([]byte("garbage"))
+	assert.Error(t, err)
+}
+
+// waitForStatus waits until the execution reaches the status.
+func waitForStatus(t *testing.T, st *mockStore, executionID string, status store.ExecutionStatus) *store.Execution {
+	t.Helper()
+	var execution *store.Execution
+	require.Eventually(t, func() bool {
+		var err error
+		execution, err = st.GetExecution(context.Background(), executionID, "test-user")
+		return err == nil && execution.Status == status
+	}, 10*time.Second, 20*time.Millisecond, "execution should be %s", status)
+	return execution
+}
+
+func TestExecutor_ResumeExecution(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("extends a completed execution", func(t *testing.T) {
+		st := newMockStore()
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		exec.Start()
+
+		executionID, err := exec.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", queueTestConfig(), "", 0)
+		require.NoError(t, err)
+		waitForStatus(t, st, executionID, store.ExecutionStatusCompleted)
+
+		checkpoints, err := st.ListCheckpoints(ctx, executionID)
+		require.NoError(t, err)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, 2, checkpoints[0].Generation, "the last generation is always checkpointed")
+
+		require.NoError(t, exec.ResumeExecution(ctx, executionID, "test-user", 4))
+		execution := waitForStatus(t, st, executionID, store.ExecutionStatusCompleted)
+		assert.Equal(t, int64(4), execution.Config.Generations)
+
+		checkpoints, err = st.ListCheckpoints(ctx, executionID)
+		require.NoError(t, err)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, 4, checkpoints[0].Generation)
+
+		// The extended run ends where an uninterrupted one does
+		config := queueTestConfig()
+		config.Generations = 4
+		uninterruptedID, err := exec.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", config, "", 0)
+		require.NoError(t, err)
+		uninterrupted := waitForStatus(t, st, uninterruptedID, store.ExecutionStatusCompleted)
+
+		extended, err := st.GetParetoSetByID(ctx, *execution.ParetoID)
+		require.NoError(t, err)
+		expected, err := st.GetParetoSetByID(ctx, *uninterrupted.ParetoID)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Vectors, extended.Vectors)
+	})
+
+	t.Run("resumes a failed execution from its checkpoint", func(t *testing.T) {
+		st := newMockStore()
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		seedClaimedJob(t, st, "failed", "worker-a", 1, time.Now())
+		require.NoError(t, st.UpdateExecutionStatus(ctx, "failed", store.ExecutionStatusFailed, "execution timed out"))
+		require.NoError(t, st.CompleteJob(ctx, "failed", "worker-a"))
+
+		data, err := encodeCheckpoint(checkpointAfter(t, 1))
+		require.NoError(t, err)
+		require.NoError(t, st.SaveCheckpoint(ctx, &store.Checkpoint{
+			ExecutionID: "failed", ExecutionNumber: 0, Generation: 1, Data: data,
+		}))
+
+		require.NoError(t, exec.ResumeExecution(ctx, "failed", "test-user", 0))
+		job, _ := st.getJob("failed")
+		assert.Equal(t, store.JobStatusQueued, job.Status)
+
+		exec.Start()
+		execution := waitForStatus(t, st, "failed", store.ExecutionStatusCompleted)
+		assert.Empty(t, execution.Error)
+		assert.Equal(t, int64(2), execution.Config.Generations)
+
+		checkpoints, err := st.ListCheckpoints(ctx, "failed")
+		require.NoError(t, err)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, 2, checkpoints[0].Generation)
+	})
+
+	t.Run("rejects executions that cannot resume", func(t *testing.T) {
+		st := newMockStore()
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		seedClaimedJob(t, st, "running", "worker-a", 1, time.Now())
+
+		err := exec.ResumeExecution(ctx, "running", "test-user", 0)
+		assert.ErrorIs(t, err, ErrNotResumable)
+
+		require.NoError(t, st.UpdateExecutionStatus(ctx, "running", store.ExecutionStatusCompleted, ""))
+		err = exec.ResumeExecution(ctx, "running", "test-user", 0)
+		assert.ErrorIs(t, err, ErrNotResumable, "completed executions need more generations")
+		err = exec.ResumeExecution(ctx, "running", "test-user", 2)
+		assert.ErrorIs(t, err, ErrNotResumable)
+
+		require.NoError(t, st.UpdateExecutionStatus(ctx, "running", store.ExecutionStatusCancelled, ""))
+		err = exec.ResumeExecution(ctx, "running", "test-user", 1)
+		assert.ErrorIs(t, err, ErrNotResumable, "generations cannot shrink")
+
+		err = exec.ResumeExecution(ctx, "running", "other-user", 0)
+		assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+	})
+}
+
+// checkpointAfter runs the execution configured by queueTestConfig for gen
+// generations and returns its checkpoint.
+func checkpointAfter(t *testing.T, gen int) *de.Checkpoint {
+	t.Helper()
+	st := newMockStore()
+	exec := newQueueTestExecutor(t, st, "worker-b")
+	exec.Start()
+
+	config := queueTestConfig()
+	config.Generations = int64(gen)
+	executionID, err := exec.SubmitExecution(context.Background(), "test-user", "gde3", "zdt1", "rand1", config, "", 0)
+	require.NoError(t, err)
+	waitForStatus(t, st, executionID, store.ExecutionStatusCompleted)
+
+	checkpoints, err := exec.loadCheckpoints(context.Background(), executionID)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	return checkpoints[0]
+}
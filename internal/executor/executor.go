@@ -2,26 +2,43 @@
 package executor
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"math/rand"
+	"runtime"
 	"runtime/debug"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
 	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/problems/custom"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/nicholaspcr/GoDE/internal/store"
 	"github.com/nicholaspcr/GoDE/internal/telemetry"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 )
 
+// referenceFrontPoints is the size of the reference front used to score
+// results of problems with a known Pareto front.
+const referenceFrontPoints = 1000
+
+// defaultResultLimit is the size of the merged Pareto set of executions whose
+// request sets no result limit.
+const defaultResultLimit = 1000
+
 // Executor manages background execution of DE algorithms.
 type Executor struct {
 	store               store.Store
@@ -31,10 +48,17 @@ type Executor struct {
 	defaultMaxExecution time.Duration
 	workers             *workerPool
 	progress            *progressTracker
-	activeExecs         map[string]context.CancelFunc
+	activeExecs         map[string]context.CancelCauseFunc
 	activeExecsMu       sync.RWMutex
-	problemRegistry     map[string]problems.Interface
+	problemRegistry     map[string]problems.ProblemFactory
 	variantRegistry     map[string]variants.Interface
+	evaluationWorkers   int
+	sharedEvaluation    *de.EvaluationPool
+	checkpointInterval  int
+	resultLimit         int
+	metrics             *telemetry.Metrics
+	queue               *jobQueue
+	quotas              *tenantQuotas
 }
 
 // Config holds configuration for the Executor.
@@ -46,7 +70,17 @@ type Config struct {
 	ResultTTL            time.Duration
 	ProgressTTL          time.Duration
 	DefaultMaxExecution  time.Duration // Maximum wall-clock time per execution (0 = no limit)
+	EvaluationWorkers    int           // Concurrent objective evaluations (0 or 1 = sequential)
+	SharedEvaluationPool bool          // One evaluation pool for all executions
+	WorkerID             string        // Identifies the executor in the jobs it claims (default: hostname-pid)
+	MaxAttempts          int           // Claims of a job before it is failed (default: 3)
+	JobLease             time.Duration // Time without a heartbeat before a claimed job is orphaned (default: 1m)
+	PollInterval         time.Duration // Interval between polls of the job queue (default: 1s)
+	CheckpointInterval   int           // Generations between checkpoints of an execution (default: 100)
+	ResultLimit          int           // Size of the merged Pareto set when the request sets none (default: 1000)
 	Metrics              *telemetry.Metrics
+	DefaultTenantQuota   TenantQuota            // Quota of the tenants without one of their own (default: unlimited)
+	TenantQuotas         map[string]TenantQuota // Quotas by tenant ID
 }
 
 // New creates a new Executor instance.
@@ -55,6 +89,14 @@ func New(cfg Config) *Executor {
 	if maxVectorsInProgress <= 0 {
 		maxVectorsInProgress = 100 // Default value
 	}
+	checkpointInterval := cfg.CheckpointInterval
+	if checkpointInterval <= 0 {
+		checkpointInterval = defaultCheckpointInterval
+	}
+	resultLimit := cfg.ResultLimit
+	if resultLimit <= 0 {
+		resultLimit = defaultResultLimit
+	}
 
 	e := &Executor{
 		store:               cfg.Store,
@@ -64,17 +106,54 @@ func New(cfg Config) *Executor {
 		defaultMaxExecution: cfg.DefaultMaxExecution,
 		workers:             newWorkerPool(cfg.MaxWorkers, cfg.Metrics),
 		progress:            newProgressTracker(cfg.Store, maxVectorsInProgress),
-		activeExecs:         make(map[string]context.CancelFunc),
-		problemRegistry:     make(map[string]problems.Interface),
+		activeExecs:         make(map[string]context.CancelCauseFunc),
+		problemRegistry:     make(map[string]problems.ProblemFactory),
 		variantRegistry:     make(map[string]variants.Interface),
+		evaluationWorkers:   evaluationWorkers(cfg.EvaluationWorkers, cfg.MaxWorkers, cfg.SharedEvaluationPool),
+		checkpointInterval:  checkpointInterval,
+		resultLimit:         resultLimit,
+		metrics:             cfg.Metrics,
+		queue:               newJobQueue(cfg.WorkerID, cfg.MaxAttempts, cfg.JobLease, cfg.PollInterval),
+		quotas:              newTenantQuotas(cfg.DefaultTenantQuota, cfg.TenantQuotas),
+	}
+	if cfg.SharedEvaluationPool {
+		e.sharedEvaluation = de.NewEvaluationPool(e.evaluationWorkers)
 	}
 
 	return e
 }
 
-// RegisterProblem registers a problem implementation.
-func (e *Executor) RegisterProblem(name string, p problems.Interface) {
-	e.problemRegistry[name] = p
+// evaluationWorkers caps the requested evaluation workers to the available
+// CPUs. Without a shared pool up to maxWorkers executions evaluate at once,
+// so each only gets its share of the CPUs.
+func evaluationWorkers(requested, maxWorkers int, shared bool) int {
+	limit := runtime.GOMAXPROCS(0)
+	if !shared && maxWorkers > 1 {
+		limit = max(1, limit/maxWorkers)
+	}
+	if requested > limit {
+		slog.Warn("evaluation workers capped to avoid oversubscribing the CPUs",
+			slog.Int("requested", requested),
+			slog.Int("workers", limit),
+		)
+		return limit
+	}
+	return requested
+}
+
+// evaluationPool returns the pool an execution evaluates its vectors with,
+// the shared one or one of its own.
+func (e *Executor) evaluationPool() *de.EvaluationPool {
+	if e.sharedEvaluation != nil {
+		return e.sharedEvaluation
+	}
+	return de.NewEvaluationPool(e.evaluationWorkers)
+}
+
+// RegisterProblem registers a problem factory, a new instance is created for
+// every execution with its dimensions and objectives.
+func (e *Executor) RegisterProblem(name string, factory problems.ProblemFactory) {
+	e.problemRegistry[name] = factory
 }
 
 // RegisterVariant registers a variant implementation.
@@ -82,22 +161,39 @@ func (e *Executor) RegisterVariant(name string, v variants.Interface) {
 	e.variantRegistry[name] = v
 }
 
+// SubmitOption sets optional fields of an execution before it is stored.
+type SubmitOption func(*store.Execution)
+
+// WithExperimentID adds the execution to the experiment.
+func WithExperimentID(experimentID string) SubmitOption {
+	return func(execution *store.Execution) {
+		execution.ExperimentID = experimentID
+	}
+}
+
 // SubmitExecution submits a new DE execution to run in the background.
 // idempotencyKey is optional; if non-empty the store is checked for an existing execution.
 // maxExecutionSeconds overrides the server default timeout (0 = use server default).
-func (e *Executor) SubmitExecution(ctx context.Context, userID, algorithm, problem, variant string, config *api.DEConfig, idempotencyKey string, maxExecutionSeconds int64) (string, error) {
+func (e *Executor) SubmitExecution(ctx context.Context, userID, algorithm, problem, variant string, config *api.DEConfig, idempotencyKey string, maxExecutionSeconds int64, opts ...SubmitOption) (string, error) {
 	// Validate problem and variant exist before creating execution record
-	if _, exists := e.problemRegistry[problem]; !exists {
-		return "", fmt.Errorf("unknown problem: %s", problem)
+	p, err := e.newProblem(problem, config)
+	if err != nil {
+		return "", err
 	}
+	closeProblem(p)
 	if _, exists := e.variantRegistry[variant]; !exists {
 		return "", fmt.Errorf("unknown variant: %s", variant)
 	}
+	if err := e.checkExecutionQuota(ctx); err != nil {
+		return "", err
+	}
 
-	// Determine effective timeout for this execution
-	timeout := e.defaultMaxExecution
-	if maxExecutionSeconds > 0 {
-		timeout = time.Duration(maxExecutionSeconds) * time.Second
+	// Pick a seed when the client did not, keeping it on the config so the run
+	// can be reproduced from the stored execution
+	if config.Seed == nil {
+		config = proto.Clone(config).(*api.DEConfig)
+		// #nosec G404 - Using math/rand for DE algorithm randomness, not cryptographic purposes
+		config.Seed = proto.Int64(rand.Int63())
 	}
 
 	// Generate execution ID
@@ -114,26 +210,39 @@ func (e *Executor) SubmitExecution(ctx context.Context, userID, algorithm, probl
 		Problem:             problem,
 		IdempotencyKey:      idempotencyKey,
 		MaxExecutionSeconds: maxExecutionSeconds,
+		Seed:                config.GetSeed(),
 		CreatedAt:           time.Now(),
 		UpdatedAt:           time.Now(),
 	}
+	for _, opt := range opts {
+		opt(execution)
+	}
 
 	if err := e.store.CreateExecution(ctx, execution); err != nil {
 		return "", fmt.Errorf("failed to create execution: %w", err)
 	}
 
-	// Extract trace context values from parent context before spawning goroutine.
-	// This allows background execution to propagate parent context values (e.g., tracing spans)
-	// while still being cancellable independently.
-	parentCtx := context.WithoutCancel(ctx)
-
-	// Submit to worker pool (non-blocking)
-	go e.executeInBackground(parentCtx, executionID, userID, algorithm, problem, variant, config, timeout)
+	// Persist the job so the execution survives restarts until a worker runs it
+	if err := e.store.EnqueueJob(ctx, &store.Job{
+		ExecutionID:         executionID,
+		TenantID:            tenant.IDFromContext(ctx),
+		UserID:              userID,
+		MaxExecutionSeconds: maxExecutionSeconds,
+	}); err != nil {
+		if updateErr := e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, "failed to enqueue execution"); updateErr != nil {
+			slog.Error("failed to update execution status after enqueue failure",
+				slog.String("execution_id", executionID),
+				slog.Any("update_error", updateErr),
+			)
+		}
+		return "", fmt.Errorf("failed to enqueue execution: %w", err)
+	}
+	e.queue.notify()
 
 	return executionID, nil
 }
 
-// CancelExecution cancels a running execution.
+// CancelExecution cancels a running or queued execution.
 func (e *Executor) CancelExecution(ctx context.Context, executionID, userID string) error {
 	// Mark for cancellation in store
 	if err := e.store.MarkExecutionForCancellation(ctx, executionID, userID); err != nil {
@@ -142,22 +251,75 @@ func (e *Executor) CancelExecution(ctx context.Context, executionID, userID stri
 
 	// Cancel the context if execution is active
 	e.activeExecsMu.Lock()
-	if cancel, exists := e.activeExecs[executionID]; exists {
-		cancel()
+	cancel, active := e.activeExecs[executionID]
+	if active {
+		cancel(nil)
 		delete(e.activeExecs, executionID)
 	}
 	e.activeExecsMu.Unlock()
+	if active {
+		return nil
+	}
+
+	// Executions still waiting in the queue are cancelled right away
+	execution, err := e.store.GetExecution(ctx, executionID, userID)
+	if err != nil {
+		return err
+	}
+	if execution.Status == store.ExecutionStatusPending {
+		if err := e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusCancelled, ""); err != nil {
+			return err
+		}
+		e.completeJob(ctx, executionID, "")
+	}
 
 	return nil
 }
 
+// PoolStats describes the workers of an executor and the jobs of the queue it
+// shares with the other executors.
+type PoolStats struct {
+	WorkerID      string
+	MaxWorkers    int
+	ActiveWorkers int
+	QueuedJobs    int
+	ClaimedJobs   int
+}
+
+// PoolStats returns the workers of the executor in use, by the executions of
+// every tenant, and the jobs of the tenant of ctx waiting in or claimed from
+// the queue.
+func (e *Executor) PoolStats(ctx context.Context) (PoolStats, error) {
+	stats := PoolStats{
+		WorkerID:      e.queue.workerID,
+		MaxWorkers:    e.workers.maxWorkers,
+		ActiveWorkers: int(e.workers.getActiveCount()),
+	}
+
+	tenantID := tenant.IDFromContext(ctx)
+	var err error
+	stats.QueuedJobs, err = e.store.CountJobs(ctx, tenantID, store.JobStatusQueued)
+	if err != nil {
+		return PoolStats{}, fmt.Errorf("failed to count queued jobs: %w", err)
+	}
+	stats.ClaimedJobs, err = e.store.CountJobs(ctx, tenantID, store.JobStatusClaimed)
+	if err != nil {
+		return PoolStats{}, fmt.Errorf("failed to count claimed jobs: %w", err)
+	}
+	return stats, nil
+}
+
 // Shutdown gracefully stops all active executions and waits for workers to finish.
-// It cancels all running executions and waits up to 30 seconds for them to complete.
+// It stops claiming jobs, cancels all running executions, which go back to the
+// queue, and waits up to 30 seconds for them to complete.
 func (e *Executor) Shutdown(ctx context.Context) error {
+	// Stop claiming jobs before draining the workers
+	e.queue.stop()
+
 	// Copy cancel functions to avoid holding lock during cancellation
 	e.activeExecsMu.Lock()
 	activeCount := len(e.activeExecs)
-	cancelFuncs := make([]context.CancelFunc, 0, activeCount)
+	cancelFuncs := make([]context.CancelCauseFunc, 0, activeCount)
 	executionIDs := make([]string, 0, activeCount)
 	for id, cancel := range e.activeExecs {
 		cancelFuncs = append(cancelFuncs, cancel)
@@ -175,11 +337,11 @@ func (e *Executor) Shutdown(ctx context.Context) error {
 		slog.Info("cancelling execution during shutdown",
 			slog.String("execution_id", executionIDs[i]),
 		)
-		cancel()
+		cancel(errShutdown)
 	}
 
 	// Wait for all workers to finish by acquiring all slots
-	// This ensures all executeInBackground goroutines have completed
+	// This ensures all runJob goroutines have completed
 	shutdownTimeout := 30 * time.Second
 	deadline := time.Now().Add(shutdownTimeout)
 
@@ -217,36 +379,64 @@ func (e *Executor) Shutdown(ctx context.Context) error {
 	return nil
 }
 
-func (e *Executor) executeInBackground(parentCtx context.Context, executionID, userID, algorithm, problem, variant string, config *api.DEConfig, timeout time.Duration) {
-	// Create base context for worker acquisition
-	ctx := context.Background()
+// runJob runs the execution of a claimed job on the worker slot acquired for
+// it, then completes the job or, when the executor shuts down mid-run, puts it
+// back in the queue.
+func (e *Executor) runJob(job *store.Job, releaseWorker func()) {
+	defer releaseWorker()
+
+	// Create base context for the execution, in the tenant it belongs to
+	baseCtx := tenant.WithID(context.Background(), job.TenantID)
+	executionID := job.ExecutionID
 
-	// Acquire worker slot (blocks until available or context cancelled)
-	releaseWorker, _, err := e.workers.acquireWorker(ctx)
+	execution, err := e.store.GetExecution(baseCtx, executionID, job.UserID)
 	if err != nil {
-		slog.Error("failed to acquire worker slot",
+		slog.Error("failed to load execution of claimed job",
 			slog.String("execution_id", executionID),
 			slog.String("error", err.Error()),
 		)
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			e.completeJob(baseCtx, executionID, job.WorkerID)
+		}
 		return
 	}
-	defer releaseWorker()
 
-	// Create cancellable context derived from parent (preserves trace context).
-	// The parent context is already detached from request cancellation via WithoutCancel.
-	var cancel context.CancelFunc
+	// Executions cancelled while queued never start
+	switch execution.Status {
+	case store.ExecutionStatusCompleted, store.ExecutionStatusFailed, store.ExecutionStatusCancelled:
+		e.completeJob(baseCtx, executionID, job.WorkerID)
+		return
+	}
+
+	// Determine effective timeout for this execution
+	timeout := e.defaultMaxExecution
+	if job.MaxExecutionSeconds > 0 {
+		timeout = time.Duration(job.MaxExecutionSeconds) * time.Second
+	}
+
+	// Create cancellable context, the cause tells a user cancellation apart
+	// from a shutdown or a lost claim.
+	ctx, cancel := context.WithCancelCause(baseCtx)
+	defer cancel(nil)
 	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(parentCtx, timeout)
-	} else {
-		ctx, cancel = context.WithCancel(parentCtx)
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
 	}
-	defer cancel()
 
 	// Register active execution
 	e.activeExecsMu.Lock()
 	e.activeExecs[executionID] = cancel
 	e.activeExecsMu.Unlock()
 
+	// Keep the claim alive while the execution runs
+	stopHeartbeat := e.queue.keepAlive(baseCtx, e.store, executionID, cancel)
+	defer stopHeartbeat()
+
+	metrics := newExecutionMetrics(e.metrics, execution.Algorithm, execution.Variant, execution.Problem)
+	defer metrics.running(baseCtx)()
+	start := time.Now()
+
 	// Cleanup on exit
 	defer func() {
 		e.activeExecsMu.Lock()
@@ -260,13 +450,17 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 				slog.Any("panic", r),
 				slog.String("stack", string(stack)),
 			)
-			if updateErr := e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, fmt.Sprintf("panic: %v", r)); updateErr != nil {
+			if !e.completeJob(baseCtx, executionID, job.WorkerID) {
+				return
+			}
+			if updateErr := e.store.UpdateExecutionStatus(baseCtx, executionID, store.ExecutionStatusFailed, fmt.Sprintf("panic: %v", r)); updateErr != nil {
 				slog.Error("failed to update execution status after panic",
 					slog.String("execution_id", executionID),
 					slog.String("panic", fmt.Sprintf("%v", r)),
 					slog.Any("update_error", updateErr),
 				)
 			}
+			metrics.finished(baseCtx, store.ExecutionStatusFailed, time.Since(start))
 		}
 	}()
 
@@ -280,12 +474,25 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 	}
 
 	// Execute the algorithm
-	pareto, maxObjs, err := e.runAlgorithm(ctx, executionID, algorithm, problem, variant, config)
+	result, err := e.runAlgorithm(ctx, executionID, execution.Algorithm, execution.Problem, execution.Variant, execution.Config, metrics.observer())
 	if err != nil {
 		var updateErr error
+		status := store.ExecutionStatusFailed
 		switch {
+		case errors.Is(context.Cause(ctx), errShutdown):
+			e.requeueJob(baseCtx, job)
+			return
+		case errors.Is(context.Cause(ctx), errClaimLost):
+			slog.Warn("execution stopped, its job was claimed by another worker",
+				slog.String("execution_id", executionID),
+			)
+			return
+		case !e.completeJob(baseCtx, executionID, job.WorkerID):
+			// Another worker owns the execution now
+			return
 		case errors.Is(err, context.Canceled):
-			updateErr = e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusCancelled, "")
+			status = store.ExecutionStatusCancelled
+			updateErr = e.store.UpdateExecutionStatus(baseCtx, executionID, store.ExecutionStatusCancelled, "")
 			if updateErr != nil {
 				slog.Error("failed to update execution status to cancelled",
 					slog.String("execution_id", executionID),
@@ -293,7 +500,7 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 				)
 			}
 		case errors.Is(err, context.DeadlineExceeded):
-			updateErr = e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, "execution timed out")
+			updateErr = e.store.UpdateExecutionStatus(baseCtx, executionID, store.ExecutionStatusFailed, "execution timed out")
 			if updateErr != nil {
 				slog.Error("failed to update execution status after timeout",
 					slog.String("execution_id", executionID),
@@ -301,7 +508,7 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 				)
 			}
 		default:
-			updateErr = e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, err.Error())
+			updateErr = e.store.UpdateExecutionStatus(baseCtx, executionID, store.ExecutionStatusFailed, err.Error())
 			if updateErr != nil {
 				slog.Error("failed to update execution status to failed",
 					slog.String("execution_id", executionID),
@@ -310,6 +517,7 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 				)
 			}
 		}
+		metrics.finished(baseCtx, status, time.Since(start))
 		slog.Info("execution failed",
 			slog.String("execution_id", executionID),
 			slog.String("error", err.Error()),
@@ -317,8 +525,15 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 		return
 	}
 
+	// Complete the job before saving results, the results of a worker that
+	// lost its claim are dropped as the job runs again elsewhere
+	stopHeartbeat()
+	if !e.completeJob(baseCtx, executionID, job.WorkerID) {
+		return
+	}
+
 	// Save results
-	paretoID, err := e.saveResults(ctx, userID, algorithm, problem, variant, pareto, maxObjs)
+	paretoID, err := e.saveResults(ctx, execution.UserID, execution.Algorithm, execution.Problem, execution.Variant, result)
 	if err != nil {
 		if updateErr := e.store.UpdateExecutionStatus(ctx, executionID, store.ExecutionStatusFailed, err.Error()); updateErr != nil {
 			slog.Error("failed to update execution status after save failure",
@@ -331,6 +546,7 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 			slog.String("execution_id", executionID),
 			slog.String("error", err.Error()),
 		)
+		metrics.finished(baseCtx, store.ExecutionStatusFailed, time.Since(start))
 		return
 	}
 
@@ -349,44 +565,119 @@ func (e *Executor) executeInBackground(parentCtx context.Context, executionID, u
 			slog.String("error", err.Error()),
 		)
 	}
+	metrics.finished(baseCtx, store.ExecutionStatusCompleted, time.Since(start))
+}
+
+// newProblem creates an instance of the problem with the dimensions and
+// objectives of the execution. Custom problems are compiled from the
+// definition recorded on the config.
+func (e *Executor) newProblem(name string, config *api.DEConfig) (problems.Interface, error) {
+	if cp := config.GetCustomProblem(); cp != nil {
+		p, err := custom.New(custom.FromProto(cp), int(config.DimensionsSize), int(config.ObjectivesSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create problem %s: %w", name, err)
+		}
+		return p, nil
+	}
+
+	factory, exists := e.problemRegistry[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown problem: %s", name)
+	}
+
+	p, err := factory(int(config.DimensionsSize), int(config.ObjectivesSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create problem %s: %w", name, err)
+	}
+	return p, nil
+}
+
+// closeProblem releases the resources held by problems that hold any, such as
+// the processes of external evaluators.
+func closeProblem(p problems.Interface) {
+	closer, ok := p.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		slog.Warn("failed to close problem",
+			slog.String("problem", p.Name()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// decisionBounds resolves the domain of each decision variable. Explicit
+// per-dimension bounds win over the scalar limiters, and when neither is set
+// the problem's natural bounds are used.
+func decisionBounds(problemName string, config *api.DEConfig) (floor, ceil []float64) {
+	dim := int(config.DimensionsSize)
+
+	if len(config.Bounds) > 0 {
+		floor = make([]float64, dim)
+		ceil = make([]float64, dim)
+		for i, b := range config.Bounds {
+			floor[i], ceil[i] = b.GetFloor(), b.GetCeil()
+		}
+		return floor, ceil
+	}
+
+	if config.FloorLimiter != 0 || config.CeilLimiter != 0 {
+		return problems.UniformBounds(float64(config.FloorLimiter), float64(config.CeilLimiter))(dim)
+	}
+
+	if cp := config.GetCustomProblem(); cp != nil {
+		meta, _ := custom.FromProto(cp).Metadata()
+		return meta.DefaultBounds(dim)
+	}
+
+	meta, _ := problems.DefaultRegistry.Get(problemName)
+	return meta.DefaultBounds(dim)
 }
 
-func (e *Executor) runAlgorithm(ctx context.Context, executionID, algorithmName, problemName, variantName string, config *api.DEConfig) ([]models.Vector, [][]float64, error) {
+func (e *Executor) runAlgorithm(ctx context.Context, executionID, algorithmName, problemName, variantName string, config *api.DEConfig, metrics de.MetricsObserver) (*de.Result, error) {
 	// Register execution for progress tracking
 	counter, cleanup := e.progress.registerExecution(executionID)
 	defer cleanup()
 
-	// Get problem
-	problemImpl, exists := e.problemRegistry[problemName]
-	if !exists {
-		return nil, nil, fmt.Errorf("unknown problem: %s", problemName)
+	// Create a problem sized for this execution
+	problemImpl, err := e.newProblem(problemName, config)
+	if err != nil {
+		return nil, err
 	}
+	defer closeProblem(problemImpl)
 
 	// Get variant
 	variantImpl, exists := e.variantRegistry[variantName]
 	if !exists {
-		return nil, nil, fmt.Errorf("unknown variant: %s", variantName)
+		return nil, fmt.Errorf("unknown variant: %s", variantName)
+	}
+
+	// Get the operators building trial vectors, empty names use the defaults
+	crossoverImpl, err := crossover.DefaultRegistry.Create(cmp.Or(config.GetCrossover(), crossover.Default))
+	if err != nil {
+		return nil, err
+	}
+	repairImpl, err := repair.DefaultRegistry.Create(cmp.Or(config.GetRepair(), repair.Default))
+	if err != nil {
+		return nil, err
 	}
 
 	// Build population parameters
+	floorRange, ceilRange := decisionBounds(problemName, config)
 	popParams := models.PopulationParams{
 		PopulationSize: int(config.PopulationSize),
 		DimensionSize:  int(config.DimensionsSize),
 		ObjectivesSize: int(config.ObjectivesSize),
-		FloorRange:     make([]float64, config.DimensionsSize),
-		CeilRange:      make([]float64, config.DimensionsSize),
-	}
-
-	for i := range config.DimensionsSize {
-		popParams.FloorRange[i] = float64(config.FloorLimiter)
-		popParams.CeilRange[i] = float64(config.CeilLimiter)
+		FloorRange:     floorRange,
+		CeilRange:      ceilRange,
 	}
 
 	// Generate initial population
 	// #nosec G404 - Using math/rand for DE algorithm randomness, not cryptographic purposes
-	initialPop, err := models.GeneratePopulation(popParams, rand.New(rand.NewSource(time.Now().UnixNano())))
+	initialPop, err := models.GeneratePopulation(popParams, rand.New(rand.NewSource(config.GetSeed())))
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate population: %w", err)
+		return nil, fmt.Errorf("failed to generate population: %w", err)
 	}
 
 	// Create progress callback using progress tracker
@@ -397,57 +688,70 @@ func (e *Executor) runAlgorithm(ctx context.Context, executionID, algorithmName,
 		int32(config.Executions),
 	)
 
+	// Resume the executions that saved a checkpoint before stopping
+	checkpoints, err := e.loadCheckpoints(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Look up algorithm factory from registry
 	factory, err := de.DefaultRegistry.GetFactory(algorithmName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unsupported algorithm %q: %w", algorithmName, err)
+		return nil, fmt.Errorf("unsupported algorithm %q: %w", algorithmName, err)
 	}
 
 	// Create algorithm via factory
 	algorithm, err := factory(de.AlgorithmParams{
-		Problem:           problemImpl,
-		Variant:           variantImpl,
-		PopulationParams:  popParams,
-		InitialPopulation: initialPop,
-		ProgressCallback:  progressCallback,
+		Problem:            problemImpl,
+		Variant:            variantImpl,
+		Crossover:          crossoverImpl,
+		Repair:             repairImpl,
+		PopulationParams:   popParams,
+		InitialPopulation:  initialPop,
+		ProgressCallback:   progressCallback,
+		EvaluationPool:     e.evaluationPool(),
+		CheckpointCallback: e.checkpointCallback(ctx, executionID),
+		CheckpointInterval: e.checkpointInterval,
+		Metrics:            metrics,
 	}, config)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create algorithm: %w", err)
+		return nil, fmt.Errorf("failed to create algorithm: %w", err)
 	}
 
 	// Create DE mode
 	deConfig := de.Config{
 		ParetoChannelLimiter: int(config.Executions),
 		MaxChannelLimiter:    int(config.Executions),
-		ResultLimiter:        1000,
+		ResultLimiter:        cmp.Or(int(config.GetResultLimit()), e.resultLimit),
 	}
 
-	mode, err := de.New(deConfig,
+	opts := []de.ModeOptions{
 		de.WithAlgorithm(algorithm),
 		de.WithExecutions(int(config.Executions)),
 		de.WithGenerations(int(config.Generations)),
 		de.WithDimensions(int(config.DimensionsSize)),
 		de.WithObjFuncAmount(int(config.ObjectivesSize)),
-	)
+		de.WithSeed(config.GetSeed()),
+		de.WithCheckpoints(checkpoints),
+	}
+	if archive := config.GetParetoArchive(); archive != nil {
+		opts = append(opts, de.WithParetoArchive(de.ParetoArchiveConfig{
+			MaxSize: int(archive.GetMaxSize()),
+			Epsilon: archive.GetEpsilon(),
+		}))
+	}
+
+	mode, err := de.New(deConfig, opts...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create DE mode: %w", err)
+		return nil, fmt.Errorf("failed to create DE mode: %w", err)
 	}
 
 	// Execute
 	return mode.Execute(ctx)
 }
 
-func (e *Executor) saveResults(ctx context.Context, userID, algorithm, problem, variant string, pareto []models.Vector, maxObjs [][]float64) (uint64, error) {
-	// Convert to API vectors
-	apiVectors := make([]*api.Vector, len(pareto))
-	for i := range pareto {
-		vec := &pareto[i]
-		apiVectors[i] = &api.Vector{
-			Elements:         vec.Elements,
-			Objectives:       vec.Objectives,
-			CrowdingDistance: vec.CrowdingDistance,
-		}
-	}
+func (e *Executor) saveResults(ctx context.Context, userID, algorithm, problem, variant string, result *de.Result) (uint64, error) {
+	pareto, maxObjs := result.Pareto, result.MaxObjectives
 
 	// Convert max objectives
 	storeMaxObjs := make([]*store.MaxObjectives, len(maxObjs))
@@ -455,14 +759,29 @@ func (e *Executor) saveResults(ctx context.Context, userID, algorithm, problem,
 		storeMaxObjs[i] = &store.MaxObjectives{Values: maxObjs[i]}
 	}
 
+	// Score the front so results carry their quality indicators, using the
+	// problem's reference front for the distance based ones when it is known
+	var opts []indicators.Option
+	if factory, ok := e.problemRegistry[problem]; ok && len(pareto) > 0 {
+		objs := len(pareto[0].Objectives)
+		if problemImpl, err := factory(len(pareto[0].Elements), objs); err == nil {
+			if front, ok := problems.ReferenceFront(problemImpl, referenceFrontPoints, objs); ok {
+				opts = append(opts, indicators.WithReferenceFront(front))
+			}
+		}
+	}
+	values := indicators.Compute(pareto, opts...)
+
 	// Create pareto set
 	paretoSet := &store.ParetoSet{
 		UserID:        userID,
 		Algorithm:     algorithm,
 		Problem:       problem,
 		Variant:       variant,
-		Vectors:       apiVectors,
+		Vectors:       toAPIVectors(pareto),
+		ParetoArchive: toAPIVectors(result.ParetoArchive),
 		MaxObjectives: storeMaxObjs,
+		Indicators:    &values,
 		CreatedAt:     time.Now(),
 	}
 
@@ -472,3 +791,21 @@ func (e *Executor) saveResults(ctx context.Context, userID, algorithm, problem,
 
 	return paretoSet.ID, nil
 }
+
+// toAPIVectors converts vectors to their API representation, nil when there
+// are none.
+func toAPIVectors(vectors []models.Vector) []*api.Vector {
+	if len(vectors) == 0 {
+		return nil
+	}
+	apiVectors := make([]*api.Vector, len(vectors))
+	for i := range vectors {
+		vec := &vectors[i]
+		apiVectors[i] = &api.Vector{
+			Elements:         vec.Elements,
+			Objectives:       vec.Objectives,
+			CrowdingDistance: vec.CrowdingDistance,
+		}
+	}
+	return apiVectors
+}
@@ -1,8 +1,12 @@
 package executor
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"math"
+	"runtime"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -12,7 +16,10 @@ import (
 
 	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/de"
 	_ "github.com/nicholaspcr/GoDE/pkg/de/gde3"            // Register GDE3 algorithm factory
+	_ "github.com/nicholaspcr/GoDE/pkg/de/moead"           // Register MOEA/D algorithm factory
+	_ "github.com/nicholaspcr/GoDE/pkg/de/nsga2"           // Register NSGA-II algorithm factory
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz" // Register DTLZ problems
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/wfg"  // Register WFG problems
@@ -22,8 +29,10 @@ import (
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best" // Register current-to-best/* variants
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/pbest"           // Register pbest/* variants
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand"            // Register rand/* variants
+	"google.golang.org/protobuf/proto"
 
 	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 )
 
 // mockStore implements a minimal store.Store for testing
@@ -31,7 +40,11 @@ type mockStore struct {
 	executions map[string]*store.Execution
 	progress   map[string]*store.ExecutionProgress
 	paretoSets map[uint64]*store.ParetoSet
-	nextID     uint64
+	jobs        map[string]*store.Job
+	jobOrder    []string
+	checkpoints map[string]map[int]*store.Checkpoint
+	cancelled   map[string]bool
+	nextID      uint64
 	mu         sync.RWMutex
 }
 
@@ -90,6 +103,12 @@ func deepCopyProgress(src *store.ExecutionProgress) *store.ExecutionProgress {
 		TotalExecutions:     src.TotalExecutions,
 		UpdatedAt:           src.UpdatedAt,
 	}
+	if src.ControlParameters != nil {
+		dst.ControlParameters = &api.ControlParameters{
+			MeanF:  src.ControlParameters.MeanF,
+			MeanCr: src.ControlParameters.MeanCr,
+		}
+	}
 
 	// Deep copy PartialPareto slice
 	if src.PartialPareto != nil {
@@ -118,6 +137,8 @@ func deepCopyExecution(src *store.Execution) *store.Execution {
 		Problem:             src.Problem,
 		IdempotencyKey:      src.IdempotencyKey,
 		MaxExecutionSeconds: src.MaxExecutionSeconds,
+		Seed:                src.Seed,
+		ExperimentID:        src.ExperimentID,
 		CreatedAt:           src.CreatedAt,
 		UpdatedAt:           src.UpdatedAt,
 	}
@@ -144,7 +165,10 @@ func newMockStore() *mockStore {
 		executions: make(map[string]*store.Execution),
 		progress:   make(map[string]*store.ExecutionProgress),
 		paretoSets: make(map[uint64]*store.ParetoSet),
-		nextID:     1,
+		jobs:        make(map[string]*store.Job),
+		checkpoints: make(map[string]map[int]*store.Checkpoint),
+		cancelled:   make(map[string]bool),
+		nextID:      1,
 	}
 }
 
@@ -198,13 +222,32 @@ func (m *mockStore) UpdateExecutionResult(ctx context.Context, executionID strin
 	return nil
 }
 
-func (m *mockStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (m *mockStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	exec, exists := m.executions[executionID]
+	if !exists {
+		return store.ErrExecutionNotFound
+	}
+	updated := deepCopyExecution(exec)
+	updated.Status = store.ExecutionStatusPending
+	updated.Error = ""
+	updated.Config = config
+	updated.CompletedAt = nil
+	updated.UpdatedAt = time.Now()
+	m.executions[executionID] = updated
+	delete(m.cancelled, executionID)
+	return nil
+}
+
+func (m *mockStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	var allMatching []*store.Execution
 	for _, exec := range m.executions {
 		if exec.UserID == userID {
-			if status == nil || exec.Status == *status {
+			if (filter.Status == nil || exec.Status == *filter.Status) &&
+				(filter.ExperimentID == "" || exec.ExperimentID == filter.ExperimentID) {
 				// Deep copy each matching execution
 				allMatching = append(allMatching, deepCopyExecution(exec))
 			}
@@ -268,11 +311,14 @@ func (m *mockStore) MarkExecutionForCancellation(ctx context.Context, executionI
 	if !exists || exec.UserID != userID {
 		return store.ErrExecutionNotFound
 	}
+	m.cancelled[executionID] = true
 	return nil
 }
 
 func (m *mockStore) IsExecutionCancelled(ctx context.Context, executionID string) (bool, error) {
-	return false, nil
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.cancelled[executionID], nil
 }
 
 func (m *mockStore) GetExecutionByIdempotencyKey(_ context.Context, _, _ string) (string, error) {
@@ -304,6 +350,180 @@ func (m *mockStore) GetParetoSetByID(ctx context.Context, id uint64) (*store.Par
 	return ps, nil
 }
 
+func (m *mockStore) EnqueueJob(ctx context.Context, job *store.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if _, exists := m.jobs[job.ExecutionID]; !exists {
+		m.jobOrder = append(m.jobOrder, job.ExecutionID)
+	}
+	m.jobs[job.ExecutionID] = &store.Job{
+		ExecutionID:         job.ExecutionID,
+		TenantID:            job.TenantID,
+		UserID:              job.UserID,
+		Status:              store.JobStatusQueued,
+		MaxExecutionSeconds: job.MaxExecutionSeconds,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	return nil
+}
+
+func (m *mockStore) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range m.jobOrder {
+		job := m.jobs[id]
+		if job.Status != store.JobStatusQueued || slices.Contains(skipTenants, job.TenantID) {
+			continue
+		}
+		now := time.Now()
+		job.Status = store.JobStatusClaimed
+		job.Attempts++
+		job.WorkerID = workerID
+		job.ClaimedAt = &now
+		job.HeartbeatAt = &now
+		claimed := *job
+		return &claimed, nil
+	}
+	return nil, store.ErrNoJobAvailable
+}
+
+func (m *mockStore) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, exists := m.jobs[executionID]
+	if !exists || job.Status != store.JobStatusClaimed || job.WorkerID != workerID {
+		return store.ErrJobNotFound
+	}
+	now := time.Now()
+	job.HeartbeatAt = &now
+	return nil
+}
+
+func (m *mockStore) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	return m.setJobStatus(executionID, workerID, store.JobStatusQueued)
+}
+
+func (m *mockStore) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	return m.setJobStatus(executionID, workerID, store.JobStatusDone)
+}
+
+func (m *mockStore) setJobStatus(executionID, workerID string, status store.JobStatus) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, exists := m.jobs[executionID]
+	if !exists {
+		return store.ErrJobNotFound
+	}
+	if workerID == "" && job.Status != store.JobStatusQueued {
+		return store.ErrJobNotFound
+	}
+	if workerID != "" && (job.Status != store.JobStatusClaimed || job.WorkerID != workerID) {
+		return store.ErrJobNotFound
+	}
+	job.Status = status
+	return nil
+}
+
+func (m *mockStore) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var jobs []*store.Job
+	for _, id := range m.jobOrder {
+		if job := m.jobs[id]; job.Status == status {
+			listed := *job
+			jobs = append(jobs, &listed)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *mockStore) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	count := 0
+	for _, job := range m.jobs {
+		if cmp.Or(job.TenantID, tenant.DefaultID) == cmp.Or(tenantID, tenant.DefaultID) && slices.Contains(statuses, job.Status) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockStore) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.checkpoints[checkpoint.ExecutionID] == nil {
+		m.checkpoints[checkpoint.ExecutionID] = make(map[int]*store.Checkpoint)
+	}
+	saved := *checkpoint
+	saved.UpdatedAt = time.Now()
+	m.checkpoints[checkpoint.ExecutionID][checkpoint.ExecutionNumber] = &saved
+	return nil
+}
+
+func (m *mockStore) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	checkpoints := make([]*store.Checkpoint, 0, len(m.checkpoints[executionID]))
+	for _, checkpoint := range m.checkpoints[executionID] {
+		listed := *checkpoint
+		checkpoints = append(checkpoints, &listed)
+	}
+	slices.SortFunc(checkpoints, func(a, b *store.Checkpoint) int {
+		return a.ExecutionNumber - b.ExecutionNumber
+	})
+	return checkpoints, nil
+}
+
+func (m *mockStore) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	return nil
+}
+
+func (m *mockStore) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	return nil, store.ErrCustomProblemNotFound
+}
+
+func (m *mockStore) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	return nil, nil
+}
+
+func (m *mockStore) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	return nil
+}
+
+func (m *mockStore) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	return nil, store.ErrExperimentNotFound
+}
+
+func (m *mockStore) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockStore) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	return store.ErrExperimentNotFound
+}
+
+func (m *mockStore) CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+	return map[string]store.ExecutionCounts{}, nil
+}
+
+func (m *mockStore) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	return nil, nil
+}
+
+// getJob returns a copy of the job of an execution.
+func (m *mockStore) getJob(executionID string) (store.Job, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	job, exists := m.jobs[executionID]
+	if !exists {
+		return store.Job{}, false
+	}
+	return *job, true
+}
+
 // Stub implementations for other store methods
 func (m *mockStore) CreateUser(ctx context.Context, user *api.User) error { return nil }
 func (m *mockStore) GetUser(ctx context.Context, ids *api.UserIDs) (*api.User, error) {
@@ -324,6 +544,32 @@ func (m *mockStore) DeletePareto(ctx context.Context, ids *api.ParetoIDs) error
 func (m *mockStore) ListParetos(ctx context.Context, ids *api.UserIDs, limit, offset int) ([]*api.Pareto, int, error) {
 	return nil, 0, nil
 }
+func (m *mockStore) ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error) {
+	return nil, 0, nil
+}
+func (m *mockStore) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return nil, 0, nil
+}
+func (m *mockStore) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	return nil, store.ErrExecutionNotFound
+}
+func (m *mockStore) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	return nil, nil
+}
+func (m *mockStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error { return nil }
+func (m *mockStore) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	return nil, nil
+}
+func (m *mockStore) DeleteAPIKey(ctx context.Context, keyID, userID string) error { return nil }
+func (m *mockStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	return nil, store.ErrAPIKeyNotFound
+}
+func (m *mockStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	return nil
+}
+func (m *mockStore) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	return nil, store.ErrIdentityNotFound
+}
 func (m *mockStore) HealthCheck(ctx context.Context) error { return nil }
 
 func TestExecutor_SubmitExecution(t *testing.T) {
@@ -337,9 +583,9 @@ func TestExecutor_SubmitExecution(t *testing.T) {
 	})
 
 	// Register a test problem and variant
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
@@ -377,6 +623,15 @@ func TestExecutor_SubmitExecution(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, userID, execution.UserID)
 	assert.Equal(t, store.ExecutionStatusPending, execution.Status)
+	assert.Empty(t, execution.ExperimentID)
+
+	// Options set the optional fields of the execution
+	executionID, err = exec.SubmitExecution(ctx, userID, algorithm, problem, variantName, config, "", 0,
+		WithExperimentID("exp-1"))
+	require.NoError(t, err)
+	execution, err = mockSt.GetExecution(ctx, executionID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "exp-1", execution.ExperimentID)
 }
 
 func TestExecutor_CancelExecution(t *testing.T) {
@@ -433,9 +688,9 @@ func TestExecutor_RegisterProblemAndVariant(t *testing.T) {
 	})
 
 	// Register problem
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	// Register variant
 	variant, err := variants.DefaultRegistry.Create("rand1")
@@ -459,13 +714,14 @@ func TestExecutor_WorkerPoolExhaustion(t *testing.T) {
 	})
 
 	// Register problem and variant
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -530,13 +786,14 @@ func TestExecutor_ConcurrentSubmissions(t *testing.T) {
 	})
 
 	// Register problem and variant
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -607,13 +864,14 @@ func TestExecutor_CompletionTracking(t *testing.T) {
 	})
 
 	// Register problem and variant
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -665,11 +923,12 @@ func TestExecutor_ActiveExecutionTracking(t *testing.T) {
 	})
 
 	// Register a slow problem to ensure execution is observable
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 10 * time.Millisecond})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 10 * time.Millisecond}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -715,6 +974,11 @@ func TestExecutor_ActiveExecutionTracking(t *testing.T) {
 	assert.False(t, exists, "Execution should be removed from activeExecs after completion")
 }
 
+// staticProblem returns a factory that always gives back p.
+func staticProblem(p problems.Interface) problems.ProblemFactory {
+	return func(_, _ int) (problems.Interface, error) { return p, nil }
+}
+
 // panicProblem is a test problem that panics during evaluation
 type panicProblem struct{}
 
@@ -738,11 +1002,12 @@ func TestExecutor_PanicRecovery(t *testing.T) {
 	})
 
 	// Register panic problem and variant
-	exec.RegisterProblem("panic-problem", &panicProblem{})
+	exec.RegisterProblem("panic-problem", staticProblem(&panicProblem{}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -816,13 +1081,14 @@ func TestExecutor_CancellationDuringExecution(t *testing.T) {
 	})
 
 	// Register problem and variant
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -898,11 +1164,12 @@ func TestExecutor_WorkerSlotReleaseOnError(t *testing.T) {
 	})
 
 	// Register error-prone problem
-	exec.RegisterProblem("error-problem", &errorProblem{})
+	exec.RegisterProblem("error-problem", staticProblem(&errorProblem{}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -972,11 +1239,12 @@ func TestExecutor_Shutdown_HappyPath(t *testing.T) {
 	})
 
 	// Register a fast problem
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 100 * time.Millisecond})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 100 * time.Millisecond}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1012,13 +1280,17 @@ func TestExecutor_Shutdown_HappyPath(t *testing.T) {
 	err = exec.Shutdown(shutdownCtx)
 	assert.NoError(t, err, "Shutdown should succeed when workers finish quickly")
 
-	// Verify executions were cancelled
+	// Verify executions went back to the queue for the next start
 	for _, execID := range []string{executionID1, executionID2} {
 		execution, err := mockSt.GetExecution(ctx, execID, userID)
 		if err == nil {
-			assert.Equal(t, store.ExecutionStatusCancelled, execution.Status,
-				"Execution should be cancelled after shutdown")
+			assert.Equal(t, store.ExecutionStatusPending, execution.Status,
+				"Execution should be pending after shutdown")
 		}
+		job, ok := mockSt.getJob(execID)
+		require.True(t, ok)
+		assert.Equal(t, store.JobStatusQueued, job.Status, "Job should be requeued after shutdown")
+		assert.Equal(t, 1, job.Attempts)
 	}
 }
 
@@ -1038,11 +1310,12 @@ func TestExecutor_Shutdown_Timeout(t *testing.T) {
 	})
 
 	// Register a very slow problem that will outlast shutdown timeout (30s)
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 60 * time.Second})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 60 * time.Second}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1092,11 +1365,12 @@ func TestExecutor_Shutdown_ContextCancellation(t *testing.T) {
 	})
 
 	// Register a slow problem
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 5 * time.Second})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 5 * time.Second}))
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1159,10 +1433,11 @@ func TestExecutor_ExecutionTimeout(t *testing.T) {
 		DefaultMaxExecution: 300 * time.Millisecond,
 	})
 
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 10 * time.Millisecond})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 10 * time.Millisecond}))
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1207,10 +1482,11 @@ func TestExecutor_ExecutionTimeout_PerRequestOverride(t *testing.T) {
 		DefaultMaxExecution: 60 * time.Second,
 	})
 
-	exec.RegisterProblem("slow-problem", &slowProblem{duration: 10 * time.Millisecond})
+	exec.RegisterProblem("slow-problem", staticProblem(&slowProblem{duration: 10 * time.Millisecond}))
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1253,13 +1529,14 @@ func TestExecutor_IdempotencyKey(t *testing.T) {
 		ProgressTTL:  time.Minute,
 	})
 
-	prob, err := problems.DefaultRegistry.Create("zdt1", 10, 2)
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
 	require.NoError(t, err)
-	exec.RegisterProblem("zdt1", prob)
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, err := variants.DefaultRegistry.Create("rand1")
 	require.NoError(t, err)
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -1287,3 +1564,405 @@ func TestExecutor_IdempotencyKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, iKey, execution.IdempotencyKey)
 }
+
+func TestExecutor_Seed(t *testing.T) {
+	mockSt := newMockStore()
+	exec := New(Config{
+		Store:        mockSt,
+		MaxWorkers:   4,
+		ExecutionTTL: time.Hour,
+		ResultTTL:    time.Hour,
+		ProgressTTL:  time.Minute,
+	})
+
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
+	require.NoError(t, err)
+	exec.RegisterProblem("zdt1", factory)
+
+	variant, err := variants.DefaultRegistry.Create("rand1")
+	require.NoError(t, err)
+	exec.RegisterVariant("rand1", variant)
+	exec.Start()
+
+	ctx := context.Background()
+	userID := "test-user"
+
+	newConfig := func(seed *int64) *api.DEConfig {
+		return &api.DEConfig{
+			Executions:     2,
+			Generations:    5,
+			PopulationSize: 10,
+			DimensionsSize: 10,
+			ObjectivesSize: 2,
+			FloorLimiter:   0.0,
+			CeilLimiter:    1.0,
+			AlgorithmConfig: &api.DEConfig_Gde3{
+				Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+			},
+			Seed: seed,
+		}
+	}
+
+	waitForPareto := func(t *testing.T, executionID string) []*api.Vector {
+		var execution *store.Execution
+		require.Eventually(t, func() bool {
+			e, getErr := mockSt.GetExecution(ctx, executionID, userID)
+			if getErr != nil {
+				return false
+			}
+			execution = e
+			return execution.Status == store.ExecutionStatusCompleted
+		}, 10*time.Second, 50*time.Millisecond, "execution should complete")
+		require.NotNil(t, execution.ParetoID)
+
+		paretoSet, err := mockSt.GetParetoSetByID(ctx, *execution.ParetoID)
+		require.NoError(t, err)
+		return paretoSet.Vectors
+	}
+
+	t.Run("records the requested seed", func(t *testing.T) {
+		executionID, err := exec.SubmitExecution(ctx, userID, "gde3", "zdt1", "rand1", newConfig(proto.Int64(42)), "", 0)
+		require.NoError(t, err)
+
+		execution, err := mockSt.GetExecution(ctx, executionID, userID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), execution.Seed)
+		assert.Equal(t, int64(42), execution.Config.GetSeed())
+	})
+
+	t.Run("picks and records a seed when none is given", func(t *testing.T) {
+		config := newConfig(nil)
+		executionID, err := exec.SubmitExecution(ctx, userID, "gde3", "zdt1", "rand1", config, "", 0)
+		require.NoError(t, err)
+		assert.Nil(t, config.Seed, "the request config should not be modified")
+
+		execution, err := mockSt.GetExecution(ctx, executionID, userID)
+		require.NoError(t, err)
+		require.NotNil(t, execution.Config.Seed)
+		assert.Equal(t, execution.Seed, execution.Config.GetSeed())
+	})
+
+	t.Run("same seed gives identical pareto sets", func(t *testing.T) {
+		id1, err := exec.SubmitExecution(ctx, userID, "gde3", "zdt1", "rand1", newConfig(proto.Int64(7)), "", 0)
+		require.NoError(t, err)
+		id2, err := exec.SubmitExecution(ctx, userID, "gde3", "zdt1", "rand1", newConfig(proto.Int64(7)), "", 0)
+		require.NoError(t, err)
+
+		pareto1 := waitForPareto(t, id1)
+		pareto2 := waitForPareto(t, id2)
+		require.NotEmpty(t, pareto1)
+		assert.Equal(t, pareto1, pareto2)
+	})
+}
+
+func TestExecutor_ProblemPerExecution(t *testing.T) {
+	mockSt := newMockStore()
+	exec := New(Config{
+		Store:        mockSt,
+		MaxWorkers:   2,
+		ExecutionTTL: time.Hour,
+		ResultTTL:    time.Hour,
+		ProgressTTL:  time.Minute,
+	})
+
+	var mu sync.Mutex
+	var sizes [][2]int
+	exec.RegisterProblem("dtlz2", func(dim, objs int) (problems.Interface, error) {
+		mu.Lock()
+		sizes = append(sizes, [2]int{dim, objs})
+		mu.Unlock()
+		return problems.DefaultRegistry.Create("dtlz2", dim, objs)
+	})
+
+	variant, err := variants.DefaultRegistry.Create("rand1")
+	require.NoError(t, err)
+	exec.RegisterVariant("rand1", variant)
+	exec.Start()
+
+	ctx := context.Background()
+	userID := "test-user"
+	newConfig := func(dim, objs int64) *api.DEConfig {
+		return &api.DEConfig{
+			Executions:     1,
+			Generations:    2,
+			PopulationSize: 10,
+			DimensionsSize: dim,
+			ObjectivesSize: objs,
+			AlgorithmConfig: &api.DEConfig_Gde3{
+				Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+			},
+		}
+	}
+
+	t.Run("creates the problem with the requested sizes", func(t *testing.T) {
+		executionID, err := exec.SubmitExecution(ctx, userID, "gde3", "dtlz2", "rand1", newConfig(7, 3), "", 0)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			execution, getErr := mockSt.GetExecution(ctx, executionID, userID)
+			return getErr == nil && execution.Status == store.ExecutionStatusCompleted
+		}, 10*time.Second, 50*time.Millisecond, "execution should complete")
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.NotEmpty(t, sizes)
+		for _, size := range sizes {
+			assert.Equal(t, [2]int{7, 3}, size)
+		}
+	})
+
+	t.Run("rejects sizes the problem cannot be built with", func(t *testing.T) {
+		_, err := exec.SubmitExecution(ctx, userID, "gde3", "dtlz2", "rand1", newConfig(3, 3), "", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create problem dtlz2")
+	})
+}
+
+func TestExecutor_CustomProblem(t *testing.T) {
+	mockSt := newMockStore()
+	exec := New(Config{
+		Store:        mockSt,
+		MaxWorkers:   2,
+		ExecutionTTL: time.Hour,
+		ResultTTL:    time.Hour,
+		ProgressTTL:  time.Minute,
+	})
+
+	variant, err := variants.DefaultRegistry.Create("rand1")
+	require.NoError(t, err)
+	exec.RegisterVariant("rand1", variant)
+	exec.Start()
+
+	ctx := context.Background()
+	userID := "test-user"
+	newConfig := func(dim int64) *api.DEConfig {
+		return &api.DEConfig{
+			Executions:     1,
+			Generations:    5,
+			PopulationSize: 20,
+			DimensionsSize: dim,
+			ObjectivesSize: 2,
+			Seed:           proto.Int64(1),
+			CustomProblem: &api.CustomProblem{
+				Name:        "srn",
+				Objectives:  []string{"2 + (x[0]-2)^2 + (x[1]-1)^2", "9*x[0] - (x[1]-1)^2"},
+				Constraints: []string{"x[0]^2 + x[1]^2 - 225", "x[0] - 3*x[1] + 10"},
+				Bounds:      []*api.Bounds{{Floor: -20, Ceil: 20}, {Floor: -20, Ceil: 20}},
+			},
+			AlgorithmConfig: &api.DEConfig_Gde3{
+				Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+			},
+		}
+	}
+
+	t.Run("runs the compiled expressions", func(t *testing.T) {
+		executionID, err := exec.SubmitExecution(ctx, userID, "gde3", "srn", "rand1", newConfig(2), "", 0)
+		require.NoError(t, err)
+
+		var execution *store.Execution
+		require.Eventually(t, func() bool {
+			var getErr error
+			execution, getErr = mockSt.GetExecution(ctx, executionID, userID)
+			return getErr == nil && execution.Status == store.ExecutionStatusCompleted
+		}, 10*time.Second, 50*time.Millisecond, "execution should complete")
+
+		pareto, err := mockSt.GetParetoSetByID(ctx, *execution.ParetoID)
+		require.NoError(t, err)
+		require.NotEmpty(t, pareto.Vectors)
+		for _, v := range pareto.Vectors {
+			require.Len(t, v.Elements, 2)
+			for _, x := range v.Elements {
+				assert.GreaterOrEqual(t, x, -20.0)
+				assert.LessOrEqual(t, x, 20.0)
+			}
+			f1 := 2 + math.Pow(v.Elements[0]-2, 2) + math.Pow(v.Elements[1]-1, 2)
+			assert.InDelta(t, f1, v.Objectives[0], 1e-9)
+		}
+	})
+
+	t.Run("rejects dimensions the bounds do not cover", func(t *testing.T) {
+		_, err := exec.SubmitExecution(ctx, userID, "gde3", "srn", "rand1", newConfig(3), "", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create problem srn")
+	})
+}
+
+func TestExecutor_Algorithms(t *testing.T) {
+	mockSt := newMockStore()
+	exec := New(Config{
+		Store:                mockSt,
+		MaxWorkers:           2,
+		ExecutionTTL:         time.Hour,
+		ResultTTL:            time.Hour,
+		ProgressTTL:          time.Minute,
+		EvaluationWorkers:    2,
+		SharedEvaluationPool: true,
+	})
+
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
+	require.NoError(t, err)
+	exec.RegisterProblem("zdt1", factory)
+
+	variant, err := variants.DefaultRegistry.Create("rand1")
+	require.NoError(t, err)
+	exec.RegisterVariant("rand1", variant)
+	exec.Start()
+
+	ctx := context.Background()
+	userID := "test-user"
+
+	tests := []struct {
+		algorithm string
+		config    *api.DEConfig
+	}{
+		{"moead", &api.DEConfig{
+			AlgorithmConfig: &api.DEConfig_Moead{
+				Moead: &api.MOEADConfig{Cr: 0.9, F: 0.5, P: 0.1},
+			},
+			Crossover: "exponential",
+			Repair:    "reflect",
+		}},
+		{"nsga2", &api.DEConfig{
+			AlgorithmConfig: &api.DEConfig_Nsga2{
+				Nsga2: &api.NSGA2Config{Cr: 0.9, F: 0.5, P: 0.1},
+			},
+			Crossover: "arithmetic",
+			Repair:    "midpoint",
+		}},
+		{"gde3", &api.DEConfig{
+			AlgorithmConfig: &api.DEConfig_Gde3{
+				Gde3: &api.GDE3Config{
+					Cr: 0.5, F: 0.5, P: 0.1,
+					Adaptation: api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE,
+				},
+			},
+			Repair: "reinit",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			config := tt.config
+			config.Executions = 1
+			config.Generations = 5
+			config.PopulationSize = 10
+			config.DimensionsSize = 5
+			config.ObjectivesSize = 2
+
+			executionID, err := exec.SubmitExecution(ctx, userID, tt.algorithm, "zdt1", "rand1", config, "", 0)
+			require.NoError(t, err)
+
+			require.Eventually(t, func() bool {
+				execution, getErr := mockSt.GetExecution(ctx, executionID, userID)
+				return getErr == nil && execution.Status == store.ExecutionStatusCompleted
+			}, 10*time.Second, 50*time.Millisecond, "execution should complete")
+		})
+	}
+}
+
+func TestEvaluationWorkers(t *testing.T) {
+	cpus := runtime.GOMAXPROCS(0)
+
+	assert.Equal(t, 0, evaluationWorkers(0, 4, false), "sequential stays sequential")
+	assert.Equal(t, 1, evaluationWorkers(cpus*2, cpus*2, false), "every execution keeps at least one worker")
+	assert.Equal(t, max(1, cpus/2), evaluationWorkers(cpus, 2, false), "executions share the CPUs")
+	assert.Equal(t, cpus, evaluationWorkers(cpus*4, 10, true), "a shared pool is capped to the CPUs")
+}
+
+func TestExecutor_EvaluationPool(t *testing.T) {
+	t.Run("shared pool is reused by every execution", func(t *testing.T) {
+		exec := New(Config{Store: newMockStore(), MaxWorkers: 2, EvaluationWorkers: 2, SharedEvaluationPool: true})
+		if runtime.GOMAXPROCS(0) < 2 {
+			assert.Nil(t, exec.evaluationPool())
+			return
+		}
+		assert.NotNil(t, exec.evaluationPool())
+		assert.Same(t, exec.evaluationPool(), exec.evaluationPool())
+	})
+
+	t.Run("per execution pools are independent", func(t *testing.T) {
+		exec := New(Config{Store: newMockStore(), MaxWorkers: 1, EvaluationWorkers: 2})
+		if runtime.GOMAXPROCS(0) < 2 {
+			assert.Nil(t, exec.evaluationPool())
+			return
+		}
+		assert.NotSame(t, exec.evaluationPool(), exec.evaluationPool())
+	})
+
+	t.Run("sequential evaluation has no pool", func(t *testing.T) {
+		exec := New(Config{Store: newMockStore(), MaxWorkers: 1})
+		assert.Nil(t, exec.evaluationPool())
+	})
+}
+
+func TestProgressTracker_ControlParameters(t *testing.T) {
+	mockSt := newMockStore()
+	tracker := newProgressTracker(mockSt, 10)
+	counter, cleanup := tracker.registerExecution("exec-1")
+	defer cleanup()
+
+	callback := tracker.createProgressCallback(context.Background(), "exec-1", counter, 1)
+
+	callback(1, 10, 0, nil, nil)
+	progress, err := mockSt.GetProgress(context.Background(), "exec-1")
+	require.NoError(t, err)
+	assert.Nil(t, progress.ControlParameters, "fixed parameters report no means")
+
+	callback(2, 10, 0, nil, &de.ControlParameters{F: 0.6, CR: 0.3})
+	progress, err = mockSt.GetProgress(context.Background(), "exec-1")
+	require.NoError(t, err)
+	require.NotNil(t, progress.ControlParameters)
+	assert.Equal(t, 0.6, progress.ControlParameters.MeanF)
+	assert.Equal(t, 0.3, progress.ControlParameters.MeanCr)
+}
+
+func TestDecisionBounds(t *testing.T) {
+	t.Run("per-dimension bounds take precedence", func(t *testing.T) {
+		floor, ceil := decisionBounds("zdt4", &api.DEConfig{
+			DimensionsSize: 2,
+			FloorLimiter:   -1,
+			CeilLimiter:    1,
+			Bounds:         []*api.Bounds{{Floor: 0, Ceil: 1}, {Floor: -2, Ceil: 3}},
+		})
+		assert.Equal(t, []float64{0, -2}, floor)
+		assert.Equal(t, []float64{1, 3}, ceil)
+	})
+
+	t.Run("scalar limiters apply to every dimension", func(t *testing.T) {
+		floor, ceil := decisionBounds("zdt4", &api.DEConfig{
+			DimensionsSize: 3,
+			FloorLimiter:   -1,
+			CeilLimiter:    1,
+		})
+		assert.Equal(t, []float64{-1, -1, -1}, floor)
+		assert.Equal(t, []float64{1, 1, 1}, ceil)
+	})
+
+	t.Run("problem defaults when nothing is set", func(t *testing.T) {
+		floor, ceil := decisionBounds("zdt4", &api.DEConfig{DimensionsSize: 3})
+		assert.Equal(t, []float64{0, -5, -5}, floor)
+		assert.Equal(t, []float64{1, 5, 5}, ceil)
+
+		floor, ceil = decisionBounds("wfg1", &api.DEConfig{DimensionsSize: 3})
+		assert.Equal(t, []float64{0, 0, 0}, floor)
+		assert.Equal(t, []float64{2, 4, 6}, ceil)
+	})
+
+	t.Run("custom problem bounds", func(t *testing.T) {
+		floor, ceil := decisionBounds("custom", &api.DEConfig{
+			DimensionsSize: 2,
+			CustomProblem: &api.CustomProblem{
+				Name:       "custom",
+				Objectives: []string{"x[0]", "x[1]"},
+				Bounds:     []*api.Bounds{{Floor: -1, Ceil: 1}, {Floor: 0, Ceil: 5}},
+			},
+		})
+		assert.Equal(t, []float64{-1, 0}, floor)
+		assert.Equal(t, []float64{1, 5}, ceil)
+	})
+
+	t.Run("unit bounds for unknown problems", func(t *testing.T) {
+		floor, ceil := decisionBounds("unknown", &api.DEConfig{DimensionsSize: 2})
+		assert.Equal(t, []float64{0, 0}, floor)
+		assert.Equal(t, []float64{1, 1}, ceil)
+	})
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/telemetry"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+)
+
+// executionMetrics records the DE metrics of an execution, labeled by its
+// algorithm, variant and problem. A nil executionMetrics records nothing.
+type executionMetrics struct {
+	metrics *telemetry.Metrics
+	attrs   []attribute.KeyValue
+	labels  metric.MeasurementOption
+}
+
+// newExecutionMetrics returns the metrics of an execution, nil when the
+// executor has no metrics.
+func newExecutionMetrics(metrics *telemetry.Metrics, algorithm, variant, problem string) *executionMetrics {
+	if metrics == nil {
+		return nil
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("algorithm", algorithm),
+		attribute.String("variant", variant),
+		attribute.String("problem", problem),
+	}
+	return &executionMetrics{
+		metrics: metrics,
+		attrs:   attrs,
+		labels:  metric.WithAttributes(attrs...),
+	}
+}
+
+// ObserveGeneration implements de.MetricsObserver, it is called by every
+// execution of the run once per generation.
+func (m *executionMetrics) ObserveGeneration(ctx context.Context, g de.GenerationMetrics) {
+	m.metrics.DEGenerationsTotal.Add(ctx, 1, m.labels)
+	m.metrics.DEObjectiveEvaluations.Add(ctx, int64(g.Evaluations), m.labels)
+	m.metrics.DEMutationsTotal.Add(ctx, int64(g.Mutations), m.labels)
+	m.metrics.DECrossoverTotal.Add(ctx, int64(g.Crossovers), m.labels)
+	m.metrics.DERankZeroSize.Record(ctx, int64(g.RankZeroSize), m.labels)
+	m.metrics.DECrowdingDistanceAvg.Record(ctx, g.CrowdingDistanceAvg, m.labels)
+	m.metrics.DEPopulationDiversity.Record(ctx, g.Diversity, m.labels)
+	m.metrics.DEConvergenceRate.Record(ctx, g.ConvergenceRate, m.labels)
+}
+
+// observer returns m as a de.MetricsObserver, nil when it records nothing so
+// the algorithms skip computing the metrics.
+func (m *executionMetrics) observer() de.MetricsObserver {
+	if m == nil {
+		return nil
+	}
+	return m
+}
+
+// running counts the execution as in flight until the returned function is
+// called.
+func (m *executionMetrics) running(ctx context.Context) func() {
+	if m == nil {
+		return func() {}
+	}
+	m.metrics.DEExecutionsInFlight.Add(ctx, 1, m.labels)
+	return func() { m.metrics.DEExecutionsInFlight.Add(ctx, -1, m.labels) }
+}
+
+// finished records an execution that ended with status after running for
+// duration.
+func (m *executionMetrics) finished(ctx context.Context, status store.ExecutionStatus, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	attrs := metric.WithAttributes(
+		append([]attribute.KeyValue{attribute.String("status", string(status))}, m.attrs...)...,
+	)
+	m.metrics.DEExecutionsTotal.Add(ctx, 1, attrs)
+	m.metrics.DEExecutionDuration.Record(ctx, duration.Seconds(), attrs)
+	m.metrics.DEVariantPerformance.Record(ctx, duration.Seconds(), attrs)
+}
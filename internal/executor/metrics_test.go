@@ -0,0 +1,124 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/telemetry"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+)
+
+// collectMetrics gathers what reader holds by metric name.
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	data := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			data[m.Name] = m.Data
+		}
+	}
+	return data
+}
+
+func TestExecutionMetrics(t *testing.T) {
+	t.Run("nil records nothing", func(t *testing.T) {
+		m := newExecutionMetrics(nil, "gde3", "rand1", "zdt1")
+		assert.Nil(t, m)
+		assert.Nil(t, m.observer())
+		m.running(context.Background())()
+		m.finished(context.Background(), store.ExecutionStatusCompleted, time.Second)
+	})
+
+	previous := otel.GetMeterProvider()
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(previous) })
+
+	metrics, err := telemetry.InitMetrics(context.Background(), "test-service")
+	require.NoError(t, err)
+
+	m := newExecutionMetrics(metrics, "gde3", "rand1", "zdt1")
+	observer := m.observer()
+	require.NotNil(t, observer)
+
+	ctx := context.Background()
+	stop := m.running(ctx)
+	observer.ObserveGeneration(ctx, de.GenerationMetrics{
+		Evaluations: 20, Mutations: 10, Crossovers: 10, RankZeroSize: 4,
+		CrowdingDistanceAvg: 0.5, Diversity: 0.25, ConvergenceRate: 0.3,
+	})
+	observer.ObserveGeneration(ctx, de.GenerationMetrics{
+		Evaluations: 10, Mutations: 10, Crossovers: 10, RankZeroSize: 6,
+	})
+	m.finished(ctx, store.ExecutionStatusCompleted, 2*time.Second)
+
+	data := collectMetrics(t, reader)
+	inFlight, ok := data["de_executions_in_flight"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, inFlight.DataPoints, 1)
+	assert.Equal(t, int64(1), inFlight.DataPoints[0].Value)
+	stop()
+	inFlight = collectMetrics(t, reader)["de_executions_in_flight"].(metricdata.Sum[int64])
+	assert.Zero(t, inFlight.DataPoints[0].Value)
+
+	labels := attribute.NewSet(
+		attribute.String("algorithm", "gde3"),
+		attribute.String("variant", "rand1"),
+		attribute.String("problem", "zdt1"),
+	)
+
+	counters := map[string]int64{
+		"de_generations_total":           2,
+		"de_objective_evaluations_total": 30,
+		"de_mutations_total":             20,
+		"de_crossover_total":             20,
+	}
+	for name, want := range counters {
+		sum, ok := data[name].(metricdata.Sum[int64])
+		require.True(t, ok, name)
+		require.Len(t, sum.DataPoints, 1, name)
+		assert.Equal(t, want, sum.DataPoints[0].Value, name)
+		assert.True(t, labels.Equals(&sum.DataPoints[0].Attributes), name)
+	}
+
+	rankZero, ok := data["de_rank_zero_size"].(metricdata.Histogram[int64])
+	require.True(t, ok)
+	require.Len(t, rankZero.DataPoints, 1)
+	assert.Equal(t, uint64(2), rankZero.DataPoints[0].Count)
+	assert.Equal(t, int64(10), rankZero.DataPoints[0].Sum)
+
+	for _, name := range []string{"de_crowding_distance_avg", "de_population_diversity", "de_convergence_rate"} {
+		hist, ok := data[name].(metricdata.Histogram[float64])
+		require.True(t, ok, name)
+		require.Len(t, hist.DataPoints, 1, name)
+		assert.Equal(t, uint64(2), hist.DataPoints[0].Count, name)
+	}
+
+	executions, ok := data["de_executions_total"].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, executions.DataPoints, 1)
+	status, ok := executions.DataPoints[0].Attributes.Value("status")
+	require.True(t, ok)
+	assert.Equal(t, "completed", status.AsString())
+
+	for _, name := range []string{"de_execution_duration_seconds", "de_variant_performance"} {
+		hist, ok := data[name].(metricdata.Histogram[float64])
+		require.True(t, ok, name)
+		require.Len(t, hist.DataPoints, 1, name)
+		assert.Equal(t, 2.0, hist.DataPoints[0].Sum, name)
+		variant, ok := hist.DataPoints[0].Attributes.Value("variant")
+		require.True(t, ok, name)
+		assert.Equal(t, "rand1", variant.AsString(), name)
+	}
+}
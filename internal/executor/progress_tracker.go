@@ -57,7 +57,13 @@ func (pt *progressTracker) createProgressCallback(
 	counter *atomic.Int32,
 	totalExecutions int32,
 ) de.ProgressCallback {
-	return func(generation int, totalGenerations int, paretoSize int, currentPareto []models.Vector) {
+	return func(
+		generation int,
+		totalGenerations int,
+		paretoSize int,
+		currentPareto []models.Vector,
+		parameters *de.ControlParameters,
+	) {
 		// If this is the final generation, increment completion counter
 		if generation == totalGenerations {
 			counter.Add(1)
@@ -75,6 +81,14 @@ func (pt *progressTracker) createProgressCallback(
 			})
 		}
 
+		var controlParameters *api.ControlParameters
+		if parameters != nil {
+			controlParameters = &api.ControlParameters{
+				MeanF:  parameters.F,
+				MeanCr: parameters.CR,
+			}
+		}
+
 		// Read current completion count
 		completedCount := counter.Load()
 
@@ -86,6 +100,7 @@ func (pt *progressTracker) createProgressCallback(
 			CompletedExecutions: completedCount,
 			TotalExecutions:     totalExecutions,
 			PartialPareto:       apiVectors,
+			ControlParameters:   controlParameters,
 			UpdatedAt:           time.Now(),
 		}
 
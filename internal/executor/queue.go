@@ -0,0 +1,337 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+)
+
+const (
+	defaultMaxAttempts  = 3
+	defaultJobLease     = time.Minute
+	defaultPollInterval = time.Second
+)
+
+var (
+	// errShutdown cancels the executions interrupted by a shutdown, they are
+	// put back in the queue instead of being marked as cancelled.
+	errShutdown = errors.New("executor shutting down")
+
+	// errClaimLost cancels an execution whose job was recovered by another
+	// worker after the heartbeats of this one stopped reaching the store.
+	errClaimLost = errors.New("job claim lost")
+)
+
+// jobQueue holds the settings and the dispatcher state of the durable job
+// queue the executor claims its executions from.
+type jobQueue struct {
+	workerID     string
+	maxAttempts  int
+	lease        time.Duration
+	pollInterval time.Duration
+
+	wakeup    chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func newJobQueue(workerID string, maxAttempts int, lease, pollInterval time.Duration) *jobQueue {
+	if workerID == "" {
+		workerID = defaultWorkerID()
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if lease <= 0 {
+		lease = defaultJobLease
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	return &jobQueue{
+		workerID:     workerID,
+		maxAttempts:  maxAttempts,
+		lease:        lease,
+		pollInterval: pollInterval,
+		wakeup:       make(chan struct{}, 1),
+	}
+}
+
+// defaultWorkerID identifies the process by host and pid, both stay the same
+// when a container restarts so the jobs it left claimed are recovered at once.
+func defaultWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		return uuid.New().String()
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// notify wakes the dispatcher up to claim a newly enqueued job.
+func (q *jobQueue) notify() {
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// stop stops the dispatcher and waits for it to exit, it is a no-op when the
+// dispatcher never started.
+func (q *jobQueue) stop() {
+	q.stopOnce.Do(func() {
+		if q.cancel == nil {
+			return
+		}
+		q.cancel()
+		<-q.done
+	})
+}
+
+// keepAlive renews the claim of the job while it runs and cancels the
+// execution if the claim is lost. It also polls the cancellation flag of the
+// execution, which is how cancellations requested through another process,
+// such as an API-only server, reach the worker running it. ctx holds the
+// tenant of the execution. The returned function stops the heartbeats.
+func (q *jobQueue) keepAlive(ctx context.Context, st store.Store, executionID string, cancel context.CancelCauseFunc) func() {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(q.lease / 3)
+		defer ticker.Stop()
+		cancelTicker := time.NewTicker(q.pollInterval)
+		defer cancelTicker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				err := st.HeartbeatJob(ctx, executionID, q.workerID)
+				if errors.Is(err, store.ErrJobNotFound) {
+					cancel(errClaimLost)
+					return
+				}
+				if err != nil {
+					slog.Warn("failed to renew job claim",
+						slog.String("execution_id", executionID),
+						slog.String("error", err.Error()),
+					)
+				}
+			case <-cancelTicker.C:
+				cancelled, err := st.IsExecutionCancelled(ctx, executionID)
+				if err != nil {
+					slog.Warn("failed to check cancellation flag",
+						slog.String("execution_id", executionID),
+						slog.String("error", err.Error()),
+					)
+					continue
+				}
+				if cancelled {
+					cancel(nil)
+					return
+				}
+			}
+		}
+	}()
+	return sync.OnceFunc(func() { close(stop) })
+}
+
+// Start starts claiming executions from the job queue. Jobs left claimed by a
+// previous run of this worker are recovered first, and the claims of workers
+// that stopped sending heartbeats are recovered periodically afterwards.
+// Register problems and variants before calling Start.
+func (e *Executor) Start() {
+	e.queue.startOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		e.queue.cancel = cancel
+		e.queue.done = make(chan struct{})
+
+		slog.Info("starting executor",
+			slog.String("worker_id", e.queue.workerID),
+			slog.Int("max_workers", e.workers.maxWorkers),
+		)
+		go e.dispatch(ctx)
+	})
+}
+
+// dispatch claims queued jobs while there are free workers, until ctx is done.
+func (e *Executor) dispatch(ctx context.Context) {
+	defer close(e.queue.done)
+
+	e.recoverJobs(ctx, true)
+	lastRecovery := time.Now()
+
+	ticker := time.NewTicker(e.queue.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		e.claimJobs(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.queue.wakeup:
+		case <-ticker.C:
+		}
+
+		if time.Since(lastRecovery) >= e.queue.lease {
+			e.recoverJobs(ctx, false)
+			lastRecovery = time.Now()
+		}
+	}
+}
+
+// claimJobs runs queued jobs until the queue is empty, blocking while every
+// worker is busy. The jobs of tenants running as many executions as their
+// worker quota allows stay queued.
+func (e *Executor) claimJobs(ctx context.Context) {
+	for {
+		releaseWorker, _, err := e.workers.acquireWorker(ctx)
+		if err != nil {
+			return
+		}
+
+		job, err := e.store.ClaimJob(ctx, e.queue.workerID, e.quotas.saturated()...)
+		if err != nil {
+			releaseWorker()
+			if !errors.Is(err, store.ErrNoJobAvailable) && ctx.Err() == nil {
+				slog.Error("failed to claim job",
+					slog.String("worker_id", e.queue.workerID),
+					slog.String("error", err.Error()),
+				)
+			}
+			return
+		}
+
+		slog.Info("claimed job",
+			slog.String("execution_id", job.ExecutionID),
+			slog.String("tenant_id", job.TenantID),
+			slog.String("worker_id", job.WorkerID),
+			slog.Int("attempt", job.Attempts),
+		)
+		releaseTenant := e.quotas.acquire(job.TenantID)
+		go e.runJob(job, func() {
+			releaseTenant()
+			releaseWorker()
+			// Jobs of the tenant may have been skipped while it was saturated
+			e.queue.notify()
+		})
+	}
+}
+
+// recoverJobs recovers the jobs orphaned by workers that stopped without
+// finishing them: on startup the ones this worker left claimed, as none of
+// them can be running yet, and the ones whose heartbeat is older than the
+// lease.
+func (e *Executor) recoverJobs(ctx context.Context, startup bool) {
+	jobs, err := e.store.ListJobs(ctx, store.JobStatusClaimed)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("failed to list claimed jobs", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	staleBefore := time.Now().Add(-e.queue.lease)
+	for _, job := range jobs {
+		orphaned := startup && job.WorkerID == e.queue.workerID
+		if job.HeartbeatAt == nil || job.HeartbeatAt.Before(staleBefore) {
+			orphaned = true
+		}
+		if orphaned {
+			e.recoverJob(ctx, job)
+		}
+	}
+}
+
+// recoverJob puts an orphaned job back in the queue, or fails its execution
+// once the job used up its attempts.
+func (e *Executor) recoverJob(ctx context.Context, job *store.Job) {
+	ctx = tenant.WithID(ctx, job.TenantID)
+	if job.Attempts >= e.queue.maxAttempts {
+		// The job is completed first, the worker may have finished it meanwhile
+		if !e.completeJob(ctx, job.ExecutionID, job.WorkerID) {
+			return
+		}
+		msg := fmt.Sprintf("abandoned after %d attempts, last claimed by worker %s", job.Attempts, job.WorkerID)
+		if err := e.store.UpdateExecutionStatus(ctx, job.ExecutionID, store.ExecutionStatusFailed, msg); err != nil {
+			slog.Error("failed to update status of abandoned execution",
+				slog.String("execution_id", job.ExecutionID),
+				slog.Any("update_error", err),
+			)
+			return
+		}
+		slog.Warn("abandoned orphaned execution",
+			slog.String("execution_id", job.ExecutionID),
+			slog.String("worker_id", job.WorkerID),
+			slog.Int("attempts", job.Attempts),
+		)
+		return
+	}
+
+	slog.Info("requeuing orphaned execution",
+		slog.String("execution_id", job.ExecutionID),
+		slog.String("worker_id", job.WorkerID),
+		slog.Int("attempts", job.Attempts),
+	)
+	e.requeueJob(ctx, job)
+}
+
+// requeueJob marks the execution as pending again and puts its job back in
+// the queue, unless the job is no longer claimed by job.WorkerID. The status
+// is updated first so that a worker claiming the job right away is the last
+// one to change it.
+func (e *Executor) requeueJob(ctx context.Context, job *store.Job) {
+	if err := e.store.UpdateExecutionStatus(ctx, job.ExecutionID, store.ExecutionStatusPending, ""); err != nil {
+		slog.Error("failed to update status of requeued execution",
+			slog.String("execution_id", job.ExecutionID),
+			slog.Any("update_error", err),
+		)
+	}
+	if err := e.store.RequeueJob(ctx, job.ExecutionID, job.WorkerID); err != nil {
+		if errors.Is(err, store.ErrJobNotFound) {
+			slog.Warn("job not requeued, its claim was lost",
+				slog.String("execution_id", job.ExecutionID),
+				slog.String("worker_id", job.WorkerID),
+			)
+			return
+		}
+		slog.Error("failed to requeue job",
+			slog.String("execution_id", job.ExecutionID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	e.queue.notify()
+}
+
+// completeJob removes the job of a finished execution from the queue and
+// reports whether it was still claimed by workerID, or still queued when
+// workerID is empty. Only the worker completing the job may record the outcome
+// of its execution.
+func (e *Executor) completeJob(ctx context.Context, executionID, workerID string) bool {
+	err := e.store.CompleteJob(ctx, executionID, workerID)
+	if errors.Is(err, store.ErrJobNotFound) {
+		slog.Warn("job not completed, its claim was lost",
+			slog.String("execution_id", executionID),
+			slog.String("worker_id", workerID),
+		)
+		return false
+	}
+	if err != nil {
+		slog.Error("failed to complete job",
+			slog.String("execution_id", executionID),
+			slog.String("error", err.Error()),
+		)
+	}
+	return true
+}
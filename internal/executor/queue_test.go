@@ -0,0 +1,206 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+)
+
+func newQueueTestExecutor(t *testing.T, st *mockStore, workerID string) *Executor {
+	t.Helper()
+	exec := New(Config{
+		Store:        st,
+		MaxWorkers:   2,
+		WorkerID:     workerID,
+		MaxAttempts:  2,
+		JobLease:     time.Minute,
+		PollInterval: 10 * time.Millisecond,
+	})
+	registerQueueTestProblem(t, exec)
+
+	t.Cleanup(func() { _ = exec.Shutdown(context.Background()) })
+	return exec
+}
+
+// registerQueueTestProblem registers the problem and variant of
+// queueTestConfig executions.
+func registerQueueTestProblem(t *testing.T, exec *Executor) {
+	t.Helper()
+	factory, err := problems.DefaultRegistry.GetFactory("zdt1")
+	require.NoError(t, err)
+	exec.RegisterProblem("zdt1", factory)
+
+	variant, err := variants.DefaultRegistry.Create("rand1")
+	require.NoError(t, err)
+	exec.RegisterVariant("rand1", variant)
+}
+
+func queueTestConfig() *api.DEConfig {
+	return &api.DEConfig{
+		Executions:     1,
+		Generations:    2,
+		PopulationSize: 10,
+		DimensionsSize: 5,
+		ObjectivesSize: 2,
+		FloorLimiter:   0.0,
+		CeilLimiter:    1.0,
+		Seed:           proto.Int64(1),
+		AlgorithmConfig: &api.DEConfig_Gde3{
+			Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+		},
+	}
+}
+
+// seedClaimedJob stores an execution whose job was claimed by workerID, as
+// left behind by a worker that stopped mid-run.
+func seedClaimedJob(t *testing.T, st *mockStore, executionID, workerID string, attempts int, heartbeat time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, st.CreateExecution(ctx, &store.Execution{
+		ID:        executionID,
+		UserID:    "test-user",
+		Status:    store.ExecutionStatusRunning,
+		Config:    queueTestConfig(),
+		Algorithm: "gde3",
+		Problem:   "zdt1",
+		Variant:   "rand1",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}))
+	require.NoError(t, st.EnqueueJob(ctx, &store.Job{ExecutionID: executionID, UserID: "test-user"}))
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	job := st.jobs[executionID]
+	job.Status = store.JobStatusClaimed
+	job.Attempts = attempts
+	job.WorkerID = workerID
+	job.HeartbeatAt = &heartbeat
+}
+
+func TestExecutor_RunsQueuedJobs(t *testing.T) {
+	st := newMockStore()
+	exec := newQueueTestExecutor(t, st, "worker-a")
+	exec.Start()
+
+	ctx := context.Background()
+	executionID, err := exec.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", queueTestConfig(), "", 30)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		job, ok := st.getJob(executionID)
+		return ok && job.Status == store.JobStatusDone
+	}, 10*time.Second, 20*time.Millisecond, "job should be completed")
+
+	job, _ := st.getJob(executionID)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, "worker-a", job.WorkerID)
+	assert.Equal(t, int64(30), job.MaxExecutionSeconds)
+
+	execution, err := st.GetExecution(ctx, executionID, "test-user")
+	require.NoError(t, err)
+	assert.Equal(t, store.ExecutionStatusCompleted, execution.Status)
+}
+
+func TestExecutor_RecoverJobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resumes jobs the worker left claimed", func(t *testing.T) {
+		st := newMockStore()
+		seedClaimedJob(t, st, "own", "worker-a", 1, time.Now())
+
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		exec.Start()
+
+		require.Eventually(t, func() bool {
+			execution, err := st.GetExecution(ctx, "own", "test-user")
+			return err == nil && execution.Status == store.ExecutionStatusCompleted
+		}, 10*time.Second, 20*time.Millisecond, "orphaned execution should run again")
+
+		job, _ := st.getJob("own")
+		assert.Equal(t, store.JobStatusDone, job.Status)
+		assert.Equal(t, 2, job.Attempts)
+	})
+
+	t.Run("leaves live claims of other workers alone", func(t *testing.T) {
+		st := newMockStore()
+		seedClaimedJob(t, st, "other", "worker-b", 1, time.Now())
+
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		exec.recoverJobs(ctx, true)
+
+		job, _ := st.getJob("other")
+		assert.Equal(t, store.JobStatusClaimed, job.Status)
+		assert.Equal(t, "worker-b", job.WorkerID)
+	})
+
+	t.Run("requeues claims with a stale heartbeat", func(t *testing.T) {
+		st := newMockStore()
+		seedClaimedJob(t, st, "stale", "worker-b", 1, time.Now().Add(-time.Hour))
+
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		exec.recoverJobs(ctx, false)
+
+		job, _ := st.getJob("stale")
+		assert.Equal(t, store.JobStatusQueued, job.Status)
+		execution, err := st.GetExecution(ctx, "stale", "test-user")
+		require.NoError(t, err)
+		assert.Equal(t, store.ExecutionStatusPending, execution.Status)
+	})
+
+	t.Run("fails executions out of attempts", func(t *testing.T) {
+		st := newMockStore()
+		seedClaimedJob(t, st, "exhausted", "worker-b", 2, time.Now().Add(-time.Hour))
+
+		exec := newQueueTestExecutor(t, st, "worker-a")
+		exec.recoverJobs(ctx, false)
+
+		job, _ := st.getJob("exhausted")
+		assert.Equal(t, store.JobStatusDone, job.Status)
+		execution, err := st.GetExecution(ctx, "exhausted", "test-user")
+		require.NoError(t, err)
+		assert.Equal(t, store.ExecutionStatusFailed, execution.Status)
+		assert.Contains(t, execution.Error, "abandoned after 2 attempts")
+		assert.Contains(t, execution.Error, "worker-b")
+	})
+}
+
+func TestExecutor_StartIsIdempotent(t *testing.T) {
+	exec := New(Config{Store: newMockStore(), MaxWorkers: 2, WorkerID: "worker-a"})
+	exec.Start()
+	exec.Start()
+	require.NoError(t, exec.Shutdown(context.Background()))
+}
+
+func TestExecutor_CancelOnRemoteWorker(t *testing.T) {
+	ctx := context.Background()
+	st := newMockStore()
+
+	// The API node only enqueues, the worker claims and runs the execution
+	api := newQueueTestExecutor(t, st, "api")
+	worker := newQueueTestExecutor(t, st, "worker-a")
+	worker.Start()
+
+	config := queueTestConfig()
+	config.Generations = 100000
+	executionID, err := api.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", config, "", 0)
+	require.NoError(t, err)
+	waitForStatus(t, st, executionID, store.ExecutionStatusRunning)
+
+	require.NoError(t, api.CancelExecution(ctx, executionID, "test-user"))
+	waitForStatus(t, st, executionID, store.ExecutionStatusCancelled)
+
+	job, _ := st.getJob(executionID)
+	assert.Equal(t, store.JobStatusDone, job.Status)
+	assert.Equal(t, "worker-a", job.WorkerID)
+}
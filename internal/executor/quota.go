@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+)
+
+// ErrQuotaExceeded is returned when submitting an execution for a tenant that
+// already has as many active executions as its quota allows.
+var ErrQuotaExceeded = errors.New("tenant execution quota exceeded")
+
+// TenantQuota limits the resources a tenant uses, a zero limit is unlimited.
+type TenantQuota struct {
+	// MaxWorkers caps the executions of the tenant an executor runs at once.
+	MaxWorkers int `mapstructure:"max_workers"`
+	// MaxExecutions caps the queued and running executions of the tenant
+	// across the deployment.
+	MaxExecutions int `mapstructure:"max_executions"`
+}
+
+// tenantQuotas tracks the executions each tenant runs on the executor
+// against the quota of the tenant.
+type tenantQuotas struct {
+	defaultQuota TenantQuota
+	quotas       map[string]TenantQuota
+
+	mu      sync.Mutex
+	running map[string]int
+}
+
+func newTenantQuotas(defaultQuota TenantQuota, quotas map[string]TenantQuota) *tenantQuotas {
+	return &tenantQuotas{
+		defaultQuota: defaultQuota,
+		quotas:       quotas,
+		running:      make(map[string]int),
+	}
+}
+
+// quota returns the quota of the tenant, the default one when the tenant has
+// none of its own.
+func (q *tenantQuotas) quota(tenantID string) TenantQuota {
+	if quota, ok := q.quotas[tenantID]; ok {
+		return quota
+	}
+	return q.defaultQuota
+}
+
+// saturated returns the tenants running as many executions as their worker
+// quota allows, the dispatcher leaves their jobs in the queue.
+func (q *tenantQuotas) saturated() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var tenants []string
+	for tenantID, running := range q.running {
+		if limit := q.quota(tenantID).MaxWorkers; limit > 0 && running >= limit {
+			tenants = append(tenants, tenantID)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+// acquire counts an execution of the tenant as running until the returned
+// function is called.
+func (q *tenantQuotas) acquire(tenantID string) func() {
+	tenantID = cmp.Or(tenantID, tenant.DefaultID)
+
+	q.mu.Lock()
+	q.running[tenantID]++
+	q.mu.Unlock()
+
+	return func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.running[tenantID]--
+		if q.running[tenantID] <= 0 {
+			delete(q.running, tenantID)
+		}
+	}
+}
+
+// checkExecutionQuota returns ErrQuotaExceeded when the tenant of ctx already
+// has as many queued and running executions as its quota allows. The check
+// counts the jobs in the queue, so concurrent submissions may overshoot the
+// quota by the submissions racing with each other.
+func (e *Executor) checkExecutionQuota(ctx context.Context) error {
+	tenantID := tenant.IDFromContext(ctx)
+	limit := e.quotas.quota(tenantID).MaxExecutions
+	if limit <= 0 {
+		return nil
+	}
+
+	active, err := e.store.CountJobs(ctx, tenantID, store.JobStatusQueued, store.JobStatusClaimed)
+	if err != nil {
+		return fmt.Errorf("failed to count active executions: %w", err)
+	}
+	if active >= limit {
+		return fmt.Errorf("%w: %d of %d executions active", ErrQuotaExceeded, active, limit)
+	}
+	return nil
+}
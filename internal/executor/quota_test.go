@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+)
+
+func TestTenantQuotas(t *testing.T) {
+	q := newTenantQuotas(TenantQuota{MaxWorkers: 1}, map[string]TenantQuota{
+		"acme":   {MaxWorkers: 2},
+		"global": {},
+	})
+	assert.Empty(t, q.saturated())
+
+	releaseDefault := q.acquire("")
+	releaseAcme := q.acquire("acme")
+	releaseGlobal := q.acquire("global")
+	defer releaseGlobal()
+	assert.Equal(t, []string{tenant.DefaultID}, q.saturated(), "an empty tenant is the default one")
+
+	releaseAcme2 := q.acquire("acme")
+	assert.Equal(t, []string{"acme", tenant.DefaultID}, q.saturated())
+
+	releaseAcme()
+	releaseDefault()
+	assert.Empty(t, q.saturated())
+	releaseAcme2()
+	assert.NotContains(t, q.running, "acme", "idle tenants are forgotten")
+}
+
+func TestExecutor_ExecutionQuota(t *testing.T) {
+	st := newMockStore()
+	exec := New(Config{
+		Store:              st,
+		MaxWorkers:         2,
+		DefaultTenantQuota: TenantQuota{MaxExecutions: 1},
+		TenantQuotas:       map[string]TenantQuota{"acme": {MaxExecutions: 2}},
+	})
+	registerQueueTestProblem(t, exec)
+	t.Cleanup(func() { _ = exec.Shutdown(context.Background()) })
+
+	submit := func(ctx context.Context) error {
+		_, err := exec.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", queueTestConfig(), "", 0)
+		return err
+	}
+
+	// The executor is not started, so the jobs stay queued and count as active
+	ctx := context.Background()
+	require.NoError(t, submit(ctx))
+	assert.ErrorIs(t, submit(ctx), ErrQuotaExceeded)
+
+	acme := tenant.WithID(ctx, "acme")
+	require.NoError(t, submit(acme))
+	require.NoError(t, submit(acme))
+	assert.ErrorIs(t, submit(acme), ErrQuotaExceeded)
+
+	jobs, err := st.ListJobs(ctx, store.JobStatusQueued)
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+	tenants := make(map[string]int)
+	for _, job := range jobs {
+		tenants[job.TenantID]++
+	}
+	assert.Equal(t, map[string]int{tenant.DefaultID: 1, "acme": 2}, tenants)
+
+	// Finished executions free the quota
+	require.NoError(t, st.CompleteJob(ctx, jobs[0].ExecutionID, ""))
+	tenantID := jobs[0].TenantID
+	assert.NoError(t, submit(tenant.WithID(ctx, tenantID)))
+}
+
+func TestExecutor_SkipsSaturatedTenants(t *testing.T) {
+	st := newMockStore()
+	exec := New(Config{
+		Store:              st,
+		MaxWorkers:         2,
+		WorkerID:           "worker-a",
+		JobLease:           time.Minute,
+		PollInterval:       10 * time.Millisecond,
+		DefaultTenantQuota: TenantQuota{MaxWorkers: 1},
+	})
+	registerQueueTestProblem(t, exec)
+	t.Cleanup(func() { _ = exec.Shutdown(context.Background()) })
+
+	// A running execution of acme uses its only worker
+	releaseAcme := exec.quotas.acquire("acme")
+
+	ctx := context.Background()
+	acmeID, err := exec.SubmitExecution(tenant.WithID(ctx, "acme"), "test-user", "gde3", "zdt1", "rand1", queueTestConfig(), "", 0)
+	require.NoError(t, err)
+	otherID, err := exec.SubmitExecution(ctx, "test-user", "gde3", "zdt1", "rand1", queueTestConfig(), "", 0)
+	require.NoError(t, err)
+	exec.Start()
+
+	require.Eventually(t, func() bool {
+		job, ok := st.getJob(otherID)
+		return ok && job.Status == store.JobStatusDone
+	}, 10*time.Second, 20*time.Millisecond, "jobs of other tenants should run")
+	job, _ := st.getJob(acmeID)
+	assert.Equal(t, store.JobStatusQueued, job.Status, "jobs of a saturated tenant stay queued")
+
+	releaseAcme()
+	require.Eventually(t, func() bool {
+		job, ok := st.getJob(acmeID)
+		return ok && job.Status == store.JobStatusDone
+	}, 10*time.Second, 20*time.Millisecond, "jobs of the tenant should run once it has a free worker")
+}
@@ -14,8 +14,8 @@ func TestEmbeddedMigrationsExist(t *testing.T) {
 	entries, err := migrations.FS.ReadDir(".")
 	require.NoError(t, err, "should be able to read embedded migrations directory")
 
-	// Should have at least 2 files (up and down) for each of 7 migrations + embed.go
-	assert.GreaterOrEqual(t, len(entries), 14, "should have at least 14 migration files (7 up + 7 down)")
+	// Should have at least 2 files (up and down) for each of 18 migrations + embed.go
+	assert.GreaterOrEqual(t, len(entries), 36, "should have at least 36 migration files (18 up + 18 down)")
 
 	// Check for specific migration files
 	fileNames := make([]string, 0, len(entries))
@@ -23,7 +23,7 @@ func TestEmbeddedMigrationsExist(t *testing.T) {
 		fileNames = append(fileNames, entry.Name())
 	}
 
-	// Verify all 7 migrations exist (up and down)
+	// Verify all 18 migrations exist (up and down)
 	expectedMigrations := []string{
 		"000001_initial_schema.up.sql",
 		"000001_initial_schema.down.sql",
@@ -39,6 +39,28 @@ func TestEmbeddedMigrationsExist(t *testing.T) {
 		"000006_add_updated_at_to_vectors.down.sql",
 		"000007_add_execution_metadata.up.sql",
 		"000007_add_execution_metadata.down.sql",
+		"000008_add_indicators_to_pareto.up.sql",
+		"000008_add_indicators_to_pareto.down.sql",
+		"000009_add_seed_to_executions.up.sql",
+		"000009_add_seed_to_executions.down.sql",
+		"000010_add_execution_jobs.up.sql",
+		"000010_add_execution_jobs.down.sql",
+		"000011_add_execution_checkpoints.up.sql",
+		"000011_add_execution_checkpoints.down.sql",
+		"000012_add_custom_problems.up.sql",
+		"000012_add_custom_problems.down.sql",
+		"000013_add_archived_to_vectors.up.sql",
+		"000013_add_archived_to_vectors.down.sql",
+		"000014_add_experiments.up.sql",
+		"000014_add_experiments.down.sql",
+		"000015_add_tenants.up.sql",
+		"000015_add_tenants.down.sql",
+		"000016_add_user_scopes.up.sql",
+		"000016_add_user_scopes.down.sql",
+		"000017_add_api_keys.up.sql",
+		"000017_add_api_keys.down.sql",
+		"000018_add_identities.up.sql",
+		"000018_add_identities.down.sql",
 	}
 
 	for _, expected := range expectedMigrations {
@@ -118,6 +140,107 @@ func TestEmbeddedMigrationContent(t *testing.T) {
 				"problem",
 			},
 		},
+		{
+			name: "000008_add_indicators_to_pareto.up.sql",
+			file: "000008_add_indicators_to_pareto.up.sql",
+			contains: []string{
+				"ALTER TABLE",
+				"pareto_sets",
+				"indicators_json",
+			},
+		},
+		{
+			name: "000009_add_seed_to_executions.up.sql",
+			file: "000009_add_seed_to_executions.up.sql",
+			contains: []string{
+				"ALTER TABLE",
+				"executions",
+				"seed",
+			},
+		},
+		{
+			name: "000010_add_execution_jobs.up.sql",
+			file: "000010_add_execution_jobs.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"execution_jobs",
+				"attempts",
+				"worker_id",
+			},
+		},
+		{
+			name: "000011_add_execution_checkpoints.up.sql",
+			file: "000011_add_execution_checkpoints.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"execution_checkpoints",
+				"execution_number",
+				"generation",
+			},
+		},
+		{
+			name: "000012_add_custom_problems.up.sql",
+			file: "000012_add_custom_problems.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"custom_problems",
+				"definition_json",
+			},
+		},
+		{
+			name: "000013_add_archived_to_vectors.up.sql",
+			file: "000013_add_archived_to_vectors.up.sql",
+			contains: []string{
+				"ALTER TABLE",
+				"vectors",
+				"archived",
+			},
+		},
+		{
+			name: "000014_add_experiments.up.sql",
+			file: "000014_add_experiments.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"experiments",
+				"experiment_id",
+			},
+		},
+		{
+			name: "000015_add_tenants.up.sql",
+			file: "000015_add_tenants.up.sql",
+			contains: []string{
+				"ALTER TABLE",
+				"tenant_id",
+				"idx_execution_jobs_tenant_status",
+			},
+		},
+		{
+			name: "000016_add_user_scopes.up.sql",
+			file: "000016_add_user_scopes.up.sql",
+			contains: []string{
+				"ALTER TABLE",
+				"users",
+				"scopes",
+			},
+		},
+		{
+			name: "000017_add_api_keys.up.sql",
+			file: "000017_add_api_keys.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"api_keys",
+				"key_hash",
+			},
+		},
+		{
+			name: "000018_add_identities.up.sql",
+			file: "000018_add_identities.up.sql",
+			contains: []string{
+				"CREATE TABLE",
+				"identities",
+				"PRIMARY KEY (issuer, subject)",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -149,7 +272,7 @@ func TestMigrationWithSQLite(t *testing.T) {
 	// Check version
 	version, dirty, err := Version(databaseURL)
 	assert.NoError(t, err, "should be able to get version")
-	assert.Equal(t, uint(7), version, "should be at version 7")
+	assert.Equal(t, uint(18), version, "should be at version 18")
 	assert.False(t, dirty, "should not be in dirty state")
 }
 
@@ -192,30 +315,30 @@ func TestMigrationMultipleRollback(t *testing.T) {
 	err = Run(databaseURL)
 	require.NoError(t, err, "should successfully run migrations")
 
-	// Verify we're at version 7
+	// Verify we're at version 18
 	version, dirty, err := Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should be at version 7")
+	assert.Equal(t, uint(18), version, "should be at version 18")
 	assert.False(t, dirty)
 
-	// Rollback 3 steps (7 -> 6 -> 5 -> 4)
+	// Rollback 3 steps (18 -> 17 -> 16 -> 15)
 	err = Rollback(databaseURL, 3)
 	assert.NoError(t, err, "should successfully rollback 3 migrations")
 
-	// Verify we're at version 4
+	// Verify we're at version 15
 	version, dirty, err = Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(4), version, "should be at version 4 after rolling back 3 steps")
+	assert.Equal(t, uint(15), version, "should be at version 15 after rolling back 3 steps")
 	assert.False(t, dirty)
 
 	// Run migrations again to get back to latest
 	err = Run(databaseURL)
 	assert.NoError(t, err, "should successfully run migrations again")
 
-	// Verify we're back at version 7
+	// Verify we're back at version 18
 	version, dirty, err = Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should be back at version 7")
+	assert.Equal(t, uint(18), version, "should be back at version 18")
 	assert.False(t, dirty)
 }
 
@@ -234,11 +357,11 @@ func TestMigrationFullCycle(t *testing.T) {
 
 	version, dirty, err := Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should be at version 7")
+	assert.Equal(t, uint(18), version, "should be at version 18")
 	assert.False(t, dirty)
 
-	// Rollback all migrations (7 steps to get to 0)
-	err = Rollback(databaseURL, 7)
+	// Rollback all migrations (18 steps to get to 0)
+	err = Rollback(databaseURL, 18)
 	assert.NoError(t, err, "should successfully rollback all migrations")
 
 	// Version should be 0 or return ErrNilVersion
@@ -254,7 +377,7 @@ func TestMigrationFullCycle(t *testing.T) {
 
 	version, dirty, err = Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should be back at version 7")
+	assert.Equal(t, uint(18), version, "should be back at version 18")
 	assert.False(t, dirty)
 }
 
@@ -273,17 +396,17 @@ func TestMigrationIdempotency(t *testing.T) {
 
 	version, dirty, err := Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should be at version 7")
+	assert.Equal(t, uint(18), version, "should be at version 18")
 	assert.False(t, dirty)
 
 	// Run migrations second time - should be idempotent (no error, no change)
 	err = Run(databaseURL)
 	assert.NoError(t, err, "running migrations again should not error (idempotent)")
 
-	// Version should still be 7
+	// Version should still be 11
 	version, dirty, err = Version(databaseURL)
 	assert.NoError(t, err)
-	assert.Equal(t, uint(7), version, "should still be at version 7")
+	assert.Equal(t, uint(18), version, "should still be at version 18")
 	assert.False(t, dirty)
 }
 
@@ -297,6 +420,17 @@ func TestMigrationDownFiles(t *testing.T) {
 		"000005_add_max_objs_to_pareto.down.sql",
 		"000006_add_updated_at_to_vectors.down.sql",
 		"000007_add_execution_metadata.down.sql",
+		"000008_add_indicators_to_pareto.down.sql",
+		"000009_add_seed_to_executions.down.sql",
+		"000010_add_execution_jobs.down.sql",
+		"000011_add_execution_checkpoints.down.sql",
+		"000012_add_custom_problems.down.sql",
+		"000013_add_archived_to_vectors.down.sql",
+		"000014_add_experiments.down.sql",
+		"000015_add_tenants.down.sql",
+		"000016_add_user_scopes.down.sql",
+		"000017_add_api_keys.down.sql",
+		"000018_add_identities.down.sql",
 	}
 
 	for _, file := range downMigrations {
@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+)
+
+// APIKeyPrefix starts every API key, telling them apart from JWTs.
+const APIKeyPrefix = "gode_"
+
+// apiKeyDisplayLength is the length of the start of a key kept to tell the
+// keys of a user apart.
+const apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+// ErrInvalidAPIKey indicates an API key that is unknown, revoked or belongs
+// to a user that no longer exists.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKeyToken is the type of the claims of requests authenticated with an
+// API key.
+const APIKeyToken TokenType = "api_key"
+
+// GenerateAPIKey returns a new random API key, its display prefix and the
+// hash stored in its place.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of the key. The keys are random
+// 256-bit secrets, so a fast hash is enough and lets requests look keys up by
+// their hash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey reports whether the bearer token is an API key rather than a JWT.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// APIKeyAuthenticator validates API keys.
+type APIKeyAuthenticator interface {
+	// AuthenticateAPIKey returns the claims of the requests made with the
+	// key, ErrInvalidAPIKey or ErrExpiredToken when it is not accepted.
+	AuthenticateAPIKey(ctx context.Context, key string) (*Claims, error)
+}
+
+// APIKeyStore is the store of the keys and their users read by the
+// authenticator.
+type APIKeyStore interface {
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error)
+	GetUser(context.Context, *api.UserIDs) (*api.User, error)
+}
+
+type apiKeyAuthenticator struct {
+	st APIKeyStore
+}
+
+// NewAPIKeyAuthenticator returns an authenticator of the keys in st.
+func NewAPIKeyAuthenticator(st APIKeyStore) APIKeyAuthenticator {
+	return &apiKeyAuthenticator{st: st}
+}
+
+// AuthenticateAPIKey looks the key up by its hash and grants the scopes of
+// the key still held by its user, so that removing a scope from a user also
+// removes it from their keys.
+func (a *apiKeyAuthenticator) AuthenticateAPIKey(ctx context.Context, key string) (*Claims, error) {
+	if !IsAPIKey(key) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey, err := a.st.GetAPIKeyByHash(ctx, HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, store.ErrAPIKeyNotFound) {
+			return nil, ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+	if apiKey.ExpiresAt != nil && !time.Now().Before(*apiKey.ExpiresAt) {
+		return nil, ErrExpiredToken
+	}
+
+	usr, err := a.st.GetUser(tenant.WithID(ctx, apiKey.TenantID), &api.UserIDs{Username: apiKey.UserID})
+	if err != nil || usr == nil {
+		return nil, ErrInvalidAPIKey
+	}
+	userScopes, err := ParseScopes(usr.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if len(userScopes) == 0 {
+		userScopes = DefaultUserScopes()
+	}
+	userClaims := &Claims{Scopes: userScopes}
+
+	keyScopes, err := ParseScopes(apiKey.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	scopes := make([]Scope, 0, len(keyScopes))
+	for _, scope := range keyScopes {
+		if userClaims.HasScope(scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	claims := &Claims{
+		TenantID:  apiKey.TenantID,
+		Username:  apiKey.UserID,
+		TokenType: APIKeyToken,
+		Scopes:    scopes,
+	}
+	if apiKey.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*apiKey.ExpiresAt)
+	}
+	return claims, nil
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAPIKeyStore holds keys by hash and users by tenant and username.
+type stubAPIKeyStore struct {
+	keys  map[string]*store.APIKey
+	users map[string]*api.User
+	err   error
+}
+
+func (s *stubAPIKeyStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	key, ok := s.keys[keyHash]
+	if !ok {
+		return nil, store.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+func (s *stubAPIKeyStore) GetUser(ctx context.Context, ids *api.UserIDs) (*api.User, error) {
+	usr, ok := s.users[tenant.IDFromContext(ctx)+"/"+ids.Username]
+	if !ok {
+		return nil, storeerrors.ErrUserNotFound
+	}
+	return usr, nil
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, hash, err := GenerateAPIKey()
+	require.NoError(t, err)
+
+	assert.True(t, IsAPIKey(key))
+	assert.True(t, strings.HasPrefix(key, prefix))
+	assert.Len(t, prefix, len(APIKeyPrefix)+8)
+	assert.Equal(t, HashAPIKey(key), hash)
+	assert.Len(t, hash, 64)
+
+	other, _, otherHash, err := GenerateAPIKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestIsAPIKey(t *testing.T) {
+	assert.True(t, IsAPIKey("gode_abc"))
+	assert.False(t, IsAPIKey("eyJhbGciOiJIUzI1NiJ9.e30.sig"))
+	assert.False(t, IsAPIKey(""))
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	key, prefix, hash, err := GenerateAPIKey()
+	require.NoError(t, err)
+	expired := time.Now().Add(-time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+
+	newStore := func(apiKey *store.APIKey, users ...*api.User) *stubAPIKeyStore {
+		st := &stubAPIKeyStore{
+			keys:  map[string]*store.APIKey{hash: apiKey},
+			users: map[string]*api.User{},
+		}
+		for _, usr := range users {
+			st.users[apiKey.TenantID+"/"+usr.Ids.Username] = usr
+		}
+		return st
+	}
+	newKey := func(scopes ...string) *store.APIKey {
+		return &store.APIKey{
+			ID: "key-1", TenantID: "lab", UserID: "robot", Name: "nightly",
+			Prefix: prefix, KeyHash: hash, Scopes: scopes, ExpiresAt: &expiresAt,
+		}
+	}
+	robot := &api.User{Ids: &api.UserIDs{Username: "robot"}}
+
+	t.Run("valid key", func(t *testing.T) {
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("de:run", "de:read"), robot))
+
+		claims, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, "lab", claims.TenantID)
+		assert.Equal(t, "robot", claims.Username)
+		assert.Equal(t, APIKeyToken, claims.TokenType)
+		assert.Equal(t, []Scope{ScopeDERun, ScopeDERead}, claims.Scopes)
+		assert.Empty(t, claims.ID, "keys are revoked by deleting them")
+		require.NotNil(t, claims.ExpiresAt)
+		assert.True(t, claims.ExpiresAt.Equal(expiresAt.Truncate(time.Second)))
+	})
+
+	t.Run("scopes the user lost are dropped", func(t *testing.T) {
+		reader := &api.User{Ids: &api.UserIDs{Username: "robot"}, Scopes: []string{"de:read"}}
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("de:run", "de:read"), reader))
+
+		claims, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, []Scope{ScopeDERead}, claims.Scopes)
+	})
+
+	t.Run("admin users keep every scope", func(t *testing.T) {
+		admin := &api.User{Ids: &api.UserIDs{Username: "robot"}, Scopes: []string{"admin"}}
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("admin"), admin))
+
+		claims, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, []Scope{ScopeAdmin}, claims.Scopes)
+	})
+
+	t.Run("key that does not expire", func(t *testing.T) {
+		apiKey := newKey("de:read")
+		apiKey.ExpiresAt = nil
+		authenticator := NewAPIKeyAuthenticator(newStore(apiKey, robot))
+
+		claims, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		require.NoError(t, err)
+		assert.Nil(t, claims.ExpiresAt)
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		apiKey := newKey("de:read")
+		apiKey.ExpiresAt = &expired
+		authenticator := NewAPIKeyAuthenticator(newStore(apiKey, robot))
+
+		_, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("de:read"), robot))
+
+		other, _, _, err := GenerateAPIKey()
+		require.NoError(t, err)
+		_, err = authenticator.AuthenticateAPIKey(context.Background(), other)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("not an api key", func(t *testing.T) {
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("de:read"), robot))
+
+		_, err := authenticator.AuthenticateAPIKey(context.Background(), "not-a-key")
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		authenticator := NewAPIKeyAuthenticator(newStore(newKey("de:read")))
+
+		_, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("store failure", func(t *testing.T) {
+		st := newStore(newKey("de:read"), robot)
+		st.err = errors.New("database unavailable")
+		authenticator := NewAPIKeyAuthenticator(st)
+
+		_, err := authenticator.AuthenticateAPIKey(context.Background(), key)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrInvalidAPIKey)
+	})
+}
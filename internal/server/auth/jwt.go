@@ -3,6 +3,7 @@ package auth
 
 import (
 	"errors"
+	"fmt"
 	"slices"
 	"time"
 
@@ -17,6 +18,8 @@ var (
 	ErrExpiredToken = errors.New("token has expired")
 	// ErrInvalidTokenType indicates the token type doesn't match what was expected.
 	ErrInvalidTokenType = errors.New("invalid token type")
+	// ErrUnknownScope indicates a scope name that is not one of AllScopes.
+	ErrUnknownScope = errors.New("unknown scope")
 )
 
 // TokenType represents the type of JWT token
@@ -54,8 +57,31 @@ func DefaultUserScopes() []Scope {
 	return []Scope{ScopeUserRead, ScopeUserWrite, ScopeDERun, ScopeDERead, ScopeParetoRead, ScopeParetoWrite}
 }
 
+// AllScopes returns every scope that can be granted to a user
+func AllScopes() []Scope {
+	return append(DefaultUserScopes(), ScopeAdmin)
+}
+
+// ParseScopes converts scope names into scopes, returning ErrUnknownScope
+// for names that are not one of AllScopes. Duplicates are dropped.
+func ParseScopes(names []string) ([]Scope, error) {
+	scopes := make([]Scope, 0, len(names))
+	for _, name := range names {
+		scope := Scope(name)
+		if !slices.Contains(AllScopes(), scope) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownScope, name)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
 // Claims represents the JWT claims
 type Claims struct {
+	// TenantID is the tenant the user belongs to, empty for the default one.
+	TenantID  string    `json:"tenant_id,omitempty"`
 	Username  string    `json:"username"`
 	TokenType TokenType `json:"token_type"`
 	Scopes    []Scope   `json:"scopes,omitempty"`
@@ -79,8 +105,8 @@ func (c *Claims) HasAnyScope(scopes ...Scope) bool {
 
 // JWTService defines methods for JWT token operations
 type JWTService interface {
-	GenerateToken(username string) (string, error)
-	GenerateTokenPair(username string) (accessToken, refreshToken string, err error)
+	GenerateToken(tenantID, username string) (string, error)
+	GenerateTokenPair(tenantID, username string, scopes ...Scope) (accessToken, refreshToken string, err error)
 	ValidateToken(tokenString string) (*Claims, error)
 	ValidateRefreshToken(tokenString string) (*Claims, error)
 	RefreshAccessToken(refreshTokenString string) (accessToken, newRefreshToken string, err error)
@@ -140,19 +166,24 @@ func NewJWTServiceWithConfig(cfg JWTConfig) JWTService {
 	}
 }
 
-// GenerateToken creates a new JWT access token for a user (legacy method for backward compatibility)
-func (j *jwtService) GenerateToken(username string) (string, error) {
-	return j.generateToken(username, AccessToken, j.accessExpiry)
+// GenerateToken creates a new JWT access token for a user of the tenant (legacy method for backward compatibility)
+func (j *jwtService) GenerateToken(tenantID, username string) (string, error) {
+	return j.generateToken(tenantID, username, AccessToken, j.accessExpiry)
 }
 
-// GenerateTokenPair creates both access and refresh tokens for a user
-func (j *jwtService) GenerateTokenPair(username string) (accessToken, refreshToken string, err error) {
-	accessToken, err = j.generateToken(username, AccessToken, j.accessExpiry)
+// GenerateTokenPair creates both access and refresh tokens for a user of the
+// tenant, granting the default user scopes when no scopes are given
+func (j *jwtService) GenerateTokenPair(tenantID, username string, scopes ...Scope) (accessToken, refreshToken string, err error) {
+	if len(scopes) == 0 {
+		scopes = DefaultUserScopes()
+	}
+
+	accessToken, err = j.generateTokenWithScopes(tenantID, username, AccessToken, j.accessExpiry, scopes)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, err = j.generateToken(username, RefreshToken, j.refreshExpiry)
+	refreshToken, err = j.generateTokenWithScopes(tenantID, username, RefreshToken, j.refreshExpiry, scopes)
 	if err != nil {
 		return "", "", err
 	}
@@ -161,14 +192,15 @@ func (j *jwtService) GenerateTokenPair(username string) (accessToken, refreshTok
 }
 
 // generateToken is the internal method that creates a token with specific type and expiry
-func (j *jwtService) generateToken(username string, tokenType TokenType, expiry time.Duration) (string, error) {
-	return j.generateTokenWithScopes(username, tokenType, expiry, DefaultUserScopes())
+func (j *jwtService) generateToken(tenantID, username string, tokenType TokenType, expiry time.Duration) (string, error) {
+	return j.generateTokenWithScopes(tenantID, username, tokenType, expiry, DefaultUserScopes())
 }
 
 // generateTokenWithScopes creates a token with specific scopes
-func (j *jwtService) generateTokenWithScopes(username string, tokenType TokenType, expiry time.Duration, scopes []Scope) (string, error) {
+func (j *jwtService) generateTokenWithScopes(tenantID, username string, tokenType TokenType, expiry time.Duration, scopes []Scope) (string, error) {
 	now := time.Now()
 	claims := &Claims{
+		TenantID:  tenantID,
 		Username:  username,
 		TokenType: tokenType,
 		Scopes:    scopes,
@@ -224,8 +256,8 @@ func (j *jwtService) RefreshAccessToken(refreshTokenString string) (accessToken,
 		return "", "", err
 	}
 
-	// Generate new token pair
-	return j.GenerateTokenPair(claims.Username)
+	// Generate new token pair, keeping the tenant and scopes of the user
+	return j.GenerateTokenPair(claims.TenantID, claims.Username, claims.Scopes...)
 }
 
 // parseToken is the internal method that parses and validates a token
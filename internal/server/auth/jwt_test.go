@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,7 +49,7 @@ func TestGenerateToken(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			service := NewJWTService(tt.secret, tt.expiry)
-			token, err := service.GenerateToken(tt.username)
+			token, err := service.GenerateToken(tenant.DefaultID, tt.username)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -73,7 +74,7 @@ func TestValidateToken(t *testing.T) {
 		{
 			name: "valid token",
 			setupFn: func() string {
-				token, _ := service.GenerateToken("testuser")
+				token, _ := service.GenerateToken(tenant.DefaultID, "testuser")
 				return token
 			},
 			wantErr:   false,
@@ -104,7 +105,7 @@ func TestValidateToken(t *testing.T) {
 			name: "token with wrong secret",
 			setupFn: func() string {
 				wrongService := NewJWTService("wrong-secret", 24*time.Hour)
-				token, _ := wrongService.GenerateToken("testuser")
+				token, _ := wrongService.GenerateToken(tenant.DefaultID, "testuser")
 				return token
 			},
 			wantErr: true,
@@ -113,7 +114,7 @@ func TestValidateToken(t *testing.T) {
 			name: "expired token",
 			setupFn: func() string {
 				expiredService := NewJWTService(secret, -1*time.Hour)
-				token, _ := expiredService.GenerateToken("testuser")
+				token, _ := expiredService.GenerateToken(tenant.DefaultID, "testuser")
 				return token
 			},
 			wantErr: true,
@@ -145,7 +146,7 @@ func TestTokenExpiration(t *testing.T) {
 	jwt.TimePrecision = time.Millisecond
 
 	// Generate token
-	token, err := service.GenerateToken("testuser")
+	token, err := service.GenerateToken(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Should be valid immediately
@@ -167,7 +168,7 @@ func TestTokenClaims(t *testing.T) {
 	service := NewJWTService("test-secret", 24*time.Hour)
 
 	username := "testuser"
-	token, err := service.GenerateToken(username)
+	token, err := service.GenerateToken(tenant.DefaultID, username)
 	require.NoError(t, err)
 
 	claims, err := service.ValidateToken(token)
@@ -189,8 +190,8 @@ func TestMultipleTokens(t *testing.T) {
 	service := NewJWTService("test-secret", 24*time.Hour)
 
 	// Generate multiple tokens
-	token1, err1 := service.GenerateToken("user1")
-	token2, err2 := service.GenerateToken("user2")
+	token1, err1 := service.GenerateToken(tenant.DefaultID, "user1")
+	token2, err2 := service.GenerateToken(tenant.DefaultID, "user2")
 
 	require.NoError(t, err1)
 	require.NoError(t, err2)
@@ -209,7 +210,7 @@ func TestMultipleTokens(t *testing.T) {
 func TestGenerateTokenPair(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
-	accessToken, refreshToken, err := service.GenerateTokenPair("testuser")
+	accessToken, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 	assert.NotEmpty(t, accessToken)
 	assert.NotEmpty(t, refreshToken)
@@ -228,11 +229,42 @@ func TestGenerateTokenPair(t *testing.T) {
 	assert.Equal(t, RefreshToken, refreshClaims.TokenType)
 }
 
+func TestGenerateTokenPair_WithScopes(t *testing.T) {
+	service := NewJWTService("test-secret", 1*time.Hour)
+
+	accessToken, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "operator", ScopeAdmin)
+	require.NoError(t, err)
+
+	claims, err := service.ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []Scope{ScopeAdmin}, claims.Scopes)
+
+	// Refreshing keeps the scopes of the refresh token
+	newAccessToken, _, err := service.RefreshAccessToken(refreshToken)
+	require.NoError(t, err)
+	claims, err = service.ValidateToken(newAccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []Scope{ScopeAdmin}, claims.Scopes)
+}
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes([]string{"de:run", "admin", "de:run"})
+	require.NoError(t, err)
+	assert.Equal(t, []Scope{ScopeDERun, ScopeAdmin}, scopes)
+
+	scopes, err = ParseScopes(nil)
+	require.NoError(t, err)
+	assert.Empty(t, scopes)
+
+	_, err = ParseScopes([]string{"de:run", "root"})
+	assert.ErrorIs(t, err, ErrUnknownScope)
+}
+
 func TestValidateRefreshToken(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate token pair
-	accessToken, refreshToken, err := service.GenerateTokenPair("testuser")
+	accessToken, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Refresh token should be validated successfully
@@ -251,7 +283,7 @@ func TestValidateAccessToken_WithRefreshToken(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate token pair
-	accessToken, refreshToken, err := service.GenerateTokenPair("testuser")
+	accessToken, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Access token should be validated successfully
@@ -270,7 +302,7 @@ func TestRefreshAccessToken(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate initial token pair
-	oldAccessToken, oldRefreshToken, err := service.GenerateTokenPair("testuser")
+	oldAccessToken, oldRefreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Small delay to ensure new tokens have different timestamps
@@ -299,13 +331,30 @@ func TestRefreshAccessToken(t *testing.T) {
 	assert.Equal(t, RefreshToken, refreshClaims.TokenType)
 }
 
+func TestRefreshAccessToken_KeepsTenant(t *testing.T) {
+	service := NewJWTService("test-secret", 15*time.Minute)
+
+	accessToken, refreshToken, err := service.GenerateTokenPair("lab", "testuser")
+	require.NoError(t, err)
+
+	claims, err := service.ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "lab", claims.TenantID)
+
+	newAccessToken, _, err := service.RefreshAccessToken(refreshToken)
+	require.NoError(t, err)
+	claims, err = service.ValidateToken(newAccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "lab", claims.TenantID)
+}
+
 func TestRefreshAccessToken_WithExpiredRefreshToken(t *testing.T) {
 	// Create service with very short refresh token expiry
 	service := NewJWTServiceWithRefreshExpiry("test-secret", 15*time.Minute, 10*time.Millisecond)
 	jwt.TimePrecision = time.Millisecond
 
 	// Generate token pair
-	_, refreshToken, err := service.GenerateTokenPair("testuser")
+	_, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Wait for refresh token to expire
@@ -321,7 +370,7 @@ func TestRefreshAccessToken_WithAccessToken(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate token pair
-	accessToken, _, err := service.GenerateTokenPair("testuser")
+	accessToken, _, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Trying to use access token to refresh should fail
@@ -334,7 +383,7 @@ func TestTokenTypeField(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate token pair
-	accessToken, refreshToken, err := service.GenerateTokenPair("testuser")
+	accessToken, refreshToken, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Parse access token and check type
@@ -352,7 +401,7 @@ func TestRefreshTokenRotation(t *testing.T) {
 	service := NewJWTService("test-secret", 15*time.Minute)
 
 	// Generate initial tokens
-	_, refreshToken1, err := service.GenerateTokenPair("testuser")
+	_, refreshToken1, err := service.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	time.Sleep(10 * time.Millisecond)
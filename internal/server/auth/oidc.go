@@ -0,0 +1,382 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrOIDCNotConfigured indicates single sign-on is not configured.
+	ErrOIDCNotConfigured = errors.New("single sign-on is not configured")
+	// ErrOIDCDeviceFlowUnsupported indicates a provider without a device
+	// authorization endpoint.
+	ErrOIDCDeviceFlowUnsupported = errors.New("identity provider does not support the device flow")
+	// ErrOIDCInvalidGrant indicates an authorization or device code the
+	// provider rejected.
+	ErrOIDCInvalidGrant = errors.New("authorization rejected by the identity provider")
+	// ErrOIDCAuthorizationPending indicates a device login the user has not
+	// completed yet.
+	ErrOIDCAuthorizationPending = errors.New("authorization pending")
+	// ErrOIDCSlowDown indicates a device login polled too often.
+	ErrOIDCSlowDown = errors.New("polling too often")
+	// ErrOIDCAccessDenied indicates a device login the user declined.
+	ErrOIDCAccessDenied = errors.New("access denied by the user")
+	// ErrOIDCDeviceCodeExpired indicates a device login that was not
+	// completed in time.
+	ErrOIDCDeviceCodeExpired = errors.New("device code has expired")
+	// ErrInvalidIDToken indicates an ID token that failed verification or
+	// lacks the claims identifying the user.
+	ErrInvalidIDToken = errors.New("invalid id token")
+)
+
+// deviceCodeGrantType is the grant type of the device access token request.
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// OIDCConfig configures single sign-on through an OpenID Connect provider.
+type OIDCConfig struct {
+	// Issuer is the URL of the provider, single sign-on is disabled when it
+	// is empty.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// Scopes are requested from the provider, empty means openid, profile
+	// and email.
+	Scopes []string
+	// UsernameClaim names the claim holding the username of provisioned
+	// users, empty means preferred_username.
+	UsernameClaim string
+	// GroupsClaim names the claim holding the groups of the user, empty
+	// means groups.
+	GroupsClaim string
+	// GroupScopes grant scopes to the members of groups. When set, the
+	// scopes of the users are replaced on every login by the ones of their
+	// groups, and users in none of them get the default scopes.
+	GroupScopes []OIDCGroupScopes
+}
+
+// OIDCGroupScopes are the scopes granted to the members of a group.
+type OIDCGroupScopes struct {
+	Group  string   `mapstructure:"group"`
+	Scopes []string `mapstructure:"scopes"`
+}
+
+// Enabled reports whether single sign-on is configured.
+func (c OIDCConfig) Enabled() bool {
+	return c.Issuer != ""
+}
+
+// Validate checks the configuration of an enabled provider.
+func (c OIDCConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.ClientID == "" {
+		return fmt.Errorf("client_id is required when issuer is set")
+	}
+	for _, group := range c.GroupScopes {
+		if group.Group == "" {
+			return fmt.Errorf("group_scopes: group cannot be empty")
+		}
+		if _, err := ParseScopes(group.Scopes); err != nil {
+			return fmt.Errorf("group_scopes %q: %w", group.Group, err)
+		}
+	}
+	return nil
+}
+
+// OIDCIdentity is the user an ID token was issued for.
+type OIDCIdentity struct {
+	Issuer   string
+	Subject  string
+	Username string
+	Email    string
+	Groups   []string
+	// SyncScopes is set when the scopes of the user are managed through
+	// GroupScopes, Scopes are then the ones granted by the groups of the
+	// user, empty for the default scopes.
+	SyncScopes bool
+	Scopes     []Scope
+}
+
+// OIDCAuthCodeConfig describes how clients start an authorization code
+// login.
+type OIDCAuthCodeConfig struct {
+	AuthorizationEndpoint string
+	ClientID              string
+	Scopes                []string
+}
+
+// OIDCProvider runs the logins through an OpenID Connect provider on behalf
+// of the clients, which never see the client secret.
+type OIDCProvider interface {
+	// AuthCodeConfig returns what the clients need to redirect users to the
+	// provider.
+	AuthCodeConfig(ctx context.Context) (*OIDCAuthCodeConfig, error)
+	// ExchangeCode redeems an authorization code with its PKCE verifier and
+	// returns the identity of the verified ID token, whose nonce must match.
+	ExchangeCode(ctx context.Context, code, codeVerifier, redirectURI, nonce string) (*OIDCIdentity, error)
+	// StartDeviceLogin starts a device authorization for a client without a
+	// browser.
+	StartDeviceLogin(ctx context.Context) (*oauth2.DeviceAuthResponse, error)
+	// PollDeviceLogin checks once whether the device login was completed,
+	// returning ErrOIDCAuthorizationPending until it is.
+	PollDeviceLogin(ctx context.Context, deviceCode string) (*OIDCIdentity, error)
+}
+
+type oidcProvider struct {
+	cfg OIDCConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCProvider returns a provider for cfg. The discovery document of the
+// issuer is fetched on first use, so the server starts while the provider
+// is unreachable.
+func NewOIDCProvider(cfg OIDCConfig) OIDCProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = "preferred_username"
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	return &oidcProvider{cfg: cfg}
+}
+
+// discover fetches the discovery document of the issuer once it succeeds.
+func (p *oidcProvider) discover(ctx context.Context) (*oidc.Provider, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider == nil {
+		// The key set of the provider outlives the request, it keeps the
+		// values of ctx but not its cancellation.
+		provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.cfg.Issuer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to discover identity provider: %w", err)
+		}
+		p.provider = provider
+		p.verifier = provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID})
+	}
+	return p.provider, p.verifier, nil
+}
+
+func (p *oidcProvider) oauth2Config(provider *oidc.Provider, redirectURI string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  redirectURI,
+		Scopes:       p.cfg.Scopes,
+	}
+}
+
+func (p *oidcProvider) AuthCodeConfig(ctx context.Context) (*OIDCAuthCodeConfig, error) {
+	provider, _, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &OIDCAuthCodeConfig{
+		AuthorizationEndpoint: provider.Endpoint().AuthURL,
+		ClientID:              p.cfg.ClientID,
+		Scopes:                p.cfg.Scopes,
+	}, nil
+}
+
+func (p *oidcProvider) ExchangeCode(
+	ctx context.Context, code, codeVerifier, redirectURI, nonce string,
+) (*OIDCIdentity, error) {
+	provider, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.oauth2Config(provider, redirectURI).Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		var rerr *oauth2.RetrieveError
+		if errors.As(err, &rerr) {
+			return nil, fmt.Errorf("%w: %s", ErrOIDCInvalidGrant, rerr.ErrorCode)
+		}
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in the token response", ErrInvalidIDToken)
+	}
+	return p.identity(ctx, verifier, rawIDToken, nonce)
+}
+
+func (p *oidcProvider) StartDeviceLogin(ctx context.Context) (*oauth2.DeviceAuthResponse, error) {
+	provider, _, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := p.oauth2Config(provider, "")
+	if conf.Endpoint.DeviceAuthURL == "" {
+		return nil, ErrOIDCDeviceFlowUnsupported
+	}
+	var opts []oauth2.AuthCodeOption
+	if p.cfg.ClientSecret != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("client_secret", p.cfg.ClientSecret))
+	}
+	return conf.DeviceAuth(ctx, opts...)
+}
+
+// PollDeviceLogin makes a single device access token request, the polling
+// loop of oauth2 would hold the request of the client until the login ends.
+func (p *oidcProvider) PollDeviceLogin(ctx context.Context, deviceCode string) (*OIDCIdentity, error) {
+	provider, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode},
+		"client_id":   {p.cfg.ClientID},
+	}
+	if p.cfg.ClientSecret != "" {
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, provider.Endpoint().TokenURL, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := http.DefaultClient
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		client = c
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to poll device login: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var body struct {
+		IDToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to read device token response: %w", err)
+	}
+
+	switch body.Error {
+	case "":
+	case "authorization_pending":
+		return nil, ErrOIDCAuthorizationPending
+	case "slow_down":
+		return nil, ErrOIDCSlowDown
+	case "access_denied":
+		return nil, ErrOIDCAccessDenied
+	case "expired_token":
+		return nil, ErrOIDCDeviceCodeExpired
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrOIDCInvalidGrant, body.Error)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device token request failed with status %d", resp.StatusCode)
+	}
+	if body.IDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in the token response", ErrInvalidIDToken)
+	}
+	return p.identity(ctx, verifier, body.IDToken, "")
+}
+
+// identity verifies the ID token against the keys of the issuer and reads
+// the user from its claims. nonce is checked when set.
+func (p *oidcProvider) identity(
+	ctx context.Context, verifier *oidc.IDTokenVerifier, rawIDToken, nonce string,
+) (*OIDCIdentity, error) {
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if nonce != "" && idToken.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	username, _ := claims[p.cfg.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("%w: missing %s claim", ErrInvalidIDToken, p.cfg.UsernameClaim)
+	}
+	email, _ := claims["email"].(string)
+
+	identity := &OIDCIdentity{
+		Issuer:   idToken.Issuer,
+		Subject:  idToken.Subject,
+		Username: username,
+		Email:    email,
+		Groups:   stringsClaim(claims[p.cfg.GroupsClaim]),
+	}
+	if len(p.cfg.GroupScopes) > 0 {
+		identity.SyncScopes = true
+		if identity.Scopes, err = p.groupScopes(identity.Groups); err != nil {
+			return nil, err
+		}
+	}
+	return identity, nil
+}
+
+// groupScopes returns the scopes granted to the groups, in the order they
+// are configured.
+func (p *oidcProvider) groupScopes(groups []string) ([]Scope, error) {
+	var scopes []Scope
+	for _, mapping := range p.cfg.GroupScopes {
+		if !slices.Contains(groups, mapping.Group) {
+			continue
+		}
+		granted, err := ParseScopes(mapping.Scopes)
+		if err != nil {
+			return nil, err
+		}
+		for _, scope := range granted {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, nil
+}
+
+// stringsClaim reads a claim holding either a list of strings or a single
+// string.
+func stringsClaim(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
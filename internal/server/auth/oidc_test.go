@@ -0,0 +1,462 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIssuer is an in-process OpenID Connect provider serving discovery,
+// its signing keys, the token endpoint with PKCE and the device flow.
+type mockIssuer struct {
+	*httptest.Server
+	key          *rsa.PrivateKey
+	clientID     string
+	clientSecret string
+	deviceFlow   bool
+
+	mu      sync.Mutex
+	codes   map[string]mockAuthorization
+	devices map[string]*mockDevice
+}
+
+// mockAuthorization is an authorization code waiting to be redeemed.
+type mockAuthorization struct {
+	challenge   string
+	redirectURI string
+	claims      jwt.MapClaims
+}
+
+// mockDevice is a device login, approved once it has claims.
+type mockDevice struct {
+	claims jwt.MapClaims
+	status string
+}
+
+func newMockIssuer(t *testing.T, deviceFlow bool) *mockIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockIssuer{
+		key:          key,
+		clientID:     "gode",
+		clientSecret: "client-secret",
+		deviceFlow:   deviceFlow,
+		codes:        map[string]mockAuthorization{},
+		devices:      map[string]*mockDevice{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", m.discovery)
+	mux.HandleFunc("/keys", m.keys)
+	mux.HandleFunc("/token", m.token)
+	mux.HandleFunc("/device", m.device)
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockIssuer) discovery(w http.ResponseWriter, _ *http.Request) {
+	doc := map[string]any{
+		"issuer":                                m.URL,
+		"authorization_endpoint":                m.URL + "/authorize",
+		"token_endpoint":                        m.URL + "/token",
+		"jwks_uri":                              m.URL + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	}
+	if m.deviceFlow {
+		doc["device_authorization_endpoint"] = m.URL + "/device"
+	}
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+func (m *mockIssuer) keys(w http.ResponseWriter, _ *http.Request) {
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"kid": "test-key",
+			"n":   encode(m.key.N.Bytes()),
+			"e":   encode(big.NewInt(int64(m.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		m.tokenError(w, "invalid_request")
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != m.clientID || clientSecret != m.clientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		m.tokenError(w, "invalid_client")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var claims jwt.MapClaims
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		auth, ok := m.codes[r.PostForm.Get("code")]
+		delete(m.codes, r.PostForm.Get("code"))
+		if !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") ||
+			auth.challenge != pkceChallenge(r.PostForm.Get("code_verifier")) {
+			m.tokenError(w, "invalid_grant")
+			return
+		}
+		claims = auth.claims
+	case deviceCodeGrantType:
+		device, ok := m.devices[r.PostForm.Get("device_code")]
+		if !ok {
+			m.tokenError(w, "invalid_grant")
+			return
+		}
+		if device.status != "" {
+			m.tokenError(w, device.status)
+			return
+		}
+		claims = device.claims
+	default:
+		m.tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     m.sign(claims, m.key),
+	})
+}
+
+func (m *mockIssuer) tokenError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func (m *mockIssuer) device(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") != m.clientID {
+		m.tokenError(w, "invalid_client")
+		return
+	}
+
+	m.mu.Lock()
+	m.devices["device-code"] = &mockDevice{status: "authorization_pending"}
+	m.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"device_code":               "device-code",
+		"user_code":                 "ABCD-EFGH",
+		"verification_uri":          m.URL + "/activate",
+		"verification_uri_complete": m.URL + "/activate?user_code=ABCD-EFGH",
+		"expires_in":                600,
+		"interval":                  5,
+	})
+}
+
+// authorize stands in for the user logging in through the browser, it
+// returns the code the provider redirects back with.
+func (m *mockIssuer) authorize(verifier, redirectURI string, claims jwt.MapClaims) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	code := "code-" + randomString()
+	m.codes[code] = mockAuthorization{
+		challenge:   pkceChallenge(verifier),
+		redirectURI: redirectURI,
+		claims:      claims,
+	}
+	return code
+}
+
+// setDevice completes the device login with claims, or ends it with the
+// error status.
+func (m *mockIssuer) setDevice(status string, claims jwt.MapClaims) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.devices["device-code"] = &mockDevice{status: status, claims: claims}
+}
+
+// claims returns valid ID token claims of the user.
+func (m *mockIssuer) claims(subject, username string, groups ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                m.URL,
+		"sub":                subject,
+		"aud":                m.clientID,
+		"iat":                time.Now().Unix(),
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": username,
+		"email":              username + "@example.com",
+		"groups":             groups,
+	}
+}
+
+func (m *mockIssuer) sign(claims jwt.MapClaims, key *rsa.PrivateKey) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+func (m *mockIssuer) config() OIDCConfig {
+	return OIDCConfig{Issuer: m.URL, ClientID: m.clientID, ClientSecret: m.clientSecret}
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestOIDCConfig_Validate(t *testing.T) {
+	assert.NoError(t, OIDCConfig{}.Validate(), "disabled")
+	assert.NoError(t, OIDCConfig{Issuer: "https://idp", ClientID: "gode"}.Validate())
+
+	assert.ErrorContains(t, OIDCConfig{Issuer: "https://idp"}.Validate(), "client_id is required")
+	assert.ErrorContains(t, OIDCConfig{
+		Issuer: "https://idp", ClientID: "gode",
+		GroupScopes: []OIDCGroupScopes{{Scopes: []string{"admin"}}},
+	}.Validate(), "group cannot be empty")
+	err := OIDCConfig{
+		Issuer: "https://idp", ClientID: "gode",
+		GroupScopes: []OIDCGroupScopes{{Group: "ops", Scopes: []string{"root"}}},
+	}.Validate()
+	assert.ErrorIs(t, err, ErrUnknownScope)
+}
+
+func TestOIDCProvider_AuthCodeConfig(t *testing.T) {
+	issuer := newMockIssuer(t, false)
+	provider := NewOIDCProvider(issuer.config())
+
+	cfg, err := provider.AuthCodeConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/authorize", cfg.AuthorizationEndpoint)
+	assert.Equal(t, "gode", cfg.ClientID)
+	assert.Equal(t, []string{"openid", "profile", "email"}, cfg.Scopes)
+
+	t.Run("unreachable issuer", func(t *testing.T) {
+		provider := NewOIDCProvider(OIDCConfig{Issuer: "http://127.0.0.1:1", ClientID: "gode"})
+		_, err := provider.AuthCodeConfig(context.Background())
+		assert.ErrorContains(t, err, "failed to discover identity provider")
+	})
+}
+
+func TestOIDCProvider_ExchangeCode(t *testing.T) {
+	issuer := newMockIssuer(t, false)
+	ctx := context.Background()
+	const redirectURI = "http://localhost:3000/auth/callback"
+	verifier := randomString() + randomString()
+
+	withNonce := func(claims jwt.MapClaims, nonce string) jwt.MapClaims {
+		claims["nonce"] = nonce
+		return claims
+	}
+
+	t.Run("valid code", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		code := issuer.authorize(verifier, redirectURI, withNonce(issuer.claims("sub-1", "alice", "researchers"), "n-1"))
+
+		identity, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		require.NoError(t, err)
+		assert.Equal(t, issuer.URL, identity.Issuer)
+		assert.Equal(t, "sub-1", identity.Subject)
+		assert.Equal(t, "alice", identity.Username)
+		assert.Equal(t, "alice@example.com", identity.Email)
+		assert.Equal(t, []string{"researchers"}, identity.Groups)
+		assert.False(t, identity.SyncScopes, "scopes are kept without group_scopes")
+	})
+
+	t.Run("groups mapped to scopes", func(t *testing.T) {
+		cfg := issuer.config()
+		cfg.GroupScopes = []OIDCGroupScopes{
+			{Group: "admins", Scopes: []string{"admin"}},
+			{Group: "researchers", Scopes: []string{"de:run", "de:read"}},
+			{Group: "viewers", Scopes: []string{"de:read", "pareto:read"}},
+		}
+		provider := NewOIDCProvider(cfg)
+
+		code := issuer.authorize(verifier, redirectURI,
+			withNonce(issuer.claims("sub-1", "alice", "researchers", "viewers"), "n-1"))
+		identity, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		require.NoError(t, err)
+		assert.True(t, identity.SyncScopes)
+		assert.Equal(t, []Scope{ScopeDERun, ScopeDERead, ScopeParetoRead}, identity.Scopes)
+
+		code = issuer.authorize(verifier, redirectURI, withNonce(issuer.claims("sub-2", "bob", "sales"), "n-2"))
+		identity, err = provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-2")
+		require.NoError(t, err)
+		assert.True(t, identity.SyncScopes)
+		assert.Empty(t, identity.Scopes, "users in no mapped group get the default scopes")
+	})
+
+	t.Run("single group claim", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		claims := withNonce(issuer.claims("sub-1", "alice"), "n-1")
+		claims["groups"] = "admins"
+		code := issuer.authorize(verifier, redirectURI, claims)
+
+		identity, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admins"}, identity.Groups)
+	})
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		code := issuer.authorize(verifier, redirectURI, withNonce(issuer.claims("sub-1", "alice"), "n-1"))
+
+		_, err := provider.ExchangeCode(ctx, code, "other-verifier", redirectURI, "n-1")
+		assert.ErrorIs(t, err, ErrOIDCInvalidGrant)
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		code := issuer.authorize(verifier, redirectURI, withNonce(issuer.claims("sub-1", "alice"), "n-1"))
+
+		_, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-2")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("token for another client", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		claims := withNonce(issuer.claims("sub-1", "alice"), "n-1")
+		claims["aud"] = "other-client"
+		code := issuer.authorize(verifier, redirectURI, claims)
+
+		_, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		claims := withNonce(issuer.claims("sub-1", "alice"), "n-1")
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		code := issuer.authorize(verifier, redirectURI, claims)
+
+		_, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("missing username claim", func(t *testing.T) {
+		provider := NewOIDCProvider(issuer.config())
+		claims := withNonce(issuer.claims("sub-1", "alice"), "n-1")
+		delete(claims, "preferred_username")
+		code := issuer.authorize(verifier, redirectURI, claims)
+
+		_, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("username claim", func(t *testing.T) {
+		cfg := issuer.config()
+		cfg.UsernameClaim = "nickname"
+		provider := NewOIDCProvider(cfg)
+		claims := withNonce(issuer.claims("sub-1", "alice"), "n-1")
+		claims["nickname"] = "ally"
+		code := issuer.authorize(verifier, redirectURI, claims)
+
+		identity, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		require.NoError(t, err)
+		assert.Equal(t, "ally", identity.Username)
+	})
+
+	t.Run("wrong client secret", func(t *testing.T) {
+		cfg := issuer.config()
+		cfg.ClientSecret = "wrong"
+		provider := NewOIDCProvider(cfg)
+		code := issuer.authorize(verifier, redirectURI, withNonce(issuer.claims("sub-1", "alice"), "n-1"))
+
+		_, err := provider.ExchangeCode(ctx, code, verifier, redirectURI, "n-1")
+		assert.ErrorIs(t, err, ErrOIDCInvalidGrant)
+	})
+}
+
+func TestOIDCProvider_SignedByAnotherKey(t *testing.T) {
+	issuer := newMockIssuer(t, true)
+	ctx := context.Background()
+	provider := NewOIDCProvider(issuer.config()).(*oidcProvider)
+	_, verifier, err := provider.discover(ctx)
+	require.NoError(t, err)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = provider.identity(ctx, verifier, issuer.sign(issuer.claims("sub-1", "alice"), other), "")
+	assert.ErrorIs(t, err, ErrInvalidIDToken)
+
+	identity, err := provider.identity(ctx, verifier, issuer.sign(issuer.claims("sub-1", "alice"), issuer.key), "")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", identity.Username)
+}
+
+func TestOIDCProvider_DeviceLogin(t *testing.T) {
+	issuer := newMockIssuer(t, true)
+	ctx := context.Background()
+	provider := NewOIDCProvider(issuer.config())
+
+	device, err := provider.StartDeviceLogin(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "device-code", device.DeviceCode)
+	assert.Equal(t, "ABCD-EFGH", device.UserCode)
+	assert.Equal(t, issuer.URL+"/activate", device.VerificationURI)
+	assert.Equal(t, issuer.URL+"/activate?user_code=ABCD-EFGH", device.VerificationURIComplete)
+	assert.Equal(t, int64(5), device.Interval)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), device.Expiry, time.Minute)
+
+	_, err = provider.PollDeviceLogin(ctx, device.DeviceCode)
+	assert.ErrorIs(t, err, ErrOIDCAuthorizationPending)
+
+	for status, want := range map[string]error{
+		"slow_down":     ErrOIDCSlowDown,
+		"access_denied": ErrOIDCAccessDenied,
+		"expired_token": ErrOIDCDeviceCodeExpired,
+	} {
+		issuer.setDevice(status, nil)
+		_, err = provider.PollDeviceLogin(ctx, device.DeviceCode)
+		assert.ErrorIs(t, err, want, status)
+	}
+
+	_, err = provider.PollDeviceLogin(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrOIDCInvalidGrant)
+
+	issuer.setDevice("", issuer.claims("sub-1", "alice", "researchers"))
+	identity, err := provider.PollDeviceLogin(ctx, device.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "sub-1", identity.Subject)
+	assert.Equal(t, "alice", identity.Username)
+	assert.Equal(t, []string{"researchers"}, identity.Groups)
+
+	t.Run("provider without device flow", func(t *testing.T) {
+		provider := NewOIDCProvider(newMockIssuer(t, false).config())
+		_, err := provider.StartDeviceLogin(ctx)
+		assert.ErrorIs(t, err, ErrOIDCDeviceFlowUnsupported)
+	})
+}
@@ -7,9 +7,13 @@ import (
 	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/cache/redis"
+	"github.com/nicholaspcr/GoDE/internal/executor"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/internal/telemetry"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/problems/external"
 	"github.com/spf13/viper"
 )
 
@@ -49,6 +53,15 @@ type Config struct {
 	PprofEnabled   bool
 	PprofPort      string
 	CORS           middleware.CORSConfig
+	// APIOnly serves the API without claiming executions from the job queue,
+	// which are run by the deserver workers sharing the store.
+	APIOnly bool
+	// ExternalProblems are the problems evaluated by external processes,
+	// only read from the config file.
+	ExternalProblems []external.Config
+	// OIDC enables single sign-on when its issuer is set, its group_scopes
+	// are only read from the config file.
+	OIDC auth.OIDCConfig
 }
 
 // ExecutorConfig contains configuration for the background execution executor.
@@ -60,6 +73,24 @@ type ExecutorConfig struct {
 	ResultTTL            time.Duration
 	ProgressTTL          time.Duration
 	DefaultMaxExecution  time.Duration // Maximum wall-clock time per execution (0 = no limit)
+	// EvaluationWorkers is how many objective evaluations run concurrently,
+	// 0 or 1 evaluates sequentially. It is capped to the available CPUs,
+	// divided among MaxWorkers unless the pool is shared.
+	EvaluationWorkers    int
+	SharedEvaluationPool bool // One pool for all executions instead of one per execution
+	// WorkerID identifies this server in the jobs it claims from the queue,
+	// empty uses the hostname and pid.
+	WorkerID     string
+	MaxAttempts  int           // Claims of a job before its execution is failed
+	JobLease     time.Duration // Time without a heartbeat before a claimed job is recovered
+	PollInterval time.Duration // Interval between polls of the job queue
+	// CheckpointInterval is how many generations run between the
+	// checkpoints executions are resumed from.
+	CheckpointInterval int
+	// DefaultTenantQuota applies to the tenants without a quota in
+	// TenantQuotas, which is only read from the config file.
+	DefaultTenantQuota executor.TenantQuota
+	TenantQuotas       map[string]executor.TenantQuota
 }
 
 // TLSConfig contains TLS/HTTPS configuration.
@@ -129,6 +160,7 @@ func LoadConfig(configPath string) (Config, error) {
 		SLOEnabled:     v.GetBool("slo_enabled"),
 		PprofEnabled:   v.GetBool("pprof_enabled"),
 		PprofPort:      v.GetString("pprof_port"),
+		APIOnly:        v.GetBool("api_only"),
 		TLS: TLSConfig{
 			Enabled:  v.GetBool("tls.enabled"),
 			CertFile: v.GetString("tls.cert_file"),
@@ -162,15 +194,44 @@ func LoadConfig(configPath string) (Config, error) {
 			ResultTTL:            v.GetDuration("executor.result_ttl"),
 			ProgressTTL:          v.GetDuration("executor.progress_ttl"),
 			DefaultMaxExecution:  v.GetDuration("executor.default_max_execution"),
+			EvaluationWorkers:    v.GetInt("executor.evaluation_workers"),
+			SharedEvaluationPool: v.GetBool("executor.shared_evaluation_pool"),
+			WorkerID:             v.GetString("executor.worker_id"),
+			MaxAttempts:          v.GetInt("executor.max_attempts"),
+			JobLease:             v.GetDuration("executor.job_lease"),
+			PollInterval:         v.GetDuration("executor.poll_interval"),
+			CheckpointInterval:   v.GetInt("executor.checkpoint_interval"),
+			DefaultTenantQuota: executor.TenantQuota{
+				MaxWorkers:    v.GetInt("executor.default_tenant_quota.max_workers"),
+				MaxExecutions: v.GetInt("executor.default_tenant_quota.max_executions"),
+			},
 		},
 		DE: de.Config{
 			ParetoChannelLimiter: v.GetInt("de.pareto_channel_limiter"),
 			MaxChannelLimiter:    v.GetInt("de.max_channel_limiter"),
 			ResultLimiter:        v.GetInt("de.result_limiter"),
 		},
+		OIDC: auth.OIDCConfig{
+			Issuer:        v.GetString("oidc.issuer"),
+			ClientID:      v.GetString("oidc.client_id"),
+			ClientSecret:  v.GetString("oidc.client_secret"),
+			Scopes:        v.GetStringSlice("oidc.scopes"),
+			UsernameClaim: v.GetString("oidc.username_claim"),
+			GroupsClaim:   v.GetString("oidc.groups_claim"),
+		},
 		CORS: middleware.DefaultCORSConfig(),
 	}
 
+	if err := v.UnmarshalKey("external_problems", &cfg.ExternalProblems); err != nil {
+		return Config{}, fmt.Errorf("failed to read external_problems: %w", err)
+	}
+	if err := v.UnmarshalKey("executor.tenant_quotas", &cfg.Executor.TenantQuotas); err != nil {
+		return Config{}, fmt.Errorf("failed to read executor.tenant_quotas: %w", err)
+	}
+	if err := v.UnmarshalKey("oidc.group_scopes", &cfg.OIDC.GroupScopes); err != nil {
+		return Config{}, fmt.Errorf("failed to read oidc.group_scopes: %w", err)
+	}
+
 	// Handle metrics type enum
 	if v.GetString("metrics_type") == "stdout" {
 		cfg.MetricsType = telemetry.MetricsExporterStdout
@@ -211,6 +272,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("slo_enabled", true)
 	v.SetDefault("pprof_enabled", false)
 	v.SetDefault("pprof_port", "localhost:6060")
+	v.SetDefault("api_only", false)
 
 	// TLS defaults
 	v.SetDefault("tls.enabled", false)
@@ -238,12 +300,27 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("executor.result_ttl", 7*24*time.Hour)
 	v.SetDefault("executor.progress_ttl", 1*time.Hour)
 	v.SetDefault("executor.default_max_execution", 0) // 0 = no limit
+	v.SetDefault("executor.evaluation_workers", 0)    // 0 = sequential
+	v.SetDefault("executor.shared_evaluation_pool", false)
+	v.SetDefault("executor.worker_id", "") // empty = hostname-pid
+	v.SetDefault("executor.max_attempts", 3)
+	v.SetDefault("executor.job_lease", time.Minute)
+	v.SetDefault("executor.poll_interval", time.Second)
+	v.SetDefault("executor.checkpoint_interval", 100)
+	v.SetDefault("executor.default_tenant_quota.max_workers", 0)    // 0 = unlimited
+	v.SetDefault("executor.default_tenant_quota.max_executions", 0) // 0 = unlimited
 
 	// DE algorithm defaults
 	v.SetDefault("de.pareto_channel_limiter", 100)
 	v.SetDefault("de.max_channel_limiter", 100)
 	v.SetDefault("de.result_limiter", 1000)
 
+	// Single sign-on defaults, disabled without an issuer
+	v.SetDefault("oidc.issuer", "")
+	v.SetDefault("oidc.scopes", []string{"openid", "profile", "email"})
+	v.SetDefault("oidc.username_claim", "preferred_username")
+	v.SetDefault("oidc.groups_claim", "groups")
+
 	// Tracing defaults
 	v.SetDefault("tracing.exporter", "none")
 	v.SetDefault("tracing.otlp_endpoint", "localhost:4317")
@@ -268,6 +345,7 @@ func bindEnvVars(v *viper.Viper) {
 		"SLO_ENABLED":        "slo_enabled",
 		"PPROF_ENABLED":      "pprof_enabled",
 		"PPROF_PORT":         "pprof_port",
+		"API_ONLY":           "api_only",
 		"REDIS_HOST":                    "redis.host",
 		"REDIS_PORT":                    "redis.port",
 		"REDIS_PASSWORD":                "redis.password",
@@ -276,6 +354,9 @@ func bindEnvVars(v *viper.Viper) {
 		"REDIS_TLS_INSECURE_SKIP_VERIFY": "redis.tls.insecure_skip_verify",
 		"REDIS_TLS_CERT_FILE":           "redis.tls.cert_file",
 		"REDIS_TLS_KEY_FILE":            "redis.tls.key_file",
+		"OIDC_ISSUER":        "oidc.issuer",
+		"OIDC_CLIENT_ID":     "oidc.client_id",
+		"OIDC_CLIENT_SECRET": "oidc.client_secret",
 	}
 
 	for envVar, configKey := range envBindings {
@@ -325,6 +406,10 @@ func fallbackConfig() Config {
 			ExecutionTTL:         24 * time.Hour,
 			ResultTTL:            7 * 24 * time.Hour,
 			ProgressTTL:          1 * time.Hour,
+			MaxAttempts:          3,
+			JobLease:             time.Minute,
+			PollInterval:         time.Second,
+			CheckpointInterval:   100,
 		},
 		TLS: TLSConfig{
 			Enabled: false,
@@ -416,6 +501,25 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("JWT expiry must be at least 1 minute")
 	}
 
+	if err := c.OIDC.Validate(); err != nil {
+		return fmt.Errorf("oidc: %w", err)
+	}
+
+	return c.validateBackend()
+}
+
+// ValidateWorker validates the configuration of a deserver worker, which
+// only serves the health endpoints and runs the queued executions.
+func (c *Config) ValidateWorker() error {
+	if c.HTTPPort == "" {
+		return fmt.Errorf("HTTP port cannot be empty")
+	}
+	return c.validateBackend()
+}
+
+// validateBackend validates the Redis and executor settings shared by the
+// API servers and the workers.
+func (c *Config) validateBackend() error {
 	// Redis validation
 	if c.Redis.Host == "" {
 		return fmt.Errorf("redis host cannot be empty")
@@ -434,6 +538,21 @@ func (c *Config) Validate() error {
 	if c.Executor.MaxVectorsInProgress < 1 {
 		return fmt.Errorf("executor max_vectors_in_progress must be at least 1")
 	}
+	if c.Executor.EvaluationWorkers < 0 {
+		return fmt.Errorf("executor evaluation_workers cannot be negative")
+	}
+	if c.Executor.MaxAttempts < 0 {
+		return fmt.Errorf("executor max_attempts cannot be negative")
+	}
+	if c.Executor.JobLease < 0 {
+		return fmt.Errorf("executor job_lease cannot be negative")
+	}
+	if c.Executor.PollInterval < 0 {
+		return fmt.Errorf("executor poll_interval cannot be negative")
+	}
+	if c.Executor.CheckpointInterval < 0 {
+		return fmt.Errorf("executor checkpoint_interval cannot be negative")
+	}
 	if c.Executor.ExecutionTTL < time.Minute {
 		return fmt.Errorf("executor execution_ttl must be at least 1 minute")
 	}
@@ -443,6 +562,40 @@ func (c *Config) Validate() error {
 	if c.Executor.ProgressTTL < time.Minute {
 		return fmt.Errorf("executor progress_ttl must be at least 1 minute")
 	}
+	if err := validateTenantQuota(c.Executor.DefaultTenantQuota); err != nil {
+		return fmt.Errorf("executor default_tenant_quota: %w", err)
+	}
+	for id, quota := range c.Executor.TenantQuotas {
+		if err := tenant.ValidateID(id); err != nil {
+			return fmt.Errorf("executor tenant_quotas: %w", err)
+		}
+		if err := validateTenantQuota(quota); err != nil {
+			return fmt.Errorf("executor tenant_quotas %q: %w", id, err)
+		}
+	}
 
+	// External problems validation
+	names := make(map[string]bool, len(c.ExternalProblems))
+	for _, p := range c.ExternalProblems {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid external problem: %w", err)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("duplicate external problem %q", p.Name)
+		}
+		names[p.Name] = true
+	}
+
+	return nil
+}
+
+// validateTenantQuota checks that the limits of quota are not negative.
+func validateTenantQuota(quota executor.TenantQuota) error {
+	if quota.MaxWorkers < 0 {
+		return fmt.Errorf("max_workers cannot be negative")
+	}
+	if quota.MaxExecutions < 0 {
+		return fmt.Errorf("max_executions cannot be negative")
+	}
 	return nil
 }
@@ -7,7 +7,11 @@ import (
 	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/cache/redis"
+	"github.com/nicholaspcr/GoDE/internal/executor"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/problems/external"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -536,6 +540,108 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: "executor progress_ttl must be at least 1 minute",
 		},
+		{
+			name: "invalid executor evaluation workers",
+			config: Config{
+				LisAddr:   "localhost:3030",
+				HTTPPort:  ":8081",
+				JWTSecret: "this-is-a-very-secure-secret-with-more-than-32-characters",
+				JWTExpiry: 24 * time.Hour,
+				TLS: TLSConfig{
+					Enabled: false,
+				},
+				RateLimit: RateLimitConfig{
+					LoginRequestsPerMinute:    5,
+					RegisterRequestsPerMinute: 3,
+					DEExecutionsPerUser:       10,
+					MaxConcurrentDEPerUser:    3,
+					MaxRequestsPerSecond:      100,
+					MaxMessageSizeBytes:       4 * 1024 * 1024,
+				},
+				Redis: redis.Config{
+					Host: "localhost",
+					Port: 6379,
+				},
+				Executor: ExecutorConfig{
+					MaxWorkers:           10,
+					QueueSize:            100,
+					MaxVectorsInProgress: 100,
+					ExecutionTTL:         24 * time.Hour,
+					ResultTTL:            7 * 24 * time.Hour,
+					ProgressTTL:          time.Hour,
+					EvaluationWorkers:    -1,
+				},
+			},
+			wantErr: "executor evaluation_workers cannot be negative",
+		},
+		{
+			name: "invalid executor job lease",
+			config: Config{
+				LisAddr:   "localhost:3030",
+				HTTPPort:  ":8081",
+				JWTSecret: "this-is-a-very-secure-secret-with-more-than-32-characters",
+				JWTExpiry: 24 * time.Hour,
+				TLS: TLSConfig{
+					Enabled: false,
+				},
+				RateLimit: RateLimitConfig{
+					LoginRequestsPerMinute:    5,
+					RegisterRequestsPerMinute: 3,
+					DEExecutionsPerUser:       10,
+					MaxConcurrentDEPerUser:    3,
+					MaxRequestsPerSecond:      100,
+					MaxMessageSizeBytes:       4 * 1024 * 1024,
+				},
+				Redis: redis.Config{
+					Host: "localhost",
+					Port: 6379,
+				},
+				Executor: ExecutorConfig{
+					MaxWorkers:           10,
+					QueueSize:            100,
+					MaxVectorsInProgress: 100,
+					ExecutionTTL:         24 * time.Hour,
+					ResultTTL:            7 * 24 * time.Hour,
+					ProgressTTL:          time.Hour,
+					JobLease:             -time.Second,
+				},
+			},
+			wantErr: "executor job_lease cannot be negative",
+		},
+		{
+			name: "invalid executor checkpoint interval",
+			config: Config{
+				LisAddr:   "localhost:3030",
+				HTTPPort:  ":8081",
+				JWTSecret: "this-is-a-very-secure-secret-with-more-than-32-characters",
+				JWTExpiry: 24 * time.Hour,
+				TLS: TLSConfig{
+					Enabled: false,
+				},
+				RateLimit: RateLimitConfig{
+					LoginRequestsPerMinute:    5,
+					RegisterRequestsPerMinute: 3,
+					DEExecutionsPerUser:       10,
+					MaxConcurrentDEPerUser:    3,
+					MaxRequestsPerSecond:      100,
+					MaxMessageSizeBytes:       4 * 1024 * 1024,
+				},
+				Redis: redis.Config{
+					Host: "localhost",
+					Port: 6379,
+				},
+				Executor: ExecutorConfig{
+					MaxWorkers:           10,
+					QueueSize:            100,
+					MaxVectorsInProgress: 100,
+					ExecutionTTL:         24 * time.Hour,
+					ResultTTL:            7 * 24 * time.Hour,
+					ProgressTTL:          time.Hour,
+					CheckpointInterval:   -1,
+				},
+			},
+			wantErr: "executor checkpoint_interval cannot be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -725,6 +831,13 @@ func TestLoadConfig_Defaults(t *testing.T) {
 	assert.Equal(t, 24*time.Hour, cfg.Executor.ExecutionTTL)
 	assert.Equal(t, 7*24*time.Hour, cfg.Executor.ResultTTL)
 	assert.Equal(t, 1*time.Hour, cfg.Executor.ProgressTTL)
+	assert.Zero(t, cfg.Executor.EvaluationWorkers)
+	assert.False(t, cfg.Executor.SharedEvaluationPool)
+	assert.Empty(t, cfg.Executor.WorkerID)
+	assert.Equal(t, 3, cfg.Executor.MaxAttempts)
+	assert.Equal(t, time.Minute, cfg.Executor.JobLease)
+	assert.Equal(t, time.Second, cfg.Executor.PollInterval)
+	assert.Equal(t, 100, cfg.Executor.CheckpointInterval)
 
 	// DE defaults
 	assert.Equal(t, 100, cfg.DE.ParetoChannelLimiter)
@@ -746,6 +859,7 @@ func TestLoadConfig_EnvironmentVariables(t *testing.T) {
 	t.Setenv("SLO_ENABLED", "false")
 	t.Setenv("PPROF_ENABLED", "true")
 	t.Setenv("PPROF_PORT", ":7070")
+	t.Setenv("API_ONLY", "true")
 
 	cfg, err := LoadConfig("")
 	require.NoError(t, err)
@@ -763,6 +877,7 @@ func TestLoadConfig_EnvironmentVariables(t *testing.T) {
 	assert.False(t, cfg.SLOEnabled)
 	assert.True(t, cfg.PprofEnabled)
 	assert.Equal(t, ":7070", cfg.PprofPort)
+	assert.True(t, cfg.APIOnly)
 }
 
 func TestLoadConfig_GODEPrefixedEnvVars(t *testing.T) {
@@ -1091,3 +1206,198 @@ func TestConfig_Validate_Redis(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ValidateWorker(t *testing.T) {
+	valid := func() Config {
+		return Config{
+			HTTPPort: ":8081",
+			Redis: redis.Config{
+				Host: "localhost",
+				Port: 6379,
+			},
+			Executor: ExecutorConfig{
+				MaxWorkers:           10,
+				QueueSize:            100,
+				MaxVectorsInProgress: 100,
+				ExecutionTTL:         24 * time.Hour,
+				ResultTTL:            7 * 24 * time.Hour,
+				ProgressTTL:          time.Hour,
+			},
+		}
+	}
+
+	t.Run("workers need no API settings", func(t *testing.T) {
+		cfg := valid()
+		require.NoError(t, cfg.ValidateWorker())
+		assert.Error(t, cfg.Validate(), "the API server still requires a JWT secret")
+	})
+
+	t.Run("empty HTTP port", func(t *testing.T) {
+		cfg := valid()
+		cfg.HTTPPort = ""
+		assert.ErrorContains(t, cfg.ValidateWorker(), "HTTP port cannot be empty")
+	})
+
+	t.Run("invalid redis", func(t *testing.T) {
+		cfg := valid()
+		cfg.Redis.Host = ""
+		assert.ErrorContains(t, cfg.ValidateWorker(), "redis host cannot be empty")
+	})
+
+	t.Run("invalid executor", func(t *testing.T) {
+		cfg := valid()
+		cfg.Executor.MaxWorkers = 0
+		assert.ErrorContains(t, cfg.ValidateWorker(), "executor max_workers must be at least 1")
+	})
+
+	t.Run("invalid external problem", func(t *testing.T) {
+		cfg := valid()
+		cfg.ExternalProblems = []external.Config{{Name: "sim"}}
+		assert.ErrorContains(t, cfg.ValidateWorker(), "external problem sim: command cannot be empty")
+	})
+
+	t.Run("duplicate external problem", func(t *testing.T) {
+		cfg := valid()
+		sim := external.Config{Name: "sim", Command: "python3"}
+		cfg.ExternalProblems = []external.Config{sim, sim}
+		assert.ErrorContains(t, cfg.ValidateWorker(), `duplicate external problem "sim"`)
+	})
+}
+
+func TestLoadConfig_ExternalProblems(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configContent := `
+external_problems:
+  - name: sim
+    description: "Simulator"
+    command: python3
+    args: ["sim.py", "--fast"]
+    dir: /opt/sim
+    env: ["OMP_NUM_THREADS=1"]
+    objectives: 2
+    constraints: 1
+    min_dim: 3
+    max_dim: 12
+    floor: -5
+    ceil: 5
+    processes: 4
+    timeout: 30s
+  - name: other
+    command: ./other
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.ExternalProblems, 2)
+	assert.Equal(t, external.Config{
+		Name:        "sim",
+		Description: "Simulator",
+		Command:     "python3",
+		Args:        []string{"sim.py", "--fast"},
+		Dir:         "/opt/sim",
+		Env:         []string{"OMP_NUM_THREADS=1"},
+		Objectives:  2,
+		Constraints: 1,
+		MinDim:      3,
+		MaxDim:      12,
+		Floor:       -5,
+		Ceil:        5,
+		Processes:   4,
+		Timeout:     30 * time.Second,
+	}, cfg.ExternalProblems[0])
+	assert.Equal(t, external.Config{Name: "other", Command: "./other"}, cfg.ExternalProblems[1])
+
+	cfg, err = LoadConfig("")
+	require.NoError(t, err)
+	assert.Empty(t, cfg.ExternalProblems)
+}
+
+func TestLoadConfig_TenantQuotas(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configContent := `
+executor:
+  default_tenant_quota:
+    max_workers: 2
+    max_executions: 10
+  tenant_quotas:
+    acme:
+      max_workers: 8
+    trial:
+      max_executions: 1
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, executor.TenantQuota{MaxWorkers: 2, MaxExecutions: 10}, cfg.Executor.DefaultTenantQuota)
+	assert.Equal(t, map[string]executor.TenantQuota{
+		"acme":  {MaxWorkers: 8},
+		"trial": {MaxExecutions: 1},
+	}, cfg.Executor.TenantQuotas)
+
+	cfg.JWTSecret = "this-is-a-very-secure-secret-with-more-than-32-characters"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Executor.TenantQuotas["Not Valid"] = executor.TenantQuota{}
+	assert.ErrorIs(t, cfg.Validate(), tenant.ErrInvalidID)
+	delete(cfg.Executor.TenantQuotas, "Not Valid")
+
+	cfg.Executor.TenantQuotas["trial"] = executor.TenantQuota{MaxExecutions: -1}
+	assert.ErrorContains(t, cfg.Validate(), "max_executions cannot be negative")
+
+	cfg, err = LoadConfig("")
+	require.NoError(t, err)
+	assert.Zero(t, cfg.Executor.DefaultTenantQuota)
+	assert.Empty(t, cfg.Executor.TenantQuotas)
+}
+
+func TestLoadConfig_OIDC(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configContent := `
+oidc:
+  issuer: https://idp.example.com/realms/gode
+  client_id: gode
+  client_secret: s3cret
+  username_claim: nickname
+  group_scopes:
+    - group: /gode/Admins
+      scopes: [admin]
+    - group: researchers
+      scopes: [de:run, de:read]
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.True(t, cfg.OIDC.Enabled())
+	assert.Equal(t, "https://idp.example.com/realms/gode", cfg.OIDC.Issuer)
+	assert.Equal(t, "gode", cfg.OIDC.ClientID)
+	assert.Equal(t, "s3cret", cfg.OIDC.ClientSecret)
+	assert.Equal(t, []string{"openid", "profile", "email"}, cfg.OIDC.Scopes)
+	assert.Equal(t, "nickname", cfg.OIDC.UsernameClaim)
+	assert.Equal(t, "groups", cfg.OIDC.GroupsClaim)
+	assert.Equal(t, []auth.OIDCGroupScopes{
+		{Group: "/gode/Admins", Scopes: []string{"admin"}},
+		{Group: "researchers", Scopes: []string{"de:run", "de:read"}},
+	}, cfg.OIDC.GroupScopes)
+
+	cfg.JWTSecret = "this-is-a-very-secure-secret-with-more-than-32-characters"
+	require.NoError(t, cfg.Validate())
+
+	cfg.OIDC.GroupScopes[1].Scopes = []string{"root"}
+	assert.ErrorIs(t, cfg.Validate(), auth.ErrUnknownScope)
+	cfg.OIDC.ClientID = ""
+	assert.ErrorContains(t, cfg.Validate(), "oidc: client_id is required")
+
+	t.Setenv("OIDC_CLIENT_SECRET", "from-env")
+	cfg, err = LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", cfg.OIDC.ClientSecret)
+
+	cfg, err = LoadConfig("")
+	require.NoError(t, err)
+	assert.False(t, cfg.OIDC.Enabled())
+	assert.Empty(t, cfg.OIDC.GroupScopes)
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nicholaspcr/GoDE/internal/executor"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// adminHandler is responsible for the admin service operations.
+type adminHandler struct {
+	api.UnimplementedAdminServiceServer
+	db       adminDB
+	executor *executor.Executor
+}
+
+// NewAdminHandler returns a handler that implements api's
+// AdminServiceServer.
+func NewAdminHandler(st adminDB, exec *executor.Executor) Handler {
+	return &adminHandler{db: st, executor: exec}
+}
+
+// RegisterService adds AdminService to the RPC server.
+func (ah *adminHandler) RegisterService(srv *grpc.Server) {
+	api.RegisterAdminServiceServer(srv, ah)
+}
+
+// RegisterHTTPHandler adds AdminService to the grpc-gateway.
+func (ah *adminHandler) RegisterHTTPHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	lisAddr string,
+	dialOpts []grpc.DialOption,
+) error {
+	return api.RegisterAdminServiceHandlerFromEndpoint(
+		ctx, mux, lisAddr, dialOpts,
+	)
+}
+
+// requireAdmin returns the username of the caller, failing unless it has the
+// admin scope.
+func requireAdmin(ctx context.Context) (string, error) {
+	username, err := usernameFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := middleware.RequireScope(ctx, auth.ScopeAdmin); err != nil {
+		return "", err
+	}
+	return username, nil
+}
+
+// ListUsers returns the users of the tenant ordered by username.
+func (ah *adminHandler) ListUsers(
+	ctx context.Context, req *api.AdminListUsersRequest,
+) (*api.AdminListUsersResponse, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.ListUsers")
+	defer span.End()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Extract and normalize pagination parameters before querying
+	limit := int(req.Limit)
+	offset := int(req.Offset)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	users, totalCount, err := ah.db.ListUsers(ctx, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list users")
+	}
+
+	apiUsers := make([]*api.UserResponse, len(users))
+	for i, usr := range users {
+		apiUsers[i] = userToResponse(usr)
+	}
+
+	return &api.AdminListUsersResponse{
+		Users:      apiUsers,
+		TotalCount: int32(totalCount),
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		HasMore:    (offset + limit) < totalCount,
+	}, nil
+}
+
+// UpdateUserScopes replaces the scopes of a user. Administrators cannot drop
+// their own admin scope, so the tenant is never left without one by mistake.
+func (ah *adminHandler) UpdateUserScopes(
+	ctx context.Context, req *api.AdminUpdateUserScopesRequest,
+) (*api.AdminUpdateUserScopesResponse, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.UpdateUserScopes")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("username", req.Username))
+
+	callerUsername, err := requireAdmin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	scopes, err := auth.ParseScopes(req.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Username == callerUsername && !slices.Contains(scopes, auth.ScopeAdmin) {
+		return nil, status.Error(codes.FailedPrecondition, "cannot remove the admin scope from yourself")
+	}
+
+	usr, err := ah.db.GetUser(ctx, &api.UserIDs{Username: req.Username})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	usr.Scopes = make([]string, len(scopes))
+	for i, scope := range scopes {
+		usr.Scopes[i] = string(scope)
+	}
+	if err := ah.db.UpdateUser(ctx, usr, "scopes"); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to update user")
+	}
+
+	return &api.AdminUpdateUserScopesResponse{User: userToResponse(usr)}, nil
+}
+
+// ListExecutions returns the executions of every user of the tenant, or of
+// the requested user, newest first.
+func (ah *adminHandler) ListExecutions(
+	ctx context.Context, req *api.AdminListExecutionsRequest,
+) (*api.AdminListExecutionsResponse, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.ListExecutions")
+	defer span.End()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Convert API status filter to store status
+	var statusFilter *store.ExecutionStatus
+	if req.Status != api.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED {
+		storeStatus := convertAPIStatusToStore(req.Status)
+		statusFilter = &storeStatus
+	}
+
+	// Extract and normalize pagination parameters before querying
+	limit := int(req.Limit)
+	offset := int(req.Offset)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	executions, totalCount, err := ah.db.ListAllExecutions(ctx, req.UserId, store.ExecutionFilter{
+		Status:       statusFilter,
+		ExperimentID: req.ExperimentId,
+	}, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list executions")
+	}
+
+	apiExecutions := make([]*api.Execution, len(executions))
+	for i, exec := range executions {
+		apiExecutions[i] = executionToProto(exec)
+	}
+
+	return &api.AdminListExecutionsResponse{
+		Executions: apiExecutions,
+		TotalCount: int32(totalCount),
+		Limit:      int32(limit),
+		Offset:     int32(offset),
+		HasMore:    (offset + limit) < totalCount,
+	}, nil
+}
+
+// CancelExecution cancels the execution of any user of the tenant.
+func (ah *adminHandler) CancelExecution(
+	ctx context.Context, req *api.AdminCancelExecutionRequest,
+) (*emptypb.Empty, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.CancelExecution")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("execution_id", req.ExecutionId))
+
+	if _, err := requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	execution, err := ah.db.FindExecution(ctx, req.ExecutionId)
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get execution")
+	}
+
+	// Cancel on behalf of the owner of the execution
+	if err := ah.executor.CancelExecution(ctx, execution.ID, execution.UserID); err != nil {
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to cancel execution")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetWorkerPool returns the workers of the server in use, by every tenant,
+// and the jobs of the tenant in the queue.
+func (ah *adminHandler) GetWorkerPool(
+	ctx context.Context, _ *api.GetWorkerPoolRequest,
+) (*api.GetWorkerPoolResponse, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.GetWorkerPool")
+	defer span.End()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	stats, err := ah.executor.PoolStats(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get worker pool")
+	}
+
+	return &api.GetWorkerPoolResponse{
+		WorkerId:      stats.WorkerID,
+		MaxWorkers:    int32(stats.MaxWorkers),
+		ActiveWorkers: int32(stats.ActiveWorkers),
+		QueuedJobs:    int32(stats.QueuedJobs),
+		ClaimedJobs:   int32(stats.ClaimedJobs),
+	}, nil
+}
+
+// PurgeResults deletes the finished executions of the tenant older than the
+// requested age, with their results.
+func (ah *adminHandler) PurgeResults(
+	ctx context.Context, req *api.PurgeResultsRequest,
+) (*api.PurgeResultsResponse, error) {
+	tracer := otel.Tracer("handlers.admin")
+	ctx, span := tracer.Start(ctx, "adminHandler.PurgeResults")
+	defer span.End()
+
+	if _, err := requireAdmin(ctx); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if req.OlderThan == nil || !req.OlderThan.IsValid() || req.OlderThan.AsDuration() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "older_than must be a positive duration")
+	}
+	olderThan := req.OlderThan.AsDuration()
+	span.SetAttributes(attribute.String("older_than", olderThan.String()))
+
+	purged, err := ah.db.PurgeExecutions(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to purge results")
+	}
+
+	span.SetAttributes(attribute.Int("purged_executions", len(purged)))
+	return &api.PurgeResultsResponse{PurgedExecutions: int32(len(purged))}, nil
+}
@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/executor"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+)
+
+// adminTestStore is a testStore that also holds users.
+type adminTestStore struct {
+	*testStore
+	users map[string]*api.User
+}
+
+func (ts *adminTestStore) GetUser(_ context.Context, ids *api.UserIDs) (*api.User, error) {
+	usr, ok := ts.users[ids.Username]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return usr, nil
+}
+
+func (ts *adminTestStore) UpdateUser(_ context.Context, usr *api.User, fields ...string) error {
+	stored, ok := ts.users[usr.Ids.Username]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	for _, field := range fields {
+		if field == "scopes" {
+			stored.Scopes = usr.Scopes
+		}
+	}
+	return nil
+}
+
+func (ts *adminTestStore) ListUsers(_ context.Context, limit, offset int) ([]*api.User, int, error) {
+	users := make([]*api.User, 0, len(ts.users))
+	for _, name := range []string{"admin", "alice", "bob"} {
+		if usr, ok := ts.users[name]; ok {
+			users = append(users, usr)
+		}
+	}
+	total := len(users)
+	users = users[min(offset, total):min(offset+limit, total)]
+	return users, total, nil
+}
+
+func setupAdminHandler() (*adminHandler, *adminTestStore, *executor.Executor) {
+	ts := &adminTestStore{
+		testStore: newTestStore(),
+		users: map[string]*api.User{
+			"admin": {Ids: &api.UserIDs{Username: "admin"}, Scopes: []string{"admin"}},
+			"alice": {Ids: &api.UserIDs{Username: "alice"}, Email: "alice@example.com"},
+			"bob":   {Ids: &api.UserIDs{Username: "bob"}, Email: "bob@example.com"},
+		},
+	}
+
+	// The executor is not started, so submitted executions stay queued
+	exec := executor.New(executor.Config{
+		Store:        ts,
+		MaxWorkers:   2,
+		WorkerID:     "worker-a",
+		ExecutionTTL: time.Hour,
+		ResultTTL:    time.Hour,
+		ProgressTTL:  time.Minute,
+	})
+	factory, _ := problems.DefaultRegistry.GetFactory("zdt1")
+	exec.RegisterProblem("zdt1", factory)
+	variant, _ := variants.DefaultRegistry.Create("rand1")
+	exec.RegisterVariant("rand1", variant)
+
+	return NewAdminHandler(ts, exec).(*adminHandler), ts, exec
+}
+
+// adminTestConfig returns a small GDE3 configuration.
+func adminTestConfig() *api.DEConfig {
+	return &api.DEConfig{
+		Executions:     1,
+		Generations:    2,
+		PopulationSize: 10,
+		DimensionsSize: 10,
+		ObjectivesSize: 2,
+		FloorLimiter:   0.0,
+		CeilLimiter:    1.0,
+		AlgorithmConfig: &api.DEConfig_Gde3{
+			Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+		},
+	}
+}
+
+// adminContext creates a context with the claims of an administrator.
+func adminContext(username string) context.Context {
+	claims := &auth.Claims{
+		Username: username,
+		Scopes:   auth.AllScopes(),
+	}
+	return middleware.ContextWithClaims(context.Background(), claims)
+}
+
+func TestAdminHandler_RequiresAdmin(t *testing.T) {
+	handler, _, _ := setupAdminHandler()
+	ctx := authContext("alice")
+
+	_, err := handler.ListUsers(ctx, &api.AdminListUsersRequest{})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{Username: "alice", Scopes: []string{"admin"}})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.ListExecutions(ctx, &api.AdminListExecutionsRequest{})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.CancelExecution(ctx, &api.AdminCancelExecutionRequest{ExecutionId: "exec"})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.GetWorkerPool(ctx, &api.GetWorkerPoolRequest{})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.PurgeResults(ctx, &api.PurgeResultsRequest{OlderThan: durationpb.New(time.Hour)})
+	requireCode(t, err, codes.PermissionDenied)
+
+	_, err = handler.ListUsers(context.Background(), &api.AdminListUsersRequest{})
+	requireCode(t, err, codes.Unauthenticated)
+}
+
+func TestAdminHandler_ListUsers(t *testing.T) {
+	handler, _, _ := setupAdminHandler()
+
+	resp, err := handler.ListUsers(adminContext("admin"), &api.AdminListUsersRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 2)
+	assert.Equal(t, "admin", resp.Users[0].Ids.Username)
+	assert.Equal(t, []string{"admin"}, resp.Users[0].Scopes)
+	assert.Equal(t, "alice@example.com", resp.Users[1].Email)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	assert.Equal(t, int32(2), resp.Limit)
+	assert.True(t, resp.HasMore)
+}
+
+func TestAdminHandler_UpdateUserScopes(t *testing.T) {
+	handler, ts, _ := setupAdminHandler()
+	ctx := adminContext("admin")
+
+	resp, err := handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{
+		Username: "alice",
+		Scopes:   []string{"de:read", "admin", "de:read"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de:read", "admin"}, resp.User.Scopes)
+	assert.Equal(t, []string{"de:read", "admin"}, ts.users["alice"].Scopes)
+
+	t.Run("empty scopes restore the defaults", func(t *testing.T) {
+		resp, err := handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{Username: "alice"})
+		require.NoError(t, err)
+		assert.Empty(t, resp.User.Scopes)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{
+			Username: "alice",
+			Scopes:   []string{"root"},
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, err := handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{Username: "carol"})
+		requireCode(t, err, codes.NotFound)
+	})
+
+	t.Run("own admin scope", func(t *testing.T) {
+		_, err := handler.UpdateUserScopes(ctx, &api.AdminUpdateUserScopesRequest{
+			Username: "admin",
+			Scopes:   []string{"de:read"},
+		})
+		requireCode(t, err, codes.FailedPrecondition)
+		assert.Equal(t, []string{"admin"}, ts.users["admin"].Scopes)
+	})
+}
+
+func TestAdminHandler_ListExecutions(t *testing.T) {
+	handler, ts, _ := setupAdminHandler()
+	ctx := adminContext("admin")
+
+	now := time.Now()
+	for i, owner := range []string{"alice", "bob", "alice"} {
+		require.NoError(t, ts.CreateExecution(context.Background(), &store.Execution{
+			ID:        owner + "-exec-" + string(rune('a'+i)),
+			UserID:    owner,
+			Status:    store.ExecutionStatusCompleted,
+			Config:    &api.DEConfig{},
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+			UpdatedAt: now,
+		}))
+	}
+
+	resp, err := handler.ListExecutions(ctx, &api.AdminListExecutionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 3)
+	assert.Equal(t, "alice-exec-c", resp.Executions[0].Id, "newest first")
+	assert.Equal(t, int32(3), resp.TotalCount)
+
+	resp, err = handler.ListExecutions(ctx, &api.AdminListExecutionsRequest{UserId: "bob"})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 1)
+	assert.Equal(t, "bob", resp.Executions[0].UserId)
+
+	resp, err = handler.ListExecutions(ctx, &api.AdminListExecutionsRequest{
+		Status: api.ExecutionStatus_EXECUTION_STATUS_RUNNING,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Executions)
+}
+
+func TestAdminHandler_CancelExecution(t *testing.T) {
+	handler, ts, exec := setupAdminHandler()
+	ctx := adminContext("admin")
+
+	executionID, err := exec.SubmitExecution(
+		context.Background(), "alice", "gde3", "zdt1", "rand1", adminTestConfig(), "", 0,
+	)
+	require.NoError(t, err)
+
+	_, err = handler.CancelExecution(ctx, &api.AdminCancelExecutionRequest{ExecutionId: executionID})
+	require.NoError(t, err)
+
+	execution, err := ts.GetExecution(context.Background(), executionID, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, store.ExecutionStatusCancelled, execution.Status)
+
+	_, err = handler.CancelExecution(ctx, &api.AdminCancelExecutionRequest{ExecutionId: "missing"})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestAdminHandler_GetWorkerPool(t *testing.T) {
+	handler, _, exec := setupAdminHandler()
+
+	_, err := exec.SubmitExecution(
+		context.Background(), "alice", "gde3", "zdt1", "rand1", adminTestConfig(), "", 0,
+	)
+	require.NoError(t, err)
+	for range 2 {
+		_, err = exec.SubmitExecution(
+			tenant.WithID(context.Background(), "lab"), "bob", "gde3", "zdt1", "rand1", adminTestConfig(), "", 0,
+		)
+		require.NoError(t, err)
+	}
+
+	resp, err := handler.GetWorkerPool(adminContext("admin"), &api.GetWorkerPoolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "worker-a", resp.WorkerId)
+	assert.Equal(t, int32(2), resp.MaxWorkers)
+	assert.Zero(t, resp.ActiveWorkers)
+	assert.Equal(t, int32(1), resp.QueuedJobs, "jobs of other tenants are not counted")
+	assert.Zero(t, resp.ClaimedJobs)
+
+	resp, err = handler.GetWorkerPool(tenant.WithID(adminContext("admin"), "lab"), &api.GetWorkerPoolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.QueuedJobs)
+}
+
+func TestAdminHandler_PurgeResults(t *testing.T) {
+	handler, ts, _ := setupAdminHandler()
+	ctx := adminContext("admin")
+
+	old := time.Now().Add(-48 * time.Hour)
+	executions := []*store.Execution{
+		{ID: "old-completed", Status: store.ExecutionStatusCompleted, UpdatedAt: old},
+		{ID: "old-running", Status: store.ExecutionStatusRunning, UpdatedAt: old},
+		{ID: "recent-failed", Status: store.ExecutionStatusFailed, UpdatedAt: time.Now()},
+	}
+	for _, execution := range executions {
+		execution.UserID = "alice"
+		execution.Config = &api.DEConfig{}
+		execution.CreatedAt = execution.UpdatedAt
+		require.NoError(t, ts.CreateExecution(context.Background(), execution))
+	}
+
+	resp, err := handler.PurgeResults(ctx, &api.PurgeResultsRequest{OlderThan: durationpb.New(24 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.PurgedExecutions)
+
+	_, err = ts.GetExecution(context.Background(), "old-completed", "alice")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+	_, err = ts.GetExecution(context.Background(), "old-running", "alice")
+	assert.NoError(t, err, "running executions are kept")
+	_, err = ts.GetExecution(context.Background(), "recent-failed", "alice")
+	assert.NoError(t, err, "recent executions are kept")
+
+	for _, olderThan := range []*durationpb.Duration{nil, durationpb.New(0), durationpb.New(-time.Hour)} {
+		_, err := handler.PurgeResults(ctx, &api.PurgeResultsRequest{OlderThan: olderThan})
+		requireCode(t, err, codes.InvalidArgument)
+	}
+}
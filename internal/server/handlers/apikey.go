@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/validation"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// apiKeyHandler is responsible for the API key service operations.
+type apiKeyHandler struct {
+	api.UnimplementedAPIKeyServiceServer
+	db apiKeyDB
+}
+
+// NewAPIKeyHandler returns a handler that implements api's
+// APIKeyServiceServer.
+func NewAPIKeyHandler(st apiKeyDB) Handler {
+	return &apiKeyHandler{db: st}
+}
+
+// RegisterService adds APIKeyService to the RPC server.
+func (kh *apiKeyHandler) RegisterService(srv *grpc.Server) {
+	api.RegisterAPIKeyServiceServer(srv, kh)
+}
+
+// RegisterHTTPHandler adds APIKeyService to the grpc-gateway.
+func (kh *apiKeyHandler) RegisterHTTPHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	lisAddr string,
+	dialOpts []grpc.DialOption,
+) error {
+	return api.RegisterAPIKeyServiceHandlerFromEndpoint(
+		ctx, mux, lisAddr, dialOpts,
+	)
+}
+
+// requireLogin returns the username of the caller, failing for requests
+// authenticated with an API key so that a leaked key cannot be used to issue
+// or remove keys.
+func requireLogin(ctx context.Context, scope auth.Scope) (string, error) {
+	username, err := usernameFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := middleware.RequireScope(ctx, scope); err != nil {
+		return "", err
+	}
+	if claims := middleware.ClaimsFromContext(ctx); claims.TokenType == auth.APIKeyToken {
+		return "", status.Error(codes.PermissionDenied, "api keys cannot manage api keys")
+	}
+	return username, nil
+}
+
+// Create issues an API key for the current user with a subset of its scopes.
+func (kh *apiKeyHandler) Create(
+	ctx context.Context, req *api.CreateAPIKeyRequest,
+) (*api.CreateAPIKeyResponse, error) {
+	tracer := otel.Tracer("handlers.apikey")
+	ctx, span := tracer.Start(ctx, "apiKeyHandler.Create")
+	defer span.End()
+
+	userID, err := requireLogin(ctx, auth.ScopeUserWrite)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var expiresIn time.Duration
+	if req.ExpiresIn != nil {
+		if !req.ExpiresIn.IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "expires_in is not a valid duration")
+		}
+		expiresIn = req.ExpiresIn.AsDuration()
+	}
+	if err := validation.ValidateAPIKey(req.Name, req.Scopes, expiresIn); err != nil {
+		span.RecordError(err)
+		return nil, ValidationErrorToStatus(err)
+	}
+
+	scopes, err := auth.ParseScopes(req.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	claims := middleware.ClaimsFromContext(ctx)
+	for _, scope := range scopes {
+		if !claims.HasScope(scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot grant scope %s", scope)
+		}
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to generate api key")
+	}
+
+	apiKey := &store.APIKey{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   hash,
+		Scopes:    make([]string, len(scopes)),
+		CreatedAt: time.Now(),
+	}
+	for i, scope := range scopes {
+		apiKey.Scopes[i] = string(scope)
+	}
+	if expiresIn > 0 {
+		expiresAt := apiKey.CreatedAt.Add(expiresIn)
+		apiKey.ExpiresAt = &expiresAt
+	}
+	if err := kh.db.CreateAPIKey(ctx, apiKey); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	span.SetAttributes(attribute.String("api_key_id", apiKey.ID))
+
+	return &api.CreateAPIKeyResponse{
+		ApiKey: apiKeyToProto(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// List returns the API keys of the current user, newest first.
+func (kh *apiKeyHandler) List(
+	ctx context.Context, _ *api.ListAPIKeysRequest,
+) (*api.ListAPIKeysResponse, error) {
+	tracer := otel.Tracer("handlers.apikey")
+	ctx, span := tracer.Start(ctx, "apiKeyHandler.List")
+	defer span.End()
+
+	userID, err := requireLogin(ctx, auth.ScopeUserRead)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	keys, err := kh.db.ListAPIKeys(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	apiKeys := make([]*api.APIKey, len(keys))
+	for i, key := range keys {
+		apiKeys[i] = apiKeyToProto(key)
+	}
+	return &api.ListAPIKeysResponse{ApiKeys: apiKeys}, nil
+}
+
+// Revoke deletes an API key of the current user.
+func (kh *apiKeyHandler) Revoke(
+	ctx context.Context, req *api.RevokeAPIKeyRequest,
+) (*emptypb.Empty, error) {
+	tracer := otel.Tracer("handlers.apikey")
+	ctx, span := tracer.Start(ctx, "apiKeyHandler.Revoke")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("api_key_id", req.Id))
+
+	userID, err := requireLogin(ctx, auth.ScopeUserWrite)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := kh.db.DeleteAPIKey(ctx, req.Id, userID); err != nil {
+		if errors.Is(err, store.ErrAPIKeyNotFound) {
+			return nil, status.Error(codes.NotFound, "api key not found")
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to revoke api key")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// apiKeyToProto converts a stored API key to its API representation, without
+// its hash.
+func apiKeyToProto(key *store.APIKey) *api.APIKey {
+	apiKey := &api.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.ExpiresAt != nil {
+		apiKey.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+	return apiKey
+}
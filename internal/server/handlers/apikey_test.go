@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// apiKeyTestStore holds the keys of every user in creation order.
+type apiKeyTestStore struct {
+	keys []*store.APIKey
+}
+
+func (ts *apiKeyTestStore) CreateAPIKey(_ context.Context, key *store.APIKey) error {
+	ts.keys = append(ts.keys, key)
+	return nil
+}
+
+func (ts *apiKeyTestStore) ListAPIKeys(_ context.Context, userID string) ([]*store.APIKey, error) {
+	var keys []*store.APIKey
+	for i := len(ts.keys) - 1; i >= 0; i-- {
+		if ts.keys[i].UserID == userID {
+			keys = append(keys, ts.keys[i])
+		}
+	}
+	return keys, nil
+}
+
+func (ts *apiKeyTestStore) DeleteAPIKey(_ context.Context, keyID, userID string) error {
+	for i, key := range ts.keys {
+		if key.ID == keyID && key.UserID == userID {
+			ts.keys = append(ts.keys[:i], ts.keys[i+1:]...)
+			return nil
+		}
+	}
+	return store.ErrAPIKeyNotFound
+}
+
+func setupAPIKeyHandler() (*apiKeyHandler, *apiKeyTestStore) {
+	ts := &apiKeyTestStore{}
+	return NewAPIKeyHandler(ts).(*apiKeyHandler), ts
+}
+
+func TestAPIKeyHandler_Create(t *testing.T) {
+	handler, ts := setupAPIKeyHandler()
+	ctx := authContext("alice")
+
+	resp, err := handler.Create(ctx, &api.CreateAPIKeyRequest{
+		Name:      "nightly",
+		Scopes:    []string{"de:run", "de:read"},
+		ExpiresIn: durationpb.New(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.True(t, auth.IsAPIKey(resp.Key))
+	assert.Equal(t, "nightly", resp.ApiKey.Name)
+	assert.Equal(t, []string{"de:run", "de:read"}, resp.ApiKey.Scopes)
+	assert.Equal(t, resp.Key[:len(resp.ApiKey.Prefix)], resp.ApiKey.Prefix)
+	require.NotNil(t, resp.ApiKey.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), resp.ApiKey.ExpiresAt.AsTime(), time.Minute)
+
+	require.Len(t, ts.keys, 1)
+	assert.Equal(t, "alice", ts.keys[0].UserID)
+	assert.Equal(t, auth.HashAPIKey(resp.Key), ts.keys[0].KeyHash, "only the hash is stored")
+
+	t.Run("without expiry", func(t *testing.T) {
+		resp, err := handler.Create(ctx, &api.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"de:read"}})
+		require.NoError(t, err)
+		assert.Nil(t, resp.ApiKey.ExpiresAt)
+	})
+
+	t.Run("scope the caller does not hold", func(t *testing.T) {
+		_, err := handler.Create(ctx, &api.CreateAPIKeyRequest{Name: "root", Scopes: []string{"admin"}})
+		requireCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := handler.Create(ctx, &api.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"root"}})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := handler.Create(ctx, &api.CreateAPIKeyRequest{Scopes: []string{"de:read"}})
+		requireCode(t, err, codes.InvalidArgument)
+		_, err = handler.Create(ctx, &api.CreateAPIKeyRequest{Name: "ci"})
+		requireCode(t, err, codes.InvalidArgument)
+		_, err = handler.Create(ctx, &api.CreateAPIKeyRequest{
+			Name: "ci", Scopes: []string{"de:read"}, ExpiresIn: durationpb.New(-time.Hour),
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := handler.Create(context.Background(), &api.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"de:read"}})
+		requireCode(t, err, codes.Unauthenticated)
+	})
+}
+
+func TestAPIKeyHandler_DeniedWithAPIKey(t *testing.T) {
+	handler, ts := setupAPIKeyHandler()
+	ts.keys = append(ts.keys, &store.APIKey{ID: "key-1", UserID: "robot", Name: "ci"})
+	ctx := middleware.ContextWithClaims(context.Background(), &auth.Claims{
+		Username:  "robot",
+		TokenType: auth.APIKeyToken,
+		Scopes:    auth.AllScopes(),
+	})
+
+	_, err := handler.Create(ctx, &api.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"de:read"}})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.List(ctx, &api.ListAPIKeysRequest{})
+	requireCode(t, err, codes.PermissionDenied)
+	_, err = handler.Revoke(ctx, &api.RevokeAPIKeyRequest{Id: "key-1"})
+	requireCode(t, err, codes.PermissionDenied)
+	assert.Len(t, ts.keys, 1)
+}
+
+func TestAPIKeyHandler_ListAndRevoke(t *testing.T) {
+	handler, _ := setupAPIKeyHandler()
+	alice := authContext("alice")
+
+	first, err := handler.Create(alice, &api.CreateAPIKeyRequest{Name: "nightly", Scopes: []string{"de:run"}})
+	require.NoError(t, err)
+	second, err := handler.Create(alice, &api.CreateAPIKeyRequest{Name: "ci", Scopes: []string{"de:read"}})
+	require.NoError(t, err)
+	_, err = handler.Create(authContext("bob"), &api.CreateAPIKeyRequest{Name: "bob", Scopes: []string{"de:read"}})
+	require.NoError(t, err)
+
+	resp, err := handler.List(alice, &api.ListAPIKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.ApiKeys, 2)
+	assert.Equal(t, second.ApiKey.Id, resp.ApiKeys[0].Id)
+	assert.Equal(t, first.ApiKey.Id, resp.ApiKeys[1].Id)
+
+	_, err = handler.Revoke(authContext("bob"), &api.RevokeAPIKeyRequest{Id: first.ApiKey.Id})
+	requireCode(t, err, codes.NotFound)
+	_, err = handler.Revoke(alice, &api.RevokeAPIKeyRequest{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = handler.Revoke(alice, &api.RevokeAPIKeyRequest{Id: first.ApiKey.Id})
+	require.NoError(t, err)
+	resp, err = handler.List(alice, &api.ListAPIKeysRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.ApiKeys, 1)
+	assert.Equal(t, second.ApiKey.Id, resp.ApiKeys[0].Id)
+
+	_, err = handler.Revoke(alice, &api.RevokeAPIKeyRequest{Id: first.ApiKey.Id})
+	requireCode(t, err, codes.NotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/nicholaspcr/GoDE/pkg/validation"
 	"golang.org/x/crypto/bcrypt"
@@ -127,8 +128,14 @@ func (ah authHandler) Login(
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
-	// Generate JWT token pair
-	accessToken, refreshToken, err := ah.jwtService.GenerateTokenPair(usr.Ids.Username)
+	// Stored scopes are validated when set, an empty set means the defaults
+	scopes, err := auth.ParseScopes(usr.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate tokens")
+	}
+
+	// Generate JWT token pair, the tokens name the tenant the user logged in to
+	accessToken, refreshToken, err := ah.jwtService.GenerateTokenPair(tenant.IDFromContext(ctx), usr.Ids.Username, scopes...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate tokens")
 	}
@@ -197,8 +204,18 @@ func (ah authHandler) RefreshToken(
 		}
 	}
 
+	// Re-read the user so that scope changes apply from the next refresh.
+	usr, err := ah.db.GetUser(tenant.WithID(ctx, oldClaims.TenantID), &api.UserIDs{Username: oldClaims.Username})
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	scopes, err := auth.ParseScopes(usr.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
 	// Issue new token pair.
-	accessToken, newRefreshToken, err := ah.jwtService.GenerateTokenPair(oldClaims.Username)
+	accessToken, newRefreshToken, err := ah.jwtService.GenerateTokenPair(oldClaims.TenantID, oldClaims.Username, scopes...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate tokens")
 	}
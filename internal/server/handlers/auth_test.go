@@ -9,12 +9,14 @@ import (
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/internal/store/mock"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 // testRevoker is a simple in-memory TokenRevoker for handler tests.
@@ -318,7 +320,17 @@ func TestAuthHandler_Logout(t *testing.T) {
 
 func TestAuthHandler_RefreshToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret-key-for-refresh-tests", 24*time.Hour)
-	mockStore := &mock.MockStore{}
+	mockStore := &mock.MockStore{
+		GetUserFn: func(ctx context.Context, userIDs *api.UserIDs) (*api.User, error) {
+			switch userIDs.Username {
+			case "testuser":
+				return &api.User{Ids: userIDs}, nil
+			case "operator":
+				return &api.User{Ids: userIDs, Scopes: []string{"admin"}}, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
 
 	handler := NewAuthHandler(mockStore, jwtService, 15*time.Minute, nil).(*authHandler)
 
@@ -344,7 +356,7 @@ func TestAuthHandler_RefreshToken(t *testing.T) {
 
 	t.Run("access token used as refresh token", func(t *testing.T) {
 		// Generate a valid access token (not refresh token)
-		accessToken, _, err := jwtService.GenerateTokenPair("testuser")
+		accessToken, _, err := jwtService.GenerateTokenPair(tenant.DefaultID, "testuser")
 		require.NoError(t, err)
 
 		_, err = handler.RefreshToken(context.Background(), &api.AuthServiceRefreshTokenRequest{
@@ -358,7 +370,7 @@ func TestAuthHandler_RefreshToken(t *testing.T) {
 
 	t.Run("successful refresh", func(t *testing.T) {
 		// Generate valid token pair
-		_, refreshToken, err := jwtService.GenerateTokenPair("testuser")
+		_, refreshToken, err := jwtService.GenerateTokenPair(tenant.DefaultID, "testuser")
 		require.NoError(t, err)
 
 		resp, err := handler.RefreshToken(context.Background(), &api.AuthServiceRefreshTokenRequest{
@@ -374,6 +386,34 @@ func TestAuthHandler_RefreshToken(t *testing.T) {
 		claims, err := jwtService.ValidateToken(resp.AccessToken)
 		require.NoError(t, err)
 		assert.Equal(t, "testuser", claims.Username)
+		assert.Equal(t, auth.DefaultUserScopes(), claims.Scopes)
+	})
+
+	t.Run("refresh applies the stored scopes", func(t *testing.T) {
+		_, refreshToken, err := jwtService.GenerateTokenPair(tenant.DefaultID, "operator")
+		require.NoError(t, err)
+
+		resp, err := handler.RefreshToken(context.Background(), &api.AuthServiceRefreshTokenRequest{
+			RefreshToken: refreshToken,
+		})
+		require.NoError(t, err)
+
+		claims, err := jwtService.ValidateToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, []auth.Scope{auth.ScopeAdmin}, claims.Scopes)
+	})
+
+	t.Run("deleted user", func(t *testing.T) {
+		_, refreshToken, err := jwtService.GenerateTokenPair(tenant.DefaultID, "deleted")
+		require.NoError(t, err)
+
+		_, err = handler.RefreshToken(context.Background(), &api.AuthServiceRefreshTokenRequest{
+			RefreshToken: refreshToken,
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
 	})
 }
 
@@ -384,7 +424,7 @@ func TestAuthHandler_Logout_WithRevocation(t *testing.T) {
 	handler := NewAuthHandler(mockStore, jwtService, 15*time.Minute, revoker).(*authHandler)
 
 	// Generate a token and extract its JTI
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 	claims, err := jwtService.ValidateToken(token)
 	require.NoError(t, err)
@@ -407,7 +447,7 @@ func TestAuthHandler_Logout_RevokerError(t *testing.T) {
 	revoker := &testRevoker{revoked: make(map[string]bool), returnErr: errors.New("redis down")}
 	handler := NewAuthHandler(mockStore, jwtService, 15*time.Minute, revoker).(*authHandler)
 
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 	claims, err := jwtService.ValidateToken(token)
 	require.NoError(t, err)
@@ -419,13 +459,22 @@ func TestAuthHandler_Logout_RevokerError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// refreshTestStore returns a store holding every user refreshing tokens.
+func refreshTestStore() *mock.MockStore {
+	return &mock.MockStore{
+		GetUserFn: func(_ context.Context, userIDs *api.UserIDs) (*api.User, error) {
+			return &api.User{Ids: userIDs}, nil
+		},
+	}
+}
+
 func TestAuthHandler_RefreshToken_RevokesOldToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	mockStore := &mock.MockStore{}
+	mockStore := refreshTestStore()
 	revoker := newTestRevoker()
 	handler := NewAuthHandler(mockStore, jwtService, 15*time.Minute, revoker).(*authHandler)
 
-	_, refreshToken, err := jwtService.GenerateTokenPair("testuser")
+	_, refreshToken, err := jwtService.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 	refreshClaims, err := jwtService.ValidateRefreshToken(refreshToken)
 	require.NoError(t, err)
@@ -449,11 +498,11 @@ func TestAuthHandler_RefreshToken_RevokesOldToken(t *testing.T) {
 
 func TestAuthHandler_RefreshToken_AlreadyRevoked(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	mockStore := &mock.MockStore{}
+	mockStore := refreshTestStore()
 	revoker := newTestRevoker()
 	handler := NewAuthHandler(mockStore, jwtService, 15*time.Minute, revoker).(*authHandler)
 
-	_, refreshToken, err := jwtService.GenerateTokenPair("testuser")
+	_, refreshToken, err := jwtService.GenerateTokenPair(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 	refreshClaims, err := jwtService.ValidateRefreshToken(refreshToken)
 	require.NoError(t, err)
@@ -5,16 +5,19 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/nicholaspcr/GoDE/internal/executor"
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
 	"github.com/nicholaspcr/GoDE/pkg/validation"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // RunAsync submits a DE execution to run in the background.
@@ -42,16 +45,22 @@ func (deh *deHandler) RunAsync(
 		return nil, err
 	}
 
+	problem, config, err := deh.resolveCustomProblem(ctx, userID, req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	// Validate DE configuration and variant-specific constraints
-	if err := validation.ValidateRunAsyncRequest(req.Algorithm, req.Variant, req.Problem, req.DeConfig); err != nil {
+	if err := validation.ValidateRunAsyncRequest(req.Algorithm, req.Variant, problem, config); err != nil {
 		span.RecordError(err)
 		return nil, ValidationErrorToStatus(err)
 	}
 
 	span.SetAttributes(
-		attribute.Int64("executions", req.DeConfig.Executions),
-		attribute.Int64("generations", req.DeConfig.Generations),
-		attribute.Int64("population_size", req.DeConfig.PopulationSize),
+		attribute.Int64("executions", config.Executions),
+		attribute.Int64("generations", config.Generations),
+		attribute.Int64("population_size", config.PopulationSize),
 	)
 
 	// Validate algorithm is supported
@@ -61,6 +70,16 @@ func (deh *deHandler) RunAsync(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if req.ExperimentId != "" {
+		if _, err := deh.Store.GetExperiment(ctx, req.ExperimentId, userID); err != nil {
+			if errors.Is(err, storeerrors.ErrExperimentNotFound) {
+				return nil, status.Error(codes.NotFound, "experiment not found")
+			}
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to get experiment")
+		}
+	}
+
 	// Check idempotency: return existing execution if key was already seen
 	if req.IdempotencyKey != "" {
 		existingID, iErr := deh.Store.GetExecutionByIdempotencyKey(ctx, userID, req.IdempotencyKey)
@@ -75,10 +94,21 @@ func (deh *deHandler) RunAsync(
 		}
 	}
 
+	if req.SaveCustomProblem {
+		if err := deh.Store.SaveCustomProblem(ctx, userID, config.CustomProblem); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to save custom problem")
+		}
+	}
+
 	// Submit execution with algorithm, problem, and variant names
-	executionID, err := deh.executor.SubmitExecution(ctx, userID, req.Algorithm, req.Problem, req.Variant, req.DeConfig, req.IdempotencyKey, req.MaxExecutionSeconds)
+	executionID, err := deh.executor.SubmitExecution(ctx, userID, req.Algorithm, problem, req.Variant, config, req.IdempotencyKey, req.MaxExecutionSeconds,
+		executor.WithExperimentID(req.ExperimentId))
 	if err != nil {
 		span.RecordError(err)
+		if errors.Is(err, executor.ErrQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to submit execution")
 	}
 
@@ -88,3 +118,45 @@ func (deh *deHandler) RunAsync(
 		ExecutionId: executionID,
 	}, nil
 }
+
+// resolveCustomProblem returns the problem name and the configuration of the
+// execution. The custom problem recorded on the configuration is the one
+// given in the request or, for names that are not built-in problems, the
+// one the user saved under that name.
+func (deh *deHandler) resolveCustomProblem(
+	ctx context.Context, userID string, req *api.RunAsyncRequest,
+) (string, *api.DEConfig, error) {
+	if req.SaveCustomProblem && req.CustomProblem == nil {
+		return "", nil, status.Error(codes.InvalidArgument, "save_custom_problem requires a custom_problem")
+	}
+	if req.DeConfig == nil {
+		return req.Problem, nil, nil
+	}
+
+	config := proto.Clone(req.DeConfig).(*api.DEConfig)
+	config.CustomProblem = nil
+
+	if cp := req.CustomProblem; cp != nil {
+		if req.Problem != "" && req.Problem != cp.Name {
+			return "", nil, status.Errorf(codes.InvalidArgument,
+				"problem %s does not match custom problem %s", req.Problem, cp.Name)
+		}
+		config.CustomProblem = proto.Clone(cp).(*api.CustomProblem)
+		return cp.Name, config, nil
+	}
+
+	if _, ok := problems.DefaultRegistry.Get(req.Problem); ok || req.Problem == "" {
+		return req.Problem, config, nil
+	}
+
+	cp, err := deh.Store.GetCustomProblem(ctx, userID, req.Problem)
+	switch {
+	case errors.Is(err, storeerrors.ErrCustomProblemNotFound):
+		// Validation reports the unsupported problem
+	case err != nil:
+		return "", nil, status.Error(codes.Internal, "failed to get custom problem")
+	default:
+		config.CustomProblem = cp
+	}
+	return req.Problem, config, nil
+}
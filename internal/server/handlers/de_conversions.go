@@ -5,6 +5,7 @@ import (
 
 	"github.com/nicholaspcr/GoDE/internal/store"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -48,16 +49,18 @@ func convertAPIStatusToStore(status api.ExecutionStatus) store.ExecutionStatus {
 // executionToProto converts store.Execution to api.Execution.
 func executionToProto(exec *store.Execution) *api.Execution {
 	apiExec := &api.Execution{
-		Id:        exec.ID,
-		UserId:    exec.UserID,
-		Status:    convertExecutionStatus(exec.Status),
-		Config:    exec.Config,
-		Algorithm: exec.Algorithm,
-		Variant:   exec.Variant,
-		Problem:   exec.Problem,
-		CreatedAt: timestampProto(exec.CreatedAt),
-		UpdatedAt: timestampProto(exec.UpdatedAt),
-		Error:     exec.Error,
+		Id:           exec.ID,
+		UserId:       exec.UserID,
+		Status:       convertExecutionStatus(exec.Status),
+		Config:       exec.Config,
+		Algorithm:    exec.Algorithm,
+		Variant:      exec.Variant,
+		Problem:      exec.Problem,
+		CreatedAt:    timestampProto(exec.CreatedAt),
+		UpdatedAt:    timestampProto(exec.UpdatedAt),
+		Error:        exec.Error,
+		Seed:         exec.Seed,
+		ExperimentId: exec.ExperimentID,
 	}
 
 	if exec.CompletedAt != nil {
@@ -99,3 +102,20 @@ func vectorFromPB(v *api.Vector) models.Vector {
 	copy(vec.Objectives, v.Objectives)
 	return vec
 }
+
+// indicatorsToPB converts quality indicator values to their protobuf form.
+func indicatorsToPB(v *indicators.Values) *api.Indicators {
+	if v == nil {
+		return nil
+	}
+	return &api.Indicators{
+		Hypervolume:          v.Hypervolume,
+		ReferencePoint:       v.ReferencePoint,
+		HypervolumeEstimated: v.HypervolumeEstimated,
+		Igd:                  v.IGD,
+		IgdPlus:              v.IGDPlus,
+		Gd:                   v.GD,
+		Spread:               v.Spread,
+		Spacing:              v.Spacing,
+	}
+}
@@ -3,10 +3,18 @@ package handlers
 import (
 	"context"
 
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
 	"github.com/nicholaspcr/GoDE/pkg/de"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/nicholaspcr/GoDE/pkg/validation"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -34,7 +42,39 @@ func (deh *deHandler) ListSupportedVariants(
 	return &api.ListSupportedVariantsResponse{Variants: apiVariants}, nil
 }
 
-// ListSupportedProblems returns the list of supported optimization problems.
+// ListSupportedCrossovers returns the list of supported crossover operators.
+func (deh *deHandler) ListSupportedCrossovers(
+	ctx context.Context, _ *emptypb.Empty,
+) (*api.ListSupportedCrossoversResponse, error) {
+	metas := crossover.DefaultRegistry.ListMetadata()
+	apiCrossovers := make([]*api.Crossover, len(metas))
+	for i, meta := range metas {
+		apiCrossovers[i] = &api.Crossover{
+			Name:        meta.Name,
+			Description: meta.Description,
+		}
+	}
+	return &api.ListSupportedCrossoversResponse{Crossovers: apiCrossovers}, nil
+}
+
+// ListSupportedRepairs returns the list of supported boundary repair
+// strategies.
+func (deh *deHandler) ListSupportedRepairs(
+	ctx context.Context, _ *emptypb.Empty,
+) (*api.ListSupportedRepairsResponse, error) {
+	metas := repair.DefaultRegistry.ListMetadata()
+	apiRepairs := make([]*api.Repair, len(metas))
+	for i, meta := range metas {
+		apiRepairs[i] = &api.Repair{
+			Name:        meta.Name,
+			Description: meta.Description,
+		}
+	}
+	return &api.ListSupportedRepairsResponse{Repairs: apiRepairs}, nil
+}
+
+// ListSupportedProblems returns the list of supported optimization problems,
+// followed by the custom problems saved by the user.
 func (deh *deHandler) ListSupportedProblems(
 	ctx context.Context, _ *emptypb.Empty,
 ) (*api.ListSupportedProblemsResponse, error) {
@@ -46,5 +86,82 @@ func (deh *deHandler) ListSupportedProblems(
 			Description: meta.Description,
 		}
 	}
+
+	if userID := middleware.UsernameFromContext(ctx); userID != "" {
+		saved, err := deh.Store.ListCustomProblems(ctx, userID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to list custom problems")
+		}
+		for _, cp := range saved {
+			apiProblems = append(apiProblems, &api.Problem{
+				Name:          cp.Name,
+				Description:   cp.Description,
+				CustomProblem: cp,
+			})
+		}
+	}
 	return &api.ListSupportedProblemsResponse{Problems: apiProblems}, nil
 }
+
+// defaultReferenceFrontPoints is the size of the reference front returned
+// when the request does not specify one.
+const defaultReferenceFrontPoints = 100
+
+// GetReferenceFront returns a sampled reference Pareto front for a problem.
+func (deh *deHandler) GetReferenceFront(
+	ctx context.Context, req *api.GetReferenceFrontRequest,
+) (*api.GetReferenceFrontResponse, error) {
+	tracer := otel.Tracer("handlers.de")
+	_, span := tracer.Start(ctx, "deHandler.GetReferenceFront")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("problem", req.Problem))
+
+	meta, ok := problems.DefaultRegistry.Get(req.Problem)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown problem: %s", req.Problem)
+	}
+
+	objectives := req.ObjectivesSize
+	if objectives == 0 {
+		objectives = 2
+		if meta.NumObjs > 0 {
+			objectives = int64(meta.NumObjs)
+		}
+	}
+	points := req.Points
+	if points == 0 {
+		points = defaultReferenceFrontPoints
+	}
+
+	if err := validation.ValidateReferenceFrontRequest(req.Problem, objectives, points); err != nil {
+		span.RecordError(err)
+		return nil, ValidationErrorToStatus(err)
+	}
+	if meta.NumObjs > 0 && objectives != int64(meta.NumObjs) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"problem %s has %d objectives, got %d", req.Problem, meta.NumObjs, objectives)
+	}
+
+	// The front does not depend on the dimensions, any size the factory
+	// accepts will do (WFG needs 2(M-1) position parameters plus distance ones)
+	dim := min(max(meta.MinDim, 2*int(objectives)), meta.MaxDim)
+	problem, err := problems.DefaultRegistry.Create(req.Problem, dim, int(objectives))
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create problem")
+	}
+
+	front, ok := problems.ReferenceFront(problem, int(points), int(objectives))
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "problem %s has no known reference front", req.Problem)
+	}
+
+	span.SetAttributes(attribute.Int("front_size", len(front)))
+
+	vectors := make([]*api.Vector, len(front))
+	for i, v := range front {
+		vectors[i] = vectorToPB(v)
+	}
+	return &api.GetReferenceFrontResponse{Vectors: vectors}, nil
+}
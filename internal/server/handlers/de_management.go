@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 
+	"github.com/nicholaspcr/GoDE/internal/executor"
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/internal/store"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/validation"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
@@ -46,7 +48,10 @@ func (deh *deHandler) ListExecutions(
 	}
 
 	// List executions with pagination
-	executions, totalCount, err := deh.Store.ListExecutions(ctx, userID, statusFilter, limit, offset)
+	executions, totalCount, err := deh.Store.ListExecutions(ctx, userID, store.ExecutionFilter{
+		Status:       statusFilter,
+		ExperimentID: req.ExperimentId,
+	}, limit, offset)
 	if err != nil {
 		span.RecordError(err)
 		return nil, status.Error(codes.Internal, "failed to list executions")
@@ -103,6 +108,52 @@ func (deh *deHandler) CancelExecution(
 	return &emptypb.Empty{}, nil
 }
 
+// ResumeExecution continues an execution from its last checkpoint.
+func (deh *deHandler) ResumeExecution(
+	ctx context.Context, req *api.ResumeExecutionRequest,
+) (*emptypb.Empty, error) {
+	tracer := otel.Tracer("handlers.de")
+	ctx, span := tracer.Start(ctx, "deHandler.ResumeExecution")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("execution_id", req.ExecutionId),
+		attribute.Int64("generations", req.Generations),
+	)
+
+	userID, err := usernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization - requires de:run scope to resume executions
+	if err := middleware.RequireScope(ctx, auth.ScopeDERun); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := validation.ValidateResumeExecutionRequest(req.ExecutionId, req.Generations); err != nil {
+		span.RecordError(err)
+		return nil, ValidationErrorToStatus(err)
+	}
+
+	// Resume execution
+	if err := deh.executor.ResumeExecution(ctx, req.ExecutionId, userID, req.Generations); err != nil {
+		switch {
+		case errors.Is(err, store.ErrExecutionNotFound):
+			return nil, status.Error(codes.NotFound, "execution not found")
+		case errors.Is(err, executor.ErrNotResumable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, executor.ErrQuotaExceeded):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to resume execution")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // DeleteExecution deletes an execution and its results.
 func (deh *deHandler) DeleteExecution(
 	ctx context.Context, req *api.DeleteExecutionRequest,
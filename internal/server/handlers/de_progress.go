@@ -129,6 +129,7 @@ func (deh *deHandler) streamProgressToClient(
 				CompletedExecutions: progress.CompletedExecutions,
 				TotalExecutions:     progress.TotalExecutions,
 				PartialPareto:       progress.PartialPareto,
+				ControlParameters:   progress.ControlParameters,
 			}
 
 			if err := stream.Send(apiProgress); err != nil {
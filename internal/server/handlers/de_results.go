@@ -60,6 +60,7 @@ func (deh *deHandler) GetExecutionStatus(
 				CompletedExecutions: progress.CompletedExecutions,
 				TotalExecutions:     progress.TotalExecutions,
 				PartialPareto:       progress.PartialPareto,
+				ControlParameters:   progress.ControlParameters,
 			}
 		}
 	}
@@ -129,10 +130,15 @@ func (deh *deHandler) GetExecutionResults(
 		}
 	}
 
-	return &api.GetExecutionResultsResponse{
+	resp := &api.GetExecutionResultsResponse{
 		Pareto: &api.Pareto{
 			Vectors: paretoSet.Vectors,
 			MaxObjs: flatMaxObjs,
 		},
-	}, nil
+		Indicators: indicatorsToPB(paretoSet.Indicators),
+	}
+	if len(paretoSet.ParetoArchive) > 0 {
+		resp.ParetoArchive = &api.Pareto{Vectors: paretoSet.ParetoArchive}
+	}
+	return resp, nil
 }
@@ -7,13 +7,16 @@ import (
 	"github.com/nicholaspcr/GoDE/internal/executor"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	_ "github.com/nicholaspcr/GoDE/pkg/de/gde3"                    // Register GDE3 algorithm
+	_ "github.com/nicholaspcr/GoDE/pkg/de/moead"                   // Register MOEA/D algorithm
+	_ "github.com/nicholaspcr/GoDE/pkg/de/nsga2"                   // Register NSGA-II algorithm
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz"         // Register DTLZ problems
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/wfg"          // Register WFG problems
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/multi"             // Register multi-objective problems
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/best"              // Register best variants
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best"   // Register current-to-best variant
-	_ "github.com/nicholaspcr/GoDE/pkg/variants/pbest"             // Register pbest variant
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/pbest"             // Register pbest variants
 	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand"              // Register rand variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand-to-best"      // Register rand-to-best variant
 	"google.golang.org/grpc"
 )
 
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -10,13 +13,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/nicholaspcr/GoDE/internal/executor"
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/internal/store"
 	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz" // Register DTLZ problems
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/wfg"  // Register WFG problems
@@ -37,6 +44,11 @@ type testStore struct {
 	paretoSets      map[uint64]*store.ParetoSet
 	cancelledExecs  map[string]bool // Track cancellation requests
 	idempotencyKeys map[string]string // userID:key → executionID
+	jobs            map[string]*store.Job
+	jobOrder        []string
+	checkpoints     map[string]map[int]*store.Checkpoint
+	customProblems  map[string]map[string]*api.CustomProblem // userID → name → problem
+	experiments     map[string]*store.Experiment
 	nextID          uint64
 	mu              sync.RWMutex
 }
@@ -48,6 +60,10 @@ func newTestStore() *testStore {
 		paretoSets:      make(map[uint64]*store.ParetoSet),
 		cancelledExecs:  make(map[string]bool),
 		idempotencyKeys: make(map[string]string),
+		jobs:            make(map[string]*store.Job),
+		checkpoints:     make(map[string]map[int]*store.Checkpoint),
+		customProblems:  make(map[string]map[string]*api.CustomProblem),
+		experiments:     make(map[string]*store.Experiment),
 		nextID:          1,
 	}
 }
@@ -85,6 +101,12 @@ func deepCopyProgress(src *store.ExecutionProgress) *store.ExecutionProgress {
 		TotalExecutions:     src.TotalExecutions,
 		UpdatedAt:           src.UpdatedAt,
 	}
+	if src.ControlParameters != nil {
+		dst.ControlParameters = &api.ControlParameters{
+			MeanF:  src.ControlParameters.MeanF,
+			MeanCr: src.ControlParameters.MeanCr,
+		}
+	}
 	if src.PartialPareto != nil {
 		dst.PartialPareto = make([]*api.Vector, len(src.PartialPareto))
 		for i, vec := range src.PartialPareto {
@@ -99,12 +121,19 @@ func deepCopyExecution(src *store.Execution) *store.Execution {
 		return nil
 	}
 	dst := &store.Execution{
-		ID:        src.ID,
-		UserID:    src.UserID,
-		Status:    src.Status,
-		Error:     src.Error,
-		CreatedAt: src.CreatedAt,
-		UpdatedAt: src.UpdatedAt,
+		ID:                  src.ID,
+		UserID:              src.UserID,
+		Status:              src.Status,
+		Error:               src.Error,
+		Algorithm:           src.Algorithm,
+		Variant:             src.Variant,
+		Problem:             src.Problem,
+		IdempotencyKey:      src.IdempotencyKey,
+		MaxExecutionSeconds: src.MaxExecutionSeconds,
+		Seed:                src.Seed,
+		ExperimentID:        src.ExperimentID,
+		CreatedAt:           src.CreatedAt,
+		UpdatedAt:           src.UpdatedAt,
 	}
 	if src.ParetoID != nil {
 		paretoID := *src.ParetoID
@@ -171,14 +200,42 @@ func (ts *testStore) UpdateExecutionResult(ctx context.Context, executionID stri
 	return nil
 }
 
-func (ts *testStore) ListExecutions(ctx context.Context, userID string, statusFilter *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (ts *testStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	exec, exists := ts.executions[executionID]
+	if !exists {
+		return store.ErrExecutionNotFound
+	}
+	updated := deepCopyExecution(exec)
+	updated.Status = store.ExecutionStatusPending
+	updated.Error = ""
+	updated.Config = config
+	updated.CompletedAt = nil
+	updated.UpdatedAt = time.Now()
+	ts.executions[executionID] = updated
+	return nil
+}
+
+func (ts *testStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return ts.listExecutions(userID, false, filter, limit, offset)
+}
+
+func (ts *testStore) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return ts.listExecutions(userID, userID == "", filter, limit, offset)
+}
+
+// listExecutions pages the executions of the user, or of every user when
+// allUsers is set, newest first.
+func (ts *testStore) listExecutions(userID string, allUsers bool, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	ts.mu.RLock()
 	defer ts.mu.RUnlock()
 
 	var allMatching []*store.Execution
 	for _, exec := range ts.executions {
-		if exec.UserID == userID {
-			if statusFilter == nil || exec.Status == *statusFilter {
+		if allUsers || exec.UserID == userID {
+			if (filter.Status == nil || exec.Status == *filter.Status) &&
+				(filter.ExperimentID == "" || exec.ExperimentID == filter.ExperimentID) {
 				allMatching = append(allMatching, deepCopyExecution(exec))
 			}
 		}
@@ -193,6 +250,9 @@ func (ts *testStore) ListExecutions(ctx context.Context, userID string, statusFi
 	}
 
 	totalCount := len(allMatching)
+	slices.SortFunc(allMatching, func(a, b *store.Execution) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
 
 	// Apply pagination
 	start := offset
@@ -204,6 +264,59 @@ func (ts *testStore) ListExecutions(ctx context.Context, userID string, statusFi
 	return allMatching[start:end], totalCount, nil
 }
 
+func (ts *testStore) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	exec, exists := ts.executions[executionID]
+	if !exists {
+		return nil, store.ErrExecutionNotFound
+	}
+	return deepCopyExecution(exec), nil
+}
+
+func (ts *testStore) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	var purged []string
+	for id, exec := range ts.executions {
+		finished := exec.Status == store.ExecutionStatusCompleted ||
+			exec.Status == store.ExecutionStatusFailed ||
+			exec.Status == store.ExecutionStatusCancelled
+		if finished && exec.UpdatedAt.Before(before) {
+			if exec.ParetoID != nil {
+				delete(ts.paretoSets, *exec.ParetoID)
+			}
+			delete(ts.executions, id)
+			delete(ts.checkpoints, id)
+			delete(ts.jobs, id)
+			purged = append(purged, id)
+		}
+	}
+	return purged, nil
+}
+
+func (ts *testStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error { return nil }
+
+func (ts *testStore) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	return nil, nil
+}
+
+func (ts *testStore) DeleteAPIKey(ctx context.Context, keyID, userID string) error {
+	return store.ErrAPIKeyNotFound
+}
+
+func (ts *testStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	return nil, store.ErrAPIKeyNotFound
+}
+
+func (ts *testStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	return nil
+}
+
+func (ts *testStore) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	return nil, store.ErrIdentityNotFound
+}
+
 func (ts *testStore) DeleteExecution(ctx context.Context, executionID, userID string) error {
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
@@ -298,6 +411,9 @@ func (ts *testStore) UpdateUser(ctx context.Context, user *api.User, fields ...s
 	return nil
 }
 func (ts *testStore) DeleteUser(ctx context.Context, ids *api.UserIDs) error     { return nil }
+func (ts *testStore) ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error) {
+	return nil, 0, nil
+}
 func (ts *testStore) CreatePareto(ctx context.Context, pareto *api.Pareto) error { return nil }
 func (ts *testStore) GetPareto(ctx context.Context, ids *api.ParetoIDs) (*api.Pareto, error) {
 	return nil, nil
@@ -326,6 +442,246 @@ func (ts *testStore) setIdempotencyKey(userID, idempotencyKey, executionID strin
 	ts.idempotencyKeys[userID+":"+idempotencyKey] = executionID
 }
 
+func (ts *testStore) EnqueueJob(ctx context.Context, job *store.Job) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	queued := *job
+	queued.Status = store.JobStatusQueued
+	if _, exists := ts.jobs[job.ExecutionID]; !exists {
+		ts.jobOrder = append(ts.jobOrder, job.ExecutionID)
+	}
+	ts.jobs[job.ExecutionID] = &queued
+	return nil
+}
+
+func (ts *testStore) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for _, id := range ts.jobOrder {
+		job := ts.jobs[id]
+		if job.Status != store.JobStatusQueued || slices.Contains(skipTenants, job.TenantID) {
+			continue
+		}
+		now := time.Now()
+		job.Status = store.JobStatusClaimed
+		job.Attempts++
+		job.WorkerID = workerID
+		job.ClaimedAt = &now
+		job.HeartbeatAt = &now
+		claimed := *job
+		return &claimed, nil
+	}
+	return nil, store.ErrNoJobAvailable
+}
+
+func (ts *testStore) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	job, exists := ts.jobs[executionID]
+	if !exists || job.Status != store.JobStatusClaimed || job.WorkerID != workerID {
+		return store.ErrJobNotFound
+	}
+	now := time.Now()
+	job.HeartbeatAt = &now
+	return nil
+}
+
+func (ts *testStore) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	return ts.setJobStatus(executionID, workerID, store.JobStatusQueued)
+}
+
+func (ts *testStore) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	return ts.setJobStatus(executionID, workerID, store.JobStatusDone)
+}
+
+func (ts *testStore) setJobStatus(executionID, workerID string, status store.JobStatus) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	job, exists := ts.jobs[executionID]
+	if !exists {
+		return store.ErrJobNotFound
+	}
+	if workerID == "" && job.Status != store.JobStatusQueued {
+		return store.ErrJobNotFound
+	}
+	if workerID != "" && (job.Status != store.JobStatusClaimed || job.WorkerID != workerID) {
+		return store.ErrJobNotFound
+	}
+	job.Status = status
+	return nil
+}
+
+func (ts *testStore) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var jobs []*store.Job
+	for _, id := range ts.jobOrder {
+		if job := ts.jobs[id]; job.Status == status {
+			listed := *job
+			jobs = append(jobs, &listed)
+		}
+	}
+	return jobs, nil
+}
+
+func (ts *testStore) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	count := 0
+	for _, job := range ts.jobs {
+		if cmp.Or(job.TenantID, tenant.DefaultID) == cmp.Or(tenantID, tenant.DefaultID) && slices.Contains(statuses, job.Status) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (ts *testStore) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.checkpoints[checkpoint.ExecutionID] == nil {
+		ts.checkpoints[checkpoint.ExecutionID] = make(map[int]*store.Checkpoint)
+	}
+	saved := *checkpoint
+	ts.checkpoints[checkpoint.ExecutionID][checkpoint.ExecutionNumber] = &saved
+	return nil
+}
+
+func (ts *testStore) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	checkpoints := make([]*store.Checkpoint, 0, len(ts.checkpoints[executionID]))
+	for _, checkpoint := range ts.checkpoints[executionID] {
+		listed := *checkpoint
+		checkpoints = append(checkpoints, &listed)
+	}
+	slices.SortFunc(checkpoints, func(a, b *store.Checkpoint) int {
+		return a.ExecutionNumber - b.ExecutionNumber
+	})
+	return checkpoints, nil
+}
+
+func (ts *testStore) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.customProblems[userID] == nil {
+		ts.customProblems[userID] = make(map[string]*api.CustomProblem)
+	}
+	ts.customProblems[userID][problem.Name] = proto.Clone(problem).(*api.CustomProblem)
+	return nil
+}
+
+func (ts *testStore) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	problem, ok := ts.customProblems[userID][name]
+	if !ok {
+		return nil, store.ErrCustomProblemNotFound
+	}
+	return proto.Clone(problem).(*api.CustomProblem), nil
+}
+
+func (ts *testStore) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	problems := make([]*api.CustomProblem, 0, len(ts.customProblems[userID]))
+	for _, problem := range ts.customProblems[userID] {
+		problems = append(problems, proto.Clone(problem).(*api.CustomProblem))
+	}
+	slices.SortFunc(problems, func(a, b *api.CustomProblem) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return problems, nil
+}
+
+func (ts *testStore) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	saved := *experiment
+	saved.Tags = slices.Clone(experiment.Tags)
+	ts.experiments[experiment.ID] = &saved
+	return nil
+}
+
+func (ts *testStore) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	experiment, exists := ts.experiments[experimentID]
+	if !exists || experiment.UserID != userID {
+		return nil, store.ErrExperimentNotFound
+	}
+	found := *experiment
+	return &found, nil
+}
+
+func (ts *testStore) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var experiments []*store.Experiment
+	for _, experiment := range ts.experiments {
+		if experiment.UserID == userID {
+			listed := *experiment
+			experiments = append(experiments, &listed)
+		}
+	}
+	slices.SortFunc(experiments, func(a, b *store.Experiment) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	total := len(experiments)
+	start := min(offset, total)
+	return experiments[start:min(start+limit, total)], total, nil
+}
+
+func (ts *testStore) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	experiment, exists := ts.experiments[experimentID]
+	if !exists || experiment.UserID != userID {
+		return store.ErrExperimentNotFound
+	}
+	delete(ts.experiments, experimentID)
+	return nil
+}
+
+func (ts *testStore) CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	counts := make(map[string]store.ExecutionCounts, len(experimentIDs))
+	for _, id := range experimentIDs {
+		counts[id] = store.ExecutionCounts{}
+	}
+	for _, exec := range ts.executions {
+		if c, ok := counts[exec.ExperimentID]; ok && exec.UserID == userID {
+			c[exec.Status]++
+		}
+	}
+	return counts, nil
+}
+
+func (ts *testStore) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var results []*store.ExperimentResult
+	for _, exec := range ts.executions {
+		if exec.UserID != userID || exec.ExperimentID != experimentID || exec.Status != store.ExecutionStatusCompleted {
+			continue
+		}
+		result := &store.ExperimentResult{
+			ExecutionID: exec.ID,
+			Algorithm:   exec.Algorithm,
+			Variant:     exec.Variant,
+			Problem:     exec.Problem,
+		}
+		if exec.ParetoID != nil {
+			if ps, ok := ts.paretoSets[*exec.ParetoID]; ok {
+				result.Indicators = ps.Indicators
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 func (ts *testStore) HealthCheck(ctx context.Context) error { return nil }
 
 func setupTestHandler() (*deHandler, *testStore) {
@@ -340,11 +696,12 @@ func setupTestHandler() (*deHandler, *testStore) {
 	})
 
 	// Register test problem and variant
-	prob, _ := problems.DefaultRegistry.Create("zdt1", 10, 2)
-	exec.RegisterProblem("zdt1", prob)
+	factory, _ := problems.DefaultRegistry.GetFactory("zdt1")
+	exec.RegisterProblem("zdt1", factory)
 
 	variant, _ := variants.DefaultRegistry.Create("rand1")
 	exec.RegisterVariant("rand1", variant)
+	exec.Start()
 
 	handler := NewDEHandler(ts, exec).(*deHandler)
 
@@ -394,6 +751,53 @@ func TestRunAsync_Success(t *testing.T) {
 	assert.NotEmpty(t, resp.ExecutionId)
 }
 
+func TestRunAsync_Experiment(t *testing.T) {
+	handler, ts := setupTestHandler()
+
+	ctx := authContext("testuser")
+	require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{ID: "exp-1", UserID: "testuser", Name: "variants"}))
+	require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{ID: "exp-other", UserID: "otheruser", Name: "other"}))
+
+	req := customRunRequest()
+	req.ExperimentId = "exp-1"
+	resp, err := handler.RunAsync(ctx, req)
+	require.NoError(t, err)
+
+	execution, err := ts.GetExecution(ctx, resp.ExecutionId, "testuser")
+	require.NoError(t, err)
+	assert.Equal(t, "exp-1", execution.ExperimentID)
+
+	for _, experimentID := range []string{"missing", "exp-other"} {
+		req.ExperimentId = experimentID
+		_, err = handler.RunAsync(ctx, req)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code(), experimentID)
+	}
+}
+
+func TestRunAsync_QuotaExceeded(t *testing.T) {
+	ts := newTestStore()
+	// Not started, so the submitted execution stays queued
+	exec := executor.New(executor.Config{
+		Store:              ts,
+		MaxWorkers:         2,
+		DefaultTenantQuota: executor.TenantQuota{MaxExecutions: 1},
+	})
+	variant, _ := variants.DefaultRegistry.Create("rand1")
+	exec.RegisterVariant("rand1", variant)
+	handler := NewDEHandler(ts, exec).(*deHandler)
+
+	ctx := authContext("testuser")
+	_, err := handler.RunAsync(ctx, customRunRequest())
+	require.NoError(t, err)
+
+	_, err = handler.RunAsync(ctx, customRunRequest())
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+}
+
 func TestRunAsync_UnauthenticatedUser(t *testing.T) {
 	handler, _ := setupTestHandler()
 
@@ -435,6 +839,126 @@ func TestRunAsync_ValidationError(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, st.Code())
 }
 
+// customRunRequest returns a request running a two objective custom problem.
+func customRunRequest() *api.RunAsyncRequest {
+	return &api.RunAsyncRequest{
+		Algorithm: "gde3",
+		Variant:   "rand1",
+		CustomProblem: &api.CustomProblem{
+			Name:        "shifted-sphere",
+			Description: "Two shifted spheres",
+			Objectives:  []string{"x[0]^2 + x[1]^2", "(x[0]-1)^2 + x[1]^2"},
+			Constraints: []string{"x[0] + x[1] - 1.5"},
+		},
+		DeConfig: &api.DEConfig{
+			Executions:     1,
+			Generations:    2,
+			PopulationSize: 10,
+			DimensionsSize: 2,
+			ObjectivesSize: 2,
+			FloorLimiter:   -1.0,
+			CeilLimiter:    2.0,
+		},
+	}
+}
+
+func TestRunAsync_CustomProblem(t *testing.T) {
+	ctx := authContext("testuser")
+
+	t.Run("runs an inline custom problem", func(t *testing.T) {
+		handler, ts := setupTestHandler()
+
+		resp, err := handler.RunAsync(ctx, customRunRequest())
+		require.NoError(t, err)
+
+		execution, err := ts.GetExecution(ctx, resp.ExecutionId, "testuser")
+		require.NoError(t, err)
+		assert.Equal(t, "shifted-sphere", execution.Problem)
+		assert.True(t, proto.Equal(customRunRequest().CustomProblem, execution.Config.CustomProblem))
+
+		saved, err := ts.ListCustomProblems(ctx, "testuser")
+		require.NoError(t, err)
+		assert.Empty(t, saved, "custom problems are only saved on request")
+	})
+
+	t.Run("saves the custom problem and runs it by name", func(t *testing.T) {
+		handler, ts := setupTestHandler()
+
+		req := customRunRequest()
+		req.SaveCustomProblem = true
+		_, err := handler.RunAsync(ctx, req)
+		require.NoError(t, err)
+
+		byName := customRunRequest()
+		byName.Problem = "shifted-sphere"
+		byName.CustomProblem = nil
+		resp, err := handler.RunAsync(ctx, byName)
+		require.NoError(t, err)
+
+		execution, err := ts.GetExecution(ctx, resp.ExecutionId, "testuser")
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(customRunRequest().CustomProblem, execution.Config.CustomProblem))
+
+		listed, err := handler.ListSupportedProblems(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+		last := listed.Problems[len(listed.Problems)-1]
+		assert.Equal(t, "shifted-sphere", last.Name)
+		assert.Equal(t, "Two shifted spheres", last.Description)
+		assert.NotNil(t, last.CustomProblem)
+
+		// Other users do not see it
+		_, err = handler.RunAsync(authContext("otheruser"), byName)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ignores custom problems set on the config", func(t *testing.T) {
+		handler, ts := setupTestHandler()
+
+		req := customRunRequest()
+		req.Problem = "zdt1"
+		req.DeConfig.CustomProblem = req.CustomProblem
+		req.DeConfig.DimensionsSize = 10
+		req.DeConfig.FloorLimiter, req.DeConfig.CeilLimiter = 0, 1
+		req.CustomProblem = nil
+		resp, err := handler.RunAsync(ctx, req)
+		require.NoError(t, err)
+
+		execution, err := ts.GetExecution(ctx, resp.ExecutionId, "testuser")
+		require.NoError(t, err)
+		assert.Equal(t, "zdt1", execution.Problem)
+		assert.Nil(t, execution.Config.CustomProblem)
+	})
+
+	invalid := []struct {
+		name   string
+		modify func(*api.RunAsyncRequest)
+	}{
+		{"invalid expression", func(r *api.RunAsyncRequest) { r.CustomProblem.Objectives[0] = "x[0] +" }},
+		{"unknown function", func(r *api.RunAsyncRequest) { r.CustomProblem.Objectives[0] = "open(x[0])" }},
+		{"built-in name", func(r *api.RunAsyncRequest) { r.CustomProblem.Name = "zdt1" }},
+		{"mismatched problem", func(r *api.RunAsyncRequest) { r.Problem = "zdt1" }},
+		{"wrong objectives", func(r *api.RunAsyncRequest) { r.DeConfig.ObjectivesSize = 3 }},
+		{"save without a problem", func(r *api.RunAsyncRequest) {
+			r.Problem, r.CustomProblem, r.SaveCustomProblem = "zdt1", nil, true
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, ts := setupTestHandler()
+
+			req := customRunRequest()
+			req.SaveCustomProblem = true
+			tt.modify(req)
+			_, err := handler.RunAsync(ctx, req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			saved, err := ts.ListCustomProblems(ctx, "testuser")
+			require.NoError(t, err)
+			assert.Empty(t, saved, "invalid custom problems are not saved")
+		})
+	}
+}
+
 func TestGetExecutionStatus_Success(t *testing.T) {
 	handler, ts := setupTestHandler()
 
@@ -565,6 +1089,30 @@ func TestListExecutions_WithStatusFilter(t *testing.T) {
 	assert.False(t, resp.HasMore)
 }
 
+func TestListExecutions_WithExperimentFilter(t *testing.T) {
+	handler, ts := setupTestHandler()
+
+	ctx := authContext("testuser")
+
+	for _, exec := range []*store.Execution{
+		{ID: "exec-1", ExperimentID: "exp-1"},
+		{ID: "exec-2", ExperimentID: "exp-2"},
+		{ID: "exec-3"},
+	} {
+		exec.UserID = "testuser"
+		exec.Status = store.ExecutionStatusCompleted
+		exec.Config = &api.DEConfig{}
+		require.NoError(t, ts.CreateExecution(ctx, exec))
+	}
+
+	resp, err := handler.ListExecutions(ctx, &api.ListExecutionsRequest{ExperimentId: "exp-1"})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 1)
+	assert.Equal(t, "exec-1", resp.Executions[0].Id)
+	assert.Equal(t, "exp-1", resp.Executions[0].ExperimentId)
+	assert.Equal(t, int32(1), resp.TotalCount)
+}
+
 func TestCancelExecution_Success(t *testing.T) {
 	handler, ts := setupTestHandler()
 
@@ -644,6 +1192,10 @@ func TestGetExecutionResults_Success(t *testing.T) {
 		MaxObjectives: []*store.MaxObjectives{
 			{Values: []float64{1.0, 2.0}},
 		},
+		Indicators: &indicators.Values{
+			Hypervolume:    0.42,
+			ReferencePoint: []float64{1.0, 1.0},
+		},
 	}
 
 	_ = ts.CreateExecution(ctx, &store.Execution{
@@ -668,6 +1220,51 @@ func TestGetExecutionResults_Success(t *testing.T) {
 	assert.Len(t, resp.Pareto.MaxObjs, 2)
 	assert.Equal(t, 1.0, resp.Pareto.MaxObjs[0])
 	assert.Equal(t, 2.0, resp.Pareto.MaxObjs[1])
+	require.NotNil(t, resp.Indicators)
+	assert.Equal(t, 0.42, resp.Indicators.Hypervolume)
+	assert.Equal(t, []float64{1.0, 1.0}, resp.Indicators.ReferencePoint)
+	assert.Nil(t, resp.Indicators.Igd)
+}
+
+func TestGetExecutionResults_ParetoArchive(t *testing.T) {
+	handler, ts := setupTestHandler()
+
+	ctx := authContext("testuser")
+
+	executionID := "test-exec-archive"
+	paretoID := uint64(1)
+
+	ts.paretoSets[paretoID] = &store.ParetoSet{
+		ID: paretoID,
+		Vectors: []*api.Vector{
+			{Elements: []float64{0.1}, Objectives: []float64{0.5, 0.6}},
+		},
+		ParetoArchive: []*api.Vector{
+			{Elements: []float64{0.1}, Objectives: []float64{0.5, 0.6}},
+			{Elements: []float64{0.2}, Objectives: []float64{0.4, 0.7}},
+		},
+		MaxObjectives: []*store.MaxObjectives{
+			{Values: []float64{1.0, 2.0}},
+		},
+	}
+
+	_ = ts.CreateExecution(ctx, &store.Execution{
+		ID:        executionID,
+		UserID:    "testuser",
+		Status:    store.ExecutionStatusCompleted,
+		Config:    &api.DEConfig{},
+		ParetoID:  &paretoID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+
+	resp, err := handler.GetExecutionResults(ctx, &api.GetExecutionResultsRequest{
+		ExecutionId: executionID,
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.Pareto.Vectors, 1)
+	require.NotNil(t, resp.ParetoArchive)
+	assert.Len(t, resp.ParetoArchive.Vectors, 2)
 }
 
 func TestGetExecutionResults_NotAuthenticated(t *testing.T) {
@@ -852,6 +1449,93 @@ func TestDeleteExecution_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
+func TestResumeExecution(t *testing.T) {
+	handler, ts := setupTestHandler()
+	ctx := authContext("testuser")
+
+	for id, executionStatus := range map[string]store.ExecutionStatus{
+		"running":   store.ExecutionStatusRunning,
+		"cancelled": store.ExecutionStatusCancelled,
+	} {
+		_ = ts.CreateExecution(ctx, &store.Execution{
+			ID:        id,
+			UserID:    "testuser",
+			Status:    executionStatus,
+			Algorithm: "gde3",
+			Problem:   "zdt1",
+			Variant:   "rand1",
+			Config: &api.DEConfig{
+				Executions:     1,
+				Generations:    2,
+				PopulationSize: 10,
+				DimensionsSize: 5,
+				ObjectivesSize: 2,
+				FloorLimiter:   0.0,
+				CeilLimiter:    1.0,
+				AlgorithmConfig: &api.DEConfig_Gde3{
+					Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1},
+				},
+			},
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *api.ResumeExecutionRequest
+		wantCode codes.Code
+	}{
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			req:      &api.ResumeExecutionRequest{ExecutionId: "cancelled"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "not found",
+			ctx:      ctx,
+			req:      &api.ResumeExecutionRequest{ExecutionId: "nonexistent"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "negative generations",
+			ctx:      ctx,
+			req:      &api.ResumeExecutionRequest{ExecutionId: "cancelled", Generations: -1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "running execution",
+			ctx:      ctx,
+			req:      &api.ResumeExecutionRequest{ExecutionId: "running"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "cancelled execution",
+			ctx:      ctx,
+			req:      &api.ResumeExecutionRequest{ExecutionId: "cancelled", Generations: 4},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.ResumeExecution(tt.ctx, tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+
+	require.Eventually(t, func() bool {
+		execution, err := ts.GetExecution(ctx, "cancelled", "testuser")
+		return err == nil && execution.Status == store.ExecutionStatusCompleted
+	}, 10*time.Second, 20*time.Millisecond, "resumed execution should complete")
+
+	execution, err := ts.GetExecution(ctx, "cancelled", "testuser")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), execution.Config.Generations)
+}
+
 func TestListExecutions_Unauthenticated(t *testing.T) {
 	handler, _ := setupTestHandler()
 
@@ -4,7 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz"       // Register DTLZ problems
@@ -23,7 +27,7 @@ func TestDEHandler_ListSupportedAlgorithms(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, []string{"gde3"}, resp.Algorithms)
+	assert.Equal(t, []string{"gde3", "moead", "nsga2"}, resp.Algorithms)
 }
 
 func TestDEHandler_ListSupportedVariants(t *testing.T) {
@@ -33,7 +37,7 @@ func TestDEHandler_ListSupportedVariants(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Len(t, resp.Variants, 6)
+	assert.Len(t, resp.Variants, 9)
 
 	// Verify we have the expected variants
 	variantNames := make(map[string]bool)
@@ -49,6 +53,37 @@ func TestDEHandler_ListSupportedVariants(t *testing.T) {
 	assert.Contains(t, variantNames, "best2")
 	assert.Contains(t, variantNames, "pbest")
 	assert.Contains(t, variantNames, "currToBest1")
+	assert.Contains(t, variantNames, "currToPBest1")
+	assert.Contains(t, variantNames, "randToBest1")
+	assert.Contains(t, variantNames, "rand1EitherOr")
+}
+
+func TestDEHandler_ListSupportedCrossovers(t *testing.T) {
+	handler, _ := setupTestHandler()
+
+	resp, err := handler.ListSupportedCrossovers(context.Background(), &emptypb.Empty{})
+
+	assert.NoError(t, err)
+	names := make([]string, len(resp.Crossovers))
+	for i, c := range resp.Crossovers {
+		names[i] = c.Name
+		assert.NotEmpty(t, c.Description)
+	}
+	assert.Equal(t, []string{"arithmetic", "binomial", "exponential"}, names)
+}
+
+func TestDEHandler_ListSupportedRepairs(t *testing.T) {
+	handler, _ := setupTestHandler()
+
+	resp, err := handler.ListSupportedRepairs(context.Background(), &emptypb.Empty{})
+
+	assert.NoError(t, err)
+	names := make([]string, len(resp.Repairs))
+	for i, r := range resp.Repairs {
+		names[i] = r.Name
+		assert.NotEmpty(t, r.Description)
+	}
+	assert.Equal(t, []string{"clamp", "midpoint", "reflect", "reinit"}, names)
 }
 
 func TestDEHandler_ListSupportedProblems(t *testing.T) {
@@ -58,7 +93,7 @@ func TestDEHandler_ListSupportedProblems(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Len(t, resp.Problems, 22) // 6 ZDT/VNT + 7 DTLZ + 9 WFG
+	assert.Len(t, resp.Problems, 27) // 6 ZDT/VNT + 3 constrained + 9 DTLZ + 9 WFG
 
 	// Verify we have the expected problem families
 	problemNames := make(map[string]bool)
@@ -84,4 +119,74 @@ func TestDEHandler_ListSupportedProblems(t *testing.T) {
 	// Check for WFG problems
 	assert.Contains(t, problemNames, "wfg1")
 	assert.Contains(t, problemNames, "wfg2")
+
+	// Check for constrained problems
+	assert.Contains(t, problemNames, "srn")
+	assert.Contains(t, problemNames, "c2dtlz2")
+}
+
+func TestDEHandler_GetReferenceFront(t *testing.T) {
+	handler, _ := setupTestHandler()
+
+	t.Run("defaults for a bi-objective problem", func(t *testing.T) {
+		resp, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem: "zdt1",
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Vectors, defaultReferenceFrontPoints)
+		for _, v := range resp.Vectors {
+			assert.Len(t, v.Objectives, 2)
+		}
+	})
+
+	t.Run("many-objective problem", func(t *testing.T) {
+		resp, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem:        "dtlz2",
+			ObjectivesSize: 3,
+			Points:         100,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Vectors, 91)
+		assert.Len(t, resp.Vectors[0].Objectives, 3)
+	})
+
+	t.Run("wfg with more than two objectives", func(t *testing.T) {
+		resp, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem:        "wfg4",
+			ObjectivesSize: 3,
+			Points:         100,
+		})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Vectors)
+		assert.Len(t, resp.Vectors[0].Objectives, 3)
+	})
+
+	t.Run("unknown problem", func(t *testing.T) {
+		_, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem: "unknown",
+		})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("objectives do not match the problem", func(t *testing.T) {
+		_, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem:        "zdt1",
+			ObjectivesSize: 3,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("too many points", func(t *testing.T) {
+		_, err := handler.GetReferenceFront(context.Background(), &api.GetReferenceFrontRequest{
+			Problem: "zdt1",
+			Points:  20000,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
@@ -0,0 +1,407 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+	"github.com/nicholaspcr/GoDE/pkg/stats"
+	"github.com/nicholaspcr/GoDE/pkg/validation"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// experimentHandler is responsible for the experiment service operations.
+type experimentHandler struct {
+	api.UnimplementedExperimentServiceServer
+	db experimentDB
+}
+
+// NewExperimentHandler returns a handler that implements api's
+// ExperimentServiceServer.
+func NewExperimentHandler(st experimentDB) Handler {
+	return &experimentHandler{db: st}
+}
+
+// RegisterService adds ExperimentService to the RPC server.
+func (eh *experimentHandler) RegisterService(srv *grpc.Server) {
+	api.RegisterExperimentServiceServer(srv, eh)
+}
+
+// RegisterHTTPHandler adds ExperimentService to the grpc-gateway.
+func (eh *experimentHandler) RegisterHTTPHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	lisAddr string,
+	dialOpts []grpc.DialOption,
+) error {
+	return api.RegisterExperimentServiceHandlerFromEndpoint(
+		ctx, mux, lisAddr, dialOpts,
+	)
+}
+
+// Create creates an experiment for the current user.
+func (eh *experimentHandler) Create(
+	ctx context.Context, req *api.CreateExperimentRequest,
+) (*api.CreateExperimentResponse, error) {
+	tracer := otel.Tracer("handlers.experiment")
+	ctx, span := tracer.Start(ctx, "experimentHandler.Create")
+	defer span.End()
+
+	userID, err := usernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization - requires de:run scope to create experiments
+	if err := middleware.RequireScope(ctx, auth.ScopeDERun); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := validation.ValidateExperiment(req.Name, req.Description, req.Tags); err != nil {
+		span.RecordError(err)
+		return nil, ValidationErrorToStatus(err)
+	}
+
+	now := time.Now()
+	experiment := &store.Experiment{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Tags:        req.Tags,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := eh.db.CreateExperiment(ctx, experiment); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create experiment")
+	}
+
+	span.SetAttributes(attribute.String("experiment_id", experiment.ID))
+
+	return &api.CreateExperimentResponse{
+		Experiment: experimentToProto(experiment, store.ExecutionCounts{}),
+	}, nil
+}
+
+// Get returns an experiment with the summary of its executions and of the
+// indicators of the completed ones.
+func (eh *experimentHandler) Get(
+	ctx context.Context, req *api.GetExperimentRequest,
+) (*api.GetExperimentResponse, error) {
+	tracer := otel.Tracer("handlers.experiment")
+	ctx, span := tracer.Start(ctx, "experimentHandler.Get")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("experiment_id", req.ExperimentId))
+
+	userID, err := usernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization - requires de:read scope to read experiments
+	if err := middleware.RequireScope(ctx, auth.ScopeDERead); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	experiment, counts, err := eh.getExperiment(ctx, req.ExperimentId, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	results, err := eh.db.ListExperimentResults(ctx, experiment.ID, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get experiment results")
+	}
+
+	apiExperiment := experimentToProto(experiment, counts)
+	apiExperiment.Summary.Groups = summarizeExperimentResults(results)
+	return &api.GetExperimentResponse{Experiment: apiExperiment}, nil
+}
+
+// List returns the experiments of the current user, newest first.
+func (eh *experimentHandler) List(
+	ctx context.Context, req *api.ListExperimentsRequest,
+) (*api.ListExperimentsResponse, error) {
+	tracer := otel.Tracer("handlers.experiment")
+	ctx, span := tracer.Start(ctx, "experimentHandler.List")
+	defer span.End()
+
+	userID, err := usernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization - requires de:read scope to read experiments
+	if err := middleware.RequireScope(ctx, auth.ScopeDERead); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Extract and normalize pagination parameters before querying
+	limit := int(req.Limit)
+	offset := int(req.Offset)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	experiments, totalCount, err := eh.db.ListExperiments(ctx, userID, limit, offset)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to list experiments")
+	}
+
+	ids := make([]string, len(experiments))
+	for i, experiment := range experiments {
+		ids[i] = experiment.ID
+	}
+	counts, err := eh.db.CountExperimentExecutions(ctx, userID, ids...)
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to count experiment executions")
+	}
+
+	apiExperiments := make([]*api.Experiment, len(experiments))
+	for i, experiment := range experiments {
+		apiExperiments[i] = experimentToProto(experiment, counts[experiment.ID])
+	}
+
+	return &api.ListExperimentsResponse{
+		Experiments: apiExperiments,
+		TotalCount:  int32(totalCount),
+		Limit:       int32(limit),
+		Offset:      int32(offset),
+		HasMore:     (offset + limit) < totalCount,
+	}, nil
+}
+
+// Delete removes an experiment and its executions. Experiments with pending
+// or running executions are kept so no worker writes to deleted executions,
+// including the ones started while the others are deleted.
+func (eh *experimentHandler) Delete(
+	ctx context.Context, req *api.DeleteExperimentRequest,
+) (*emptypb.Empty, error) {
+	tracer := otel.Tracer("handlers.experiment")
+	ctx, span := tracer.Start(ctx, "experimentHandler.Delete")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("experiment_id", req.ExperimentId))
+
+	userID, err := usernameFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authorization - requires de:run scope to delete experiments
+	if err := middleware.RequireScope(ctx, auth.ScopeDERun); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	experiment, counts, err := eh.getExperiment(ctx, req.ExperimentId, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	errActive := status.Error(codes.FailedPrecondition,
+		"experiment has pending or running executions, cancel them first")
+	if counts[store.ExecutionStatusPending]+counts[store.ExecutionStatusRunning] > 0 {
+		return nil, errActive
+	}
+
+	// Executions are deleted through the store so their cached copies go too,
+	// every page starting over as the previous one is gone.
+	filter := store.ExecutionFilter{ExperimentID: experiment.ID}
+	for {
+		executions, _, err := eh.db.ListExecutions(ctx, userID, filter, 100, 0)
+		if err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to list experiment executions")
+		}
+		deleted := 0
+		for _, exec := range executions {
+			if exec.Status == store.ExecutionStatusPending || exec.Status == store.ExecutionStatusRunning {
+				return nil, errActive
+			}
+			if err := eh.db.DeleteExecution(ctx, exec.ID, userID); err != nil {
+				if errors.Is(err, store.ErrExecutionNotFound) {
+					continue
+				}
+				span.RecordError(err)
+				return nil, status.Error(codes.Internal, "failed to delete experiment executions")
+			}
+			deleted++
+		}
+		if deleted == 0 {
+			break
+		}
+	}
+
+	// Executions may have been started since the last page was listed
+	_, counts, err = eh.getExperiment(ctx, experiment.ID, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if counts[store.ExecutionStatusPending]+counts[store.ExecutionStatusRunning] > 0 {
+		return nil, errActive
+	}
+
+	if err := eh.db.DeleteExperiment(ctx, experiment.ID, userID); err != nil {
+		if errors.Is(err, store.ErrExperimentNotFound) {
+			return nil, status.Error(codes.NotFound, "experiment not found")
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to delete experiment")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// getExperiment returns the experiment of the user with the number of its
+// executions of each status, as gRPC errors.
+func (eh *experimentHandler) getExperiment(
+	ctx context.Context, experimentID, userID string,
+) (*store.Experiment, store.ExecutionCounts, error) {
+	if experimentID == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "experiment_id is required")
+	}
+
+	experiment, err := eh.db.GetExperiment(ctx, experimentID, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrExperimentNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "experiment not found")
+		}
+		return nil, nil, status.Error(codes.Internal, "failed to get experiment")
+	}
+
+	counts, err := eh.db.CountExperimentExecutions(ctx, userID, experiment.ID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, "failed to count experiment executions")
+	}
+	return experiment, counts[experiment.ID], nil
+}
+
+// experimentToProto converts an experiment and the number of its executions
+// of each status to the API format.
+func experimentToProto(experiment *store.Experiment, counts store.ExecutionCounts) *api.Experiment {
+	summary := &api.ExperimentSummary{
+		PendingExecutions:   int32(counts[store.ExecutionStatusPending]),
+		RunningExecutions:   int32(counts[store.ExecutionStatusRunning]),
+		CompletedExecutions: int32(counts[store.ExecutionStatusCompleted]),
+		FailedExecutions:    int32(counts[store.ExecutionStatusFailed]),
+		CancelledExecutions: int32(counts[store.ExecutionStatusCancelled]),
+	}
+	for _, n := range counts {
+		summary.TotalExecutions += int32(n)
+	}
+
+	return &api.Experiment{
+		Id:          experiment.ID,
+		UserId:      experiment.UserID,
+		Name:        experiment.Name,
+		Description: experiment.Description,
+		Tags:        experiment.Tags,
+		CreatedAt:   timestampProto(experiment.CreatedAt),
+		UpdatedAt:   timestampProto(experiment.UpdatedAt),
+		Summary:     summary,
+	}
+}
+
+// summarizeExperimentResults groups the results by algorithm, variant and
+// problem and summarizes the indicators of each group.
+func summarizeExperimentResults(results []*store.ExperimentResult) []*api.ExperimentGroupSummary {
+	type groupKey struct{ algorithm, variant, problem string }
+	groups := map[groupKey][]*indicators.Values{}
+	for _, result := range results {
+		key := groupKey{result.Algorithm, result.Variant, result.Problem}
+		groups[key] = append(groups[key], result.Indicators)
+	}
+
+	keys := make([]groupKey, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b groupKey) int {
+		return cmp.Or(
+			cmp.Compare(a.algorithm, b.algorithm),
+			cmp.Compare(a.variant, b.variant),
+			cmp.Compare(a.problem, b.problem),
+		)
+	})
+
+	summaries := make([]*api.ExperimentGroupSummary, len(keys))
+	for i, key := range keys {
+		var hv, igd, igdPlus, gd, spread, spacing []float64
+		for _, values := range groups[key] {
+			// Executions completed without indicators only count as completed
+			if values == nil {
+				continue
+			}
+			hv = append(hv, values.Hypervolume)
+			spread = append(spread, values.Spread)
+			spacing = append(spacing, values.Spacing)
+			if values.IGD != nil {
+				igd = append(igd, *values.IGD)
+			}
+			if values.IGDPlus != nil {
+				igdPlus = append(igdPlus, *values.IGDPlus)
+			}
+			if values.GD != nil {
+				gd = append(gd, *values.GD)
+			}
+		}
+		summaries[i] = &api.ExperimentGroupSummary{
+			Algorithm:           key.algorithm,
+			Variant:             key.variant,
+			Problem:             key.problem,
+			CompletedExecutions: int32(len(groups[key])),
+			Hypervolume:         indicatorSummary(hv),
+			Igd:                 indicatorSummary(igd),
+			IgdPlus:             indicatorSummary(igdPlus),
+			Gd:                  indicatorSummary(gd),
+			Spread:              indicatorSummary(spread),
+			Spacing:             indicatorSummary(spacing),
+		}
+	}
+	return summaries
+}
+
+// indicatorSummary returns the descriptive statistics of values, nil when
+// there are none.
+func indicatorSummary(values []float64) *api.IndicatorSummary {
+	if len(values) == 0 {
+		return nil
+	}
+	s := stats.Summarize(values)
+	return &api.IndicatorSummary{
+		Count:  int32(s.N),
+		Mean:   s.Mean,
+		Median: s.Median,
+		StdDev: s.StdDev,
+		Min:    s.Min,
+		Max:    s.Max,
+	}
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/server/middleware"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupExperimentHandler() (*experimentHandler, *testStore) {
+	ts := newTestStore()
+	return NewExperimentHandler(ts).(*experimentHandler), ts
+}
+
+// addExperimentExecution stores a finished execution of the experiment, with
+// a Pareto set holding values when given.
+func addExperimentExecution(
+	t *testing.T, ts *testStore, id, experimentID string, status store.ExecutionStatus, values *indicators.Values,
+) {
+	t.Helper()
+	exec := &store.Execution{
+		ID:           id,
+		UserID:       "testuser",
+		Status:       status,
+		Config:       &api.DEConfig{},
+		Algorithm:    "gde3",
+		Variant:      "rand1",
+		Problem:      "zdt1",
+		ExperimentID: experimentID,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if values != nil {
+		ps := &store.ParetoSet{UserID: "testuser", Indicators: values}
+		require.NoError(t, ts.CreateParetoSet(context.Background(), ps))
+		exec.ParetoID = &ps.ID
+	}
+	require.NoError(t, ts.CreateExecution(context.Background(), exec))
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status, got %v", err)
+	assert.Equal(t, code, st.Code())
+}
+
+func TestExperimentHandler_Create(t *testing.T) {
+	handler, ts := setupExperimentHandler()
+	ctx := authContext("testuser")
+
+	resp, err := handler.Create(ctx, &api.CreateExperimentRequest{
+		Name:        "crossovers",
+		Description: "binomial against exponential",
+		Tags:        []string{"zdt"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Experiment.Id)
+	assert.Equal(t, "testuser", resp.Experiment.UserId)
+	assert.Equal(t, "crossovers", resp.Experiment.Name)
+	assert.Equal(t, []string{"zdt"}, resp.Experiment.Tags)
+	assert.Zero(t, resp.Experiment.Summary.TotalExecutions)
+
+	stored, err := ts.GetExperiment(ctx, resp.Experiment.Id, "testuser")
+	require.NoError(t, err)
+	assert.Equal(t, "binomial against exponential", stored.Description)
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := handler.Create(ctx, &api.CreateExperimentRequest{Name: " "})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := handler.Create(context.Background(), &api.CreateExperimentRequest{Name: "crossovers"})
+		requireCode(t, err, codes.Unauthenticated)
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		readOnly := middleware.ContextWithClaims(context.Background(), &auth.Claims{
+			Username: "testuser",
+			Scopes:   []auth.Scope{auth.ScopeDERead},
+		})
+		_, err := handler.Create(readOnly, &api.CreateExperimentRequest{Name: "crossovers"})
+		requireCode(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestExperimentHandler_Get(t *testing.T) {
+	handler, ts := setupExperimentHandler()
+	ctx := authContext("testuser")
+
+	require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{ID: "exp-1", UserID: "testuser", Name: "variants"}))
+	igd := 0.5
+	addExperimentExecution(t, ts, "exec-1", "exp-1", store.ExecutionStatusCompleted,
+		&indicators.Values{Hypervolume: 0.6, IGD: &igd, Spread: 0.2, Spacing: 0.1})
+	addExperimentExecution(t, ts, "exec-2", "exp-1", store.ExecutionStatusCompleted,
+		&indicators.Values{Hypervolume: 0.8, Spread: 0.4, Spacing: 0.3})
+	addExperimentExecution(t, ts, "exec-3", "exp-1", store.ExecutionStatusCompleted, nil)
+	addExperimentExecution(t, ts, "exec-4", "exp-1", store.ExecutionStatusFailed, nil)
+	addExperimentExecution(t, ts, "exec-5", "exp-1", store.ExecutionStatusRunning, nil)
+	addExperimentExecution(t, ts, "exec-6", "exp-2", store.ExecutionStatusCompleted, nil)
+
+	resp, err := handler.Get(ctx, &api.GetExperimentRequest{ExperimentId: "exp-1"})
+	require.NoError(t, err)
+
+	summary := resp.Experiment.Summary
+	assert.Equal(t, int32(5), summary.TotalExecutions)
+	assert.Equal(t, int32(3), summary.CompletedExecutions)
+	assert.Equal(t, int32(1), summary.FailedExecutions)
+	assert.Equal(t, int32(1), summary.RunningExecutions)
+	assert.Zero(t, summary.PendingExecutions)
+
+	require.Len(t, summary.Groups, 1)
+	group := summary.Groups[0]
+	assert.Equal(t, "gde3", group.Algorithm)
+	assert.Equal(t, "rand1", group.Variant)
+	assert.Equal(t, "zdt1", group.Problem)
+	assert.Equal(t, int32(3), group.CompletedExecutions)
+	assert.Equal(t, int32(2), group.Hypervolume.Count)
+	assert.InDelta(t, 0.7, group.Hypervolume.Mean, 1e-12)
+	assert.Equal(t, 0.6, group.Hypervolume.Min)
+	assert.Equal(t, 0.8, group.Hypervolume.Max)
+	assert.Equal(t, int32(1), group.Igd.Count)
+	assert.Equal(t, 0.5, group.Igd.Mean)
+	assert.Nil(t, group.Gd, "no execution measured the generational distance")
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := handler.Get(ctx, &api.GetExperimentRequest{ExperimentId: "missing"})
+		requireCode(t, err, codes.NotFound)
+		_, err = handler.Get(authContext("otheruser"), &api.GetExperimentRequest{ExperimentId: "exp-1"})
+		requireCode(t, err, codes.NotFound)
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		_, err := handler.Get(ctx, &api.GetExperimentRequest{})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestExperimentHandler_List(t *testing.T) {
+	handler, ts := setupExperimentHandler()
+	ctx := authContext("testuser")
+
+	start := time.Now()
+	for i, id := range []string{"exp-1", "exp-2", "exp-3"} {
+		require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{
+			ID: id, UserID: "testuser", Name: id, CreatedAt: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	addExperimentExecution(t, ts, "exec-1", "exp-3", store.ExecutionStatusCompleted, nil)
+
+	resp, err := handler.List(ctx, &api.ListExperimentsRequest{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	assert.Equal(t, int32(2), resp.Limit)
+	assert.True(t, resp.HasMore)
+	require.Len(t, resp.Experiments, 2)
+	assert.Equal(t, "exp-3", resp.Experiments[0].Id)
+	assert.Equal(t, int32(1), resp.Experiments[0].Summary.CompletedExecutions)
+	assert.Empty(t, resp.Experiments[0].Summary.Groups, "groups are only summarized by Get")
+	assert.Equal(t, "exp-2", resp.Experiments[1].Id)
+
+	resp, err = handler.List(authContext("otheruser"), &api.ListExperimentsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Experiments)
+	assert.Equal(t, int32(50), resp.Limit)
+}
+
+func TestExperimentHandler_Delete(t *testing.T) {
+	handler, ts := setupExperimentHandler()
+	ctx := authContext("testuser")
+
+	require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{ID: "exp-1", UserID: "testuser", Name: "variants"}))
+	addExperimentExecution(t, ts, "exec-1", "exp-1", store.ExecutionStatusCompleted, nil)
+	addExperimentExecution(t, ts, "exec-2", "exp-1", store.ExecutionStatusPending, nil)
+	addExperimentExecution(t, ts, "exec-other", "", store.ExecutionStatusCompleted, nil)
+
+	_, err := handler.Delete(ctx, &api.DeleteExperimentRequest{ExperimentId: "exp-1"})
+	requireCode(t, err, codes.FailedPrecondition)
+
+	require.NoError(t, ts.UpdateExecutionStatus(ctx, "exec-2", store.ExecutionStatusCancelled, ""))
+	_, err = handler.Delete(ctx, &api.DeleteExperimentRequest{ExperimentId: "exp-1"})
+	require.NoError(t, err)
+
+	_, err = ts.GetExperiment(ctx, "exp-1", "testuser")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound)
+	for _, id := range []string{"exec-1", "exec-2"} {
+		_, err = ts.GetExecution(ctx, id, "testuser")
+		assert.ErrorIs(t, err, store.ErrExecutionNotFound, id)
+	}
+	_, err = ts.GetExecution(ctx, "exec-other", "testuser")
+	assert.NoError(t, err, "executions outside the experiment are kept")
+
+	_, err = handler.Delete(ctx, &api.DeleteExperimentRequest{ExperimentId: "exp-1"})
+	requireCode(t, err, codes.NotFound)
+}
+
+// racingStore is a testStore running start before deleting the first
+// execution, such as a run of the experiment submitted meanwhile.
+type racingStore struct {
+	*testStore
+	start func()
+}
+
+func (rs *racingStore) DeleteExecution(ctx context.Context, executionID, userID string) error {
+	if start := rs.start; start != nil {
+		rs.start = nil
+		start()
+	}
+	return rs.testStore.DeleteExecution(ctx, executionID, userID)
+}
+
+func TestExperimentHandler_Delete_ExecutionStartedMeanwhile(t *testing.T) {
+	ts := newTestStore()
+	rs := &racingStore{testStore: ts}
+	handler := NewExperimentHandler(rs).(*experimentHandler)
+	ctx := authContext("testuser")
+
+	require.NoError(t, ts.CreateExperiment(ctx, &store.Experiment{ID: "exp-1", UserID: "testuser", Name: "variants"}))
+	addExperimentExecution(t, ts, "exec-1", "exp-1", store.ExecutionStatusCompleted, nil)
+	rs.start = func() {
+		addExperimentExecution(t, ts, "exec-new", "exp-1", store.ExecutionStatusPending, nil)
+	}
+
+	_, err := handler.Delete(ctx, &api.DeleteExperimentRequest{ExperimentId: "exp-1"})
+	requireCode(t, err, codes.FailedPrecondition)
+
+	_, err = ts.GetExperiment(ctx, "exp-1", "testuser")
+	assert.NoError(t, err, "the experiment is kept")
+	_, err = ts.GetExecution(ctx, "exec-new", "testuser")
+	assert.NoError(t, err, "the execution started meanwhile is kept")
+}
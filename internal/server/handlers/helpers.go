@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/server/middleware"
 	"github.com/nicholaspcr/GoDE/internal/store"
@@ -43,11 +44,52 @@ type paretoDB interface {
 
 // deStore is the minimal store interface required by deHandler.
 type deStore interface {
-	ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error)
+	ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
 	DeleteExecution(ctx context.Context, executionID, userID string) error
 	GetExecution(ctx context.Context, executionID, userID string) (*store.Execution, error)
 	GetProgress(ctx context.Context, executionID string) (*store.ExecutionProgress, error)
 	GetParetoSetByID(context.Context, uint64) (*store.ParetoSet, error)
 	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
 	GetExecutionByIdempotencyKey(ctx context.Context, userID, idempotencyKey string) (string, error)
+	SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error
+	GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error)
+	ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error)
+	GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error)
+}
+
+// experimentDB is the minimal store interface required by experimentHandler.
+type experimentDB interface {
+	CreateExperiment(ctx context.Context, experiment *store.Experiment) error
+	GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error)
+	ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error)
+	DeleteExperiment(ctx context.Context, experimentID, userID string) error
+	CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error)
+	ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error)
+	ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
+	DeleteExecution(ctx context.Context, executionID, userID string) error
+}
+
+// adminDB is the minimal store interface required by adminHandler.
+type adminDB interface {
+	GetUser(context.Context, *api.UserIDs) (*api.User, error)
+	UpdateUser(context.Context, *api.User, ...string) error
+	ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error)
+	ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
+	FindExecution(ctx context.Context, executionID string) (*store.Execution, error)
+	PurgeExecutions(ctx context.Context, before time.Time) ([]string, error)
+}
+
+// apiKeyDB is the minimal store interface required by apiKeyHandler.
+type apiKeyDB interface {
+	CreateAPIKey(ctx context.Context, key *store.APIKey) error
+	ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error)
+	DeleteAPIKey(ctx context.Context, keyID, userID string) error
+}
+
+// oidcDB is the minimal store interface required by oidcHandler.
+type oidcDB interface {
+	GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error)
+	CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error
+	GetUser(context.Context, *api.UserIDs) (*api.User, error)
+	UpdateUser(context.Context, *api.User, ...string) error
 }
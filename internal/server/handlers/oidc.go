@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/validation"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// oidcHandler is responsible for the single sign-on operations.
+type oidcHandler struct {
+	api.UnimplementedOIDCServiceServer
+	db           oidcDB
+	provider     auth.OIDCProvider // nil if single sign-on is not configured
+	jwtService   auth.JWTService
+	accessExpiry time.Duration
+}
+
+// NewOIDCHandler returns a handler that implements api's OIDCServiceServer.
+// provider may be nil, in which case GetConfig reports single sign-on as
+// disabled and the logins fail.
+func NewOIDCHandler(
+	st oidcDB, provider auth.OIDCProvider, jwtService auth.JWTService, accessExpiry time.Duration,
+) Handler {
+	return &oidcHandler{db: st, provider: provider, jwtService: jwtService, accessExpiry: accessExpiry}
+}
+
+// RegisterService adds OIDCService to the RPC server.
+func (oh *oidcHandler) RegisterService(srv *grpc.Server) {
+	api.RegisterOIDCServiceServer(srv, oh)
+}
+
+// RegisterHTTPHandler adds OIDCService to the grpc-gateway.
+func (oh *oidcHandler) RegisterHTTPHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	lisAddr string,
+	dialOpts []grpc.DialOption,
+) error {
+	return api.RegisterOIDCServiceHandlerFromEndpoint(
+		ctx, mux, lisAddr, dialOpts,
+	)
+}
+
+// GetConfig returns what clients need to start an authorization code login.
+func (oh *oidcHandler) GetConfig(
+	ctx context.Context, _ *api.GetOIDCConfigRequest,
+) (*api.GetOIDCConfigResponse, error) {
+	tracer := otel.Tracer("handlers.oidc")
+	ctx, span := tracer.Start(ctx, "oidcHandler.GetConfig")
+	defer span.End()
+
+	if oh.provider == nil {
+		return &api.GetOIDCConfigResponse{}, nil
+	}
+	cfg, err := oh.provider.AuthCodeConfig(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, oidcErrorToStatus(err)
+	}
+	return &api.GetOIDCConfigResponse{
+		Enabled:               true,
+		AuthorizationEndpoint: cfg.AuthorizationEndpoint,
+		ClientId:              cfg.ClientID,
+		Scopes:                cfg.Scopes,
+	}, nil
+}
+
+// ExchangeCode completes an authorization code login.
+func (oh *oidcHandler) ExchangeCode(
+	ctx context.Context, req *api.ExchangeOIDCCodeRequest,
+) (*api.AuthServiceLoginResponse, error) {
+	tracer := otel.Tracer("handlers.oidc")
+	ctx, span := tracer.Start(ctx, "oidcHandler.ExchangeCode")
+	defer span.End()
+
+	if oh.provider == nil {
+		return nil, oidcErrorToStatus(auth.ErrOIDCNotConfigured)
+	}
+	for field, value := range map[string]string{
+		"code":          req.Code,
+		"code_verifier": req.CodeVerifier,
+		"redirect_uri":  req.RedirectUri,
+		"nonce":         req.Nonce,
+	} {
+		if err := validation.ValidateNonEmpty(value, field); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	identity, err := oh.provider.ExchangeCode(ctx, req.Code, req.CodeVerifier, req.RedirectUri, req.Nonce)
+	if err != nil {
+		span.RecordError(err)
+		return nil, oidcErrorToStatus(err)
+	}
+	return oh.login(ctx, identity)
+}
+
+// StartDeviceLogin starts a device code login.
+func (oh *oidcHandler) StartDeviceLogin(
+	ctx context.Context, _ *api.StartOIDCDeviceLoginRequest,
+) (*api.StartOIDCDeviceLoginResponse, error) {
+	tracer := otel.Tracer("handlers.oidc")
+	ctx, span := tracer.Start(ctx, "oidcHandler.StartDeviceLogin")
+	defer span.End()
+
+	if oh.provider == nil {
+		return nil, oidcErrorToStatus(auth.ErrOIDCNotConfigured)
+	}
+	device, err := oh.provider.StartDeviceLogin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, oidcErrorToStatus(err)
+	}
+
+	resp := &api.StartOIDCDeviceLoginResponse{
+		DeviceCode:              device.DeviceCode,
+		UserCode:                device.UserCode,
+		VerificationUri:         device.VerificationURI,
+		VerificationUriComplete: device.VerificationURIComplete,
+		Interval:                device.Interval,
+	}
+	if resp.Interval <= 0 {
+		// RFC 8628 default polling interval
+		resp.Interval = 5
+	}
+	if !device.Expiry.IsZero() {
+		resp.ExpiresIn = int64(time.Until(device.Expiry).Seconds())
+	}
+	return resp, nil
+}
+
+// PollDeviceLogin completes a device code login once the user approved it.
+func (oh *oidcHandler) PollDeviceLogin(
+	ctx context.Context, req *api.PollOIDCDeviceLoginRequest,
+) (*api.PollOIDCDeviceLoginResponse, error) {
+	tracer := otel.Tracer("handlers.oidc")
+	ctx, span := tracer.Start(ctx, "oidcHandler.PollDeviceLogin")
+	defer span.End()
+
+	if oh.provider == nil {
+		return nil, oidcErrorToStatus(auth.ErrOIDCNotConfigured)
+	}
+	if err := validation.ValidateNonEmpty(req.DeviceCode, "device_code"); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	identity, err := oh.provider.PollDeviceLogin(ctx, req.DeviceCode)
+	switch {
+	case errors.Is(err, auth.ErrOIDCAuthorizationPending):
+		return &api.PollOIDCDeviceLoginResponse{Pending: true}, nil
+	case errors.Is(err, auth.ErrOIDCSlowDown):
+		return &api.PollOIDCDeviceLoginResponse{Pending: true, SlowDown: true}, nil
+	case err != nil:
+		span.RecordError(err)
+		return nil, oidcErrorToStatus(err)
+	}
+
+	login, err := oh.login(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PollOIDCDeviceLoginResponse{Login: login}, nil
+}
+
+// login issues the tokens of the user linked to the identity, linking the
+// identity to a new user on its first login. Users created this way have no
+// password, so they cannot log in locally.
+func (oh *oidcHandler) login(
+	ctx context.Context, identity *auth.OIDCIdentity,
+) (*api.AuthServiceLoginResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("oidc.issuer", identity.Issuer),
+		attribute.String("oidc.subject", identity.Subject),
+	)
+	tenantID := tenant.IDFromContext(ctx)
+
+	var scopeNames []string
+	if identity.SyncScopes {
+		scopeNames = make([]string, len(identity.Scopes))
+		for i, scope := range identity.Scopes {
+			scopeNames[i] = string(scope)
+		}
+	}
+
+	var usr *api.User
+	link, err := oh.db.GetIdentity(ctx, identity.Issuer, identity.Subject)
+	switch {
+	case err == nil:
+		if link.TenantID != tenantID {
+			return nil, status.Error(codes.PermissionDenied, "identity belongs to another tenant")
+		}
+		if usr, err = oh.linkedUser(ctx, link, identity, scopeNames); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, store.ErrIdentityNotFound):
+		if usr, err = oh.link(ctx, identity, scopeNames); err != nil {
+			return nil, err
+		}
+	default:
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to look up identity")
+	}
+
+	// Stored scopes are validated when set, an empty set means the defaults
+	scopes, err := auth.ParseScopes(usr.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate tokens")
+	}
+	accessToken, refreshToken, err := oh.jwtService.GenerateTokenPair(tenantID, usr.Ids.Username, scopes...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate tokens")
+	}
+
+	return &api.AuthServiceLoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(oh.accessExpiry.Seconds()),
+	}, nil
+}
+
+// link creates the user named by the username claim of an identity seen for
+// the first time and links the identity to it. Names of existing users of
+// any tenant are refused, so that the provider cannot take over local
+// accounts.
+func (oh *oidcHandler) link(
+	ctx context.Context, identity *auth.OIDCIdentity, scopeNames []string,
+) (*api.User, error) {
+	if err := validation.ValidateUsername(identity.Username); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "username claim is not a valid username: %v", err)
+	}
+
+	usr := &api.User{
+		Ids:    &api.UserIDs{Username: identity.Username},
+		Email:  strings.ToLower(strings.TrimSpace(identity.Email)),
+		Scopes: scopeNames,
+	}
+	link := &store.Identity{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		CreatedAt: time.Now(),
+	}
+	if err := oh.db.CreateIdentityUser(ctx, link, usr); err != nil {
+		if errors.Is(err, store.ErrUsernameTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "user %s already exists", identity.Username)
+		}
+		trace.SpanFromContext(ctx).RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to create user")
+	}
+	return usr, nil
+}
+
+// linkedUser returns the user the identity is linked to, updating its scopes
+// when they follow the groups of the identity. Deleted users are not created
+// again: their names stay taken, and an administrator removed them.
+func (oh *oidcHandler) linkedUser(
+	ctx context.Context, link *store.Identity, identity *auth.OIDCIdentity, scopeNames []string,
+) (*api.User, error) {
+	span := trace.SpanFromContext(ctx)
+
+	usr, err := oh.db.GetUser(ctx, &api.UserIDs{Username: link.UserID})
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, storeerrors.ErrUserNotFound):
+		return nil, status.Error(codes.PermissionDenied, "user was deleted")
+	case err != nil:
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, "failed to get user")
+	case identity.SyncScopes && !slices.Equal(usr.Scopes, scopeNames):
+		usr.Scopes = scopeNames
+		if err := oh.db.UpdateUser(ctx, usr, "scopes"); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, "failed to update user")
+		}
+	}
+	return usr, nil
+}
+
+// oidcErrorToStatus maps the errors of the identity provider to gRPC
+// statuses.
+func oidcErrorToStatus(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrOIDCNotConfigured),
+		errors.Is(err, auth.ErrOIDCDeviceFlowUnsupported),
+		errors.Is(err, auth.ErrOIDCDeviceCodeExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, auth.ErrOIDCInvalidGrant), errors.Is(err, auth.ErrInvalidIDToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, auth.ErrOIDCAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Unavailable, "identity provider unavailable")
+	}
+}
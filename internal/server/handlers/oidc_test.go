@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+)
+
+// oidcTestStore holds users by tenant and username and identities by issuer
+// and subject. getUserErr fails the user lookups.
+type oidcTestStore struct {
+	users      map[string]*api.User
+	identities map[string]*store.Identity
+	getUserErr error
+}
+
+func (ts *oidcTestStore) GetIdentity(_ context.Context, issuer, subject string) (*store.Identity, error) {
+	identity, ok := ts.identities[issuer+"/"+subject]
+	if !ok {
+		return nil, store.ErrIdentityNotFound
+	}
+	return identity, nil
+}
+
+func (ts *oidcTestStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	for key := range ts.users {
+		if strings.HasSuffix(key, "/"+usr.Ids.Username) {
+			return store.ErrUsernameTaken
+		}
+	}
+	identity.TenantID = tenant.IDFromContext(ctx)
+	identity.UserID = usr.Ids.Username
+	ts.identities[identity.Issuer+"/"+identity.Subject] = identity
+	ts.users[identity.TenantID+"/"+usr.Ids.Username] = usr
+	return nil
+}
+
+func (ts *oidcTestStore) GetUser(ctx context.Context, ids *api.UserIDs) (*api.User, error) {
+	if ts.getUserErr != nil {
+		return nil, ts.getUserErr
+	}
+	usr, ok := ts.users[tenant.IDFromContext(ctx)+"/"+ids.Username]
+	if !ok {
+		return nil, storeerrors.ErrUserNotFound
+	}
+	return usr, nil
+}
+
+func (ts *oidcTestStore) UpdateUser(ctx context.Context, usr *api.User, _ ...string) error {
+	ts.users[tenant.IDFromContext(ctx)+"/"+usr.Ids.Username] = usr
+	return nil
+}
+
+// fakeOIDCProvider returns identity from every login, or err.
+type fakeOIDCProvider struct {
+	identity *auth.OIDCIdentity
+	err      error
+}
+
+func (p *fakeOIDCProvider) AuthCodeConfig(context.Context) (*auth.OIDCAuthCodeConfig, error) {
+	return &auth.OIDCAuthCodeConfig{
+		AuthorizationEndpoint: "https://idp.example.com/authorize",
+		ClientID:              "gode",
+		Scopes:                []string{"openid", "profile"},
+	}, p.err
+}
+
+func (p *fakeOIDCProvider) ExchangeCode(context.Context, string, string, string, string) (*auth.OIDCIdentity, error) {
+	return p.identity, p.err
+}
+
+func (p *fakeOIDCProvider) StartDeviceLogin(context.Context) (*oauth2.DeviceAuthResponse, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &oauth2.DeviceAuthResponse{
+		DeviceCode:      "device-code",
+		UserCode:        "ABCD-EFGH",
+		VerificationURI: "https://idp.example.com/activate",
+		Expiry:          time.Now().Add(10 * time.Minute),
+	}, nil
+}
+
+func (p *fakeOIDCProvider) PollDeviceLogin(context.Context, string) (*auth.OIDCIdentity, error) {
+	return p.identity, p.err
+}
+
+func setupOIDCHandler(identity *auth.OIDCIdentity) (*oidcHandler, *oidcTestStore, *fakeOIDCProvider, auth.JWTService) {
+	ts := &oidcTestStore{users: map[string]*api.User{}, identities: map[string]*store.Identity{}}
+	provider := &fakeOIDCProvider{identity: identity}
+	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
+	return NewOIDCHandler(ts, provider, jwtService, 15*time.Minute).(*oidcHandler), ts, provider, jwtService
+}
+
+func aliceIdentity() *auth.OIDCIdentity {
+	return &auth.OIDCIdentity{
+		Issuer:   "https://idp.example.com",
+		Subject:  "sub-1",
+		Username: "alice",
+		Email:    "Alice@Example.com",
+		Groups:   []string{"researchers"},
+	}
+}
+
+var exchangeRequest = &api.ExchangeOIDCCodeRequest{
+	Code:         "code",
+	CodeVerifier: "verifier",
+	RedirectUri:  "http://localhost:3000/auth/callback",
+	Nonce:        "nonce",
+}
+
+func TestOIDCHandler_GetConfig(t *testing.T) {
+	handler, _, provider, _ := setupOIDCHandler(aliceIdentity())
+
+	resp, err := handler.GetConfig(context.Background(), &api.GetOIDCConfigRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Enabled)
+	assert.Equal(t, "https://idp.example.com/authorize", resp.AuthorizationEndpoint)
+	assert.Equal(t, "gode", resp.ClientId)
+	assert.Equal(t, []string{"openid", "profile"}, resp.Scopes)
+
+	provider.err = errors.New("connection refused")
+	_, err = handler.GetConfig(context.Background(), &api.GetOIDCConfigRequest{})
+	requireCode(t, err, codes.Unavailable)
+
+	t.Run("not configured", func(t *testing.T) {
+		handler := NewOIDCHandler(&oidcTestStore{}, nil, auth.NewJWTService("test-secret", time.Minute), time.Minute)
+		resp, err := handler.(*oidcHandler).GetConfig(context.Background(), &api.GetOIDCConfigRequest{})
+		require.NoError(t, err)
+		assert.False(t, resp.Enabled)
+
+		_, err = handler.(*oidcHandler).ExchangeCode(context.Background(), exchangeRequest)
+		requireCode(t, err, codes.FailedPrecondition)
+		_, err = handler.(*oidcHandler).StartDeviceLogin(context.Background(), &api.StartOIDCDeviceLoginRequest{})
+		requireCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+func TestOIDCHandler_ExchangeCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first login provisions the user", func(t *testing.T) {
+		handler, ts, _, jwtService := setupOIDCHandler(aliceIdentity())
+
+		resp, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+		assert.Equal(t, int64(900), resp.ExpiresIn)
+
+		claims, err := jwtService.ValidateToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", claims.Username)
+		assert.Equal(t, tenant.DefaultID, claims.TenantID)
+		assert.Equal(t, auth.DefaultUserScopes(), claims.Scopes)
+
+		usr := ts.users[tenant.DefaultID+"/alice"]
+		require.NotNil(t, usr)
+		assert.Equal(t, "alice@example.com", usr.Email)
+		assert.Empty(t, usr.Password, "provisioned users cannot log in locally")
+		link := ts.identities["https://idp.example.com/sub-1"]
+		require.NotNil(t, link)
+		assert.Equal(t, "alice", link.UserID)
+		assert.Equal(t, tenant.DefaultID, link.TenantID)
+
+		// Later logins reuse the user
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+		assert.Len(t, ts.users, 1)
+		assert.Len(t, ts.identities, 1)
+	})
+
+	t.Run("linked user keeps its name", func(t *testing.T) {
+		handler, ts, provider, jwtService := setupOIDCHandler(aliceIdentity())
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+
+		provider.identity.Username = "alice-renamed"
+		resp, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+		claims, err := jwtService.ValidateToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", claims.Username)
+		assert.Len(t, ts.users, 1)
+	})
+
+	t.Run("groups mapped to scopes", func(t *testing.T) {
+		identity := aliceIdentity()
+		identity.SyncScopes = true
+		identity.Scopes = []auth.Scope{auth.ScopeDERead}
+		handler, ts, provider, jwtService := setupOIDCHandler(identity)
+
+		resp, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+		claims, err := jwtService.ValidateToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, []auth.Scope{auth.ScopeDERead}, claims.Scopes)
+		assert.Equal(t, []string{"de:read"}, ts.users[tenant.DefaultID+"/alice"].Scopes)
+
+		// Scope changes in the provider apply on the next login
+		provider.identity.Scopes = []auth.Scope{auth.ScopeAdmin}
+		resp, err = handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+		claims, err = jwtService.ValidateToken(resp.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, []auth.Scope{auth.ScopeAdmin}, claims.Scopes)
+		assert.Equal(t, []string{"admin"}, ts.users[tenant.DefaultID+"/alice"].Scopes)
+	})
+
+	t.Run("local account with the same name", func(t *testing.T) {
+		handler, ts, _, _ := setupOIDCHandler(aliceIdentity())
+		ts.users[tenant.DefaultID+"/alice"] = &api.User{Ids: &api.UserIDs{Username: "alice"}, Password: "hash"}
+
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.AlreadyExists)
+		assert.Empty(t, ts.identities)
+	})
+
+	t.Run("user of another tenant with the same name", func(t *testing.T) {
+		handler, ts, _, _ := setupOIDCHandler(aliceIdentity())
+		ts.users["acme/alice"] = &api.User{Ids: &api.UserIDs{Username: "alice"}, Password: "hash"}
+
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.AlreadyExists)
+		assert.Empty(t, ts.identities, "usernames are unique across tenants")
+		assert.Len(t, ts.users, 1)
+	})
+
+	t.Run("failed user lookup", func(t *testing.T) {
+		handler, ts, _, _ := setupOIDCHandler(aliceIdentity())
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+
+		ts.getUserErr = errors.New("connection refused")
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.Internal)
+	})
+
+	t.Run("deleted user is refused", func(t *testing.T) {
+		handler, ts, _, _ := setupOIDCHandler(aliceIdentity())
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		require.NoError(t, err)
+
+		delete(ts.users, tenant.DefaultID+"/alice")
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.PermissionDenied)
+		assert.Empty(t, ts.users, "deleted users are not created again")
+	})
+
+	t.Run("identity of another tenant", func(t *testing.T) {
+		handler, _, _, _ := setupOIDCHandler(aliceIdentity())
+		_, err := handler.ExchangeCode(tenant.WithID(ctx, "acme"), exchangeRequest)
+		require.NoError(t, err)
+
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid username claim", func(t *testing.T) {
+		identity := aliceIdentity()
+		identity.Username = "alice@example.com"
+		handler, ts, _, _ := setupOIDCHandler(identity)
+
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.FailedPrecondition)
+		assert.Empty(t, ts.users)
+	})
+
+	t.Run("provider errors", func(t *testing.T) {
+		handler, _, provider, _ := setupOIDCHandler(nil)
+
+		provider.err = auth.ErrOIDCInvalidGrant
+		_, err := handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.Unauthenticated)
+
+		provider.err = auth.ErrInvalidIDToken
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.Unauthenticated)
+
+		provider.err = errors.New("connection refused")
+		_, err = handler.ExchangeCode(ctx, exchangeRequest)
+		requireCode(t, err, codes.Unavailable)
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		handler, _, _, _ := setupOIDCHandler(aliceIdentity())
+
+		_, err := handler.ExchangeCode(ctx, &api.ExchangeOIDCCodeRequest{Code: "code", RedirectUri: "uri", Nonce: "n"})
+		requireCode(t, err, codes.InvalidArgument)
+		_, err = handler.ExchangeCode(ctx, &api.ExchangeOIDCCodeRequest{Code: "code", CodeVerifier: "v", RedirectUri: "uri"})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestOIDCHandler_DeviceLogin(t *testing.T) {
+	ctx := context.Background()
+	handler, ts, provider, _ := setupOIDCHandler(aliceIdentity())
+
+	start, err := handler.StartDeviceLogin(ctx, &api.StartOIDCDeviceLoginRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "device-code", start.DeviceCode)
+	assert.Equal(t, "ABCD-EFGH", start.UserCode)
+	assert.Equal(t, "https://idp.example.com/activate", start.VerificationUri)
+	assert.Equal(t, int64(5), start.Interval, "providers without an interval get the default")
+	assert.InDelta(t, 600, start.ExpiresIn, 5)
+
+	req := &api.PollOIDCDeviceLoginRequest{DeviceCode: start.DeviceCode}
+
+	provider.err = auth.ErrOIDCAuthorizationPending
+	resp, err := handler.PollDeviceLogin(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, resp.Pending)
+	assert.False(t, resp.SlowDown)
+	assert.Nil(t, resp.Login)
+
+	provider.err = auth.ErrOIDCSlowDown
+	resp, err = handler.PollDeviceLogin(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, resp.Pending)
+	assert.True(t, resp.SlowDown)
+
+	provider.err = auth.ErrOIDCAccessDenied
+	_, err = handler.PollDeviceLogin(ctx, req)
+	requireCode(t, err, codes.PermissionDenied)
+
+	provider.err = auth.ErrOIDCDeviceCodeExpired
+	_, err = handler.PollDeviceLogin(ctx, req)
+	requireCode(t, err, codes.FailedPrecondition)
+
+	_, err = handler.PollDeviceLogin(ctx, &api.PollOIDCDeviceLoginRequest{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	provider.err = nil
+	resp, err = handler.PollDeviceLogin(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, resp.Pending)
+	require.NotNil(t, resp.Login)
+	assert.NotEmpty(t, resp.Login.AccessToken)
+	assert.NotEmpty(t, resp.Login.RefreshToken)
+	assert.Contains(t, ts.users, tenant.DefaultID+"/alice")
+
+	t.Run("provider without device flow", func(t *testing.T) {
+		provider.err = auth.ErrOIDCDeviceFlowUnsupported
+		_, err := handler.StartDeviceLogin(ctx, &api.StartOIDCDeviceLoginRequest{})
+		requireCode(t, err, codes.FailedPrecondition)
+	})
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
@@ -56,6 +57,11 @@ func (uh *userHandler) Create(
 	if err := validation.ValidateUser(req.User); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if len(req.User.Scopes) > 0 {
+		if err := checkScopesChange(ctx, req.User.Scopes); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := uh.db.CreateUser(ctx, req.User); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user")
@@ -86,12 +92,7 @@ func (uh *userHandler) Get(
 		}
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	// Convert User to UserResponse (exclude password)
-	userResp := &api.UserResponse{
-		Ids:   usr.Ids,
-		Email: usr.Email,
-	}
-	return &api.UserServiceGetResponse{User: userResp}, nil
+	return &api.UserServiceGetResponse{User: userToResponse(usr)}, nil
 }
 
 func (uh *userHandler) Update(
@@ -109,6 +110,11 @@ func (uh *userHandler) Update(
 	if req.User != nil && req.User.Ids != nil && req.User.Ids.Username != callerUsername && !middleware.HasScope(ctx, auth.ScopeAdmin) {
 		return nil, status.Error(codes.PermissionDenied, "cannot modify other users' data")
 	}
+	if slices.Contains(req.FieldMask.GetPaths(), "scopes") {
+		if err := checkScopesChange(ctx, req.User.GetScopes()); err != nil {
+			return nil, err
+		}
+	}
 
 	if err = uh.db.UpdateUser(ctx, req.User, req.FieldMask.GetPaths()...); err != nil {
 		if errors.Is(err, storerrors.ErrUnsupportedFieldMask) {
@@ -140,3 +146,24 @@ func (uh *userHandler) Delete(
 	}
 	return api.Empty, nil
 }
+
+// userToResponse converts a User to a UserResponse, excluding the password.
+func userToResponse(usr *api.User) *api.UserResponse {
+	return &api.UserResponse{
+		Ids:    usr.Ids,
+		Email:  usr.Email,
+		Scopes: usr.Scopes,
+	}
+}
+
+// checkScopesChange only lets administrators set the scopes of a user, and
+// only to known scopes.
+func checkScopesChange(ctx context.Context, scopes []string) error {
+	if !middleware.HasScope(ctx, auth.ScopeAdmin) {
+		return status.Error(codes.PermissionDenied, "only administrators can set user scopes")
+	}
+	if _, err := auth.ParseScopes(scopes); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
@@ -40,6 +40,22 @@ func TestUserHandler_Create(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "scopes require admin",
+			req: &api.UserServiceCreateRequest{
+				User: &api.User{
+					Ids:      &api.UserIDs{Username: "testuser"},
+					Email:    "test@example.com",
+					Password: "validpass123",
+					Scopes:   []string{"admin"},
+				},
+			},
+			setupMock: func(m *mock.MockStore) {
+				// Should not be called
+			},
+			wantErr:  true,
+			wantCode: codes.PermissionDenied,
+		},
 		{
 			name: "invalid username",
 			req: &api.UserServiceCreateRequest{
@@ -235,6 +251,25 @@ func TestUserHandler_Update(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "scopes require admin",
+			req: &api.UserServiceUpdateRequest{
+				User: &api.User{
+					Ids:    &api.UserIDs{Username: "testuser"},
+					Scopes: []string{"admin"},
+				},
+				FieldMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"scopes"},
+				},
+			},
+			setupMock: func(m *mock.MockStore) {
+				m.UpdateUserFn = func(ctx context.Context, user *api.User, fields ...string) error {
+					t.Error("UpdateUser should not be called")
+					return nil
+				}
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -255,6 +290,34 @@ func TestUserHandler_Update(t *testing.T) {
 	}
 }
 
+func TestUserHandler_AdminSetsScopes(t *testing.T) {
+	var stored []string
+	mockStore := &mock.MockStore{
+		CreateUserFn: func(ctx context.Context, user *api.User) error {
+			stored = user.Scopes
+			return nil
+		},
+	}
+	handler := NewUserHandler(mockStore).(*userHandler)
+	ctx := middleware.ContextWithClaims(context.Background(), &auth.Claims{Username: "operator", Scopes: auth.AllScopes()})
+
+	user := &api.User{
+		Ids:      &api.UserIDs{Username: "robot"},
+		Email:    "robot@example.com",
+		Password: "validpass123",
+		Scopes:   []string{"de:run", "de:read"},
+	}
+	_, err := handler.Create(ctx, &api.UserServiceCreateRequest{User: user})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de:run", "de:read"}, stored)
+
+	user.Scopes = []string{"root"}
+	_, err = handler.Create(ctx, &api.UserServiceCreateRequest{User: user})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestUserHandler_Delete(t *testing.T) {
 	tests := []struct {
 		req       *api.UserServiceDeleteRequest
@@ -233,7 +233,7 @@ func (l *lifecycle) setupGRPCServer() error {
 	unaryInterceptors = append(unaryInterceptors,
 		l.rateLimiter.UnaryGlobalRateLimitMiddleware(),
 		l.rateLimiter.UnaryAuthRateLimitMiddleware(),        // IP-based, runs before auth
-		middleware.UnaryAuthMiddleware(l.server.jwtService, l.server.revoker), // Auth BEFORE DE limiter
+		middleware.UnaryAuthMiddleware(l.server.jwtService, l.server.revoker, l.server.apiKeys), // Auth BEFORE DE limiter
 		l.rateLimiter.UnaryDERateLimitMiddleware(),          // User-based, needs auth
 		logging.UnaryServerInterceptor(InterceptorLogger(logger)),
 	)
@@ -243,7 +243,7 @@ func (l *lifecycle) setupGRPCServer() error {
 		grpc.ChainStreamInterceptor(
 			middleware.StreamPanicRecoveryMiddleware(),
 			middleware.StreamMetricsMiddleware(l.server.metrics),
-			middleware.StreamAuthMiddleware(l.server.jwtService, l.server.revoker),
+			middleware.StreamAuthMiddleware(l.server.jwtService, l.server.revoker, l.server.apiKeys),
 			logging.StreamServerInterceptor(InterceptorLogger(logger)),
 		),
 	)
@@ -283,7 +283,7 @@ func (l *lifecycle) setupGRPCServer() error {
 
 // setupHTTPGateway creates and configures the HTTP gateway
 func (l *lifecycle) setupHTTPGateway(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(middleware.GatewayHeaderMatcher))
 
 	// Prepare dial options for HTTP gateway
 	var dialOpts []grpc.DialOption
@@ -311,22 +311,12 @@ func (l *lifecycle) setupHTTPGateway(ctx context.Context) error {
 
 	// Add health check HTTP endpoints
 	_ = mux.HandlePath("GET", "/health", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"status":"UP"}`))
+		l.handleHealth(w, r)
 	})
 
+	readiness := l.handleReadiness(ctx)
 	_ = mux.HandlePath("GET", "/readiness", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
-		if !l.server.checkDatabaseHealth(ctx) {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			_, _ = w.Write([]byte(`{"status":"DOWN","reason":"database unavailable"}`))
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"status":"UP"}`))
+		readiness(w, r)
 	})
 
 	slog.Info("Health check endpoints available at /health and /readiness")
@@ -372,6 +362,43 @@ func (l *lifecycle) setupHTTPGateway(ctx context.Context) error {
 	return nil
 }
 
+// setupHealthServer creates the HTTP server of the workers, which serves
+// only the health check endpoints.
+func (l *lifecycle) setupHealthServer(ctx context.Context) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", l.handleHealth)
+	mux.HandleFunc("GET /readiness", l.handleReadiness(ctx))
+
+	l.httpServer = &http.Server{
+		Addr:              l.cfg.HTTPPort,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// handleHealth reports that the process is alive.
+func (l *lifecycle) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"status":"UP"}`))
+}
+
+// handleReadiness reports whether the store is reachable.
+func (l *lifecycle) handleReadiness(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if !l.server.checkDatabaseHealth(ctx) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status":"DOWN","reason":"database unavailable"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"UP"}`))
+	}
+}
+
 // run starts all servers and waits for shutdown signal
 func (l *lifecycle) run(ctx context.Context) error {
 	// Start gRPC server
@@ -403,6 +430,25 @@ func (l *lifecycle) run(ctx context.Context) error {
 		}
 	}()
 
+	l.waitForShutdown(ctx)
+	return nil
+}
+
+// runWorker starts the health check server of a worker and waits for
+// shutdown signal
+func (l *lifecycle) runWorker(ctx context.Context) {
+	slog.Info("Health check server listening on: ", slog.String("port", l.cfg.HTTPPort))
+	go func() {
+		if err := l.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("Unexpected error on the health check server", slog.String("error", err.Error()))
+		}
+	}()
+
+	l.waitForShutdown(ctx)
+}
+
+// waitForShutdown blocks until a shutdown signal arrives or ctx is done
+func (l *lifecycle) waitForShutdown(ctx context.Context) {
 	// Setup signal handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
@@ -414,8 +460,6 @@ func (l *lifecycle) run(ctx context.Context) error {
 	case <-ctx.Done():
 		slog.Info("Context cancelled, initiating shutdown")
 	}
-
-	return nil
 }
 
 // shutdown gracefully stops all servers and cleans up resources
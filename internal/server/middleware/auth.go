@@ -2,10 +2,12 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"strings"
 
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -35,10 +37,14 @@ var (
 	claimsCtxKey   = &contextKey{"claims"}
 )
 
-// UnaryAuthMiddleware checks for Bearer authentication and validates JWT tokens.
+// UnaryAuthMiddleware checks for Bearer authentication and validates JWT
+// tokens, or API keys when apiKeys is not nil.
 // The revoker is optional; pass nil to disable revocation checks.
 func UnaryAuthMiddleware(
-	jwtService auth.JWTService, revoker auth.TokenRevoker, ignoreMethods ...string,
+	jwtService auth.JWTService,
+	revoker auth.TokenRevoker,
+	apiKeys auth.APIKeyAuthenticator,
+	ignoreMethods ...string,
 ) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -52,6 +58,10 @@ func UnaryAuthMiddleware(
 		for _, imethod := range []string{
 			"/api.v1.AuthService/Login",
 			"/api.v1.AuthService/Register",
+			"/api.v1.OIDCService/GetConfig",
+			"/api.v1.OIDCService/ExchangeCode",
+			"/api.v1.OIDCService/StartDeviceLogin",
+			"/api.v1.OIDCService/PollDeviceLogin",
 		} {
 			if imethod == method {
 				ignoreAuth = true
@@ -59,35 +69,9 @@ func UnaryAuthMiddleware(
 		}
 
 		if !ignoreAuth {
-			md, ok := metadata.FromIncomingContext(ctx)
-			if !ok {
-				return nil, errMetadataNotFound
-			}
-
-			values := md["authorization"]
-			if len(values) == 0 {
-				return nil, errTokenNotFound
-			}
-
-			token := values[0]
-			token = strings.TrimPrefix(token, "Bearer ")
-
-			claims, err := jwtService.ValidateToken(token)
+			claims, err := authenticate(ctx, jwtService, revoker, apiKeys)
 			if err != nil {
-				return nil, errTokenInvalid
-			}
-
-			// Check token revocation list
-			if revoker != nil && claims.ID != "" {
-				revoked, revokeErr := revoker.IsRevoked(ctx, claims.ID)
-				if revokeErr != nil {
-					slog.WarnContext(ctx, "failed to check token revocation, allowing request",
-						slog.String("jti", claims.ID),
-						slog.String("error", revokeErr.Error()),
-					)
-				} else if revoked {
-					return nil, errTokenInvalid
-				}
+				return nil, err
 			}
 
 			// Add user info and claims to context for downstream handlers
@@ -95,10 +79,71 @@ func UnaryAuthMiddleware(
 			ctx = context.WithValue(ctx, claimsCtxKey, claims)
 		}
 
+		ctx, err = tenantContext(ctx, ClaimsFromContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
 		return handler(ctx, req)
 	}
 }
 
+// authenticate returns the claims of the bearer token of the request, an API
+// key when it starts with auth.APIKeyPrefix and apiKeys is not nil, a JWT
+// access token otherwise.
+func authenticate(
+	ctx context.Context,
+	jwtService auth.JWTService,
+	revoker auth.TokenRevoker,
+	apiKeys auth.APIKeyAuthenticator,
+) (*auth.Claims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errMetadataNotFound
+	}
+
+	values := md["authorization"]
+	if len(values) == 0 {
+		return nil, errTokenNotFound
+	}
+
+	token := values[0]
+	token = strings.TrimPrefix(token, "Bearer ")
+
+	if apiKeys != nil && auth.IsAPIKey(token) {
+		claims, err := apiKeys.AuthenticateAPIKey(ctx, token)
+		if err != nil {
+			if !errors.Is(err, auth.ErrInvalidAPIKey) && !errors.Is(err, auth.ErrExpiredToken) {
+				slog.WarnContext(ctx, "failed to authenticate api key",
+					slog.String("error", err.Error()),
+				)
+			}
+			return nil, errTokenInvalid
+		}
+		return claims, nil
+	}
+
+	claims, err := jwtService.ValidateToken(token)
+	if err != nil {
+		return nil, errTokenInvalid
+	}
+
+	// Check token revocation list
+	if revoker != nil && claims.ID != "" {
+		revoked, revokeErr := revoker.IsRevoked(ctx, claims.ID)
+		if revokeErr != nil {
+			slog.WarnContext(ctx, "failed to check token revocation, allowing request",
+				slog.String("jti", claims.ID),
+				slog.String("error", revokeErr.Error()),
+			)
+		} else if revoked {
+			return nil, errTokenInvalid
+		}
+	}
+
+	return claims, nil
+}
+
 // ctxValue retrieves a typed value from a context, returning the zero value if absent.
 func ctxValue[T any](ctx context.Context, key any) T {
 	val, _ := ctx.Value(key).(T)
@@ -123,19 +168,23 @@ func ClaimsFromContext(ctx context.Context) *auth.Claims {
 	return ctxValue[*auth.Claims](ctx, claimsCtxKey)
 }
 
-// ContextWithClaims creates a context with the given claims.
+// ContextWithClaims creates a context with the given claims and their tenant.
 // This is primarily for testing purposes.
 func ContextWithClaims(ctx context.Context, claims *auth.Claims) context.Context {
 	ctx = context.WithValue(ctx, claimsCtxKey, claims)
 	if claims != nil {
 		ctx = context.WithValue(ctx, usernameCtxKey, claims.Username)
+		ctx = tenant.WithID(ctx, claims.TenantID)
 	}
 	return ctx
 }
 
-// StreamAuthMiddleware checks for Bearer authentication and validates JWT tokens on stream RPCs.
+// StreamAuthMiddleware checks for Bearer authentication and validates JWT
+// tokens, or API keys when apiKeys is not nil, on stream RPCs.
 // The revoker is optional; pass nil to disable revocation checks.
-func StreamAuthMiddleware(jwtService auth.JWTService, revoker auth.TokenRevoker) grpc.StreamServerInterceptor {
+func StreamAuthMiddleware(
+	jwtService auth.JWTService, revoker auth.TokenRevoker, apiKeys auth.APIKeyAuthenticator,
+) grpc.StreamServerInterceptor {
 	return func(
 		srv any,
 		ss grpc.ServerStream,
@@ -144,41 +193,20 @@ func StreamAuthMiddleware(jwtService auth.JWTService, revoker auth.TokenRevoker)
 	) error {
 		ctx := ss.Context()
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return errMetadataNotFound
-		}
-
-		values := md["authorization"]
-		if len(values) == 0 {
-			return errTokenNotFound
-		}
-
-		token := values[0]
-		token = strings.TrimPrefix(token, "Bearer ")
-
-		claims, err := jwtService.ValidateToken(token)
+		claims, err := authenticate(ctx, jwtService, revoker, apiKeys)
 		if err != nil {
-			return errTokenInvalid
-		}
-
-		// Check token revocation list
-		if revoker != nil && claims.ID != "" {
-			revoked, revokeErr := revoker.IsRevoked(ctx, claims.ID)
-			if revokeErr != nil {
-				slog.WarnContext(ctx, "failed to check token revocation, allowing request",
-					slog.String("jti", claims.ID),
-					slog.String("error", revokeErr.Error()),
-				)
-			} else if revoked {
-				return errTokenInvalid
-			}
+			return err
 		}
 
 		// Add user info and claims to context for downstream handlers
 		ctx = context.WithValue(ctx, usernameCtxKey, claims.Username)
 		ctx = context.WithValue(ctx, claimsCtxKey, claims)
 
+		ctx, err = tenantContext(ctx, claims)
+		if err != nil {
+			return err
+		}
+
 		// Wrap the stream with the new context
 		wrapped := &wrappedServerStream{ServerStream: ss, ctx: ctx}
 		return handler(srv, wrapped)
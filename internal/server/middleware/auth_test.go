@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -31,7 +32,7 @@ func TestUnaryAuthMiddleware_IgnoredMethods(t *testing.T) {
 
 func TestUnaryAuthMiddleware_MissingMetadata(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	mockHandler := func(ctx context.Context, req any) (any, error) {
 		t.Fatal("handler should not be called")
@@ -54,7 +55,7 @@ func TestUnaryAuthMiddleware_MissingMetadata(t *testing.T) {
 
 func TestUnaryAuthMiddleware_MissingToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	mockHandler := func(ctx context.Context, req any) (any, error) {
 		t.Fatal("handler should not be called")
@@ -81,7 +82,7 @@ func TestUnaryAuthMiddleware_MissingToken(t *testing.T) {
 
 func TestUnaryAuthMiddleware_InvalidToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	mockHandler := func(ctx context.Context, req any) (any, error) {
 		t.Fatal("handler should not be called")
@@ -130,10 +131,10 @@ func TestUnaryAuthMiddleware_InvalidToken(t *testing.T) {
 func TestUnaryAuthMiddleware_ExpiredToken(t *testing.T) {
 	// Create JWT service with very short expiration
 	jwtService := auth.NewJWTService("test-secret", 100*time.Millisecond)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	// Generate token
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	assert.NoError(t, err)
 
 	// Wait for token to expire
@@ -163,10 +164,10 @@ func TestUnaryAuthMiddleware_ExpiredToken(t *testing.T) {
 
 func TestUnaryAuthMiddleware_ValidToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	// Generate valid token
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	assert.NoError(t, err)
 
 	tests := []struct {
@@ -217,12 +218,12 @@ func TestUnaryAuthMiddleware_ValidToken(t *testing.T) {
 func TestUnaryAuthMiddleware_WrongSecret(t *testing.T) {
 	// Create token with one secret
 	jwtService1 := auth.NewJWTService("secret1", 15*time.Minute)
-	token, err := jwtService1.GenerateToken("testuser")
+	token, err := jwtService1.GenerateToken(tenant.DefaultID, "testuser")
 	assert.NoError(t, err)
 
 	// Try to validate with different secret
 	jwtService2 := auth.NewJWTService("secret2", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService2, nil)
+	middleware := UnaryAuthMiddleware(jwtService2, nil, nil)
 
 	mockHandler := func(ctx context.Context, req any) (any, error) {
 		t.Fatal("handler should not be called")
@@ -248,10 +249,10 @@ func TestUnaryAuthMiddleware_WrongSecret(t *testing.T) {
 
 func TestUnaryAuthMiddleware_MultipleAuthorizationHeaders(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	middleware := UnaryAuthMiddleware(jwtService, nil)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
 
 	// Generate valid token
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	assert.NoError(t, err)
 
 	handlerCalled := false
@@ -299,7 +300,7 @@ func (s *stubRevoker) IsRevoked(_ context.Context, jti string) (bool, error) {
 
 func TestUnaryAuthMiddleware_RevokedToken(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Validate once to extract the JTI
@@ -307,7 +308,7 @@ func TestUnaryAuthMiddleware_RevokedToken(t *testing.T) {
 	require.NoError(t, err)
 
 	revoker := &stubRevoker{revokedJTIs: map[string]bool{claims.ID: true}}
-	mw := UnaryAuthMiddleware(jwtService, revoker)
+	mw := UnaryAuthMiddleware(jwtService, revoker, nil)
 
 	mockHandler := func(ctx context.Context, req any) (any, error) {
 		t.Fatal("handler should not be called for a revoked token")
@@ -328,12 +329,12 @@ func TestUnaryAuthMiddleware_RevokedToken(t *testing.T) {
 
 func TestUnaryAuthMiddleware_RevokerError(t *testing.T) {
 	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
-	token, err := jwtService.GenerateToken("testuser")
+	token, err := jwtService.GenerateToken(tenant.DefaultID, "testuser")
 	require.NoError(t, err)
 
 	// Revoker returns an error — middleware should allow through (fail open)
 	revoker := &stubRevoker{revokeErr: errors.New("redis unavailable")}
-	mw := UnaryAuthMiddleware(jwtService, revoker)
+	mw := UnaryAuthMiddleware(jwtService, revoker, nil)
 
 	handlerCalled := false
 	mockHandler := func(ctx context.Context, req any) (any, error) {
@@ -352,6 +353,74 @@ func TestUnaryAuthMiddleware_RevokerError(t *testing.T) {
 	assert.True(t, handlerCalled, "should allow through when revoker errors")
 }
 
+// stubAPIKeyAuthenticator accepts a single key.
+type stubAPIKeyAuthenticator struct {
+	key    string
+	claims *auth.Claims
+	err    error
+}
+
+func (s *stubAPIKeyAuthenticator) AuthenticateAPIKey(_ context.Context, key string) (*auth.Claims, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if key != s.key {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	return s.claims, nil
+}
+
+func TestUnaryAuthMiddleware_APIKey(t *testing.T) {
+	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
+	apiKeys := &stubAPIKeyAuthenticator{
+		key: "gode_valid",
+		claims: &auth.Claims{
+			TenantID:  "lab",
+			Username:  "robot",
+			TokenType: auth.APIKeyToken,
+			Scopes:    []auth.Scope{auth.ScopeDERead},
+		},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.v1.SomeService/ProtectedMethod"}
+	call := func(mw grpc.UnaryServerInterceptor, token string) (*auth.Claims, error) {
+		var claims *auth.Claims
+		handler := func(ctx context.Context, req any) (any, error) {
+			claims = ClaimsFromContext(ctx)
+			assert.Equal(t, "robot", UsernameFromContext(ctx))
+			assert.Equal(t, "lab", tenant.IDFromContext(ctx))
+			return "ok", nil
+		}
+		md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := mw(ctx, nil, info, handler)
+		return claims, err
+	}
+
+	t.Run("valid key", func(t *testing.T) {
+		claims, err := call(UnaryAuthMiddleware(jwtService, nil, apiKeys), "gode_valid")
+		require.NoError(t, err)
+		require.NotNil(t, claims)
+		assert.Equal(t, auth.APIKeyToken, claims.TokenType)
+		assert.True(t, claims.HasScope(auth.ScopeDERead))
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := call(UnaryAuthMiddleware(jwtService, nil, apiKeys), "gode_unknown")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("authenticator failure", func(t *testing.T) {
+		failing := &stubAPIKeyAuthenticator{err: errors.New("database unavailable")}
+		_, err := call(UnaryAuthMiddleware(jwtService, nil, failing), "gode_valid")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("keys are not accepted without an authenticator", func(t *testing.T) {
+		_, err := call(UnaryAuthMiddleware(jwtService, nil, nil), "gode_valid")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
 func TestClaimsFromContext(t *testing.T) {
 	t.Run("returns nil when no claims in context", func(t *testing.T) {
 		ctx := context.Background()
@@ -38,6 +38,7 @@ func DefaultCORSConfig() CORSConfig {
 			"Content-Language",
 			"Origin",
 			"Authorization",
+			"X-Tenant-ID",
 			"X-Request-ID",
 			"X-Correlation-ID",
 		},
@@ -66,6 +67,7 @@ func ProductionCORSConfig(allowedOrigins []string) CORSConfig {
 			"Accept",
 			"Content-Type",
 			"Authorization",
+			"X-Tenant-ID",
 			"X-Request-ID",
 		},
 		ExposedHeaders: []string{
@@ -261,9 +261,14 @@ func (rl *RateLimiter) UnaryAuthRateLimitMiddleware() grpc.UnaryServerIntercepto
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		// Only apply to auth endpoints
-		if info.FullMethod != "/api.v1.AuthService/Login" &&
-			info.FullMethod != "/api.v1.AuthService/Register" {
+		// Only apply to auth endpoints, single sign-on logins count as
+		// logins. Device logins are limited when started, not when polled.
+		switch info.FullMethod {
+		case "/api.v1.AuthService/Login",
+			"/api.v1.AuthService/Register",
+			"/api.v1.OIDCService/ExchangeCode",
+			"/api.v1.OIDCService/StartDeviceLogin":
+		default:
 			return handler(ctx, req)
 		}
 
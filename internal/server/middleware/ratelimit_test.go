@@ -152,6 +152,32 @@ func TestRateLimiter_UnaryAuthRateLimitMiddleware(t *testing.T) {
 	})
 }
 
+func TestRateLimiter_UnaryAuthRateLimitMiddleware_OIDC(t *testing.T) {
+	rl := NewRateLimiter(60, 60, 10, 3, 100)
+	middleware := rl.UnaryAuthRateLimitMiddleware()
+	mockHandler := func(ctx context.Context, req any) (any, error) { return "response", nil }
+	call := func(method string) error {
+		_, err := middleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, mockHandler)
+		return err
+	}
+
+	// Single sign-on logins share the login limiter
+	require.NoError(t, call("/api.v1.OIDCService/ExchangeCode"))
+	require.NoError(t, call("/api.v1.OIDCService/StartDeviceLogin"))
+	err := call("/api.v1.OIDCService/ExchangeCode")
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Contains(t, st.Message(), "too many login attempts")
+
+	// Device logins are polled until the user completes them
+	for range 10 {
+		assert.NoError(t, call("/api.v1.OIDCService/PollDeviceLogin"))
+		assert.NoError(t, call("/api.v1.OIDCService/GetConfig"))
+	}
+}
+
 func TestRateLimiter_UnaryDERateLimitMiddleware(t *testing.T) {
 	// Create rate limiter with low limits for testing
 	rl := NewRateLimiter(5, 3, 60, 2, 100) // 60 DE per minute = 1 per second, max 2 concurrent
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantHeader is the metadata key naming the tenant of a request, the HTTP
+// gateway forwards it from the X-Tenant-ID header.
+const TenantHeader = "x-tenant-id"
+
+var (
+	errTenantInvalid = status.Errorf(
+		codes.InvalidArgument, "tenant id is invalid",
+	)
+	errTenantMismatch = status.Errorf(
+		codes.PermissionDenied, "token does not belong to the requested tenant",
+	)
+)
+
+// GatewayHeaderMatcher forwards the tenant header to the gRPC server on top
+// of the headers the gateway forwards by default.
+func GatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, TenantHeader) {
+		return TenantHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// tenantContext stores the tenant of the request in ctx. Authenticated
+// requests belong to the tenant of their token and a header naming another
+// one is rejected, unauthenticated ones, such as Login and Register, to the
+// tenant of the header. Without either the request goes to the default tenant.
+func tenantContext(ctx context.Context, claims *auth.Claims) (context.Context, error) {
+	var requested string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(TenantHeader); len(values) > 0 {
+			requested = values[0]
+			if err := tenant.ValidateID(requested); err != nil {
+				return nil, errTenantInvalid
+			}
+		}
+	}
+
+	id := requested
+	if claims != nil {
+		id = claims.TenantID
+		if id == "" {
+			id = tenant.DefaultID
+		}
+		if requested != "" && requested != id {
+			return nil, errTenantMismatch
+		}
+	}
+	return tenant.WithID(ctx, id), nil
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/server/auth"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryAuthMiddleware_Tenant(t *testing.T) {
+	jwtService := auth.NewJWTService("test-secret", 15*time.Minute)
+	middleware := UnaryAuthMiddleware(jwtService, nil, nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.v1.SomeService/ProtectedMethod"}
+
+	labToken, err := jwtService.GenerateToken("lab", "testuser")
+	require.NoError(t, err)
+	defaultToken, err := jwtService.GenerateToken("", "testuser")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		token      string
+		header     string
+		wantTenant string
+		wantCode   codes.Code
+	}{
+		{name: "tenant of the token", token: labToken, wantTenant: "lab"},
+		{name: "header matching the token", token: labToken, header: "lab", wantTenant: "lab"},
+		{name: "token without tenant", token: defaultToken, wantTenant: tenant.DefaultID},
+		{name: "header naming another tenant", token: labToken, header: "acme", wantCode: codes.PermissionDenied},
+		{name: "invalid header", token: labToken, header: "Lab:1", wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.Pairs("authorization", "Bearer "+tt.token)
+			if tt.header != "" {
+				md.Set(TenantHeader, tt.header)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			var tenantID string
+			_, err := middleware(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				tenantID = tenant.IDFromContext(ctx)
+				return nil, nil
+			})
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTenant, tenantID)
+		})
+	}
+}
+
+func TestTenantContext_Unauthenticated(t *testing.T) {
+	ctx, err := tenantContext(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, tenant.DefaultID, tenant.IDFromContext(ctx))
+
+	md := metadata.Pairs(TenantHeader, "acme")
+	ctx, err = tenantContext(metadata.NewIncomingContext(context.Background(), md), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", tenant.IDFromContext(ctx))
+}
+
+func TestGatewayHeaderMatcher(t *testing.T) {
+	key, ok := GatewayHeaderMatcher("X-Tenant-Id")
+	assert.True(t, ok)
+	assert.Equal(t, TenantHeader, key)
+
+	key, ok = GatewayHeaderMatcher("Authorization")
+	assert.True(t, ok)
+	assert.Equal(t, "grpcgateway-Authorization", key)
+
+	_, ok = GatewayHeaderMatcher("X-Unknown")
+	assert.False(t, ok)
+}
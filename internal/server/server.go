@@ -16,6 +16,7 @@ import (
 	"github.com/nicholaspcr/GoDE/internal/store"
 	"github.com/nicholaspcr/GoDE/internal/telemetry"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/problems/external"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
 )
 
@@ -52,33 +53,17 @@ func New(ctx context.Context, cfg Config, opts ...serverOpts) (Server, error) {
 		return nil, fmt.Errorf("store must be provided via WithStore option")
 	}
 
-	srv.executor = executor.New(executor.Config{
-		Store:                srv.st,
-		MaxWorkers:           cfg.Executor.MaxWorkers,
-		MaxVectorsInProgress: cfg.Executor.MaxVectorsInProgress,
-		ExecutionTTL:         cfg.Executor.ExecutionTTL,
-		ResultTTL:            cfg.Executor.ResultTTL,
-		ProgressTTL:          cfg.Executor.ProgressTTL,
-		DefaultMaxExecution:  cfg.Executor.DefaultMaxExecution,
-		Metrics:              srv.metrics,
-	})
-
-	// Register all problems and variants
-	problemMetas := problems.DefaultRegistry.ListMetadata()
-	for _, meta := range problemMetas {
-		// Create problem instance with default dimensions (will be overridden per execution)
-		prob, err := problems.DefaultRegistry.Create(meta.Name, 10, 2)
-		if err == nil {
-			srv.executor.RegisterProblem(meta.Name, prob)
-		}
+	exec, err := newExecutor(cfg, srv.st, srv.metrics)
+	if err != nil {
+		return nil, err
 	}
+	srv.executor = exec
+	srv.apiKeys = auth.NewAPIKeyAuthenticator(srv.st)
 
-	variantMetas := variants.DefaultRegistry.ListMetadata()
-	for _, meta := range variantMetas {
-		variant, err := variants.DefaultRegistry.Create(meta.Name)
-		if err == nil {
-			srv.executor.RegisterVariant(meta.Name, variant)
-		}
+	// Single sign-on is optional, its handler reports it as disabled
+	var oidcProvider auth.OIDCProvider
+	if cfg.OIDC.Enabled() {
+		oidcProvider = auth.NewOIDCProvider(cfg.OIDC)
 	}
 
 	// Create handlers with dependencies
@@ -87,6 +72,10 @@ func New(ctx context.Context, cfg Config, opts ...serverOpts) (Server, error) {
 		handlers.NewUserHandler(srv.st),
 		handlers.NewParetoHandler(srv.st),
 		handlers.NewDEHandler(srv.st, srv.executor),
+		handlers.NewExperimentHandler(srv.st),
+		handlers.NewAdminHandler(srv.st, srv.executor),
+		handlers.NewAPIKeyHandler(srv.st),
+		handlers.NewOIDCHandler(srv.st, oidcProvider, jwtService, cfg.JWTExpiry),
 	}
 
 	return srv, nil
@@ -96,6 +85,7 @@ type server struct {
 	st         store.Store
 	jwtService auth.JWTService
 	revoker    auth.TokenRevoker
+	apiKeys    auth.APIKeyAuthenticator
 	handlers   []handlers.Handler
 	cfg        Config
 	metrics    *telemetry.Metrics
@@ -114,6 +104,14 @@ func (s *server) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Claim queued executions, including the ones a previous run left behind.
+	// API-only servers leave them to the workers.
+	if s.cfg.APIOnly {
+		slog.Info("API-only mode, executions are run by deserver workers")
+	} else {
+		s.executor.Start()
+	}
+
 	if err := lifecycle.run(ctx); err != nil {
 		cancel()
 		return err
@@ -124,6 +122,58 @@ func (s *server) Start(ctx context.Context) error {
 	return lifecycle.shutdown(ctx)
 }
 
+// newExecutor creates the executor of the executions in st, with all problems
+// and variants registered. The external problems of cfg are added to the
+// default registry first so the API lists them with the built-in ones.
+func newExecutor(cfg Config, st store.Store, metrics *telemetry.Metrics) (*executor.Executor, error) {
+	for _, p := range cfg.ExternalProblems {
+		if err := external.Register(problems.DefaultRegistry, p); err != nil {
+			return nil, fmt.Errorf("failed to register external problem: %w", err)
+		}
+	}
+
+	exec := executor.New(executor.Config{
+		Store:                st,
+		MaxWorkers:           cfg.Executor.MaxWorkers,
+		MaxVectorsInProgress: cfg.Executor.MaxVectorsInProgress,
+		ExecutionTTL:         cfg.Executor.ExecutionTTL,
+		ResultTTL:            cfg.Executor.ResultTTL,
+		ProgressTTL:          cfg.Executor.ProgressTTL,
+		DefaultMaxExecution:  cfg.Executor.DefaultMaxExecution,
+		EvaluationWorkers:    cfg.Executor.EvaluationWorkers,
+		SharedEvaluationPool: cfg.Executor.SharedEvaluationPool,
+		WorkerID:             cfg.Executor.WorkerID,
+		MaxAttempts:          cfg.Executor.MaxAttempts,
+		JobLease:             cfg.Executor.JobLease,
+		PollInterval:         cfg.Executor.PollInterval,
+		CheckpointInterval:   cfg.Executor.CheckpointInterval,
+		DefaultTenantQuota:   cfg.Executor.DefaultTenantQuota,
+		TenantQuotas:         cfg.Executor.TenantQuotas,
+		ResultLimit:          cfg.DE.ResultLimiter,
+		Metrics:              metrics,
+	})
+
+	// Register all problems and variants
+	problemMetas := problems.DefaultRegistry.ListMetadata()
+	for _, meta := range problemMetas {
+		// Problems are created per execution with the requested dimensions
+		factory, err := problems.DefaultRegistry.GetFactory(meta.Name)
+		if err == nil {
+			exec.RegisterProblem(meta.Name, factory)
+		}
+	}
+
+	variantMetas := variants.DefaultRegistry.ListMetadata()
+	for _, meta := range variantMetas {
+		variant, err := variants.DefaultRegistry.Create(meta.Name)
+		if err == nil {
+			exec.RegisterVariant(meta.Name, variant)
+		}
+	}
+
+	return exec, nil
+}
+
 // InterceptorLogger adapts slog logger to interceptor logger.
 // This code is simple enough to be copied and not imported.
 func InterceptorLogger(l *slog.Logger) logging.Logger {
@@ -28,7 +28,7 @@ func TestNew(t *testing.T) {
 		assert.NotNil(t, s.st, "store should be set")
 		assert.NotNil(t, s.jwtService, "jwt service should be initialized")
 		assert.NotNil(t, s.executor, "executor should be initialized")
-		assert.Len(t, s.handlers, 4, "should have 4 handlers (auth, user, pareto, de)")
+		assert.Len(t, s.handlers, 8, "should have 8 handlers (auth, user, pareto, de, experiment, admin, api key, oidc)")
 	})
 
 	t.Run("returns error when store is not provided", func(t *testing.T) {
@@ -112,8 +112,8 @@ func TestNew(t *testing.T) {
 		s, ok := srv.(*server)
 		require.True(t, ok)
 
-		// Should have exactly 4 handlers
-		assert.Len(t, s.handlers, 4)
+		// Should have exactly 7 handlers
+		assert.Len(t, s.handlers, 8)
 
 		// Verify handlers are not nil
 		for i, h := range s.handlers {
@@ -211,7 +211,7 @@ func TestServerIntegration_Construction(t *testing.T) {
 		assert.NotNil(t, s.jwtService)
 		assert.NotNil(t, s.executor)
 		assert.NotNil(t, s.handlers)
-		assert.Len(t, s.handlers, 4)
+		assert.Len(t, s.handlers, 8)
 	})
 
 	t.Run("server construction with custom ports", func(t *testing.T) {
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// NewWorker returns a compute worker. Workers claim the executions queued in
+// the store by the API servers, run them and report their progress and
+// results back through the store. They serve no API, only the health check
+// endpoints on the HTTP port.
+func NewWorker(cfg Config, opts ...serverOpts) (Server, error) {
+	srv := &server{cfg: cfg}
+
+	for _, opt := range opts {
+		opt(srv)
+	}
+
+	if srv.st == nil {
+		return nil, fmt.Errorf("store must be provided via WithStore option")
+	}
+
+	exec, err := newExecutor(cfg, srv.st, srv.metrics)
+	if err != nil {
+		return nil, err
+	}
+	srv.executor = exec
+
+	return &worker{server: srv}, nil
+}
+
+// worker runs executions on behalf of API-only servers.
+type worker struct {
+	*server
+}
+
+// Start claims executions until a shutdown signal arrives, then stops
+// claiming and puts the running executions back in the queue.
+func (w *worker) Start(ctx context.Context) error {
+	lifecycle := newLifecycle(w.cfg, w.server, w.executor)
+
+	slog.Info("Setting up worker components")
+	if err := lifecycle.setupTelemetry(ctx); err != nil {
+		return err
+	}
+	if err := lifecycle.setupPprof(); err != nil {
+		return err
+	}
+	lifecycle.setupHealthServer(ctx)
+
+	w.executor.Start()
+
+	lifecycle.runWorker(ctx)
+	return lifecycle.shutdown(ctx)
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/internal/store/mock"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/problems/external"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorker(t *testing.T) {
+	t.Run("creates a worker with an executor and no handlers", func(t *testing.T) {
+		st := &mock.MockStore{}
+
+		srv, err := NewWorker(DefaultConfig(), WithStore(st))
+		require.NoError(t, err)
+
+		w, ok := srv.(*worker)
+		require.True(t, ok, "server should be of type *worker")
+		assert.Equal(t, st, w.st)
+		assert.NotNil(t, w.executor)
+		assert.Empty(t, w.handlers)
+		assert.Nil(t, w.jwtService)
+	})
+
+	t.Run("registers external problems", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ExternalProblems = []external.Config{{Name: "worker-test-sim", Command: "python3", Objectives: 2}}
+
+		_, err := NewWorker(cfg, WithStore(&mock.MockStore{}))
+		require.NoError(t, err)
+
+		meta, ok := problems.DefaultRegistry.Get("worker-test-sim")
+		require.True(t, ok)
+		assert.Equal(t, external.Category, meta.Category)
+		assert.Equal(t, 2, meta.NumObjs)
+	})
+
+	t.Run("rejects external problems named as built-in ones", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.ExternalProblems = []external.Config{{Name: "zdt1", Command: "python3"}}
+
+		srv, err := NewWorker(cfg, WithStore(&mock.MockStore{}))
+		assert.Nil(t, srv)
+		assert.ErrorContains(t, err, "a built-in problem has the same name")
+	})
+
+	t.Run("returns error when store is not provided", func(t *testing.T) {
+		srv, err := NewWorker(DefaultConfig())
+		assert.Nil(t, srv)
+		assert.ErrorContains(t, err, "store must be provided")
+	})
+}
+
+func TestWorker_Start(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.HTTPPort = "127.0.0.1:0"
+	cfg.MetricsEnabled = false
+	cfg.TracingEnabled = false
+	cfg.SLOEnabled = false
+
+	srv, err := NewWorker(cfg, WithStore(&mock.MockStore{}))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, srv.Start(ctx), "a cancelled context shuts the worker down")
+}
+
+func TestLifecycle_SetupHealthServer(t *testing.T) {
+	tests := []struct {
+		name           string
+		endpoint       string
+		dbHealthy      bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "health endpoint returns UP",
+			endpoint:       "/health",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"status":"UP"}`,
+		},
+		{
+			name:           "readiness returns UP when DB healthy",
+			endpoint:       "/readiness",
+			dbHealthy:      true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"status":"UP"}`,
+		},
+		{
+			name:           "readiness returns DOWN when DB unhealthy",
+			endpoint:       "/readiness",
+			dbHealthy:      false,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   `{"status":"DOWN","reason":"database unavailable"}`,
+		},
+		{
+			name:           "API routes are not served",
+			endpoint:       "/v1/de/executions",
+			dbHealthy:      true,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &mock.MockStore{
+				HealthCheckFn: func(ctx context.Context) error {
+					if tt.dbHealthy {
+						return nil
+					}
+					return assert.AnError
+				},
+			}
+			lc := newLifecycle(DefaultConfig(), &server{st: st}, &mockExecutor{})
+			lc.setupHealthServer(context.Background())
+
+			req := httptest.NewRequest("GET", tt.endpoint, nil)
+			w := httptest.NewRecorder()
+			lc.httpServer.Handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store/errors"
+)
+
+// Re-export API key errors from the errors package.
+var ErrAPIKeyNotFound = errors.ErrAPIKeyNotFound
+
+// APIKey is a long-lived credential of a user, only the hash of its secret is
+// stored.
+type APIKey struct {
+	ID       string
+	TenantID string
+	UserID   string
+	Name     string
+	// Prefix is the start of the key, shown to tell the keys apart.
+	Prefix    string
+	KeyHash   string
+	Scopes    []string
+	CreatedAt time.Time
+	ExpiresAt *time.Time // nil when the key does not expire
+}
@@ -0,0 +1,14 @@
+package store
+
+import "time"
+
+// Checkpoint is the latest saved state of one of the executions of a run.
+// The executor encodes the algorithm state in Data, the store only keeps
+// the newest checkpoint of every execution number.
+type Checkpoint struct {
+	ExecutionID     string
+	ExecutionNumber int
+	Generation      int
+	Data            []byte
+	UpdatedAt       time.Time
+}
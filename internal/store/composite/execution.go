@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 )
 
 // ExecutionStore combines Redis and database stores for execution operations.
@@ -102,9 +103,26 @@ func (s *ExecutionStore) UpdateExecutionResult(ctx context.Context, executionID
 	return nil
 }
 
+// RestartExecution restarts the execution in database and invalidates cache.
+func (s *ExecutionStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	// Update database first (source of truth)
+	if err := s.db.RestartExecution(ctx, executionID, config); err != nil {
+		return err
+	}
+
+	// Invalidate cache instead of updating to avoid stale data
+	if err := s.redis.DeleteExecution(ctx, executionID, ""); err != nil {
+		s.logger.Warn("failed to invalidate cache on restart",
+			slog.String("execution_id", executionID),
+			slog.Any("error", err))
+	}
+
+	return nil
+}
+
 // ListExecutions queries the database (source of truth for listing).
-func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
-	return s.db.ListExecutions(ctx, userID, status, limit, offset)
+func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return s.db.ListExecutions(ctx, userID, filter, limit, offset)
 }
 
 // DeleteExecution removes from both stores.
@@ -130,8 +148,13 @@ func (s *ExecutionStore) GetProgress(ctx context.Context, executionID string) (*
 	return s.redis.GetProgress(ctx, executionID)
 }
 
-// MarkExecutionForCancellation delegates to Redis only.
+// MarkExecutionForCancellation sets the cancellation flag in Redis. The
+// execution is read through the cache first, so that executions evicted from
+// Redis can still be cancelled by the workers polling the flag.
 func (s *ExecutionStore) MarkExecutionForCancellation(ctx context.Context, executionID, userID string) error {
+	if _, err := s.GetExecution(ctx, executionID, userID); err != nil {
+		return err
+	}
 	return s.redis.MarkExecutionForCancellation(ctx, executionID, userID)
 }
 
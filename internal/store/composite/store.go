@@ -3,6 +3,7 @@ package composite
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/cache/redis"
 	"github.com/nicholaspcr/GoDE/internal/store"
@@ -89,8 +90,12 @@ func (s *Store) UpdateExecutionResult(ctx context.Context, executionID string, p
 	return s.execStore.UpdateExecutionResult(ctx, executionID, paretoID)
 }
 
-func (s *Store) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
-	return s.execStore.ListExecutions(ctx, userID, status, limit, offset)
+func (s *Store) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	return s.execStore.RestartExecution(ctx, executionID, config)
+}
+
+func (s *Store) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return s.execStore.ListExecutions(ctx, userID, filter, limit, offset)
 }
 
 func (s *Store) DeleteExecution(ctx context.Context, executionID, userID string) error {
@@ -121,6 +126,134 @@ func (s *Store) GetExecutionByIdempotencyKey(ctx context.Context, userID, idempo
 	return s.execStore.GetExecutionByIdempotencyKey(ctx, userID, idempotencyKey)
 }
 
+// Job operations delegate to database
+func (s *Store) EnqueueJob(ctx context.Context, job *store.Job) error {
+	return s.db.EnqueueJob(ctx, job)
+}
+
+func (s *Store) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	return s.db.ClaimJob(ctx, workerID, skipTenants...)
+}
+
+func (s *Store) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	return s.db.HeartbeatJob(ctx, executionID, workerID)
+}
+
+func (s *Store) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	return s.db.RequeueJob(ctx, executionID, workerID)
+}
+
+func (s *Store) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	return s.db.CompleteJob(ctx, executionID, workerID)
+}
+
+func (s *Store) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	return s.db.ListJobs(ctx, status)
+}
+
+func (s *Store) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	return s.db.CountJobs(ctx, tenantID, statuses...)
+}
+
+// Checkpoint operations delegate to database
+func (s *Store) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	return s.db.SaveCheckpoint(ctx, checkpoint)
+}
+
+func (s *Store) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	return s.db.ListCheckpoints(ctx, executionID)
+}
+
+// Custom problem operations delegate to database
+func (s *Store) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	return s.db.SaveCustomProblem(ctx, userID, problem)
+}
+
+func (s *Store) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	return s.db.GetCustomProblem(ctx, userID, name)
+}
+
+func (s *Store) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	return s.db.ListCustomProblems(ctx, userID)
+}
+
+// Experiment operations delegate to database
+func (s *Store) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	return s.db.CreateExperiment(ctx, experiment)
+}
+
+func (s *Store) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	return s.db.GetExperiment(ctx, experimentID, userID)
+}
+
+func (s *Store) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	return s.db.ListExperiments(ctx, userID, limit, offset)
+}
+
+func (s *Store) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	return s.db.DeleteExperiment(ctx, experimentID, userID)
+}
+
+func (s *Store) CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+	return s.db.CountExperimentExecutions(ctx, userID, experimentIDs...)
+}
+
+func (s *Store) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	return s.db.ListExperimentResults(ctx, experimentID, userID)
+}
+
+// Admin operations delegate to database
+func (s *Store) ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error) {
+	return s.db.ListUsers(ctx, limit, offset)
+}
+
+func (s *Store) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	return s.db.ListAllExecutions(ctx, userID, filter, limit, offset)
+}
+
+func (s *Store) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	return s.db.FindExecution(ctx, executionID)
+}
+
+// PurgeExecutions deletes the executions from the database, then drops them
+// from Redis (best effort).
+func (s *Store) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	purged, err := s.db.PurgeExecutions(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+	for _, executionID := range purged {
+		_ = s.execStore.redis.DeleteExecution(ctx, executionID, "")
+	}
+	return purged, nil
+}
+
+// API key operations delegate to database
+func (s *Store) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	return s.db.CreateAPIKey(ctx, key)
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	return s.db.ListAPIKeys(ctx, userID)
+}
+
+func (s *Store) DeleteAPIKey(ctx context.Context, keyID, userID string) error {
+	return s.db.DeleteAPIKey(ctx, keyID, userID)
+}
+
+func (s *Store) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	return s.db.GetAPIKeyByHash(ctx, keyHash)
+}
+
+// Identity operations delegate to database
+func (s *Store) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	return s.db.CreateIdentityUser(ctx, identity, usr)
+}
+
+func (s *Store) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	return s.db.GetIdentity(ctx, issuer, subject)
+}
+
 // HealthCheck checks both database and Redis health.
 func (s *Store) HealthCheck(ctx context.Context) error {
 	// Check database health
@@ -20,7 +20,8 @@ type mockExecutionStore struct {
 	GetExecutionFn                 func(ctx context.Context, executionID, userID string) (*store.Execution, error)
 	UpdateExecutionStatusFn        func(ctx context.Context, executionID string, status store.ExecutionStatus, errorMsg string) error
 	UpdateExecutionResultFn        func(ctx context.Context, executionID string, paretoID uint64) error
-	ListExecutionsFn               func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error)
+	RestartExecutionFn             func(ctx context.Context, executionID string, config *api.DEConfig) error
+	ListExecutionsFn               func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
 	DeleteExecutionFn              func(ctx context.Context, executionID, userID string) error
 	SaveProgressFn                 func(ctx context.Context, progress *store.ExecutionProgress) error
 	GetProgressFn                  func(ctx context.Context, executionID string) (*store.ExecutionProgress, error)
@@ -33,6 +34,7 @@ type mockExecutionStore struct {
 	getExecutionCalls                 int
 	updateExecutionStatusCalls        int
 	updateExecutionResultCalls        int
+	restartExecutionCalls             int
 	listExecutionsCalls               int
 	deleteExecutionCalls              int
 	saveProgressCalls                 int
@@ -77,10 +79,18 @@ func (m *mockExecutionStore) UpdateExecutionResult(ctx context.Context, executio
 	return nil
 }
 
-func (m *mockExecutionStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (m *mockExecutionStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	m.restartExecutionCalls++
+	if m.RestartExecutionFn != nil {
+		return m.RestartExecutionFn(ctx, executionID, config)
+	}
+	return nil
+}
+
+func (m *mockExecutionStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	m.listExecutionsCalls++
 	if m.ListExecutionsFn != nil {
-		return m.ListExecutionsFn(ctx, userID, status, limit, offset)
+		return m.ListExecutionsFn(ctx, userID, filter, limit, offset)
 	}
 	return nil, 0, nil
 }
@@ -597,6 +607,40 @@ func TestExecutionStore_UpdateExecutionStatus(t *testing.T) {
 	}
 }
 
+func TestExecutionStore_RestartExecution(t *testing.T) {
+	t.Run("success - db restarts, cache invalidated", func(t *testing.T) {
+		redis := &mockExecutionStore{}
+		db := &mockExecutionStore{}
+		var restarted *api.DEConfig
+		db.RestartExecutionFn = func(ctx context.Context, executionID string, config *api.DEConfig) error {
+			restarted = config
+			return nil
+		}
+
+		s := NewExecutionStore(redis, db)
+		config := &api.DEConfig{Generations: 200}
+		require.NoError(t, s.RestartExecution(context.Background(), "exec-1", config))
+
+		assert.Same(t, config, restarted)
+		assert.Equal(t, 0, redis.restartExecutionCalls)
+		assert.Equal(t, 1, redis.deleteExecutionCalls)
+	})
+
+	t.Run("failure - db fails", func(t *testing.T) {
+		redis := &mockExecutionStore{}
+		db := &mockExecutionStore{}
+		db.RestartExecutionFn = func(ctx context.Context, executionID string, config *api.DEConfig) error {
+			return errors.New("database error")
+		}
+
+		s := NewExecutionStore(redis, db)
+		err := s.RestartExecution(context.Background(), "exec-1", &api.DEConfig{})
+
+		assert.ErrorContains(t, err, "database error")
+		assert.Equal(t, 0, redis.deleteExecutionCalls)
+	})
+}
+
 func TestExecutionStore_UpdateExecutionResult(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -720,7 +764,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 			limit:  50,
 			offset: 0,
 			setupDB: func(m *mockExecutionStore) {
-				m.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+				m.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 					return []*store.Execution{
 						createTestExecution("exec-1", userID, store.ExecutionStatusRunning),
 						createTestExecution("exec-2", userID, store.ExecutionStatusCompleted),
@@ -739,9 +783,9 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 			limit:  50,
 			offset: 0,
 			setupDB: func(m *mockExecutionStore) {
-				m.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
-					assert.NotNil(t, status)
-					assert.Equal(t, store.ExecutionStatusRunning, *status)
+				m.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+					assert.NotNil(t, filter.Status)
+					assert.Equal(t, store.ExecutionStatusRunning, *filter.Status)
 					return []*store.Execution{
 						createTestExecution("exec-1", userID, store.ExecutionStatusRunning),
 					}, 1, nil
@@ -759,7 +803,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 			limit:  50,
 			offset: 0,
 			setupDB: func(m *mockExecutionStore) {
-				m.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+				m.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 					return []*store.Execution{}, 0, nil
 				}
 			},
@@ -775,7 +819,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 			limit:  50,
 			offset: 0,
 			setupDB: func(m *mockExecutionStore) {
-				m.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+				m.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 					return nil, 0, errors.New("database error")
 				}
 			},
@@ -796,7 +840,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 			s := NewExecutionStore(redis, db)
 			ctx := context.Background()
 
-			executions, total, err := s.ListExecutions(ctx, tt.userID, tt.status, tt.limit, tt.offset)
+			executions, total, err := s.ListExecutions(ctx, tt.userID, store.ExecutionFilter{Status: tt.status}, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -1143,6 +1187,46 @@ func TestExecutionStore_MarkExecutionForCancellation(t *testing.T) {
 	}
 }
 
+func TestExecutionStore_MarkExecutionForCancellation_CacheMiss(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("populates the cache from the database", func(t *testing.T) {
+		redis := &mockExecutionStore{
+			GetExecutionFn: func(ctx context.Context, executionID, userID string) (*store.Execution, error) {
+				return nil, store.ErrExecutionNotFound
+			},
+		}
+		db := &mockExecutionStore{
+			GetExecutionFn: func(ctx context.Context, executionID, userID string) (*store.Execution, error) {
+				return &store.Execution{ID: executionID, UserID: userID, Status: store.ExecutionStatusRunning}, nil
+			},
+		}
+
+		s := NewExecutionStore(redis, db)
+		require.NoError(t, s.MarkExecutionForCancellation(ctx, "exec-1", "user-1"))
+		assert.Equal(t, 1, redis.createExecutionCalls)
+		assert.Equal(t, 1, redis.markExecutionForCancellationCalls)
+	})
+
+	t.Run("fails when the execution does not exist", func(t *testing.T) {
+		redis := &mockExecutionStore{
+			GetExecutionFn: func(ctx context.Context, executionID, userID string) (*store.Execution, error) {
+				return nil, store.ErrExecutionNotFound
+			},
+		}
+		db := &mockExecutionStore{
+			GetExecutionFn: func(ctx context.Context, executionID, userID string) (*store.Execution, error) {
+				return nil, store.ErrExecutionNotFound
+			},
+		}
+
+		s := NewExecutionStore(redis, db)
+		err := s.MarkExecutionForCancellation(ctx, "exec-1", "user-1")
+		assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+		assert.Equal(t, 0, redis.markExecutionForCancellationCalls)
+	})
+}
+
 func TestExecutionStore_IsExecutionCancelled(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1368,7 +1452,7 @@ func TestExecutionStore_EmptyUserID(t *testing.T) {
 	redis := &mockExecutionStore{}
 	db := &mockExecutionStore{}
 
-	db.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+	db.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 		if userID == "" {
 			return nil, 0, errors.New("empty user ID")
 		}
@@ -1378,7 +1462,7 @@ func TestExecutionStore_EmptyUserID(t *testing.T) {
 	s := NewExecutionStore(redis, db)
 	ctx := context.Background()
 
-	_, _, err := s.ListExecutions(ctx, "", nil, 50, 0)
+	_, _, err := s.ListExecutions(ctx, "", store.ExecutionFilter{}, 50, 0)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "empty user ID")
 }
@@ -1881,14 +1965,14 @@ func TestStore_ExecutionOperations_Direct(t *testing.T) {
 		dbMock := &mockStore{}
 		redisMock := &mockExecutionStore{}
 		expectedExecs := []*store.Execution{createTestExecution("exec-1", "user-1", store.ExecutionStatusRunning)}
-		dbMock.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+		dbMock.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 			return expectedExecs, 1, nil
 		}
 
 		st := createMockStoreWrapper(dbMock, redisMock)
 		ctx := context.Background()
 
-		execs, total, err := st.ListExecutions(ctx, "user-1", nil, 50, 0)
+		execs, total, err := st.ListExecutions(ctx, "user-1", store.ExecutionFilter{}, 50, 0)
 
 		assert.NoError(t, err)
 		assert.Len(t, execs, 1)
@@ -1996,6 +2080,301 @@ func TestStore_ExecutionOperations_Direct(t *testing.T) {
 	})
 }
 
+func TestStore_JobOperations_Direct(t *testing.T) {
+	t.Run("EnqueueJob and ClaimJob", func(t *testing.T) {
+		dbMock := &mockStore{}
+		var enqueued *store.Job
+		dbMock.EnqueueJobFn = func(ctx context.Context, job *store.Job) error {
+			enqueued = job
+			return nil
+		}
+		dbMock.ClaimJobFn = func(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+			return &store.Job{ExecutionID: enqueued.ExecutionID, WorkerID: workerID, Attempts: 1}, nil
+		}
+
+		st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+		ctx := context.Background()
+
+		require.NoError(t, st.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user-1"}))
+		job, err := st.ClaimJob(ctx, "worker-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "exec-1", job.ExecutionID)
+		assert.Equal(t, "worker-1", job.WorkerID)
+	})
+
+	t.Run("HeartbeatJob, RequeueJob and CompleteJob", func(t *testing.T) {
+		dbMock := &mockStore{}
+		var calls []string
+		dbMock.HeartbeatJobFn = func(ctx context.Context, executionID, workerID string) error {
+			calls = append(calls, "heartbeat:"+executionID+":"+workerID)
+			return nil
+		}
+		dbMock.RequeueJobFn = func(ctx context.Context, executionID, workerID string) error {
+			calls = append(calls, "requeue:"+executionID+":"+workerID)
+			return nil
+		}
+		dbMock.CompleteJobFn = func(ctx context.Context, executionID, workerID string) error {
+			calls = append(calls, "complete:"+executionID+":"+workerID)
+			return nil
+		}
+
+		st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+		ctx := context.Background()
+
+		require.NoError(t, st.HeartbeatJob(ctx, "exec-1", "worker-1"))
+		require.NoError(t, st.RequeueJob(ctx, "exec-1", "worker-1"))
+		require.NoError(t, st.CompleteJob(ctx, "exec-1", "worker-1"))
+
+		assert.Equal(t, []string{"heartbeat:exec-1:worker-1", "requeue:exec-1:worker-1", "complete:exec-1:worker-1"}, calls)
+	})
+
+	t.Run("ListJobs", func(t *testing.T) {
+		dbMock := &mockStore{}
+		expected := []*store.Job{{ExecutionID: "exec-1", Status: store.JobStatusClaimed}}
+		dbMock.ListJobsFn = func(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+			assert.Equal(t, store.JobStatusClaimed, status)
+			return expected, nil
+		}
+
+		st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+
+		jobs, err := st.ListJobs(context.Background(), store.JobStatusClaimed)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, jobs)
+	})
+
+	t.Run("CountJobs", func(t *testing.T) {
+		dbMock := &mockStore{}
+		dbMock.CountJobsFn = func(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+			assert.Equal(t, "acme", tenantID)
+			assert.Equal(t, []store.JobStatus{store.JobStatusQueued, store.JobStatusClaimed}, statuses)
+			return 2, nil
+		}
+
+		st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+
+		count, err := st.CountJobs(context.Background(), "acme", store.JobStatusQueued, store.JobStatusClaimed)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestStore_CheckpointOperations_Direct(t *testing.T) {
+	dbMock := &mockStore{}
+	var saved *store.Checkpoint
+	dbMock.SaveCheckpointFn = func(ctx context.Context, checkpoint *store.Checkpoint) error {
+		saved = checkpoint
+		return nil
+	}
+	dbMock.ListCheckpointsFn = func(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+		return []*store.Checkpoint{saved}, nil
+	}
+
+	st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+	ctx := context.Background()
+
+	checkpoint := &store.Checkpoint{ExecutionID: "exec-1", ExecutionNumber: 0, Generation: 10}
+	require.NoError(t, st.SaveCheckpoint(ctx, checkpoint))
+
+	checkpoints, err := st.ListCheckpoints(ctx, "exec-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*store.Checkpoint{checkpoint}, checkpoints)
+}
+
+func TestStore_CustomProblemOperations_Direct(t *testing.T) {
+	dbMock := &mockStore{}
+	saved := map[string]*api.CustomProblem{}
+	dbMock.SaveCustomProblemFn = func(ctx context.Context, userID string, problem *api.CustomProblem) error {
+		saved[userID+"/"+problem.Name] = problem
+		return nil
+	}
+	dbMock.GetCustomProblemFn = func(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+		if problem, ok := saved[userID+"/"+name]; ok {
+			return problem, nil
+		}
+		return nil, store.ErrCustomProblemNotFound
+	}
+	dbMock.ListCustomProblemsFn = func(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+		return []*api.CustomProblem{saved[userID+"/sphere"]}, nil
+	}
+
+	st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+	ctx := context.Background()
+
+	problem := &api.CustomProblem{Name: "sphere", Objectives: []string{"x[0]^2"}}
+	require.NoError(t, st.SaveCustomProblem(ctx, "user1", problem))
+
+	got, err := st.GetCustomProblem(ctx, "user1", "sphere")
+	require.NoError(t, err)
+	assert.Equal(t, problem, got)
+
+	_, err = st.GetCustomProblem(ctx, "user2", "sphere")
+	assert.ErrorIs(t, err, store.ErrCustomProblemNotFound)
+
+	problems, err := st.ListCustomProblems(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, []*api.CustomProblem{problem}, problems)
+}
+
+func TestStore_ExperimentOperations_Direct(t *testing.T) {
+	dbMock := &mockStore{}
+	experiments := map[string]*store.Experiment{}
+	dbMock.CreateExperimentFn = func(ctx context.Context, experiment *store.Experiment) error {
+		experiments[experiment.ID] = experiment
+		return nil
+	}
+	dbMock.GetExperimentFn = func(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+		if experiment, ok := experiments[experimentID]; ok && experiment.UserID == userID {
+			return experiment, nil
+		}
+		return nil, store.ErrExperimentNotFound
+	}
+	dbMock.ListExperimentsFn = func(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+		return []*store.Experiment{experiments["exp-1"]}, 1, nil
+	}
+	dbMock.DeleteExperimentFn = func(ctx context.Context, experimentID, userID string) error {
+		delete(experiments, experimentID)
+		return nil
+	}
+	dbMock.CountExperimentExecutionsFn = func(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+		return map[string]store.ExecutionCounts{experimentIDs[0]: {store.ExecutionStatusCompleted: 2}}, nil
+	}
+	dbMock.ListExperimentResultsFn = func(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+		return []*store.ExperimentResult{{ExecutionID: "exec-1"}}, nil
+	}
+
+	st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+	ctx := context.Background()
+
+	experiment := &store.Experiment{ID: "exp-1", UserID: "user1", Name: "variants"}
+	require.NoError(t, st.CreateExperiment(ctx, experiment))
+
+	got, err := st.GetExperiment(ctx, "exp-1", "user1")
+	require.NoError(t, err)
+	assert.Equal(t, experiment, got)
+
+	list, total, err := st.ListExperiments(ctx, "user1", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []*store.Experiment{experiment}, list)
+
+	counts, err := st.CountExperimentExecutions(ctx, "user1", "exp-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, counts["exp-1"][store.ExecutionStatusCompleted])
+
+	results, err := st.ListExperimentResults(ctx, "exp-1", "user1")
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	require.NoError(t, st.DeleteExperiment(ctx, "exp-1", "user1"))
+	_, err = st.GetExperiment(ctx, "exp-1", "user1")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound)
+}
+
+func TestStore_APIKeyOperations_Direct(t *testing.T) {
+	dbMock := &mockStore{}
+	keys := map[string]*store.APIKey{}
+	dbMock.CreateAPIKeyFn = func(ctx context.Context, key *store.APIKey) error {
+		keys[key.ID] = key
+		return nil
+	}
+	dbMock.ListAPIKeysFn = func(ctx context.Context, userID string) ([]*store.APIKey, error) {
+		return []*store.APIKey{keys["key-1"]}, nil
+	}
+	dbMock.DeleteAPIKeyFn = func(ctx context.Context, keyID, userID string) error {
+		delete(keys, keyID)
+		return nil
+	}
+	dbMock.GetAPIKeyByHashFn = func(ctx context.Context, keyHash string) (*store.APIKey, error) {
+		for _, key := range keys {
+			if key.KeyHash == keyHash {
+				return key, nil
+			}
+		}
+		return nil, store.ErrAPIKeyNotFound
+	}
+
+	st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+	ctx := context.Background()
+
+	key := &store.APIKey{ID: "key-1", UserID: "user1", Name: "ci", KeyHash: "hash"}
+	require.NoError(t, st.CreateAPIKey(ctx, key))
+
+	list, err := st.ListAPIKeys(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, []*store.APIKey{key}, list)
+
+	got, err := st.GetAPIKeyByHash(ctx, "hash")
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	require.NoError(t, st.DeleteAPIKey(ctx, "key-1", "user1"))
+	_, err = st.GetAPIKeyByHash(ctx, "hash")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound)
+}
+
+func TestStore_IdentityOperations_Direct(t *testing.T) {
+	dbMock := &mockStore{}
+	var created *store.Identity
+	dbMock.CreateIdentityUserFn = func(ctx context.Context, identity *store.Identity, usr *api.User) error {
+		identity.UserID = usr.Ids.Username
+		created = identity
+		return nil
+	}
+	dbMock.GetIdentityFn = func(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+		if created != nil && created.Issuer == issuer && created.Subject == subject {
+			return created, nil
+		}
+		return nil, store.ErrIdentityNotFound
+	}
+
+	st := createMockStoreWrapper(dbMock, &mockExecutionStore{})
+	ctx := context.Background()
+
+	_, err := st.GetIdentity(ctx, "https://idp.example.edu", "sub-1")
+	assert.ErrorIs(t, err, store.ErrIdentityNotFound)
+
+	identity := &store.Identity{Issuer: "https://idp.example.edu", Subject: "sub-1"}
+	require.NoError(t, st.CreateIdentityUser(ctx, identity, &api.User{Ids: &api.UserIDs{Username: "alice"}}))
+	assert.Equal(t, "alice", identity.UserID)
+
+	got, err := st.GetIdentity(ctx, "https://idp.example.edu", "sub-1")
+	require.NoError(t, err)
+	assert.Equal(t, identity, got)
+}
+
+func TestStore_PurgeExecutions(t *testing.T) {
+	cutoff := time.Now().Add(-time.Hour)
+	dbMock := &mockStore{}
+	dbMock.PurgeExecutionsFn = func(ctx context.Context, before time.Time) ([]string, error) {
+		assert.Equal(t, cutoff, before)
+		return []string{"exec-1", "exec-2"}, nil
+	}
+	var evicted []string
+	redisMock := &mockExecutionStore{}
+	redisMock.DeleteExecutionFn = func(ctx context.Context, executionID, userID string) error {
+		evicted = append(evicted, executionID)
+		return errors.New("redis unavailable")
+	}
+
+	st := createMockStoreWrapper(dbMock, redisMock)
+	purged, err := st.PurgeExecutions(context.Background(), cutoff)
+	require.NoError(t, err, "cache failures do not fail the purge")
+	assert.Equal(t, []string{"exec-1", "exec-2"}, purged)
+	assert.Equal(t, purged, evicted)
+
+	dbMock.PurgeExecutionsFn = func(ctx context.Context, before time.Time) ([]string, error) {
+		return nil, errors.New("database unavailable")
+	}
+	evicted = nil
+	_, err = st.PurgeExecutions(context.Background(), cutoff)
+	assert.Error(t, err)
+	assert.Empty(t, evicted)
+}
+
 func TestStore_HealthCheck_Direct(t *testing.T) {
 	t.Run("db health check failure", func(t *testing.T) {
 		dbMock := &mockStore{}
@@ -2052,7 +2431,8 @@ type mockStore struct {
 	GetExecutionFn                 func(ctx context.Context, executionID, userID string) (*store.Execution, error)
 	UpdateExecutionStatusFn        func(ctx context.Context, executionID string, status store.ExecutionStatus, errorMsg string) error
 	UpdateExecutionResultFn        func(ctx context.Context, executionID string, paretoID uint64) error
-	ListExecutionsFn               func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error)
+	RestartExecutionFn             func(ctx context.Context, executionID string, config *api.DEConfig) error
+	ListExecutionsFn               func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
 	DeleteExecutionFn              func(ctx context.Context, executionID, userID string) error
 	SaveProgressFn                 func(ctx context.Context, progress *store.ExecutionProgress) error
 	GetProgressFn                  func(ctx context.Context, executionID string) (*store.ExecutionProgress, error)
@@ -2060,6 +2440,48 @@ type mockStore struct {
 	IsExecutionCancelledFn         func(ctx context.Context, executionID string) (bool, error)
 	SubscribeFn                    func(ctx context.Context, channel string) (<-chan []byte, error)
 
+	// Job operations
+	EnqueueJobFn   func(ctx context.Context, job *store.Job) error
+	ClaimJobFn     func(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error)
+	HeartbeatJobFn func(ctx context.Context, executionID, workerID string) error
+	RequeueJobFn   func(ctx context.Context, executionID, workerID string) error
+	CompleteJobFn  func(ctx context.Context, executionID, workerID string) error
+	ListJobsFn     func(ctx context.Context, status store.JobStatus) ([]*store.Job, error)
+	CountJobsFn    func(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error)
+
+	// Checkpoint operations
+	SaveCheckpointFn  func(ctx context.Context, checkpoint *store.Checkpoint) error
+	ListCheckpointsFn func(ctx context.Context, executionID string) ([]*store.Checkpoint, error)
+
+	// Custom problem operations
+	SaveCustomProblemFn  func(ctx context.Context, userID string, problem *api.CustomProblem) error
+	GetCustomProblemFn   func(ctx context.Context, userID, name string) (*api.CustomProblem, error)
+	ListCustomProblemsFn func(ctx context.Context, userID string) ([]*api.CustomProblem, error)
+
+	// Experiment operations
+	CreateExperimentFn          func(ctx context.Context, experiment *store.Experiment) error
+	GetExperimentFn             func(ctx context.Context, experimentID, userID string) (*store.Experiment, error)
+	ListExperimentsFn           func(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error)
+	DeleteExperimentFn          func(ctx context.Context, experimentID, userID string) error
+	CountExperimentExecutionsFn func(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error)
+	ListExperimentResultsFn     func(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error)
+
+	// Admin operations
+	ListUsersFn         func(ctx context.Context, limit, offset int) ([]*api.User, int, error)
+	ListAllExecutionsFn func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
+	FindExecutionFn     func(ctx context.Context, executionID string) (*store.Execution, error)
+	PurgeExecutionsFn   func(ctx context.Context, before time.Time) ([]string, error)
+
+	// API key operations
+	CreateAPIKeyFn    func(ctx context.Context, key *store.APIKey) error
+	ListAPIKeysFn     func(ctx context.Context, userID string) ([]*store.APIKey, error)
+	DeleteAPIKeyFn    func(ctx context.Context, keyID, userID string) error
+	GetAPIKeyByHashFn func(ctx context.Context, keyHash string) (*store.APIKey, error)
+
+	// Identity operations
+	CreateIdentityUserFn func(ctx context.Context, identity *store.Identity, usr *api.User) error
+	GetIdentityFn        func(ctx context.Context, issuer, subject string) (*store.Identity, error)
+
 	HealthCheckFn func(ctx context.Context) error
 
 	// Call tracking
@@ -2196,9 +2618,16 @@ func (m *mockStore) UpdateExecutionResult(ctx context.Context, executionID strin
 	return nil
 }
 
-func (m *mockStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (m *mockStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	if m.RestartExecutionFn != nil {
+		return m.RestartExecutionFn(ctx, executionID, config)
+	}
+	return nil
+}
+
+func (m *mockStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	if m.ListExecutionsFn != nil {
-		return m.ListExecutionsFn(ctx, userID, status, limit, offset)
+		return m.ListExecutionsFn(ctx, userID, filter, limit, offset)
 	}
 	return nil, 0, nil
 }
@@ -2251,6 +2680,202 @@ func (m *mockStore) GetExecutionByIdempotencyKey(_ context.Context, _, _ string)
 	return "", nil
 }
 
+func (m *mockStore) EnqueueJob(ctx context.Context, job *store.Job) error {
+	if m.EnqueueJobFn != nil {
+		return m.EnqueueJobFn(ctx, job)
+	}
+	return nil
+}
+
+func (m *mockStore) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	if m.ClaimJobFn != nil {
+		return m.ClaimJobFn(ctx, workerID, skipTenants...)
+	}
+	return nil, store.ErrNoJobAvailable
+}
+
+func (m *mockStore) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	if m.HeartbeatJobFn != nil {
+		return m.HeartbeatJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+func (m *mockStore) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	if m.RequeueJobFn != nil {
+		return m.RequeueJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+func (m *mockStore) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	if m.CompleteJobFn != nil {
+		return m.CompleteJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+func (m *mockStore) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	if m.ListJobsFn != nil {
+		return m.ListJobsFn(ctx, status)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	if m.CountJobsFn != nil {
+		return m.CountJobsFn(ctx, tenantID, statuses...)
+	}
+	return 0, nil
+}
+
+func (m *mockStore) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	if m.SaveCheckpointFn != nil {
+		return m.SaveCheckpointFn(ctx, checkpoint)
+	}
+	return nil
+}
+
+func (m *mockStore) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	if m.ListCheckpointsFn != nil {
+		return m.ListCheckpointsFn(ctx, executionID)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	if m.SaveCustomProblemFn != nil {
+		return m.SaveCustomProblemFn(ctx, userID, problem)
+	}
+	return nil
+}
+
+func (m *mockStore) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	if m.GetCustomProblemFn != nil {
+		return m.GetCustomProblemFn(ctx, userID, name)
+	}
+	return nil, store.ErrCustomProblemNotFound
+}
+
+func (m *mockStore) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	if m.ListCustomProblemsFn != nil {
+		return m.ListCustomProblemsFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	if m.CreateExperimentFn != nil {
+		return m.CreateExperimentFn(ctx, experiment)
+	}
+	return nil
+}
+
+func (m *mockStore) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	if m.GetExperimentFn != nil {
+		return m.GetExperimentFn(ctx, experimentID, userID)
+	}
+	return nil, store.ErrExperimentNotFound
+}
+
+func (m *mockStore) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	if m.ListExperimentsFn != nil {
+		return m.ListExperimentsFn(ctx, userID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStore) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	if m.DeleteExperimentFn != nil {
+		return m.DeleteExperimentFn(ctx, experimentID, userID)
+	}
+	return store.ErrExperimentNotFound
+}
+
+func (m *mockStore) CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+	if m.CountExperimentExecutionsFn != nil {
+		return m.CountExperimentExecutionsFn(ctx, userID, experimentIDs...)
+	}
+	return map[string]store.ExecutionCounts{}, nil
+}
+
+func (m *mockStore) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	if m.ListExperimentResultsFn != nil {
+		return m.ListExperimentResultsFn(ctx, experimentID, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error) {
+	if m.ListUsersFn != nil {
+		return m.ListUsersFn(ctx, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStore) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	if m.ListAllExecutionsFn != nil {
+		return m.ListAllExecutionsFn(ctx, userID, filter, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockStore) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	if m.FindExecutionFn != nil {
+		return m.FindExecutionFn(ctx, executionID)
+	}
+	return nil, store.ErrExecutionNotFound
+}
+
+func (m *mockStore) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	if m.PurgeExecutionsFn != nil {
+		return m.PurgeExecutionsFn(ctx, before)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	if m.CreateAPIKeyFn != nil {
+		return m.CreateAPIKeyFn(ctx, key)
+	}
+	return nil
+}
+
+func (m *mockStore) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	if m.ListAPIKeysFn != nil {
+		return m.ListAPIKeysFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockStore) DeleteAPIKey(ctx context.Context, keyID, userID string) error {
+	if m.DeleteAPIKeyFn != nil {
+		return m.DeleteAPIKeyFn(ctx, keyID, userID)
+	}
+	return nil
+}
+
+func (m *mockStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	if m.GetAPIKeyByHashFn != nil {
+		return m.GetAPIKeyByHashFn(ctx, keyHash)
+	}
+	return nil, store.ErrAPIKeyNotFound
+}
+
+func (m *mockStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	if m.CreateIdentityUserFn != nil {
+		return m.CreateIdentityUserFn(ctx, identity, usr)
+	}
+	return nil
+}
+
+func (m *mockStore) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	if m.GetIdentityFn != nil {
+		return m.GetIdentityFn(ctx, issuer, subject)
+	}
+	return nil, store.ErrIdentityNotFound
+}
+
 func (m *mockStore) HealthCheck(ctx context.Context) error {
 	m.healthCheckCalls++
 	if m.HealthCheckFn != nil {
@@ -2621,14 +3246,14 @@ func TestStore_ExecutionOperationsDelegation(t *testing.T) {
 			createTestExecution("exec-1", "user-1", store.ExecutionStatusRunning),
 			createTestExecution("exec-2", "user-1", store.ExecutionStatusCompleted),
 		}
-		db.ListExecutionsFn = func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+		db.ListExecutionsFn = func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 			return expectedExecs, 2, nil
 		}
 
 		execStore := NewExecutionStore(redis, db)
 		ctx := context.Background()
 
-		execs, total, err := execStore.ListExecutions(ctx, "user-1", nil, 50, 0)
+		execs, total, err := execStore.ListExecutions(ctx, "user-1", store.ExecutionFilter{}, 50, 0)
 
 		assert.NoError(t, err)
 		assert.Len(t, execs, 2)
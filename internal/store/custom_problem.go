@@ -0,0 +1,6 @@
+package store
+
+import "github.com/nicholaspcr/GoDE/internal/store/errors"
+
+// Re-export custom problem errors from the errors package.
+var ErrCustomProblemNotFound = errors.ErrCustomProblemNotFound
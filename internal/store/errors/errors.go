@@ -24,4 +24,27 @@ var (
 
 	// ErrUserNotFound indicates the requested user was not found.
 	ErrUserNotFound = errors.New("user not found")
+
+	// ErrNoJobAvailable indicates the execution queue has no job to claim.
+	ErrNoJobAvailable = errors.New("no job available")
+
+	// ErrJobNotFound indicates the job does not exist or is not claimed by the worker.
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrCustomProblemNotFound indicates the user saved no custom problem with the name.
+	ErrCustomProblemNotFound = errors.New("custom problem not found")
+
+	// ErrExperimentNotFound indicates the user has no experiment with the ID.
+	ErrExperimentNotFound = errors.New("experiment not found")
+
+	// ErrAPIKeyNotFound indicates no API key has the ID or hash.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	// ErrIdentityNotFound indicates no user is linked to the external
+	// identity.
+	ErrIdentityNotFound = errors.New("identity not found")
+
+	// ErrUsernameTaken indicates a user of some tenant, deleted or not,
+	// already has the username.
+	ErrUsernameTaken = errors.New("username taken")
 )
@@ -6,6 +6,7 @@ import (
 
 	"github.com/nicholaspcr/GoDE/internal/store/errors"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 )
 
 // Re-export errors from the errors package for backward compatibility.
@@ -42,6 +43,15 @@ type Execution struct {
 	CompletedAt         *time.Time
 	IdempotencyKey      string        // Optional client-provided deduplication key
 	MaxExecutionSeconds int64         // 0 = use server default
+	Seed                int64         // Seed of the random streams, reproduces the run
+	ExperimentID        string        // Experiment the execution belongs to, empty for none
+}
+
+// ExecutionFilter narrows the executions listed, zero fields match every
+// execution.
+type ExecutionFilter struct {
+	Status       *ExecutionStatus
+	ExperimentID string
 }
 
 // ExecutionProgress represents the current progress of a running execution.
@@ -52,19 +62,21 @@ type ExecutionProgress struct {
 	CompletedExecutions int32
 	TotalExecutions     int32
 	PartialPareto       []*api.Vector
+	ControlParameters   *api.ControlParameters // Set only for adaptive F and CR
 	UpdatedAt           time.Time
 }
 
 // MarshalJSON implements json.Marshaler for ExecutionProgress.
 func (ep *ExecutionProgress) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		ExecutionID         string        `json:"execution_id"`
-		CurrentGeneration   int32         `json:"current_generation"`
-		TotalGenerations    int32         `json:"total_generations"`
-		CompletedExecutions int32         `json:"completed_executions"`
-		TotalExecutions     int32         `json:"total_executions"`
-		PartialPareto       []*api.Vector `json:"partial_pareto"`
-		UpdatedAt           time.Time     `json:"updated_at"`
+		ExecutionID         string                 `json:"execution_id"`
+		CurrentGeneration   int32                  `json:"current_generation"`
+		TotalGenerations    int32                  `json:"total_generations"`
+		CompletedExecutions int32                  `json:"completed_executions"`
+		TotalExecutions     int32                  `json:"total_executions"`
+		PartialPareto       []*api.Vector          `json:"partial_pareto"`
+		ControlParameters   *api.ControlParameters `json:"control_parameters,omitempty"`
+		UpdatedAt           time.Time              `json:"updated_at"`
 	}{
 		ExecutionID:         ep.ExecutionID,
 		CurrentGeneration:   ep.CurrentGeneration,
@@ -72,6 +84,7 @@ func (ep *ExecutionProgress) MarshalJSON() ([]byte, error) {
 		CompletedExecutions: ep.CompletedExecutions,
 		TotalExecutions:     ep.TotalExecutions,
 		PartialPareto:       ep.PartialPareto,
+		ControlParameters:   ep.ControlParameters,
 		UpdatedAt:           ep.UpdatedAt,
 	})
 }
@@ -79,13 +92,14 @@ func (ep *ExecutionProgress) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements json.Unmarshaler for ExecutionProgress.
 func (ep *ExecutionProgress) UnmarshalJSON(data []byte) error {
 	aux := struct {
-		ExecutionID         string        `json:"execution_id"`
-		CurrentGeneration   int32         `json:"current_generation"`
-		TotalGenerations    int32         `json:"total_generations"`
-		CompletedExecutions int32         `json:"completed_executions"`
-		TotalExecutions     int32         `json:"total_executions"`
-		PartialPareto       []*api.Vector `json:"partial_pareto"`
-		UpdatedAt           time.Time     `json:"updated_at"`
+		ExecutionID         string                 `json:"execution_id"`
+		CurrentGeneration   int32                  `json:"current_generation"`
+		TotalGenerations    int32                  `json:"total_generations"`
+		CompletedExecutions int32                  `json:"completed_executions"`
+		TotalExecutions     int32                  `json:"total_executions"`
+		PartialPareto       []*api.Vector          `json:"partial_pareto"`
+		ControlParameters   *api.ControlParameters `json:"control_parameters,omitempty"`
+		UpdatedAt           time.Time              `json:"updated_at"`
 	}{}
 
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -98,6 +112,7 @@ func (ep *ExecutionProgress) UnmarshalJSON(data []byte) error {
 	ep.CompletedExecutions = aux.CompletedExecutions
 	ep.TotalExecutions = aux.TotalExecutions
 	ep.PartialPareto = aux.PartialPareto
+	ep.ControlParameters = aux.ControlParameters
 	ep.UpdatedAt = aux.UpdatedAt
 
 	return nil
@@ -116,6 +131,8 @@ type ParetoSet struct {
 	Problem       string
 	Variant       string
 	Vectors       []*api.Vector
+	ParetoArchive []*api.Vector // Merged external archive, nil unless kept
 	MaxObjectives []*MaxObjectives
+	Indicators    *indicators.Values
 	CreatedAt     time.Time
 }
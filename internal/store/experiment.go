@@ -0,0 +1,35 @@
+package store
+
+import (
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+)
+
+// Re-export experiment errors from the errors package.
+var ErrExperimentNotFound = errors.ErrExperimentNotFound
+
+// Experiment groups related executions of a user.
+type Experiment struct {
+	ID          string
+	UserID      string
+	Name        string
+	Description string
+	Tags        []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ExecutionCounts is the number of executions of each status.
+type ExecutionCounts map[ExecutionStatus]int
+
+// ExperimentResult holds the indicators of a completed execution of an
+// experiment.
+type ExperimentResult struct {
+	ExecutionID string
+	Algorithm   string
+	Variant     string
+	Problem     string
+	Indicators  *indicators.Values // nil when none were stored
+}
@@ -0,0 +1,182 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupAdminTestDB(t *testing.T) *gormStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	err = db.AutoMigrate(
+		&userModel{}, &paretoModel{}, &vectorModel{}, &executionModel{}, &jobModel{}, &checkpointModel{},
+	)
+	require.NoError(t, err)
+	return &gormStore{
+		db:              db,
+		userStore:       newUserStore(db),
+		paretoStore:     newParetoStore(db),
+		vectorStore:     newVectorStore(db),
+		executionStore:  newExecutionStore(db),
+		jobStore:        newJobStore(db),
+		checkpointStore: newCheckpointStore(db),
+	}
+}
+
+func TestUserStore_Scopes(t *testing.T) {
+	s := setupAdminTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateUser(ctx, &api.User{
+		Ids: &api.UserIDs{Username: "robot"}, Email: "robot@example.com", Password: "hash",
+		Scopes: []string{"de:run", "de:read"},
+	}))
+	usr, err := s.GetUser(ctx, &api.UserIDs{Username: "robot"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de:run", "de:read"}, usr.Scopes)
+
+	usr.Scopes = []string{"admin"}
+	require.NoError(t, s.UpdateUser(ctx, usr, "scopes"))
+	usr, err = s.GetUser(ctx, &api.UserIDs{Username: "robot"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin"}, usr.Scopes)
+
+	usr.Scopes = nil
+	require.NoError(t, s.UpdateUser(ctx, usr, "scopes"))
+	usr, err = s.GetUser(ctx, &api.UserIDs{Username: "robot"})
+	require.NoError(t, err)
+	assert.Empty(t, usr.Scopes, "empty scopes mean the default ones")
+}
+
+func TestUserStore_ListUsers(t *testing.T) {
+	s := setupAdminTestDB(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"carol", "alice", "bob"} {
+		require.NoError(t, s.CreateUser(ctx, &api.User{
+			Ids: &api.UserIDs{Username: name}, Email: name + "@example.com", Password: "hash",
+		}))
+	}
+	require.NoError(t, s.CreateUser(tenant.WithID(ctx, "lab"), &api.User{
+		Ids: &api.UserIDs{Username: "dave"}, Email: "dave@lab.org", Password: "hash",
+	}))
+
+	users, total, err := s.ListUsers(ctx, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total, "users of other tenants are not counted")
+	require.Len(t, users, 2)
+	assert.Equal(t, "alice", users[0].Ids.Username)
+	assert.Equal(t, "bob", users[1].Ids.Username)
+	assert.Empty(t, users[0].Password, "passwords are not listed")
+
+	users, _, err = s.ListUsers(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "carol", users[0].Ids.Username)
+}
+
+func TestExecutionStore_ListAllExecutions_FindExecution(t *testing.T) {
+	s := setupAdminTestDB(t)
+	ctx := context.Background()
+
+	for i, owner := range []string{"user1", "user2", "user1"} {
+		exec := newTestExecution("exec-"+string(rune('a'+i)), owner)
+		exec.CreatedAt = exec.CreatedAt.Add(time.Duration(i) * time.Second)
+		require.NoError(t, s.CreateExecution(ctx, exec))
+	}
+	require.NoError(t, s.CreateExecution(tenant.WithID(ctx, "lab"), newTestExecution("exec-lab", "user1")))
+
+	executions, total, err := s.ListAllExecutions(ctx, "", store.ExecutionFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, executions, 3)
+	assert.Equal(t, "exec-c", executions[0].ID, "newest first")
+
+	executions, total, err = s.ListAllExecutions(ctx, "user2", store.ExecutionFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, "exec-b", executions[0].ID)
+
+	exec, err := s.FindExecution(ctx, "exec-b")
+	require.NoError(t, err)
+	assert.Equal(t, "user2", exec.UserID)
+
+	_, err = s.FindExecution(ctx, "exec-lab")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound, "executions of other tenants are not found")
+}
+
+func TestExecutionStore_PurgeExecutions(t *testing.T) {
+	s := setupAdminTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateUser(ctx, &api.User{
+		Ids: &api.UserIDs{Username: "user1"}, Email: "user1@example.com", Password: "hash",
+	}))
+	paretoSet := &store.ParetoSet{
+		UserID:    "user1",
+		Algorithm: "gde3",
+		Problem:   "zdt1",
+		Variant:   "rand1",
+		Vectors:   []*api.Vector{{Objectives: []float64{0.5, 0.5}}},
+	}
+	require.NoError(t, s.CreateParetoSet(ctx, paretoSet))
+
+	old := time.Now().Add(-48 * time.Hour)
+	completed := newTestExecution("exec-completed", "user1")
+	completed.Status = store.ExecutionStatusCompleted
+	completed.ParetoID = &paretoSet.ID
+	completed.UpdatedAt = old
+	require.NoError(t, s.CreateExecution(ctx, completed))
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-completed", UserID: "user1"}))
+	require.NoError(t, s.CompleteJob(ctx, "exec-completed", ""))
+	require.NoError(t, s.SaveCheckpoint(ctx, &store.Checkpoint{ExecutionID: "exec-completed", Data: []byte("state")}))
+
+	running := newTestExecution("exec-running", "user1")
+	running.Status = store.ExecutionStatusRunning
+	running.UpdatedAt = old
+	require.NoError(t, s.CreateExecution(ctx, running))
+
+	recent := newTestExecution("exec-recent", "user1")
+	recent.Status = store.ExecutionStatusFailed
+	require.NoError(t, s.CreateExecution(ctx, recent))
+
+	otherTenant := newTestExecution("exec-lab", "user1")
+	otherTenant.Status = store.ExecutionStatusCompleted
+	otherTenant.UpdatedAt = old
+	require.NoError(t, s.CreateExecution(tenant.WithID(ctx, "lab"), otherTenant))
+
+	purged, err := s.PurgeExecutions(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"exec-completed"}, purged)
+
+	_, err = s.FindExecution(ctx, "exec-completed")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+	_, err = s.GetParetoSetByID(ctx, paretoSet.ID)
+	assert.Error(t, err, "the Pareto set of the execution is purged")
+	var vectors int64
+	require.NoError(t, s.db.Unscoped().Model(&vectorModel{}).Count(&vectors).Error)
+	assert.Zero(t, vectors)
+	checkpoints, err := s.ListCheckpoints(ctx, "exec-completed")
+	require.NoError(t, err)
+	assert.Empty(t, checkpoints)
+	jobs, err := s.ListJobs(ctx, store.JobStatusDone)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	for _, id := range []string{"exec-running", "exec-recent"} {
+		_, err = s.FindExecution(ctx, id)
+		assert.NoError(t, err, "%s is kept", id)
+	}
+	_, err = s.FindExecution(tenant.WithID(ctx, "lab"), "exec-lab")
+	assert.NoError(t, err, "executions of other tenants are kept")
+}
@@ -0,0 +1,114 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"gorm.io/gorm"
+)
+
+// apiKeyModel represents the database model for API keys.
+type apiKeyModel struct {
+	ID       string `gorm:"primaryKey;type:varchar(36)"`
+	TenantID string `gorm:"type:varchar(64);not null;default:'default';index:idx_api_keys_tenant_user_created"`
+	UserID   string `gorm:"type:varchar(255);not null;index:idx_api_keys_tenant_user_created"`
+	Name     string `gorm:"type:varchar(100);not null"`
+	Prefix   string `gorm:"type:varchar(16);not null"`
+	KeyHash  string `gorm:"type:varchar(64);not null;uniqueIndex:idx_api_keys_key_hash"`
+	// Scopes granted to the key separated by spaces.
+	Scopes    string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"not null;index:idx_api_keys_tenant_user_created"`
+	ExpiresAt *time.Time
+}
+
+func (apiKeyModel) TableName() string {
+	return "api_keys"
+}
+
+func (m *apiKeyModel) toStore() *store.APIKey {
+	return &store.APIKey{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		Prefix:    m.Prefix,
+		KeyHash:   m.KeyHash,
+		Scopes:    strings.Fields(m.Scopes),
+		CreatedAt: m.CreatedAt,
+		ExpiresAt: m.ExpiresAt,
+	}
+}
+
+// apiKeyStore implements APIKeyOperations using GORM.
+type apiKeyStore struct {
+	db *gorm.DB
+}
+
+func newAPIKeyStore(db *gorm.DB) *apiKeyStore {
+	return &apiKeyStore{db: db}
+}
+
+// CreateAPIKey creates a new API key record in the tenant of ctx.
+func (s *apiKeyStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	model := &apiKeyModel{
+		ID:        key.ID,
+		TenantID:  tenant.IDFromContext(ctx),
+		UserID:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		KeyHash:   key.KeyHash,
+		Scopes:    strings.Join(key.Scopes, " "),
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+	}
+	if err := s.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	key.TenantID = model.TenantID
+	return nil
+}
+
+// ListAPIKeys retrieves the API keys of a user, newest first.
+func (s *apiKeyStore) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	var models []apiKeyModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]*store.APIKey, len(models))
+	for i := range models {
+		keys[i] = models[i].toStore()
+	}
+	return keys, nil
+}
+
+// DeleteAPIKey removes an API key of the user from the database.
+func (s *apiKeyStore) DeleteAPIKey(ctx context.Context, keyID, userID string) error {
+	result := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ? AND user_id = ?", keyID, userID).Delete(&apiKeyModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// GetAPIKeyByHash retrieves the API key with the hash from any tenant.
+func (s *apiKeyStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	var model apiKeyModel
+	if err := s.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return model.toStore(), nil
+}
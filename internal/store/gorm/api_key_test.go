@@ -0,0 +1,102 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupAPIKeyTestDB(t *testing.T) *apiKeyStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&apiKeyModel{}))
+	return newAPIKeyStore(db)
+}
+
+func TestAPIKeyStore_CreateAndList(t *testing.T) {
+	s := setupAPIKeyTestDB(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	expiresAt := now.Add(24 * time.Hour)
+	require.NoError(t, s.CreateAPIKey(ctx, &store.APIKey{
+		ID: "key-1", UserID: "user1", Name: "nightly", Prefix: "gode_abcd",
+		KeyHash: "hash-1", Scopes: []string{"de:run", "de:read"},
+		CreatedAt: now.Add(-time.Hour), ExpiresAt: &expiresAt,
+	}))
+	require.NoError(t, s.CreateAPIKey(ctx, &store.APIKey{
+		ID: "key-2", UserID: "user1", Name: "ci", Prefix: "gode_efgh",
+		KeyHash: "hash-2", Scopes: []string{"de:read"}, CreatedAt: now,
+	}))
+	require.NoError(t, s.CreateAPIKey(ctx, &store.APIKey{
+		ID: "key-3", UserID: "user2", Name: "other", Prefix: "gode_ijkl",
+		KeyHash: "hash-3", Scopes: []string{"de:read"}, CreatedAt: now,
+	}))
+
+	keys, err := s.ListAPIKeys(ctx, "user1")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "key-2", keys[0].ID, "newest first")
+	assert.Nil(t, keys[0].ExpiresAt)
+	assert.Equal(t, "key-1", keys[1].ID)
+	assert.Equal(t, []string{"de:run", "de:read"}, keys[1].Scopes)
+	require.NotNil(t, keys[1].ExpiresAt)
+	assert.True(t, expiresAt.Equal(*keys[1].ExpiresAt))
+
+	keys, err = s.ListAPIKeys(tenant.WithID(ctx, "lab"), "user1")
+	require.NoError(t, err)
+	assert.Empty(t, keys, "keys of other tenants are not listed")
+}
+
+func TestAPIKeyStore_GetAPIKeyByHash(t *testing.T) {
+	s := setupAPIKeyTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateAPIKey(tenant.WithID(ctx, "lab"), &store.APIKey{
+		ID: "key-1", UserID: "user1", Name: "nightly", Prefix: "gode_abcd",
+		KeyHash: "hash-1", Scopes: []string{"de:run"}, CreatedAt: time.Now(),
+	}))
+
+	key, err := s.GetAPIKeyByHash(ctx, "hash-1")
+	require.NoError(t, err, "keys are found from any tenant")
+	assert.Equal(t, "lab", key.TenantID)
+	assert.Equal(t, "user1", key.UserID)
+
+	_, err = s.GetAPIKeyByHash(ctx, "unknown")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound)
+
+	err = s.CreateAPIKey(ctx, &store.APIKey{
+		ID: "key-2", UserID: "user2", Name: "copy", Prefix: "gode_abcd",
+		KeyHash: "hash-1", Scopes: []string{"de:run"}, CreatedAt: time.Now(),
+	})
+	assert.Error(t, err, "hashes are unique")
+}
+
+func TestAPIKeyStore_DeleteAPIKey(t *testing.T) {
+	s := setupAPIKeyTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateAPIKey(ctx, &store.APIKey{
+		ID: "key-1", UserID: "user1", Name: "nightly", Prefix: "gode_abcd",
+		KeyHash: "hash-1", Scopes: []string{"de:run"}, CreatedAt: time.Now(),
+	}))
+
+	err := s.DeleteAPIKey(ctx, "key-1", "user2")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound, "keys of other users are not deleted")
+	err = s.DeleteAPIKey(tenant.WithID(ctx, "lab"), "key-1", "user1")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound, "keys of other tenants are not deleted")
+
+	require.NoError(t, s.DeleteAPIKey(ctx, "key-1", "user1"))
+	_, err = s.GetAPIKeyByHash(ctx, "hash-1")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound)
+	err = s.DeleteAPIKey(ctx, "key-1", "user1")
+	assert.ErrorIs(t, err, store.ErrAPIKeyNotFound)
+}
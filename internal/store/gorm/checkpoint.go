@@ -0,0 +1,78 @@
+package gorm
+
+import (
+	"context"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// checkpointModel represents the database model for execution checkpoints.
+type checkpointModel struct {
+	ExecutionID     string    `gorm:"primaryKey;type:varchar(36)"`
+	ExecutionNumber int       `gorm:"primaryKey;autoIncrement:false"`
+	TenantID        string    `gorm:"type:varchar(64);not null;default:'default'"`
+	Generation      int       `gorm:"not null"`
+	Data            []byte    `gorm:"not null"`
+	CreatedAt       time.Time `gorm:"not null"`
+	UpdatedAt       time.Time `gorm:"not null"`
+}
+
+func (checkpointModel) TableName() string {
+	return "execution_checkpoints"
+}
+
+// checkpointStore implements CheckpointOperations using GORM.
+type checkpointStore struct {
+	db *gorm.DB
+}
+
+func newCheckpointStore(db *gorm.DB) *checkpointStore {
+	return &checkpointStore{db: db}
+}
+
+// SaveCheckpoint inserts the checkpoint or replaces the previous one of the
+// same execution number.
+func (s *checkpointStore) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	now := time.Now()
+	model := &checkpointModel{
+		ExecutionID:     checkpoint.ExecutionID,
+		ExecutionNumber: checkpoint.ExecutionNumber,
+		TenantID:        tenant.IDFromContext(ctx),
+		Generation:      checkpoint.Generation,
+		Data:            checkpoint.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "execution_id"}, {Name: "execution_number"}},
+		DoUpdates: clause.AssignmentColumns([]string{"generation", "data", "updated_at"}),
+	}).Create(model).Error
+}
+
+// ListCheckpoints retrieves the checkpoints of an execution ordered by
+// execution number.
+func (s *checkpointStore) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	var models []checkpointModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).
+		Where("execution_id = ?", executionID).
+		Order("execution_number ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	checkpoints := make([]*store.Checkpoint, len(models))
+	for i, model := range models {
+		checkpoints[i] = &store.Checkpoint{
+			ExecutionID:     model.ExecutionID,
+			ExecutionNumber: model.ExecutionNumber,
+			Generation:      model.Generation,
+			Data:            model.Data,
+			UpdatedAt:       model.UpdatedAt,
+		}
+	}
+	return checkpoints, nil
+}
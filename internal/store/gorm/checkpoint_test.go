@@ -0,0 +1,47 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupCheckpointTestDB creates an in-memory DB with the checkpoint model migrated.
+func setupCheckpointTestDB(t *testing.T) *checkpointStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	err = db.AutoMigrate(&checkpointModel{})
+	require.NoError(t, err)
+	return newCheckpointStore(db)
+}
+
+func TestCheckpointStore_SaveCheckpoint_ListCheckpoints(t *testing.T) {
+	s := setupCheckpointTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.SaveCheckpoint(ctx, &store.Checkpoint{ExecutionID: "exec-1", ExecutionNumber: 1, Generation: 10, Data: []byte("b")}))
+	require.NoError(t, s.SaveCheckpoint(ctx, &store.Checkpoint{ExecutionID: "exec-1", ExecutionNumber: 0, Generation: 10, Data: []byte("a")}))
+	require.NoError(t, s.SaveCheckpoint(ctx, &store.Checkpoint{ExecutionID: "exec-2", ExecutionNumber: 0, Generation: 5, Data: []byte("c")}))
+
+	// A newer checkpoint replaces the previous one of the execution number
+	require.NoError(t, s.SaveCheckpoint(ctx, &store.Checkpoint{ExecutionID: "exec-1", ExecutionNumber: 0, Generation: 20, Data: []byte("d")}))
+
+	checkpoints, err := s.ListCheckpoints(ctx, "exec-1")
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	assert.Equal(t, 0, checkpoints[0].ExecutionNumber)
+	assert.Equal(t, 20, checkpoints[0].Generation)
+	assert.Equal(t, []byte("d"), checkpoints[0].Data)
+	assert.Equal(t, 1, checkpoints[1].ExecutionNumber)
+	assert.Equal(t, []byte("b"), checkpoints[1].Data)
+
+	checkpoints, err = s.ListCheckpoints(ctx, "missing")
+	require.NoError(t, err)
+	assert.Empty(t, checkpoints)
+}
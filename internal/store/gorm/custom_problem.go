@@ -0,0 +1,102 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// customProblemModel represents the database model for saved custom problems.
+type customProblemModel struct {
+	TenantID       string    `gorm:"type:varchar(64);not null;default:'default'"`
+	UserID         string    `gorm:"primaryKey;type:varchar(255)"`
+	Name           string    `gorm:"primaryKey;type:varchar(50)"`
+	DefinitionJSON string    `gorm:"type:text;not null"`
+	CreatedAt      time.Time `gorm:"not null"`
+	UpdatedAt      time.Time `gorm:"not null"`
+}
+
+func (customProblemModel) TableName() string {
+	return "custom_problems"
+}
+
+// customProblemStore implements CustomProblemOperations using GORM.
+type customProblemStore struct {
+	db *gorm.DB
+}
+
+func newCustomProblemStore(db *gorm.DB) *customProblemStore {
+	return &customProblemStore{db: db}
+}
+
+// SaveCustomProblem inserts the problem or replaces the definition of the
+// problem of the user with the same name.
+func (s *customProblemStore) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	definitionJSON, err := protojson.Marshal(problem)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	model := &customProblemModel{
+		TenantID:       tenant.IDFromContext(ctx),
+		UserID:         userID,
+		Name:           problem.GetName(),
+		DefinitionJSON: string(definitionJSON),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"definition_json", "updated_at"}),
+	}).Create(model).Error
+}
+
+// GetCustomProblem retrieves a problem of the user by name.
+func (s *customProblemStore) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	var model customProblemModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("user_id = ? AND name = ?", userID, name).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrCustomProblemNotFound
+		}
+		return nil, err
+	}
+	return modelToCustomProblem(&model)
+}
+
+// ListCustomProblems retrieves the problems of the user ordered by name.
+func (s *customProblemStore) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	var models []customProblemModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	problems := make([]*api.CustomProblem, len(models))
+	for i := range models {
+		problem, err := modelToCustomProblem(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		problems[i] = problem
+	}
+	return problems, nil
+}
+
+// modelToCustomProblem converts a database model to a custom problem.
+func modelToCustomProblem(model *customProblemModel) (*api.CustomProblem, error) {
+	problem := &api.CustomProblem{}
+	if err := protojson.Unmarshal([]byte(model.DefinitionJSON), problem); err != nil {
+		return nil, err
+	}
+	return problem, nil
+}
@@ -0,0 +1,75 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// setupCustomProblemTestDB creates an in-memory DB with the custom problem model migrated.
+func setupCustomProblemTestDB(t *testing.T) *customProblemStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	err = db.AutoMigrate(&customProblemModel{})
+	require.NoError(t, err)
+	return newCustomProblemStore(db)
+}
+
+func TestCustomProblemStore_SaveGet(t *testing.T) {
+	s := setupCustomProblemTestDB(t)
+	ctx := context.Background()
+
+	problem := &api.CustomProblem{
+		Name:        "sphere",
+		Description: "Two spheres",
+		Objectives:  []string{"x[0]^2 + x[1]^2", "(x[0] - 1)^2 + x[1]^2"},
+		Constraints: []string{"x[0] + x[1] - 1"},
+		Bounds:      []*api.Bounds{{Floor: -1, Ceil: 1}, {Floor: -1, Ceil: 1}},
+	}
+	require.NoError(t, s.SaveCustomProblem(ctx, "user1", problem))
+
+	got, err := s.GetCustomProblem(ctx, "user1", "sphere")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(problem, got))
+
+	_, err = s.GetCustomProblem(ctx, "user2", "sphere")
+	assert.ErrorIs(t, err, store.ErrCustomProblemNotFound, "problems belong to their user")
+	_, err = s.GetCustomProblem(ctx, "user1", "missing")
+	assert.ErrorIs(t, err, store.ErrCustomProblemNotFound)
+
+	// Saving the same name replaces the definition
+	replaced := &api.CustomProblem{Name: "sphere", Objectives: []string{"x[0]"}}
+	require.NoError(t, s.SaveCustomProblem(ctx, "user1", replaced))
+	got, err = s.GetCustomProblem(ctx, "user1", "sphere")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(replaced, got))
+}
+
+func TestCustomProblemStore_ListCustomProblems(t *testing.T) {
+	s := setupCustomProblemTestDB(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"zeta", "alpha", "mid"} {
+		require.NoError(t, s.SaveCustomProblem(ctx, "user1", &api.CustomProblem{Name: name, Objectives: []string{"x[0]"}}))
+	}
+	require.NoError(t, s.SaveCustomProblem(ctx, "user2", &api.CustomProblem{Name: "other", Objectives: []string{"x[0]"}}))
+
+	problems, err := s.ListCustomProblems(ctx, "user1")
+	require.NoError(t, err)
+	require.Len(t, problems, 3)
+	assert.Equal(t, "alpha", problems[0].Name)
+	assert.Equal(t, "mid", problems[1].Name)
+	assert.Equal(t, "zeta", problems[2].Name)
+
+	problems, err = s.ListCustomProblems(ctx, "user3")
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/nicholaspcr/GoDE/internal/store"
 	storeerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
@@ -15,18 +16,21 @@ import (
 
 // executionModel represents the database model for executions.
 type executionModel struct {
-	ID          string    `gorm:"primaryKey;type:varchar(36)"`
-	UserID      string    `gorm:"type:varchar(255);not null;index"`
-	Status      string    `gorm:"type:varchar(20);not null;index"`
-	ConfigJSON  string    `gorm:"type:text;not null"`
-	Algorithm   string    `gorm:"type:varchar(50);not null;default:''"`
-	Variant     string    `gorm:"type:varchar(50);not null;default:''"`
-	Problem     string    `gorm:"type:varchar(50);not null;default:''"`
-	ParetoID    *uint64   `gorm:"type:bigint;index"`
-	Error       string    `gorm:"type:text"`
-	CreatedAt   time.Time `gorm:"not null;index"`
-	UpdatedAt   time.Time `gorm:"not null"`
-	CompletedAt *time.Time
+	ID           string    `gorm:"primaryKey;type:varchar(36)"`
+	TenantID     string    `gorm:"type:varchar(64);not null;default:'default';index"`
+	UserID       string    `gorm:"type:varchar(255);not null;index"`
+	Status       string    `gorm:"type:varchar(20);not null;index"`
+	ConfigJSON   string    `gorm:"type:text;not null"`
+	Algorithm    string    `gorm:"type:varchar(50);not null;default:''"`
+	Variant      string    `gorm:"type:varchar(50);not null;default:''"`
+	Problem      string    `gorm:"type:varchar(50);not null;default:''"`
+	ParetoID     *uint64   `gorm:"type:bigint;index"`
+	Error        string    `gorm:"type:text"`
+	CreatedAt    time.Time `gorm:"not null;index"`
+	UpdatedAt    time.Time `gorm:"not null"`
+	CompletedAt  *time.Time
+	Seed         int64  `gorm:"type:bigint;not null;default:0"`
+	ExperimentID string `gorm:"type:varchar(36);not null;default:'';index"`
 }
 
 func (executionModel) TableName() string {
@@ -50,17 +54,20 @@ func (s *executionStore) CreateExecution(ctx context.Context, execution *store.E
 	}
 
 	model := &executionModel{
-		ID:         execution.ID,
-		UserID:     execution.UserID,
-		Status:     string(execution.Status),
-		ConfigJSON: string(configJSON),
-		Algorithm:  execution.Algorithm,
-		Variant:    execution.Variant,
-		Problem:    execution.Problem,
-		ParetoID:   execution.ParetoID,
-		Error:      execution.Error,
-		CreatedAt:  execution.CreatedAt,
-		UpdatedAt:  execution.UpdatedAt,
+		ID:           execution.ID,
+		TenantID:     tenant.IDFromContext(ctx),
+		UserID:       execution.UserID,
+		Status:       string(execution.Status),
+		ConfigJSON:   string(configJSON),
+		Algorithm:    execution.Algorithm,
+		Variant:      execution.Variant,
+		Problem:      execution.Problem,
+		ParetoID:     execution.ParetoID,
+		Error:        execution.Error,
+		CreatedAt:    execution.CreatedAt,
+		UpdatedAt:    execution.UpdatedAt,
+		Seed:         execution.Seed,
+		ExperimentID: execution.ExperimentID,
 	}
 
 	return s.db.WithContext(ctx).Create(model).Error
@@ -69,7 +76,7 @@ func (s *executionStore) CreateExecution(ctx context.Context, execution *store.E
 // GetExecution retrieves an execution by ID and verifies ownership.
 func (s *executionStore) GetExecution(ctx context.Context, executionID, userID string) (*store.Execution, error) {
 	var model executionModel
-	if err := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", executionID, userID).First(&model).Error; err != nil {
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ? AND user_id = ?", executionID, userID).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, store.ErrExecutionNotFound
 		}
@@ -94,19 +101,59 @@ func (s *executionStore) UpdateExecutionStatus(ctx context.Context, executionID
 		updates["completed_at"] = time.Now()
 	}
 
-	return s.db.WithContext(ctx).Model(&executionModel{}).Where("id = ?", executionID).Updates(updates).Error
+	return s.db.WithContext(ctx).Model(&executionModel{}).Scopes(tenantScope(ctx)).Where("id = ?", executionID).Updates(updates).Error
 }
 
 // UpdateExecutionResult updates the pareto ID for a completed execution.
 func (s *executionStore) UpdateExecutionResult(ctx context.Context, executionID string, paretoID uint64) error {
-	return s.db.WithContext(ctx).Model(&executionModel{}).Where("id = ?", executionID).Updates(map[string]any{
+	return s.db.WithContext(ctx).Model(&executionModel{}).Scopes(tenantScope(ctx)).Where("id = ?", executionID).Updates(map[string]any{
 		"pareto_id":  paretoID,
 		"updated_at": time.Now(),
 	}).Error
 }
 
+// RestartExecution puts a finished execution back to pending with config.
+func (s *executionStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	configJSON, err := protojson.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	result := s.db.WithContext(ctx).Model(&executionModel{}).Scopes(tenantScope(ctx)).Where("id = ?", executionID).Updates(map[string]any{
+		"status":       string(store.ExecutionStatusPending),
+		"config_json":  string(configJSON),
+		"error":        "",
+		"completed_at": nil,
+		"updated_at":   time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrExecutionNotFound
+	}
+	return nil
+}
+
 // ListExecutions retrieves executions for a user with pagination, optionally filtered by status.
-func (s *executionStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (s *executionStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	query := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("user_id = ?", userID)
+	return s.listExecutions(query, filter, limit, offset)
+}
+
+// ListAllExecutions lists the executions of every user of the tenant, or of
+// userID when it is not empty.
+func (s *executionStore) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	query := s.db.WithContext(ctx).Scopes(tenantScope(ctx))
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	return s.listExecutions(query, filter, limit, offset)
+}
+
+// listExecutions returns a page of the executions selected by query and
+// filter, newest first, with the total number of them.
+func (s *executionStore) listExecutions(query *gorm.DB, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	// Apply defaults and max limits
 	if limit <= 0 || limit > 100 {
 		limit = 50
@@ -115,10 +162,11 @@ func (s *executionStore) ListExecutions(ctx context.Context, userID string, stat
 		offset = 0
 	}
 
-	query := s.db.WithContext(ctx).Where("user_id = ?", userID)
-
-	if status != nil {
-		query = query.Where("status = ?", string(*status))
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+	if filter.ExperimentID != "" {
+		query = query.Where("experiment_id = ?", filter.ExperimentID)
 	}
 
 	// Get total count
@@ -153,7 +201,7 @@ func (s *executionStore) ListExecutions(ctx context.Context, userID string, stat
 
 // DeleteExecution removes an execution from the database.
 func (s *executionStore) DeleteExecution(ctx context.Context, executionID, userID string) error {
-	result := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", executionID, userID).Delete(&executionModel{})
+	result := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ? AND user_id = ?", executionID, userID).Delete(&executionModel{})
 	if result.Error != nil {
 		return result.Error
 	}
@@ -163,6 +211,74 @@ func (s *executionStore) DeleteExecution(ctx context.Context, executionID, userI
 	return nil
 }
 
+// FindExecution retrieves an execution of any user of the tenant.
+func (s *executionStore) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	var model executionModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ?", executionID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrExecutionNotFound
+		}
+		return nil, err
+	}
+
+	return s.modelToExecution(&model)
+}
+
+// PurgeExecutions deletes the finished executions of the tenant last updated
+// before the cutoff, with their Pareto sets, vectors, checkpoints and jobs.
+func (s *executionStore) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	var purged []string
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var models []executionModel
+		if err := tx.Scopes(tenantScope(ctx)).Select("id", "pareto_id").
+			Where("status IN ? AND updated_at < ?", []string{
+				string(store.ExecutionStatusCompleted),
+				string(store.ExecutionStatusFailed),
+				string(store.ExecutionStatusCancelled),
+			}, before).
+			Find(&models).Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(models))
+		var paretoIDs []uint64
+		for _, model := range models {
+			ids = append(ids, model.ID)
+			if model.ParetoID != nil {
+				paretoIDs = append(paretoIDs, *model.ParetoID)
+			}
+		}
+
+		if err := tx.Where("execution_id IN ?", ids).Delete(&checkpointModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("execution_id IN ?", ids).Delete(&jobModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id IN ?", ids).Delete(&executionModel{}).Error; err != nil {
+			return err
+		}
+		if len(paretoIDs) > 0 {
+			// The results are gone for good, so are the soft deleted rows
+			if err := tx.Unscoped().Where("pareto_set_id IN ?", paretoIDs).Delete(&vectorModel{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Where("id IN ?", paretoIDs).Delete(&paretoModel{}).Error; err != nil {
+				return err
+			}
+		}
+		purged = ids
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
 // SaveProgress is not implemented for GORM store (handled by Redis).
 func (s *executionStore) SaveProgress(ctx context.Context, progress *store.ExecutionProgress) error {
 	return storeerrors.ErrProgressNotSupported
@@ -201,17 +317,19 @@ func (s *executionStore) modelToExecution(model *executionModel) (*store.Executi
 	}
 
 	return &store.Execution{
-		ID:          model.ID,
-		UserID:      model.UserID,
-		Status:      store.ExecutionStatus(model.Status),
-		Config:      &config,
-		Algorithm:   model.Algorithm,
-		Variant:     model.Variant,
-		Problem:     model.Problem,
-		ParetoID:    model.ParetoID,
-		Error:       model.Error,
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
-		CompletedAt: model.CompletedAt,
+		ID:           model.ID,
+		UserID:       model.UserID,
+		Status:       store.ExecutionStatus(model.Status),
+		Config:       &config,
+		Algorithm:    model.Algorithm,
+		Variant:      model.Variant,
+		Problem:      model.Problem,
+		ParetoID:     model.ParetoID,
+		Error:        model.Error,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		CompletedAt:  model.CompletedAt,
+		Seed:         model.Seed,
+		ExperimentID: model.ExperimentID,
 	}, nil
 }
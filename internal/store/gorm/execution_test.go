@@ -43,6 +43,7 @@ func TestExecutionStore_Create_Get(t *testing.T) {
 	ctx := context.Background()
 
 	exec := newTestExecution("exec-1", "user1")
+	exec.Seed = 42
 	require.NoError(t, s.CreateExecution(ctx, exec))
 
 	got, err := s.GetExecution(ctx, "exec-1", "user1")
@@ -53,6 +54,7 @@ func TestExecutionStore_Create_Get(t *testing.T) {
 	assert.Equal(t, exec.Variant, got.Variant)
 	assert.Equal(t, exec.Problem, got.Problem)
 	assert.Equal(t, exec.Status, got.Status)
+	assert.Equal(t, exec.Seed, got.Seed)
 }
 
 func TestExecutionStore_GetExecution_NotFound(t *testing.T) {
@@ -146,6 +148,27 @@ func TestExecutionStore_UpdateExecutionResult(t *testing.T) {
 	assert.Equal(t, paretoID, *got.ParetoID)
 }
 
+func TestExecutionStore_RestartExecution(t *testing.T) {
+	s := setupExecutionTestDB(t)
+	ctx := context.Background()
+
+	exec := newTestExecution("exec-restart", "user1")
+	require.NoError(t, s.CreateExecution(ctx, exec))
+	require.NoError(t, s.UpdateExecutionStatus(ctx, "exec-restart", store.ExecutionStatusFailed, "execution timed out"))
+
+	require.NoError(t, s.RestartExecution(ctx, "exec-restart", &api.DEConfig{Generations: 200}))
+
+	got, err := s.GetExecution(ctx, "exec-restart", "user1")
+	require.NoError(t, err)
+	assert.Equal(t, store.ExecutionStatusPending, got.Status)
+	assert.Empty(t, got.Error)
+	assert.Nil(t, got.CompletedAt)
+	assert.Equal(t, int64(200), got.Config.Generations)
+
+	err = s.RestartExecution(ctx, "missing", &api.DEConfig{})
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+}
+
 func TestExecutionStore_ListExecutions(t *testing.T) {
 	s := setupExecutionTestDB(t)
 	ctx := context.Background()
@@ -160,7 +183,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 	require.NoError(t, s.CreateExecution(ctx, exec))
 
 	t.Run("list all for user1", func(t *testing.T) {
-		execs, total, err := s.ListExecutions(ctx, "user1", nil, 50, 0)
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{}, 50, 0)
 		require.NoError(t, err)
 		assert.Equal(t, 3, total)
 		assert.Len(t, execs, 3)
@@ -168,7 +191,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 
 	t.Run("list with status filter", func(t *testing.T) {
 		status := store.ExecutionStatusCompleted
-		execs, total, err := s.ListExecutions(ctx, "user1", &status, 50, 0)
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{Status: &status}, 50, 0)
 		require.NoError(t, err)
 		assert.Equal(t, 3, total)
 		assert.Len(t, execs, 3)
@@ -176,28 +199,41 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 
 	t.Run("list with non-matching status filter", func(t *testing.T) {
 		status := store.ExecutionStatusFailed
-		execs, total, err := s.ListExecutions(ctx, "user1", &status, 50, 0)
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{Status: &status}, 50, 0)
 		require.NoError(t, err)
 		assert.Equal(t, 0, total)
 		assert.Empty(t, execs)
 	})
 
 	t.Run("list with pagination", func(t *testing.T) {
-		execs, total, err := s.ListExecutions(ctx, "user1", nil, 2, 0)
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{}, 2, 0)
 		require.NoError(t, err)
 		assert.Equal(t, 3, total)
 		assert.Len(t, execs, 2)
 	})
 
 	t.Run("list with offset", func(t *testing.T) {
-		execs, total, err := s.ListExecutions(ctx, "user1", nil, 50, 2)
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{}, 50, 2)
 		require.NoError(t, err)
 		assert.Equal(t, 3, total)
 		assert.Len(t, execs, 1)
 	})
 
+	t.Run("list with experiment filter", func(t *testing.T) {
+		exec := newTestExecution("u1-exec-exp", "user1")
+		exec.ExperimentID = "exp-1"
+		require.NoError(t, s.CreateExecution(ctx, exec))
+		defer func() { require.NoError(t, s.DeleteExecution(ctx, "u1-exec-exp", "user1")) }()
+
+		execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{ExperimentID: "exp-1"}, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, execs, 1)
+		assert.Equal(t, "exp-1", execs[0].ExperimentID)
+	})
+
 	t.Run("user isolation", func(t *testing.T) {
-		execs, total, err := s.ListExecutions(ctx, "user2", nil, 50, 0)
+		execs, total, err := s.ListExecutions(ctx, "user2", store.ExecutionFilter{}, 50, 0)
 		require.NoError(t, err)
 		assert.Equal(t, 1, total)
 		assert.Len(t, execs, 1)
@@ -209,7 +245,7 @@ func TestExecutionStore_ListExecutions_DefaultsAndMaxLimit(t *testing.T) {
 	ctx := context.Background()
 
 	// Should not panic or fail with negative/zero limits
-	execs, total, err := s.ListExecutions(ctx, "user1", nil, 0, -1)
+	execs, total, err := s.ListExecutions(ctx, "user1", store.ExecutionFilter{}, 0, -1)
 	require.NoError(t, err)
 	assert.Equal(t, 0, total)
 	assert.Empty(t, execs)
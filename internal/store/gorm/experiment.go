@@ -0,0 +1,200 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+	"gorm.io/gorm"
+)
+
+// experimentModel represents the database model for experiments.
+type experimentModel struct {
+	ID          string    `gorm:"primaryKey;type:varchar(36)"`
+	TenantID    string    `gorm:"type:varchar(64);not null;default:'default';index"`
+	UserID      string    `gorm:"type:varchar(255);not null;index:idx_experiments_user_created"`
+	Name        string    `gorm:"type:varchar(100);not null"`
+	Description string    `gorm:"type:text;not null;default:''"`
+	TagsJSON    string    `gorm:"type:text;not null;default:'[]'"`
+	CreatedAt   time.Time `gorm:"not null;index:idx_experiments_user_created"`
+	UpdatedAt   time.Time `gorm:"not null"`
+}
+
+func (experimentModel) TableName() string {
+	return "experiments"
+}
+
+// experimentStore implements ExperimentOperations using GORM.
+type experimentStore struct {
+	db *gorm.DB
+}
+
+func newExperimentStore(db *gorm.DB) *experimentStore {
+	return &experimentStore{db: db}
+}
+
+// CreateExperiment creates a new experiment record in the database.
+func (s *experimentStore) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	tagsJSON, err := marshalJSON(experiment.Tags)
+	if err != nil {
+		return err
+	}
+
+	model := &experimentModel{
+		ID:          experiment.ID,
+		TenantID:    tenant.IDFromContext(ctx),
+		UserID:      experiment.UserID,
+		Name:        experiment.Name,
+		Description: experiment.Description,
+		TagsJSON:    tagsJSON,
+		CreatedAt:   experiment.CreatedAt,
+		UpdatedAt:   experiment.UpdatedAt,
+	}
+	return s.db.WithContext(ctx).Create(model).Error
+}
+
+// GetExperiment retrieves an experiment by ID and verifies ownership.
+func (s *experimentStore) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	var model experimentModel
+	if err := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ? AND user_id = ?", experimentID, userID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrExperimentNotFound
+		}
+		return nil, err
+	}
+	return modelToExperiment(&model)
+}
+
+// ListExperiments retrieves the experiments of a user with pagination, newest
+// first.
+func (s *experimentStore) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	// Apply defaults and max limits
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := s.db.WithContext(ctx).Model(&experimentModel{}).Scopes(tenantScope(ctx)).Where("user_id = ?", userID)
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []experimentModel
+	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	experiments := make([]*store.Experiment, len(models))
+	for i := range models {
+		experiment, err := modelToExperiment(&models[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		experiments[i] = experiment
+	}
+	return experiments, int(totalCount), nil
+}
+
+// DeleteExperiment removes an experiment from the database.
+func (s *experimentStore) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	result := s.db.WithContext(ctx).Scopes(tenantScope(ctx)).Where("id = ? AND user_id = ?", experimentID, userID).Delete(&experimentModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrExperimentNotFound
+	}
+	return nil
+}
+
+// CountExperimentExecutions counts the executions of each status of the
+// experiments, grouped in a single query.
+func (s *experimentStore) CountExperimentExecutions(
+	ctx context.Context, userID string, experimentIDs ...string,
+) (map[string]store.ExecutionCounts, error) {
+	counts := make(map[string]store.ExecutionCounts, len(experimentIDs))
+	for _, id := range experimentIDs {
+		counts[id] = store.ExecutionCounts{}
+	}
+	if len(experimentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ExperimentID string
+		Status       string
+		Count        int
+	}
+	if err := s.db.WithContext(ctx).Model(&executionModel{}).Scopes(tenantScope(ctx)).
+		Select("experiment_id, status, COUNT(*) AS count").
+		Where("user_id = ? AND experiment_id IN ?", userID, experimentIDs).
+		Group("experiment_id, status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.ExperimentID][store.ExecutionStatus(row.Status)] = row.Count
+	}
+	return counts, nil
+}
+
+// ListExperimentResults joins the completed executions of the experiment with
+// the indicators stored in their Pareto sets.
+func (s *experimentStore) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	var rows []struct {
+		ID             string
+		Algorithm      string
+		Variant        string
+		Problem        string
+		IndicatorsJSON string
+	}
+	if err := s.db.WithContext(ctx).Table("executions").
+		Select("executions.id, executions.algorithm, executions.variant, executions.problem, pareto_sets.indicators_json").
+		Joins("LEFT JOIN pareto_sets ON pareto_sets.id = executions.pareto_id AND pareto_sets.deleted_at IS NULL").
+		Where("executions.tenant_id = ? AND executions.user_id = ? AND executions.experiment_id = ? AND executions.status = ?",
+			tenant.IDFromContext(ctx), userID, experimentID, string(store.ExecutionStatusCompleted)).
+		Order("executions.created_at ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]*store.ExperimentResult, len(rows))
+	for i, row := range rows {
+		values, err := unmarshalJSON[*indicators.Values](row.IndicatorsJSON)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = &store.ExperimentResult{
+			ExecutionID: row.ID,
+			Algorithm:   row.Algorithm,
+			Variant:     row.Variant,
+			Problem:     row.Problem,
+			Indicators:  values,
+		}
+	}
+	return results, nil
+}
+
+// modelToExperiment converts a database model to a store.Experiment.
+func modelToExperiment(model *experimentModel) (*store.Experiment, error) {
+	tags, err := unmarshalJSON[[]string](model.TagsJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &store.Experiment{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Name:        model.Name,
+		Description: model.Description,
+		Tags:        tags,
+		CreatedAt:   model.CreatedAt,
+		UpdatedAt:   model.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,198 @@
+package gorm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupExperimentTestDB creates an in-memory DB with the models experiments
+// read from migrated.
+func setupExperimentTestDB(t *testing.T) *gormStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	err = db.AutoMigrate(&userModel{}, &paretoModel{}, &vectorModel{}, &executionModel{}, &experimentModel{})
+	require.NoError(t, err)
+	return &gormStore{
+		db:              db,
+		userStore:       newUserStore(db),
+		paretoStore:     newParetoStore(db),
+		executionStore:  newExecutionStore(db),
+		experimentStore: newExperimentStore(db),
+	}
+}
+
+func newTestExperiment(id, userID string, createdAt time.Time) *store.Experiment {
+	return &store.Experiment{
+		ID:          id,
+		UserID:      userID,
+		Name:        "experiment " + id,
+		Description: "compares variants",
+		Tags:        []string{"zdt", "variants"},
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+func TestExperimentStore_Create_Get(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	ctx := context.Background()
+
+	experiment := newTestExperiment("exp-1", "user1", time.Now().Truncate(time.Second))
+	require.NoError(t, s.CreateExperiment(ctx, experiment))
+
+	got, err := s.GetExperiment(ctx, "exp-1", "user1")
+	require.NoError(t, err)
+	assert.Equal(t, experiment.Name, got.Name)
+	assert.Equal(t, experiment.Description, got.Description)
+	assert.Equal(t, experiment.Tags, got.Tags)
+	assert.True(t, experiment.CreatedAt.Equal(got.CreatedAt))
+
+	_, err = s.GetExperiment(ctx, "exp-1", "user2")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound, "experiments belong to their user")
+	_, err = s.GetExperiment(ctx, "missing", "user1")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound)
+}
+
+func TestExperimentStore_ListExperiments(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	ctx := context.Background()
+
+	start := time.Now().Truncate(time.Second)
+	for i := range 3 {
+		id := fmt.Sprintf("exp-%d", i)
+		require.NoError(t, s.CreateExperiment(ctx, newTestExperiment(id, "user1", start.Add(time.Duration(i)*time.Minute))))
+	}
+	require.NoError(t, s.CreateExperiment(ctx, newTestExperiment("other", "user2", start)))
+
+	experiments, total, err := s.ListExperiments(ctx, "user1", 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, experiments, 2)
+	assert.Equal(t, "exp-2", experiments[0].ID, "newest first")
+	assert.Equal(t, "exp-1", experiments[1].ID)
+
+	experiments, total, err = s.ListExperiments(ctx, "user1", 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, "exp-0", experiments[0].ID)
+}
+
+func TestExperimentStore_DeleteExperiment(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateExperiment(ctx, newTestExperiment("exp-1", "user1", time.Now())))
+
+	assert.ErrorIs(t, s.DeleteExperiment(ctx, "exp-1", "user2"), store.ErrExperimentNotFound)
+	require.NoError(t, s.DeleteExperiment(ctx, "exp-1", "user1"))
+	_, err := s.GetExperiment(ctx, "exp-1", "user1")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound)
+	assert.ErrorIs(t, s.DeleteExperiment(ctx, "exp-1", "user1"), store.ErrExperimentNotFound)
+}
+
+func TestExperimentStore_CountExperimentExecutions(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	ctx := context.Background()
+
+	statuses := []store.ExecutionStatus{
+		store.ExecutionStatusCompleted,
+		store.ExecutionStatusCompleted,
+		store.ExecutionStatusFailed,
+		store.ExecutionStatusRunning,
+	}
+	for i, status := range statuses {
+		exec := newTestExecution(fmt.Sprintf("exec-%d", i), "user1")
+		exec.Status = status
+		exec.ExperimentID = "exp-1"
+		require.NoError(t, s.CreateExecution(ctx, exec))
+	}
+	other := newTestExecution("exec-other", "user2")
+	other.ExperimentID = "exp-1"
+	require.NoError(t, s.CreateExecution(ctx, other))
+	require.NoError(t, s.CreateExecution(ctx, newTestExecution("exec-none", "user1")))
+
+	counts, err := s.CountExperimentExecutions(ctx, "user1", "exp-1", "exp-2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]store.ExecutionCounts{
+		"exp-1": {
+			store.ExecutionStatusCompleted: 2,
+			store.ExecutionStatusFailed:    1,
+			store.ExecutionStatusRunning:   1,
+		},
+		"exp-2": {},
+	}, counts)
+
+	counts, err = s.CountExperimentExecutions(ctx, "user1")
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
+func TestExperimentStore_ListExperimentResults(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateUser(ctx, &api.User{
+		Ids:      &api.UserIDs{Username: "user1"},
+		Email:    "user1@example.com",
+		Password: "password",
+	}))
+
+	igd := 0.25
+	paretoSet := &store.ParetoSet{
+		UserID:     "user1",
+		Algorithm:  "gde3",
+		Problem:    "zdt1",
+		Variant:    "rand1",
+		Vectors:    []*api.Vector{{Objectives: []float64{0.5, 0.5}}},
+		Indicators: &indicators.Values{Hypervolume: 0.75, IGD: &igd, ReferencePoint: []float64{1.1, 1.1}},
+	}
+	require.NoError(t, s.CreateParetoSet(ctx, paretoSet))
+
+	completed := newTestExecution("exec-completed", "user1")
+	completed.Status = store.ExecutionStatusCompleted
+	completed.ExperimentID = "exp-1"
+	completed.ParetoID = &paretoSet.ID
+	require.NoError(t, s.CreateExecution(ctx, completed))
+
+	noIndicators := newTestExecution("exec-no-indicators", "user1")
+	noIndicators.Status = store.ExecutionStatusCompleted
+	noIndicators.ExperimentID = "exp-1"
+	noIndicators.CreatedAt = noIndicators.CreatedAt.Add(time.Second)
+	require.NoError(t, s.CreateExecution(ctx, noIndicators))
+
+	failed := newTestExecution("exec-failed", "user1")
+	failed.Status = store.ExecutionStatusFailed
+	failed.ExperimentID = "exp-1"
+	require.NoError(t, s.CreateExecution(ctx, failed))
+
+	results, err := s.ListExperimentResults(ctx, "exp-1", "user1")
+	require.NoError(t, err)
+	require.Len(t, results, 2, "only completed executions have results")
+
+	assert.Equal(t, "exec-completed", results[0].ExecutionID)
+	assert.Equal(t, "gde3", results[0].Algorithm)
+	assert.Equal(t, "rand/1", results[0].Variant)
+	assert.Equal(t, "zdt1", results[0].Problem)
+	require.NotNil(t, results[0].Indicators)
+	assert.Equal(t, 0.75, results[0].Indicators.Hypervolume)
+	assert.Equal(t, &igd, results[0].Indicators.IGD)
+
+	assert.Equal(t, "exec-no-indicators", results[1].ExecutionID)
+	assert.Nil(t, results[1].Indicators)
+
+	results, err = s.ListExperimentResults(ctx, "exp-1", "user2")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
@@ -22,6 +22,12 @@ type gormStore struct {
 	*paretoStore
 	*vectorStore
 	*executionStore
+	*jobStore
+	*checkpointStore
+	*customProblemStore
+	*experimentStore
+	*apiKeyStore
+	*identityStore
 }
 
 // New returns a new GormStore.
@@ -48,11 +54,17 @@ func New(dialector gorm.Dialector, pool store.ConnectionPool) (*gormStore, error
 	}
 
 	store := &gormStore{
-		db:             db,
-		userStore:      newUserStore(db),
-		paretoStore:    newParetoStore(db),
-		vectorStore:    newVectorStore(db),
-		executionStore: newExecutionStore(db),
+		db:                 db,
+		userStore:          newUserStore(db),
+		paretoStore:        newParetoStore(db),
+		vectorStore:        newVectorStore(db),
+		executionStore:     newExecutionStore(db),
+		jobStore:           newJobStore(db),
+		checkpointStore:    newCheckpointStore(db),
+		customProblemStore: newCustomProblemStore(db),
+		experimentStore:    newExperimentStore(db),
+		apiKeyStore:        newAPIKeyStore(db),
+		identityStore:      newIdentityStore(db),
 	}
 
 	return store, nil
@@ -64,6 +76,12 @@ func (s *gormStore) AutoMigrate() error {
 		&paretoModel{},
 		&vectorModel{},
 		&executionModel{},
+		&jobModel{},
+		&checkpointModel{},
+		&customProblemModel{},
+		&experimentModel{},
+		&apiKeyModel{},
+		&identityModel{},
 	)
 }
 
@@ -0,0 +1,89 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"gorm.io/gorm"
+)
+
+// identityModel represents the database model for the external identities of
+// users.
+type identityModel struct {
+	Issuer    string    `gorm:"primaryKey;type:varchar(255)"`
+	Subject   string    `gorm:"primaryKey;type:varchar(255)"`
+	TenantID  string    `gorm:"type:varchar(64);not null;default:'default'"`
+	UserID    string    `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+func (identityModel) TableName() string {
+	return "identities"
+}
+
+// identityStore implements IdentityOperations using GORM.
+type identityStore struct {
+	db *gorm.DB
+}
+
+func newIdentityStore(db *gorm.DB) *identityStore {
+	return &identityStore{db: db}
+}
+
+// CreateIdentityUser creates the user in the tenant of ctx and links the
+// identity to it, in a single transaction.
+func (s *identityStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	model := &identityModel{
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		TenantID:  tenant.IDFromContext(ctx),
+		UserID:    usr.GetIds().GetUsername(),
+		CreatedAt: identity.CreatedAt,
+	}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Deleted users keep their names, which stay unique in the table
+		var taken int64
+		if err := tx.Unscoped().Model(&userModel{}).
+			Where("username = ?", model.UserID).
+			Count(&taken).Error; err != nil {
+			return err
+		}
+		if taken > 0 {
+			return store.ErrUsernameTaken
+		}
+
+		if err := newUserStore(tx).CreateUser(ctx, usr); err != nil {
+			return err
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return err
+	}
+	identity.TenantID = model.TenantID
+	identity.UserID = model.UserID
+	return nil
+}
+
+// GetIdentity retrieves the identity with the issuer and subject from any
+// tenant.
+func (s *identityStore) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	var model identityModel
+	if err := s.db.WithContext(ctx).Where("issuer = ? AND subject = ?", issuer, subject).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrIdentityNotFound
+		}
+		return nil, err
+	}
+	return &store.Identity{
+		Issuer:    model.Issuer,
+		Subject:   model.Subject,
+		TenantID:  model.TenantID,
+		UserID:    model.UserID,
+		CreatedAt: model.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package gorm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/migrations"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupIdentityTestDB(t *testing.T) *identityStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&identityModel{}, &userModel{}))
+	return newIdentityStore(db)
+}
+
+// setupMigratedIdentityTestDB runs the migrations of the server rather than
+// AutoMigrate, whose schema lacks the unique usernames.
+func setupMigratedIdentityTestDB(t *testing.T) *identityStore {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gode.db")
+	require.NoError(t, migrations.Run("sqlite3://"+path))
+	db, err := gorm.Open(sqlite.Open(path))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return newIdentityStore(db)
+}
+
+func TestIdentityStore_CreateAndGet(t *testing.T) {
+	s := setupIdentityTestDB(t)
+	ctx := context.Background()
+	lab := tenant.WithID(ctx, "lab")
+
+	identity := &store.Identity{Issuer: "https://idp.example.edu", Subject: "248289761001", CreatedAt: time.Now()}
+	require.NoError(t, s.CreateIdentityUser(lab, identity, &api.User{Ids: &api.UserIDs{Username: "alice"}}))
+	assert.Equal(t, "lab", identity.TenantID)
+	assert.Equal(t, "alice", identity.UserID)
+
+	usr, err := newUserStore(s.db).GetUser(lab, &api.UserIDs{Username: "alice"})
+	require.NoError(t, err, "the user is created with the identity")
+	assert.Empty(t, usr.Password)
+
+	got, err := s.GetIdentity(ctx, "https://idp.example.edu", "248289761001")
+	require.NoError(t, err, "identities are found from any tenant")
+	assert.Equal(t, "lab", got.TenantID)
+	assert.Equal(t, "alice", got.UserID)
+
+	_, err = s.GetIdentity(ctx, "https://other.example.edu", "248289761001")
+	assert.ErrorIs(t, err, store.ErrIdentityNotFound, "subjects are only unique within an issuer")
+
+	err = s.CreateIdentityUser(lab, &store.Identity{
+		Issuer: "https://idp.example.edu", Subject: "248289761001", CreatedAt: time.Now(),
+	}, &api.User{Ids: &api.UserIDs{Username: "mallory"}})
+	assert.Error(t, err, "an identity is linked to a single user")
+	_, err = newUserStore(s.db).GetUser(lab, &api.UserIDs{Username: "mallory"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound, "the user is rolled back with the identity")
+}
+
+func TestIdentityStore_CreateIdentityUser_UsernameTaken(t *testing.T) {
+	s := setupIdentityTestDB(t)
+	ctx := context.Background()
+	users := newUserStore(s.db)
+	require.NoError(t, users.CreateUser(tenant.WithID(ctx, "acme"), &api.User{
+		Ids: &api.UserIDs{Username: "alice"}, Password: "hash",
+	}))
+	require.NoError(t, users.CreateUser(ctx, &api.User{Ids: &api.UserIDs{Username: "bob"}}))
+	require.NoError(t, users.DeleteUser(ctx, &api.UserIDs{Username: "bob"}))
+
+	for _, username := range []string{"alice", "bob"} {
+		identity := &store.Identity{Issuer: "https://idp.example.edu", Subject: username, CreatedAt: time.Now()}
+		err := s.CreateIdentityUser(tenant.WithID(ctx, "lab"), identity, &api.User{Ids: &api.UserIDs{Username: username}})
+		assert.ErrorIs(t, err, store.ErrUsernameTaken, "users of other tenants and deleted users keep their names")
+
+		_, err = s.GetIdentity(ctx, "https://idp.example.edu", username)
+		assert.ErrorIs(t, err, store.ErrIdentityNotFound)
+	}
+}
+
+func TestIdentityStore_DeletedUser_Migrated(t *testing.T) {
+	s := setupMigratedIdentityTestDB(t)
+	ctx := context.Background()
+	users := newUserStore(s.db)
+
+	identity := &store.Identity{Issuer: "https://idp.example.edu", Subject: "248289761001", CreatedAt: time.Now()}
+	require.NoError(t, s.CreateIdentityUser(ctx, identity, &api.User{Ids: &api.UserIDs{Username: "alice"}}))
+	require.NoError(t, users.DeleteUser(ctx, &api.UserIDs{Username: "alice"}))
+
+	_, err := users.GetUser(ctx, &api.UserIDs{Username: "alice"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound, "deleted users are hidden")
+	link, err := s.GetIdentity(ctx, "https://idp.example.edu", "248289761001")
+	require.NoError(t, err, "identities outlive their users")
+	assert.Equal(t, "alice", link.UserID)
+
+	// The soft deleted row keeps the name, so the user cannot be created again
+	assert.Error(t, users.CreateUser(ctx, &api.User{Ids: &api.UserIDs{Username: "alice"}}))
+	err = s.CreateIdentityUser(ctx, &store.Identity{
+		Issuer: "https://idp.example.edu", Subject: "other", CreatedAt: time.Now(),
+	}, &api.User{Ids: &api.UserIDs{Username: "alice"}})
+	assert.ErrorIs(t, err, store.ErrUsernameTaken)
+}
@@ -0,0 +1,223 @@
+package gorm
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// claimAttempts bounds how many times ClaimJob retries when other workers
+// claim the candidate job first.
+const claimAttempts = 5
+
+// jobModel represents the database model for execution queue jobs.
+type jobModel struct {
+	ExecutionID         string `gorm:"primaryKey;type:varchar(36)"`
+	TenantID            string `gorm:"type:varchar(64);not null;default:'default';index:idx_execution_jobs_tenant_status,priority:1"`
+	UserID              string `gorm:"type:varchar(255);not null"`
+	Status              string `gorm:"type:varchar(20);not null;index:idx_execution_jobs_status_created,priority:1;index:idx_execution_jobs_tenant_status,priority:2"`
+	MaxExecutionSeconds int64  `gorm:"type:bigint;not null;default:0"`
+	Attempts            int    `gorm:"not null;default:0"`
+	WorkerID            string `gorm:"type:varchar(255);not null;default:''"`
+	ClaimedAt           *time.Time
+	HeartbeatAt         *time.Time
+	CreatedAt           time.Time `gorm:"not null;index:idx_execution_jobs_status_created,priority:2"`
+	UpdatedAt           time.Time `gorm:"not null"`
+}
+
+func (jobModel) TableName() string {
+	return "execution_jobs"
+}
+
+// jobStore implements JobOperations using GORM.
+type jobStore struct {
+	db *gorm.DB
+}
+
+func newJobStore(db *gorm.DB) *jobStore {
+	return &jobStore{db: db}
+}
+
+// EnqueueJob adds a queued job for an execution. The job of a resumed
+// execution replaces its finished one, starting over with no attempts.
+func (s *jobStore) EnqueueJob(ctx context.Context, job *store.Job) error {
+	now := time.Now()
+	model := &jobModel{
+		ExecutionID:         job.ExecutionID,
+		TenantID:            cmp.Or(job.TenantID, tenant.DefaultID),
+		UserID:              job.UserID,
+		Status:              string(store.JobStatusQueued),
+		MaxExecutionSeconds: job.MaxExecutionSeconds,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "execution_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"status":                string(store.JobStatusQueued),
+			"max_execution_seconds": job.MaxExecutionSeconds,
+			"attempts":              0,
+			"worker_id":             "",
+			"claimed_at":            nil,
+			"heartbeat_at":          nil,
+			"created_at":            now,
+			"updated_at":            now,
+		}),
+	}).Create(model).Error
+}
+
+// ClaimJob claims the oldest queued job of the tenants not skipped. The claim
+// is a conditional update on the queued status, so when several workers race
+// for the same job only one of them wins and the others move on to the next
+// candidate.
+func (s *jobStore) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	for range claimAttempts {
+		query := s.db.WithContext(ctx).Where("status = ?", string(store.JobStatusQueued))
+		if len(skipTenants) > 0 {
+			query = query.Where("tenant_id NOT IN ?", skipTenants)
+		}
+
+		var candidate jobModel
+		err := query.Order("created_at ASC").First(&candidate).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, store.ErrNoJobAvailable
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		result := s.db.WithContext(ctx).Model(&jobModel{}).
+			Where("execution_id = ? AND status = ?", candidate.ExecutionID, string(store.JobStatusQueued)).
+			Updates(map[string]any{
+				"status":       string(store.JobStatusClaimed),
+				"attempts":     gorm.Expr("attempts + 1"),
+				"worker_id":    workerID,
+				"claimed_at":   now,
+				"heartbeat_at": now,
+				"updated_at":   now,
+			})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		candidate.Status = string(store.JobStatusClaimed)
+		candidate.Attempts++
+		candidate.WorkerID = workerID
+		candidate.ClaimedAt = &now
+		candidate.HeartbeatAt = &now
+		candidate.UpdatedAt = now
+		return modelToJob(&candidate), nil
+	}
+	return nil, store.ErrNoJobAvailable
+}
+
+// HeartbeatJob renews the claim of the worker on the job.
+func (s *jobStore) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	now := time.Now()
+	result := s.db.WithContext(ctx).Model(&jobModel{}).
+		Where("execution_id = ? AND worker_id = ? AND status = ?", executionID, workerID, string(store.JobStatusClaimed)).
+		Updates(map[string]any{
+			"heartbeat_at": now,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrJobNotFound
+	}
+	return nil
+}
+
+// RequeueJob puts the job claimed by the worker back in the queue, keeping
+// its attempts and the worker of the latest claim.
+func (s *jobStore) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	return s.setJobStatus(ctx, executionID, workerID, store.JobStatusQueued)
+}
+
+// CompleteJob marks the job claimed by the worker, or the queued job when
+// workerID is empty, as done.
+func (s *jobStore) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	return s.setJobStatus(ctx, executionID, workerID, store.JobStatusDone)
+}
+
+// ListJobs retrieves the jobs with the given status, oldest first.
+func (s *jobStore) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	var models []jobModel
+	if err := s.db.WithContext(ctx).
+		Where("status = ?", string(status)).
+		Order("created_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*store.Job, len(models))
+	for i := range models {
+		jobs[i] = modelToJob(&models[i])
+	}
+	return jobs, nil
+}
+
+// CountJobs counts the jobs of the tenant with any of the given statuses.
+func (s *jobStore) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	names := make([]string, len(statuses))
+	for i, status := range statuses {
+		names[i] = string(status)
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&jobModel{}).
+		Where("tenant_id = ? AND status IN ?", cmp.Or(tenantID, tenant.DefaultID), names).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (s *jobStore) setJobStatus(ctx context.Context, executionID, workerID string, status store.JobStatus) error {
+	query := s.db.WithContext(ctx).Model(&jobModel{}).Where("execution_id = ?", executionID)
+	if workerID == "" {
+		query = query.Where("status = ?", string(store.JobStatusQueued))
+	} else {
+		query = query.Where("worker_id = ? AND status = ?", workerID, string(store.JobStatusClaimed))
+	}
+	result := query.
+		Updates(map[string]any{
+			"status":     string(status),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrJobNotFound
+	}
+	return nil
+}
+
+// modelToJob converts a database model to a store.Job.
+func modelToJob(model *jobModel) *store.Job {
+	return &store.Job{
+		ExecutionID:         model.ExecutionID,
+		TenantID:            model.TenantID,
+		UserID:              model.UserID,
+		Status:              store.JobStatus(model.Status),
+		MaxExecutionSeconds: model.MaxExecutionSeconds,
+		Attempts:            model.Attempts,
+		WorkerID:            model.WorkerID,
+		ClaimedAt:           model.ClaimedAt,
+		HeartbeatAt:         model.HeartbeatAt,
+		CreatedAt:           model.CreatedAt,
+		UpdatedAt:           model.UpdatedAt,
+	}
+}
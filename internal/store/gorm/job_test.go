@@ -0,0 +1,200 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupJobTestDB creates an in-memory DB with the job model migrated.
+func setupJobTestDB(t *testing.T) *jobStore {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"))
+	require.NoError(t, err)
+	err = db.AutoMigrate(&jobModel{})
+	require.NoError(t, err)
+	return newJobStore(db)
+}
+
+func TestJobStore_ClaimJob(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1", MaxExecutionSeconds: 30}))
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-2", UserID: "user1"}))
+
+	job, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	assert.Equal(t, "exec-1", job.ExecutionID, "oldest job is claimed first")
+	assert.Equal(t, "user1", job.UserID)
+	assert.Equal(t, store.JobStatusClaimed, job.Status)
+	assert.Equal(t, int64(30), job.MaxExecutionSeconds)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, "worker-a", job.WorkerID)
+	assert.NotNil(t, job.ClaimedAt)
+	assert.NotNil(t, job.HeartbeatAt)
+
+	job, err = s.ClaimJob(ctx, "worker-b")
+	require.NoError(t, err)
+	assert.Equal(t, "exec-2", job.ExecutionID)
+	assert.Equal(t, "worker-b", job.WorkerID)
+
+	_, err = s.ClaimJob(ctx, "worker-a")
+	assert.ErrorIs(t, err, store.ErrNoJobAvailable)
+}
+
+func TestJobStore_ClaimJob_SkipTenants(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", TenantID: "lab", UserID: "user1"}))
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-2", UserID: "user2"}))
+
+	job, err := s.ClaimJob(ctx, "worker-a", "lab")
+	require.NoError(t, err)
+	assert.Equal(t, "exec-2", job.ExecutionID, "jobs of skipped tenants are left queued")
+	assert.Equal(t, "default", job.TenantID)
+
+	_, err = s.ClaimJob(ctx, "worker-a", "lab")
+	assert.ErrorIs(t, err, store.ErrNoJobAvailable)
+
+	job, err = s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	assert.Equal(t, "exec-1", job.ExecutionID)
+	assert.Equal(t, "lab", job.TenantID)
+}
+
+func TestJobStore_RequeueJob(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1"}))
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+
+	require.NoError(t, s.RequeueJob(ctx, "exec-1", "worker-a"))
+
+	job, err := s.ClaimJob(ctx, "worker-b")
+	require.NoError(t, err)
+	assert.Equal(t, 2, job.Attempts, "attempts accumulate across claims")
+	assert.Equal(t, "worker-b", job.WorkerID)
+
+	assert.ErrorIs(t, s.RequeueJob(ctx, "missing", "worker-a"), store.ErrJobNotFound)
+}
+
+func TestJobStore_LostClaim(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1"}))
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+
+	// The claim of worker-a went stale, the job was recovered and claimed by
+	// worker-b while worker-a was still running it
+	require.NoError(t, s.RequeueJob(ctx, "exec-1", "worker-a"))
+	_, err = s.ClaimJob(ctx, "worker-b")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, s.CompleteJob(ctx, "exec-1", "worker-a"), store.ErrJobNotFound)
+	assert.ErrorIs(t, s.RequeueJob(ctx, "exec-1", "worker-a"), store.ErrJobNotFound)
+	assert.ErrorIs(t, s.CompleteJob(ctx, "exec-1", ""), store.ErrJobNotFound, "claimed jobs are not completed as queued ones")
+
+	claimed, err := s.ListJobs(ctx, store.JobStatusClaimed)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "worker-b", claimed[0].WorkerID)
+
+	require.NoError(t, s.CompleteJob(ctx, "exec-1", "worker-b"))
+	assert.ErrorIs(t, s.CompleteJob(ctx, "exec-1", "worker-b"), store.ErrJobNotFound, "done jobs have no claim")
+}
+
+func TestJobStore_HeartbeatJob(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1"}))
+	assert.ErrorIs(t, s.HeartbeatJob(ctx, "exec-1", "worker-a"), store.ErrJobNotFound, "queued jobs have no claim to renew")
+
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	assert.NoError(t, s.HeartbeatJob(ctx, "exec-1", "worker-a"))
+	assert.ErrorIs(t, s.HeartbeatJob(ctx, "exec-1", "worker-b"), store.ErrJobNotFound, "only the claiming worker renews the claim")
+}
+
+func TestJobStore_CompleteJob_ListJobs(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"exec-1", "exec-2", "exec-3"} {
+		require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: id, UserID: "user1"}))
+	}
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	_, err = s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	require.NoError(t, s.CompleteJob(ctx, "exec-1", "worker-a"))
+
+	queued, err := s.ListJobs(ctx, store.JobStatusQueued)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, "exec-3", queued[0].ExecutionID)
+
+	claimed, err := s.ListJobs(ctx, store.JobStatusClaimed)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "exec-2", claimed[0].ExecutionID)
+
+	done, err := s.ListJobs(ctx, store.JobStatusDone)
+	require.NoError(t, err)
+	require.Len(t, done, 1)
+	assert.Equal(t, "exec-1", done[0].ExecutionID)
+	assert.Equal(t, 1, done[0].Attempts)
+}
+
+func TestJobStore_CountJobs(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", TenantID: "lab", UserID: "user1"}))
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-2", TenantID: "lab", UserID: "user1"}))
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-3", UserID: "user2"}))
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	require.NoError(t, s.CompleteJob(ctx, "exec-2", ""))
+
+	count, err := s.CountJobs(ctx, "lab", store.JobStatusQueued, store.JobStatusClaimed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "done jobs are not counted")
+
+	count, err = s.CountJobs(ctx, "", store.JobStatusQueued, store.JobStatusClaimed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "an empty tenant is the default one")
+
+	count, err = s.CountJobs(ctx, "lab", store.JobStatusDone)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestJobStore_EnqueueJob_Resumed(t *testing.T) {
+	s := setupJobTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1"}))
+	_, err := s.ClaimJob(ctx, "worker-a")
+	require.NoError(t, err)
+	require.NoError(t, s.CompleteJob(ctx, "exec-1", "worker-a"))
+
+	require.NoError(t, s.EnqueueJob(ctx, &store.Job{ExecutionID: "exec-1", UserID: "user1", MaxExecutionSeconds: 60}))
+
+	job, err := s.ClaimJob(ctx, "worker-b")
+	require.NoError(t, err)
+	assert.Equal(t, "exec-1", job.ExecutionID)
+	assert.Equal(t, 1, job.Attempts, "a resumed job starts over with its attempts")
+	assert.Equal(t, int64(60), job.MaxExecutionSeconds)
+}
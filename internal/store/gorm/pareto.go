@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 	"github.com/nicholaspcr/GoDE/pkg/util"
 	"gorm.io/gorm"
 )
@@ -13,12 +15,14 @@ import (
 type paretoModel struct {
 	User userModel `gorm:"foreignKey:UserID"`
 	gorm.Model
-	MaxObjsJSON string        `gorm:"type:text"`
-	Vectors     []vectorModel `gorm:"foreignKey:ParetoSetID"`
-	UserID      uint
-	Algorithm   string `gorm:"type:varchar(100);not null"`
-	Problem     string `gorm:"type:varchar(100);not null"`
-	Variant     string `gorm:"type:varchar(100);not null"`
+	TenantID       string        `gorm:"type:varchar(64);not null;default:'default';index"`
+	MaxObjsJSON    string        `gorm:"type:text"`
+	IndicatorsJSON string        `gorm:"type:text"`
+	Vectors        []vectorModel `gorm:"foreignKey:ParetoSetID"`
+	UserID         uint
+	Algorithm      string `gorm:"type:varchar(100);not null"`
+	Problem        string `gorm:"type:varchar(100);not null"`
+	Variant        string `gorm:"type:varchar(100);not null"`
 }
 
 func (paretoModel) TableName() string {
@@ -37,6 +41,22 @@ func (p *paretoModel) GetMaxObjs() ([]float64, error) {
 	return unmarshalJSON[[]float64](p.MaxObjsJSON)
 }
 
+// SetIndicators serializes the quality indicators to JSON
+func (p *paretoModel) SetIndicators(values *indicators.Values) error {
+	if values == nil {
+		p.IndicatorsJSON = ""
+		return nil
+	}
+	var err error
+	p.IndicatorsJSON, err = marshalJSON(values)
+	return err
+}
+
+// GetIndicators deserializes JSON to quality indicators, nil when none were stored
+func (p *paretoModel) GetIndicators() (*indicators.Values, error) {
+	return unmarshalJSON[*indicators.Values](p.IndicatorsJSON)
+}
+
 // vectorModelToAPI converts a vectorModel to an api.Vector including its database ID.
 func vectorModelToAPI(vec vectorModel) (*api.Vector, error) {
 	elements, err := vec.GetElements()
@@ -63,7 +83,7 @@ func (st *paretoStore) CreatePareto(
 	ctx context.Context, pareto *api.Pareto,
 ) error {
 	// Create pareto model
-	paretoModel := paretoModel{}
+	paretoModel := paretoModel{TenantID: tenant.IDFromContext(ctx)}
 
 	// Set max objectives
 	if err := paretoModel.SetMaxObjs(pareto.MaxObjs); err != nil {
@@ -74,7 +94,7 @@ func (st *paretoStore) CreatePareto(
 	if pareto.Ids != nil && pareto.Ids.UserId != "" {
 		// Look up user by username/ID
 		var user userModel
-		tx := st.DB.WithContext(ctx).Where("username = ?", pareto.Ids.UserId).First(&user)
+		tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Where("username = ?", pareto.Ids.UserId).First(&user)
 		if tx.Error != nil {
 			if tx.Error == gorm.ErrRecordNotFound {
 				return fmt.Errorf("user not found: %s", pareto.Ids.UserId)
@@ -122,7 +142,7 @@ func (st *paretoStore) GetPareto(
 	ctx context.Context, paretoIDs *api.ParetoIDs,
 ) (*api.Pareto, error) {
 	var pareto paretoModel
-	tx := st.DB.WithContext(ctx).Preload("Vectors").First(&pareto, paretoIDs.Id)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Preload("Vectors", "archived = ?", false).First(&pareto, paretoIDs.Id)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
@@ -153,7 +173,7 @@ func (st *paretoStore) UpdatePareto(
 	}
 
 	var paretoModel paretoModel
-	tx := st.DB.WithContext(ctx).First(&paretoModel, pareto.Ids.Id)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).First(&paretoModel, pareto.Ids.Id)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -187,17 +207,14 @@ func (st *paretoStore) DeletePareto(
 	ctx context.Context, paretoIDs *api.ParetoIDs,
 ) error {
 	return st.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Delete associated vectors first (cascade)
-		if err := tx.Where("pareto_set_id = ?", paretoIDs.Id).Delete(&vectorModel{}).Error; err != nil {
-			return err
-		}
-
-		// Delete pareto
-		if err := tx.Delete(&paretoModel{}, paretoIDs.Id).Error; err != nil {
-			return err
+		// Delete pareto, leaving the vectors alone when it belongs to another tenant
+		result := tx.Scopes(tenantScope(ctx)).Delete(&paretoModel{}, paretoIDs.Id)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
 		}
 
-		return nil
+		// Delete associated vectors (cascade)
+		return tx.Where("pareto_set_id = ?", paretoIDs.Id).Delete(&vectorModel{}).Error
 	})
 }
 
@@ -215,12 +232,12 @@ func (st *paretoStore) ListParetos(
 
 	// Look up user by username
 	var user userModel
-	tx := st.DB.WithContext(ctx).Where("username = ?", userIDs.Username).First(&user)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Where("username = ?", userIDs.Username).First(&user)
 	if tx.Error != nil {
 		return nil, 0, tx.Error
 	}
 
-	query := st.DB.WithContext(ctx).Where("user_id = ?", user.ID)
+	query := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Where("user_id = ?", user.ID)
 
 	// Get total count
 	var totalCount int64
@@ -232,7 +249,7 @@ func (st *paretoStore) ListParetos(
 	query = query.Order("created_at DESC").Limit(limit).Offset(offset)
 
 	var paretos []paretoModel
-	if err := query.Preload("Vectors").Find(&paretos).Error; err != nil {
+	if err := query.Preload("Vectors", "archived = ?", false).Find(&paretos).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -262,6 +279,7 @@ func (st *paretoStore) ListParetos(
 func (st *paretoStore) CreateParetoSet(ctx context.Context, paretoSet *store.ParetoSet) error {
 	// Create pareto model
 	paretoModel := paretoModel{
+		TenantID:  tenant.IDFromContext(ctx),
 		Algorithm: paretoSet.Algorithm,
 		Problem:   paretoSet.Problem,
 		Variant:   paretoSet.Variant,
@@ -279,9 +297,13 @@ func (st *paretoStore) CreateParetoSet(ctx context.Context, paretoSet *store.Par
 		return err
 	}
 
+	if err := paretoModel.SetIndicators(paretoSet.Indicators); err != nil {
+		return err
+	}
+
 	// Look up user - return error if not found to prevent orphaned pareto records
 	var user userModel
-	tx := st.DB.WithContext(ctx).Where("username = ?", paretoSet.UserID).First(&user)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Where("username = ?", paretoSet.UserID).First(&user)
 	if tx.Error != nil {
 		if tx.Error == gorm.ErrRecordNotFound {
 			return fmt.Errorf("user not found: %s", paretoSet.UserID)
@@ -300,20 +322,30 @@ func (st *paretoStore) CreateParetoSet(ctx context.Context, paretoSet *store.Par
 		// Set the ID back to the paretoSet
 		paretoSet.ID = uint64(paretoModel.ID)
 
-		// Prepare all vectors for batch insert
-		vectorModels := make([]vectorModel, 0, len(paretoSet.Vectors))
-		for _, vec := range paretoSet.Vectors {
-			vectorModel := vectorModel{
-				ParetoSetID:      paretoModel.ID,
-				CrowdingDistance: vec.CrowdingDistance,
+		// Prepare all vectors for batch insert, flagging the archived ones
+		vectorModels := make([]vectorModel, 0, len(paretoSet.Vectors)+len(paretoSet.ParetoArchive))
+		appendVectors := func(vectors []*api.Vector, archived bool) error {
+			for _, vec := range vectors {
+				vectorModel := vectorModel{
+					ParetoSetID:      paretoModel.ID,
+					CrowdingDistance: vec.CrowdingDistance,
+					Archived:         archived,
+				}
+				if err := vectorModel.SetElements(vec.Elements); err != nil {
+					return err
+				}
+				if err := vectorModel.SetObjectives(vec.Objectives); err != nil {
+					return err
+				}
+				vectorModels = append(vectorModels, vectorModel)
 			}
-			if err := vectorModel.SetElements(vec.Elements); err != nil {
-				return err
-			}
-			if err := vectorModel.SetObjectives(vec.Objectives); err != nil {
-				return err
-			}
-			vectorModels = append(vectorModels, vectorModel)
+			return nil
+		}
+		if err := appendVectors(paretoSet.Vectors, false); err != nil {
+			return err
+		}
+		if err := appendVectors(paretoSet.ParetoArchive, true); err != nil {
+			return err
 		}
 
 		// Batch insert all vectors (100 per batch for optimal performance)
@@ -330,7 +362,7 @@ func (st *paretoStore) CreateParetoSet(ctx context.Context, paretoSet *store.Par
 // GetParetoSetByID retrieves a pareto set by its ID.
 func (st *paretoStore) GetParetoSetByID(ctx context.Context, id uint64) (*store.ParetoSet, error) {
 	var paretoModel paretoModel
-	tx := st.DB.WithContext(ctx).Preload("Vectors").Preload("User").First(&paretoModel, id)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Preload("Vectors").Preload("User").First(&paretoModel, id)
 	if tx.Error != nil {
 		if tx.Error == gorm.ErrRecordNotFound {
 			return nil, store.ErrParetoSetNotFound
@@ -344,7 +376,13 @@ func (st *paretoStore) GetParetoSetByID(ctx context.Context, id uint64) (*store.
 		return nil, err
 	}
 
-	vectors, err := util.MapSlice(paretoModel.Vectors, func(vec vectorModel) (*api.Vector, error) {
+	values, err := paretoModel.GetIndicators()
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors, archive []*api.Vector
+	for _, vec := range paretoModel.Vectors {
 		elements, err := vec.GetElements()
 		if err != nil {
 			return nil, err
@@ -353,14 +391,16 @@ func (st *paretoStore) GetParetoSetByID(ctx context.Context, id uint64) (*store.
 		if err != nil {
 			return nil, err
 		}
-		return &api.Vector{
+		v := &api.Vector{
 			Elements:         elements,
 			Objectives:       objectives,
 			CrowdingDistance: vec.CrowdingDistance,
-		}, nil
-	})
-	if err != nil {
-		return nil, err
+		}
+		if vec.Archived {
+			archive = append(archive, v)
+		} else {
+			vectors = append(vectors, v)
+		}
 	}
 
 	// Convert flat max objs to store.MaxObjectives
@@ -375,7 +415,9 @@ func (st *paretoStore) GetParetoSetByID(ctx context.Context, id uint64) (*store.
 		Problem:       paretoModel.Problem,
 		Variant:       paretoModel.Variant,
 		Vectors:       vectors,
+		ParetoArchive: archive,
 		MaxObjectives: maxObjectives,
+		Indicators:    values,
 		CreatedAt:     paretoModel.CreatedAt,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/indicators"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -361,6 +362,40 @@ func TestParetoModel_MaxObjsSerialization(t *testing.T) {
 	})
 }
 
+func TestParetoModel_IndicatorsSerialization(t *testing.T) {
+	t.Run("serialize and deserialize indicators", func(t *testing.T) {
+		model := &paretoModel{}
+		igd := 0.25
+		values := &indicators.Values{
+			Hypervolume:    0.8,
+			ReferencePoint: []float64{1.1, 1.1},
+			IGD:            &igd,
+			Spread:         0.3,
+			Spacing:        0.05,
+		}
+
+		err := model.SetIndicators(values)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, model.IndicatorsJSON)
+
+		retrieved, err := model.GetIndicators()
+		assert.NoError(t, err)
+		assert.Equal(t, values, retrieved)
+	})
+
+	t.Run("nil indicators", func(t *testing.T) {
+		model := &paretoModel{}
+
+		err := model.SetIndicators(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, model.IndicatorsJSON)
+
+		retrieved, err := model.GetIndicators()
+		assert.NoError(t, err)
+		assert.Nil(t, retrieved)
+	})
+}
+
 func TestParetoStore_TransactionRollback(t *testing.T) {
 	store := setupTestDB(t)
 	ctx := context.Background()
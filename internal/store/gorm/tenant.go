@@ -0,0 +1,18 @@
+package gorm
+
+import (
+	"context"
+
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"gorm.io/gorm"
+)
+
+// tenantScope restricts the queries it is applied to to the rows of the
+// tenant of ctx, every table holding data of the users has a tenant_id
+// column.
+func tenantScope(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	tenantID := tenant.IDFromContext(ctx)
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tenant_id = ?", tenantID)
+	}
+}
@@ -0,0 +1,65 @@
+package gorm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_TenantIsolation(t *testing.T) {
+	s := setupExperimentTestDB(t)
+	lab := tenant.WithID(context.Background(), "lab")
+	acme := tenant.WithID(context.Background(), "acme")
+
+	require.NoError(t, s.CreateUser(lab, &api.User{
+		Ids: &api.UserIDs{Username: "alice"}, Email: "alice@lab.org", Password: "hash",
+	}))
+	_, err := s.GetUser(acme, &api.UserIDs{Username: "alice"})
+	assert.Error(t, err, "users of another tenant are not found")
+	_, err = s.GetUser(lab, &api.UserIDs{Username: "alice"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateExecution(lab, newTestExecution("exec-1", "alice")))
+	_, err = s.GetExecution(acme, "exec-1", "alice")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+	executions, total, err := s.ListExecutions(acme, "alice", store.ExecutionFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+	assert.Zero(t, total)
+
+	require.NoError(t, s.UpdateExecutionStatus(acme, "exec-1", store.ExecutionStatusFailed, "not yours"))
+	got, err := s.GetExecution(lab, "exec-1", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, store.ExecutionStatusPending, got.Status, "updates of another tenant change nothing")
+
+	assert.ErrorIs(t, s.DeleteExecution(acme, "exec-1", "alice"), store.ErrExecutionNotFound)
+
+	ps := &store.ParetoSet{UserID: "alice", Algorithm: "gde3", Problem: "zdt1", Variant: "rand1"}
+	assert.Error(t, s.CreateParetoSet(acme, ps), "the user is looked up in the tenant")
+	require.NoError(t, s.CreateParetoSet(lab, ps))
+	_, err = s.GetParetoSetByID(acme, ps.ID)
+	assert.ErrorIs(t, err, store.ErrParetoSetNotFound)
+	_, err = s.GetParetoSetByID(lab, ps.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateExperiment(lab, newTestExperiment("exp-1", "alice", time.Now())))
+	_, err = s.GetExperiment(acme, "exp-1", "alice")
+	assert.ErrorIs(t, err, store.ErrExperimentNotFound)
+}
+
+func TestStore_DefaultTenant(t *testing.T) {
+	s := setupExecutionTestDB(t)
+
+	// Rows stored without a tenant in the context belong to the default one
+	require.NoError(t, s.CreateExecution(context.Background(), newTestExecution("exec-1", "user1")))
+	_, err := s.GetExecution(tenant.WithID(context.Background(), tenant.DefaultID), "exec-1", "user1")
+	require.NoError(t, err)
+	_, err = s.GetExecution(tenant.WithID(context.Background(), "lab"), "exec-1", "user1")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+}
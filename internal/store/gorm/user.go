@@ -2,23 +2,38 @@ package gorm
 
 import (
 	"context"
+	"strings"
 
 	"github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"gorm.io/gorm"
 )
 
 type userModel struct {
 	gorm.Model
+	TenantID string `gorm:"type:varchar(64);not null;default:'default';index"`
 	Username string `gorm:"index:username_index,not null,size:64"`
 	Email    string `gorm:"index:user_email_index,not null,size:256"`
 	Password string `gorm:"not null,size:256"`
+	// Scopes granted to the user separated by spaces, empty for the
+	// default ones.
+	Scopes string `gorm:"type:text;not null;default:''"`
 }
 
 func (userModel) TableName() string {
 	return "users"
 }
 
+// toAPI converts the model to an api.User without its password.
+func (m *userModel) toAPI() *api.User {
+	return &api.User{
+		Ids:    &api.UserIDs{Username: m.Username},
+		Email:  m.Email,
+		Scopes: strings.Fields(m.Scopes),
+	}
+}
+
 type userStore struct{ *gorm.DB }
 
 func newUserStore(db *gorm.DB) *userStore { return &userStore{db} }
@@ -27,9 +42,11 @@ func (st *userStore) CreateUser(
 	ctx context.Context, usr *api.User,
 ) error {
 	user := userModel{
+		TenantID: tenant.IDFromContext(ctx),
 		Username: usr.GetIds().Username,
 		Email:    usr.Email,
 		Password: usr.Password,
+		Scopes:   strings.Join(usr.Scopes, " "),
 	}
 	tx := st.DB.WithContext(ctx).Create(&user)
 	return tx.Error
@@ -44,15 +61,13 @@ func (st *userStore) GetUser(
 
 	var usr userModel
 
-	tx := st.DB.WithContext(ctx).First(&usr, "username = ?", usrIDs.Username)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).First(&usr, "username = ?", usrIDs.Username)
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
-	return &api.User{
-		Ids:      &api.UserIDs{Username: usr.Username},
-		Email:    usr.Email,
-		Password: usr.Password, // Password hash needed for auth verification
-	}, nil
+	user := usr.toAPI()
+	user.Password = usr.Password // Password hash needed for auth verification
+	return user, nil
 }
 
 func (st *userStore) UpdateUser(
@@ -60,7 +75,7 @@ func (st *userStore) UpdateUser(
 ) error {
 	var model userModel
 
-	tx := st.DB.WithContext(ctx).First(&model, "username = ?", usr.GetIds().Username)
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).First(&model, "username = ?", usr.GetIds().Username)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -74,6 +89,8 @@ func (st *userStore) UpdateUser(
 			columns[field] = usr.Email
 		case "password":
 			columns[field] = usr.Password
+		case "scopes":
+			columns[field] = strings.Join(usr.Scopes, " ")
 		}
 	}
 
@@ -87,6 +104,38 @@ func (st *userStore) UpdateUser(
 func (st *userStore) DeleteUser(
 	ctx context.Context, usrIDs *api.UserIDs,
 ) error {
-	tx := st.DB.WithContext(ctx).Where("username = ?", usrIDs.Username).Delete(&userModel{})
+	tx := st.DB.WithContext(ctx).Scopes(tenantScope(ctx)).Where("username = ?", usrIDs.Username).Delete(&userModel{})
 	return tx.Error
 }
+
+// ListUsers returns the users of the tenant ordered by username, without
+// their passwords.
+func (st *userStore) ListUsers(
+	ctx context.Context, limit, offset int,
+) ([]*api.User, int, error) {
+	// Apply defaults and max limits
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := st.DB.WithContext(ctx).Model(&userModel{}).Scopes(tenantScope(ctx))
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []userModel
+	if err := query.Order("username ASC").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]*api.User, len(models))
+	for i := range models {
+		users[i] = models[i].toAPI()
+	}
+	return users, int(totalCount), nil
+}
@@ -14,6 +14,9 @@ type vectorModel struct {
 	Pareto           paretoModel `gorm:"foreignKey:ParetoSetID"`
 	ParetoSetID      uint        `gorm:"column:pareto_set_id"`
 	CrowdingDistance float64     `gorm:"column:crowding_distance"`
+	// Archived vectors belong to the external archive of the pareto set
+	// instead of the set itself.
+	Archived bool `gorm:"column:archived;not null;default:false"`
 }
 
 func (vectorModel) TableName() string {
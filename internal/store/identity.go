@@ -0,0 +1,23 @@
+package store
+
+import (
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store/errors"
+)
+
+// Re-export identity errors from the errors package.
+var (
+	ErrIdentityNotFound = errors.ErrIdentityNotFound
+	ErrUsernameTaken    = errors.ErrUsernameTaken
+)
+
+// Identity links a user to their account at an external identity provider,
+// named by the issuer of the provider and the subject of the account.
+type Identity struct {
+	Issuer    string
+	Subject   string
+	TenantID  string
+	UserID    string
+	CreatedAt time.Time
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 )
@@ -11,6 +12,13 @@ type Store interface {
 	UserOperations
 	ParetoOperations
 	ExecutionOperations
+	JobOperations
+	CheckpointOperations
+	CustomProblemOperations
+	ExperimentOperations
+	AdminOperations
+	APIKeyOperations
+	IdentityOperations
 	HealthCheck(context.Context) error
 }
 
@@ -39,7 +47,10 @@ type ExecutionOperations interface {
 	GetExecution(ctx context.Context, executionID, userID string) (*Execution, error)
 	UpdateExecutionStatus(ctx context.Context, executionID string, status ExecutionStatus, errorMsg string) error
 	UpdateExecutionResult(ctx context.Context, executionID string, paretoID uint64) error
-	ListExecutions(ctx context.Context, userID string, status *ExecutionStatus, limit, offset int) ([]*Execution, int, error)
+	// RestartExecution puts a finished execution back to pending with the
+	// given config, clearing its error and completion time.
+	RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error
+	ListExecutions(ctx context.Context, userID string, filter ExecutionFilter, limit, offset int) ([]*Execution, int, error)
 	DeleteExecution(ctx context.Context, executionID, userID string) error
 
 	// Idempotency: returns existing executionID or ErrExecutionNotFound.
@@ -56,3 +67,113 @@ type ExecutionOperations interface {
 	// Real-time updates
 	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
 }
+
+// JobOperations is the interface for the durable execution queue. The queue
+// is shared by every tenant, its operations ignore the tenant of the context.
+type JobOperations interface {
+	EnqueueJob(ctx context.Context, job *Job) error
+	// ClaimJob atomically claims the oldest queued job for the worker,
+	// skipping the jobs of the given tenants. Returns ErrNoJobAvailable when
+	// no job is left to claim.
+	ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*Job, error)
+	// HeartbeatJob renews the claim of the worker, returns ErrJobNotFound
+	// when the job is no longer claimed by it.
+	HeartbeatJob(ctx context.Context, executionID, workerID string) error
+	// RequeueJob and CompleteJob end the claim of the worker on the job and
+	// return ErrJobNotFound when the job is no longer claimed by it, so that
+	// a worker whose job was recovered cannot finish it. An empty worker
+	// completes a job still queued, such as the job of a cancelled execution.
+	RequeueJob(ctx context.Context, executionID, workerID string) error
+	CompleteJob(ctx context.Context, executionID, workerID string) error
+	ListJobs(ctx context.Context, status JobStatus) ([]*Job, error)
+	// CountJobs counts the jobs of the tenant with any of the given statuses.
+	CountJobs(ctx context.Context, tenantID string, statuses ...JobStatus) (int, error)
+}
+
+// CheckpointOperations is the interface for the checkpoints executions
+// resume from.
+type CheckpointOperations interface {
+	// SaveCheckpoint replaces the checkpoint of the same execution number.
+	SaveCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	// ListCheckpoints returns the latest checkpoint of every execution
+	// number of the execution, ordered by execution number.
+	ListCheckpoints(ctx context.Context, executionID string) ([]*Checkpoint, error)
+}
+
+// CustomProblemOperations is the interface for the custom problems users
+// save to run again by name.
+type CustomProblemOperations interface {
+	// SaveCustomProblem creates the problem or replaces the problem of the
+	// user with the same name.
+	SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error
+	// GetCustomProblem returns ErrCustomProblemNotFound when the user has no
+	// problem with the name.
+	GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error)
+	// ListCustomProblems returns the problems of the user ordered by name.
+	ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error)
+}
+
+// ExperimentOperations is the interface for the experiments grouping the
+// executions of a user.
+type ExperimentOperations interface {
+	CreateExperiment(ctx context.Context, experiment *Experiment) error
+	// GetExperiment returns ErrExperimentNotFound when the user has no
+	// experiment with the ID.
+	GetExperiment(ctx context.Context, experimentID, userID string) (*Experiment, error)
+	// ListExperiments returns the experiments of the user, newest first.
+	ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*Experiment, int, error)
+	// DeleteExperiment removes the experiment, leaving its executions to the
+	// caller.
+	DeleteExperiment(ctx context.Context, experimentID, userID string) error
+	// CountExperimentExecutions returns the number of executions of each
+	// status of the given experiments of the user.
+	CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]ExecutionCounts, error)
+	// ListExperimentResults returns the indicators of the completed
+	// executions of the experiment.
+	ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*ExperimentResult, error)
+}
+
+// AdminOperations is the interface for the operations of administrators,
+// which span every user of the tenant.
+type AdminOperations interface {
+	// ListUsers returns the users of the tenant ordered by username.
+	ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error)
+	// ListAllExecutions returns the executions of every user, or of userID
+	// when it is not empty, newest first.
+	ListAllExecutions(ctx context.Context, userID string, filter ExecutionFilter, limit, offset int) ([]*Execution, int, error)
+	// FindExecution returns the execution of any user, ErrExecutionNotFound
+	// when there is none with the ID.
+	FindExecution(ctx context.Context, executionID string) (*Execution, error)
+	// PurgeExecutions deletes the completed, failed and cancelled executions
+	// last updated before the cutoff with their Pareto sets, checkpoints and
+	// jobs. It returns the IDs of the deleted executions.
+	PurgeExecutions(ctx context.Context, before time.Time) ([]string, error)
+}
+
+// APIKeyOperations is the interface for the API keys of the users.
+type APIKeyOperations interface {
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	// ListAPIKeys returns the keys of the user, newest first.
+	ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	// DeleteAPIKey returns ErrAPIKeyNotFound when the user has no key with
+	// the ID.
+	DeleteAPIKey(ctx context.Context, keyID, userID string) error
+	// GetAPIKeyByHash returns the key of any tenant with the hash, the tenant
+	// of a request is only known once its key is found. It returns
+	// ErrAPIKeyNotFound when there is none.
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+}
+
+// IdentityOperations is the interface for the links between users and their
+// accounts at external identity providers.
+type IdentityOperations interface {
+	// CreateIdentityUser creates the user in the tenant of ctx and links the
+	// identity to it, in a single transaction. Usernames are unique across
+	// tenants, it returns ErrUsernameTaken when a user of any tenant, deleted
+	// ones included, has the username.
+	CreateIdentityUser(ctx context.Context, identity *Identity, usr *api.User) error
+	// GetIdentity returns the identity of any tenant with the issuer and
+	// subject, the tenant of a login is only known once it is found. It
+	// returns ErrIdentityNotFound when there is none.
+	GetIdentity(ctx context.Context, issuer, subject string) (*Identity, error)
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"time"
+
+	"github.com/nicholaspcr/GoDE/internal/store/errors"
+)
+
+// Re-export job errors from the errors package.
+var (
+	ErrNoJobAvailable = errors.ErrNoJobAvailable
+	ErrJobNotFound    = errors.ErrJobNotFound
+)
+
+// JobStatus represents the state of a job in the execution queue.
+type JobStatus string
+
+const (
+	JobStatusQueued  JobStatus = "queued"
+	JobStatusClaimed JobStatus = "claimed"
+	JobStatusDone    JobStatus = "done"
+)
+
+// Job is the durable queue entry of an execution. Submitting an execution
+// enqueues its job, a worker claims it to run the execution and completes it
+// once the execution reaches a terminal status. The queue is shared by every
+// tenant, so jobs carry the tenant their execution belongs to.
+type Job struct {
+	ExecutionID         string
+	TenantID            string // Tenant of the execution, empty for the default one
+	UserID              string
+	Status              JobStatus
+	MaxExecutionSeconds int64      // 0 = use server default
+	Attempts            int        // Times the job was claimed
+	WorkerID            string     // Worker of the latest claim
+	ClaimedAt           *time.Time // Time of the latest claim
+	HeartbeatAt         *time.Time // Last time the claiming worker reported it alive
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
@@ -0,0 +1,2 @@
+-- Remove indicators_json column from pareto_sets table
+ALTER TABLE pareto_sets DROP COLUMN indicators_json;
@@ -0,0 +1,2 @@
+-- Add indicators_json column to pareto_sets table
+ALTER TABLE pareto_sets ADD COLUMN indicators_json TEXT;
@@ -0,0 +1,2 @@
+-- Remove seed column from executions table
+ALTER TABLE executions DROP COLUMN seed;
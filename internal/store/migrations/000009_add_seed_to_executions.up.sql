@@ -0,0 +1,2 @@
+-- Add the random seed used by an execution so it can be reproduced
+ALTER TABLE executions ADD COLUMN seed BIGINT NOT NULL DEFAULT 0;
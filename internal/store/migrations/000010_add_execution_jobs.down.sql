@@ -0,0 +1,3 @@
+-- Remove the execution queue
+DROP INDEX IF EXISTS idx_execution_jobs_status_created;
+DROP TABLE IF EXISTS execution_jobs;
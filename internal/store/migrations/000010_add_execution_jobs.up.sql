@@ -0,0 +1,16 @@
+-- Add the durable queue of executions waiting for or claimed by a worker
+CREATE TABLE IF NOT EXISTS execution_jobs (
+    execution_id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    max_execution_seconds BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    worker_id VARCHAR(255) NOT NULL DEFAULT '',
+    claimed_at TIMESTAMP,
+    heartbeat_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Workers claim the oldest queued job first
+CREATE INDEX IF NOT EXISTS idx_execution_jobs_status_created ON execution_jobs(status, created_at);
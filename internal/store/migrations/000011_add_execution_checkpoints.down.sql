@@ -0,0 +1,2 @@
+-- Remove the execution checkpoints
+DROP TABLE IF EXISTS execution_checkpoints;
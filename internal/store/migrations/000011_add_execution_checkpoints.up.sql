@@ -0,0 +1,11 @@
+-- Add the checkpoints executions resume from, the latest one of every
+-- execution number of a run
+CREATE TABLE IF NOT EXISTS execution_checkpoints (
+    execution_id VARCHAR(36) NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+    execution_number INTEGER NOT NULL,
+    generation INTEGER NOT NULL,
+    data BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (execution_id, execution_number)
+);
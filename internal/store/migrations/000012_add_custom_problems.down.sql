@@ -0,0 +1,2 @@
+-- Remove the saved custom problems
+DROP TABLE IF EXISTS custom_problems;
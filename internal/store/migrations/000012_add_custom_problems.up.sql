@@ -0,0 +1,9 @@
+-- Add the custom problems users save to run again by name
+CREATE TABLE IF NOT EXISTS custom_problems (
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    definition_json TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, name)
+);
@@ -0,0 +1,3 @@
+-- Remove archived vectors and the archived column from vectors table
+DELETE FROM vectors WHERE archived = TRUE;
+ALTER TABLE vectors DROP COLUMN archived;
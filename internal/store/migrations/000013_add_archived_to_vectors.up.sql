@@ -0,0 +1,2 @@
+-- Mark the vectors of a pareto set that belong to its external archive
+ALTER TABLE vectors ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+-- Remove experiments and the experiment of executions
+DROP INDEX IF EXISTS idx_executions_experiment_id;
+ALTER TABLE executions DROP COLUMN experiment_id;
+DROP TABLE IF EXISTS experiments;
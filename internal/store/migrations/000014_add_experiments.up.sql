@@ -0,0 +1,16 @@
+-- Add the experiments grouping related executions of a user
+CREATE TABLE IF NOT EXISTS experiments (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    tags_json TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_experiments_user_created ON experiments(user_id, created_at DESC);
+
+-- Executions belong to at most one experiment, empty for none
+ALTER TABLE executions ADD COLUMN experiment_id VARCHAR(36) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_executions_experiment_id ON executions(experiment_id);
@@ -0,0 +1,14 @@
+-- Remove tenants, every row goes back to the single namespace
+DROP INDEX IF EXISTS idx_execution_jobs_tenant_status;
+DROP INDEX IF EXISTS idx_experiments_tenant_user_created;
+DROP INDEX IF EXISTS idx_executions_tenant_user_created;
+DROP INDEX IF EXISTS idx_pareto_sets_tenant_user;
+DROP INDEX IF EXISTS idx_users_tenant_username;
+
+ALTER TABLE experiments DROP COLUMN tenant_id;
+ALTER TABLE custom_problems DROP COLUMN tenant_id;
+ALTER TABLE execution_checkpoints DROP COLUMN tenant_id;
+ALTER TABLE execution_jobs DROP COLUMN tenant_id;
+ALTER TABLE executions DROP COLUMN tenant_id;
+ALTER TABLE pareto_sets DROP COLUMN tenant_id;
+ALTER TABLE users DROP COLUMN tenant_id;
@@ -0,0 +1,19 @@
+-- Namespace the data of each tenant, rows stored before tenants existed
+-- belong to the default tenant. Usernames stay unique across tenants, so
+-- the user ID keeps identifying the rows of a user.
+ALTER TABLE users ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE pareto_sets ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE executions ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE execution_jobs ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE execution_checkpoints ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE custom_problems ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE experiments ADD COLUMN tenant_id VARCHAR(64) NOT NULL DEFAULT 'default';
+
+-- Listings filter on the tenant before the user
+CREATE INDEX IF NOT EXISTS idx_users_tenant_username ON users(tenant_id, username);
+CREATE INDEX IF NOT EXISTS idx_pareto_sets_tenant_user ON pareto_sets(tenant_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_executions_tenant_user_created ON executions(tenant_id, user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_experiments_tenant_user_created ON experiments(tenant_id, user_id, created_at DESC);
+
+-- Quotas count the active jobs of a tenant
+CREATE INDEX IF NOT EXISTS idx_execution_jobs_tenant_status ON execution_jobs(tenant_id, status);
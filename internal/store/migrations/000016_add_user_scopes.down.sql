@@ -0,0 +1,2 @@
+-- Remove the scopes of users
+ALTER TABLE users DROP COLUMN scopes;
@@ -0,0 +1,3 @@
+-- Add the scopes granted to users by administrators, separated by spaces.
+-- Empty grants the default user scopes.
+ALTER TABLE users ADD COLUMN scopes TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+-- Remove the API keys of users
+DROP INDEX IF EXISTS idx_api_keys_tenant_user_created;
+DROP INDEX IF EXISTS idx_api_keys_key_hash;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,2 @@
+-- Remove the links between users and external identity providers
+DROP TABLE IF EXISTS identities;
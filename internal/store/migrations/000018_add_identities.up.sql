@@ -0,0 +1,11 @@
+-- Add the links between users and their accounts at external identity
+-- providers, an account is named by the issuer of its provider and its
+-- subject.
+CREATE TABLE IF NOT EXISTS identities (
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    user_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (issuer, subject)
+);
@@ -3,6 +3,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/nicholaspcr/GoDE/internal/store"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
@@ -36,7 +37,8 @@ type MockStore struct {
 	GetExecutionFn                 func(ctx context.Context, executionID, userID string) (*store.Execution, error)
 	UpdateExecutionStatusFn        func(ctx context.Context, executionID string, status store.ExecutionStatus, errorMsg string) error
 	UpdateExecutionResultFn        func(ctx context.Context, executionID string, paretoID uint64) error
-	ListExecutionsFn               func(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error)
+	RestartExecutionFn             func(ctx context.Context, executionID string, config *api.DEConfig) error
+	ListExecutionsFn               func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
 	DeleteExecutionFn              func(ctx context.Context, executionID, userID string) error
 	SaveProgressFn                 func(ctx context.Context, progress *store.ExecutionProgress) error
 	GetProgressFn                  func(ctx context.Context, executionID string) (*store.ExecutionProgress, error)
@@ -45,6 +47,48 @@ type MockStore struct {
 	GetExecutionByIdempotencyKeyFn      func(ctx context.Context, userID, idempotencyKey string) (string, error)
 	SubscribeFn                         func(ctx context.Context, channel string) (<-chan []byte, error)
 
+	// Job operations
+	EnqueueJobFn   func(ctx context.Context, job *store.Job) error
+	ClaimJobFn     func(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error)
+	HeartbeatJobFn func(ctx context.Context, executionID, workerID string) error
+	RequeueJobFn   func(ctx context.Context, executionID, workerID string) error
+	CompleteJobFn  func(ctx context.Context, executionID, workerID string) error
+	ListJobsFn     func(ctx context.Context, status store.JobStatus) ([]*store.Job, error)
+	CountJobsFn    func(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error)
+
+	// Checkpoint operations
+	SaveCheckpointFn  func(ctx context.Context, checkpoint *store.Checkpoint) error
+	ListCheckpointsFn func(ctx context.Context, executionID string) ([]*store.Checkpoint, error)
+
+	// Custom problem operations
+	SaveCustomProblemFn  func(ctx context.Context, userID string, problem *api.CustomProblem) error
+	GetCustomProblemFn   func(ctx context.Context, userID, name string) (*api.CustomProblem, error)
+	ListCustomProblemsFn func(ctx context.Context, userID string) ([]*api.CustomProblem, error)
+
+	// Experiment operations
+	CreateExperimentFn          func(ctx context.Context, experiment *store.Experiment) error
+	GetExperimentFn             func(ctx context.Context, experimentID, userID string) (*store.Experiment, error)
+	ListExperimentsFn           func(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error)
+	DeleteExperimentFn          func(ctx context.Context, experimentID, userID string) error
+	CountExperimentExecutionsFn func(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error)
+	ListExperimentResultsFn     func(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error)
+
+	// Admin operations
+	ListUsersFn         func(ctx context.Context, limit, offset int) ([]*api.User, int, error)
+	ListAllExecutionsFn func(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error)
+	FindExecutionFn     func(ctx context.Context, executionID string) (*store.Execution, error)
+	PurgeExecutionsFn   func(ctx context.Context, before time.Time) ([]string, error)
+
+	// API key operations
+	CreateAPIKeyFn    func(ctx context.Context, key *store.APIKey) error
+	ListAPIKeysFn     func(ctx context.Context, userID string) ([]*store.APIKey, error)
+	DeleteAPIKeyFn    func(ctx context.Context, keyID, userID string) error
+	GetAPIKeyByHashFn func(ctx context.Context, keyHash string) (*store.APIKey, error)
+
+	// Identity operations
+	CreateIdentityUserFn func(ctx context.Context, identity *store.Identity, usr *api.User) error
+	GetIdentityFn        func(ctx context.Context, issuer, subject string) (*store.Identity, error)
+
 	AutoMigrateFn func() error
 	HealthCheckFn func(ctx context.Context) error
 }
@@ -220,10 +264,18 @@ func (m *MockStore) UpdateExecutionResult(ctx context.Context, executionID strin
 	return nil
 }
 
+// RestartExecution implements store.Store
+func (m *MockStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	if m.RestartExecutionFn != nil {
+		return m.RestartExecutionFn(ctx, executionID, config)
+	}
+	return nil
+}
+
 // ListExecutions implements store.Store
-func (m *MockStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (m *MockStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	if m.ListExecutionsFn != nil {
-		return m.ListExecutionsFn(ctx, userID, status, limit, offset)
+		return m.ListExecutionsFn(ctx, userID, filter, limit, offset)
 	}
 	return nil, 0, nil
 }
@@ -286,3 +338,227 @@ func (m *MockStore) Subscribe(ctx context.Context, channel string) (<-chan []byt
 	close(ch)
 	return ch, nil
 }
+
+// EnqueueJob implements store.Store
+func (m *MockStore) EnqueueJob(ctx context.Context, job *store.Job) error {
+	if m.EnqueueJobFn != nil {
+		return m.EnqueueJobFn(ctx, job)
+	}
+	return nil
+}
+
+// ClaimJob implements store.Store
+func (m *MockStore) ClaimJob(ctx context.Context, workerID string, skipTenants ...string) (*store.Job, error) {
+	if m.ClaimJobFn != nil {
+		return m.ClaimJobFn(ctx, workerID, skipTenants...)
+	}
+	return nil, store.ErrNoJobAvailable
+}
+
+// HeartbeatJob implements store.Store
+func (m *MockStore) HeartbeatJob(ctx context.Context, executionID, workerID string) error {
+	if m.HeartbeatJobFn != nil {
+		return m.HeartbeatJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+// RequeueJob implements store.Store
+func (m *MockStore) RequeueJob(ctx context.Context, executionID, workerID string) error {
+	if m.RequeueJobFn != nil {
+		return m.RequeueJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+// CompleteJob implements store.Store
+func (m *MockStore) CompleteJob(ctx context.Context, executionID, workerID string) error {
+	if m.CompleteJobFn != nil {
+		return m.CompleteJobFn(ctx, executionID, workerID)
+	}
+	return nil
+}
+
+// ListJobs implements store.Store
+func (m *MockStore) ListJobs(ctx context.Context, status store.JobStatus) ([]*store.Job, error) {
+	if m.ListJobsFn != nil {
+		return m.ListJobsFn(ctx, status)
+	}
+	return nil, nil
+}
+
+// CountJobs implements store.Store
+func (m *MockStore) CountJobs(ctx context.Context, tenantID string, statuses ...store.JobStatus) (int, error) {
+	if m.CountJobsFn != nil {
+		return m.CountJobsFn(ctx, tenantID, statuses...)
+	}
+	return 0, nil
+}
+
+// SaveCheckpoint implements store.Store
+func (m *MockStore) SaveCheckpoint(ctx context.Context, checkpoint *store.Checkpoint) error {
+	if m.SaveCheckpointFn != nil {
+		return m.SaveCheckpointFn(ctx, checkpoint)
+	}
+	return nil
+}
+
+// ListCheckpoints implements store.Store
+func (m *MockStore) ListCheckpoints(ctx context.Context, executionID string) ([]*store.Checkpoint, error) {
+	if m.ListCheckpointsFn != nil {
+		return m.ListCheckpointsFn(ctx, executionID)
+	}
+	return nil, nil
+}
+
+// SaveCustomProblem implements store.Store
+func (m *MockStore) SaveCustomProblem(ctx context.Context, userID string, problem *api.CustomProblem) error {
+	if m.SaveCustomProblemFn != nil {
+		return m.SaveCustomProblemFn(ctx, userID, problem)
+	}
+	return nil
+}
+
+// GetCustomProblem implements store.Store
+func (m *MockStore) GetCustomProblem(ctx context.Context, userID, name string) (*api.CustomProblem, error) {
+	if m.GetCustomProblemFn != nil {
+		return m.GetCustomProblemFn(ctx, userID, name)
+	}
+	return nil, store.ErrCustomProblemNotFound
+}
+
+// ListCustomProblems implements store.Store
+func (m *MockStore) ListCustomProblems(ctx context.Context, userID string) ([]*api.CustomProblem, error) {
+	if m.ListCustomProblemsFn != nil {
+		return m.ListCustomProblemsFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+// CreateExperiment implements store.Store
+func (m *MockStore) CreateExperiment(ctx context.Context, experiment *store.Experiment) error {
+	if m.CreateExperimentFn != nil {
+		return m.CreateExperimentFn(ctx, experiment)
+	}
+	return nil
+}
+
+// GetExperiment implements store.Store
+func (m *MockStore) GetExperiment(ctx context.Context, experimentID, userID string) (*store.Experiment, error) {
+	if m.GetExperimentFn != nil {
+		return m.GetExperimentFn(ctx, experimentID, userID)
+	}
+	return nil, store.ErrExperimentNotFound
+}
+
+// ListExperiments implements store.Store
+func (m *MockStore) ListExperiments(ctx context.Context, userID string, limit, offset int) ([]*store.Experiment, int, error) {
+	if m.ListExperimentsFn != nil {
+		return m.ListExperimentsFn(ctx, userID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// DeleteExperiment implements store.Store
+func (m *MockStore) DeleteExperiment(ctx context.Context, experimentID, userID string) error {
+	if m.DeleteExperimentFn != nil {
+		return m.DeleteExperimentFn(ctx, experimentID, userID)
+	}
+	return store.ErrExperimentNotFound
+}
+
+// CountExperimentExecutions implements store.Store
+func (m *MockStore) CountExperimentExecutions(ctx context.Context, userID string, experimentIDs ...string) (map[string]store.ExecutionCounts, error) {
+	if m.CountExperimentExecutionsFn != nil {
+		return m.CountExperimentExecutionsFn(ctx, userID, experimentIDs...)
+	}
+	return map[string]store.ExecutionCounts{}, nil
+}
+
+// ListExperimentResults implements store.Store
+func (m *MockStore) ListExperimentResults(ctx context.Context, experimentID, userID string) ([]*store.ExperimentResult, error) {
+	if m.ListExperimentResultsFn != nil {
+		return m.ListExperimentResultsFn(ctx, experimentID, userID)
+	}
+	return nil, nil
+}
+
+// ListUsers implements store.Store
+func (m *MockStore) ListUsers(ctx context.Context, limit, offset int) ([]*api.User, int, error) {
+	if m.ListUsersFn != nil {
+		return m.ListUsersFn(ctx, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// ListAllExecutions implements store.Store
+func (m *MockStore) ListAllExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
+	if m.ListAllExecutionsFn != nil {
+		return m.ListAllExecutionsFn(ctx, userID, filter, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+// FindExecution implements store.Store
+func (m *MockStore) FindExecution(ctx context.Context, executionID string) (*store.Execution, error) {
+	if m.FindExecutionFn != nil {
+		return m.FindExecutionFn(ctx, executionID)
+	}
+	return nil, store.ErrExecutionNotFound
+}
+
+// PurgeExecutions implements store.Store
+func (m *MockStore) PurgeExecutions(ctx context.Context, before time.Time) ([]string, error) {
+	if m.PurgeExecutionsFn != nil {
+		return m.PurgeExecutionsFn(ctx, before)
+	}
+	return nil, nil
+}
+
+// CreateAPIKey implements store.Store
+func (m *MockStore) CreateAPIKey(ctx context.Context, key *store.APIKey) error {
+	if m.CreateAPIKeyFn != nil {
+		return m.CreateAPIKeyFn(ctx, key)
+	}
+	return nil
+}
+
+// ListAPIKeys implements store.Store
+func (m *MockStore) ListAPIKeys(ctx context.Context, userID string) ([]*store.APIKey, error) {
+	if m.ListAPIKeysFn != nil {
+		return m.ListAPIKeysFn(ctx, userID)
+	}
+	return nil, nil
+}
+
+// DeleteAPIKey implements store.Store
+func (m *MockStore) DeleteAPIKey(ctx context.Context, keyID, userID string) error {
+	if m.DeleteAPIKeyFn != nil {
+		return m.DeleteAPIKeyFn(ctx, keyID, userID)
+	}
+	return nil
+}
+
+// GetAPIKeyByHash implements store.Store
+func (m *MockStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*store.APIKey, error) {
+	if m.GetAPIKeyByHashFn != nil {
+		return m.GetAPIKeyByHashFn(ctx, keyHash)
+	}
+	return nil, store.ErrAPIKeyNotFound
+}
+
+// CreateIdentityUser implements store.Store
+func (m *MockStore) CreateIdentityUser(ctx context.Context, identity *store.Identity, usr *api.User) error {
+	if m.CreateIdentityUserFn != nil {
+		return m.CreateIdentityUserFn(ctx, identity, usr)
+	}
+	return nil
+}
+
+// GetIdentity implements store.Store
+func (m *MockStore) GetIdentity(ctx context.Context, issuer, subject string) (*store.Identity, error) {
+	if m.GetIdentityFn != nil {
+		return m.GetIdentityFn(ctx, issuer, subject)
+	}
+	return nil, store.ErrIdentityNotFound
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nicholaspcr/GoDE/internal/cache/redis"
 	"github.com/nicholaspcr/GoDE/internal/store"
 	storerrors "github.com/nicholaspcr/GoDE/internal/store/errors"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -31,6 +32,8 @@ type executionJSON struct {
 	CompletedAt         *time.Time            `json:"completed_at,omitempty"`
 	IdempotencyKey      string                `json:"idempotency_key,omitempty"`
 	MaxExecutionSeconds int64                 `json:"max_execution_seconds,omitempty"`
+	Seed                int64                 `json:"seed,omitempty"`
+	ExperimentID        string                `json:"experiment_id,omitempty"`
 }
 
 func marshalExecution(exec *store.Execution) ([]byte, error) {
@@ -58,6 +61,8 @@ func marshalExecution(exec *store.Execution) ([]byte, error) {
 		CompletedAt:         exec.CompletedAt,
 		IdempotencyKey:      exec.IdempotencyKey,
 		MaxExecutionSeconds: exec.MaxExecutionSeconds,
+		Seed:                exec.Seed,
+		ExperimentID:        exec.ExperimentID,
 	}
 
 	return json.Marshal(helper)
@@ -92,6 +97,8 @@ func unmarshalExecution(data []byte) (*store.Execution, error) {
 		CompletedAt:         helper.CompletedAt,
 		IdempotencyKey:      helper.IdempotencyKey,
 		MaxExecutionSeconds: helper.MaxExecutionSeconds,
+		Seed:                helper.Seed,
+		ExperimentID:        helper.ExperimentID,
 	}, nil
 }
 
@@ -120,20 +127,31 @@ func (s *ExecutionStore) getUpdateLock(executionID string) *sync.Mutex {
 	return mu.(*sync.Mutex)
 }
 
-func (s *ExecutionStore) executionKey(executionID string) string {
-	return fmt.Sprintf("execution:%s", executionID)
+// tenantKey namespaces a key or channel by the tenant of ctx. Those of the
+// default tenant keep their unprefixed form, so the executions stored before
+// tenants existed stay readable.
+func tenantKey(ctx context.Context, key string) string {
+	tenantID := tenant.IDFromContext(ctx)
+	if tenantID == tenant.DefaultID {
+		return key
+	}
+	return fmt.Sprintf("tenant:%s:%s", tenantID, key)
+}
+
+func (s *ExecutionStore) executionKey(ctx context.Context, executionID string) string {
+	return tenantKey(ctx, fmt.Sprintf("execution:%s", executionID))
 }
 
-func (s *ExecutionStore) progressKey(executionID string) string {
-	return fmt.Sprintf("execution:%s:progress", executionID)
+func (s *ExecutionStore) progressKey(ctx context.Context, executionID string) string {
+	return tenantKey(ctx, fmt.Sprintf("execution:%s:progress", executionID))
 }
 
-func (s *ExecutionStore) cancelKey(executionID string) string {
-	return fmt.Sprintf("execution:%s:cancel", executionID)
+func (s *ExecutionStore) cancelKey(ctx context.Context, executionID string) string {
+	return tenantKey(ctx, fmt.Sprintf("execution:%s:cancel", executionID))
 }
 
-func (s *ExecutionStore) userExecutionsKey(userID string) string {
-	return fmt.Sprintf("user:%s:executions", userID)
+func (s *ExecutionStore) userExecutionsKey(ctx context.Context, userID string) string {
+	return tenantKey(ctx, fmt.Sprintf("user:%s:executions", userID))
 }
 
 // updateExecution is a helper that implements the read-modify-write pattern for execution updates.
@@ -145,7 +163,7 @@ func (s *ExecutionStore) updateExecution(ctx context.Context, executionID string
 	mu.Lock()
 	defer mu.Unlock()
 
-	key := s.executionKey(executionID)
+	key := s.executionKey(ctx, executionID)
 
 	// Get current execution
 	data, err := s.client.Get(ctx, key)
@@ -177,7 +195,7 @@ func (s *ExecutionStore) updateExecution(ctx context.Context, executionID string
 	}
 
 	// Update in user's execution set
-	userKey := s.userExecutionsKey(execution.UserID)
+	userKey := s.userExecutionsKey(ctx, execution.UserID)
 	if err := s.client.HSet(ctx, userKey, executionID, string(updatedData)); err != nil {
 		return fmt.Errorf("failed to update execution in user set: %w", err)
 	}
@@ -185,13 +203,13 @@ func (s *ExecutionStore) updateExecution(ctx context.Context, executionID string
 	return nil
 }
 
-func (s *ExecutionStore) idempotencyKey(userID, key string) string {
-	return fmt.Sprintf("execution:idempotency:%s:%s", userID, key)
+func (s *ExecutionStore) idempotencyKey(ctx context.Context, userID, key string) string {
+	return tenantKey(ctx, fmt.Sprintf("execution:idempotency:%s:%s", userID, key))
 }
 
 // CreateExecution stores a new execution in Redis.
 func (s *ExecutionStore) CreateExecution(ctx context.Context, execution *store.Execution) error {
-	key := s.executionKey(execution.ID)
+	key := s.executionKey(ctx, execution.ID)
 
 	data, err := marshalExecution(execution)
 	if err != nil {
@@ -203,7 +221,7 @@ func (s *ExecutionStore) CreateExecution(ctx context.Context, execution *store.E
 	}
 
 	// Add to user's execution set
-	userKey := s.userExecutionsKey(execution.UserID)
+	userKey := s.userExecutionsKey(ctx, execution.UserID)
 	if err := s.client.HSet(ctx, userKey, execution.ID, string(data)); err != nil {
 		return fmt.Errorf("failed to add execution to user set: %w", err)
 	}
@@ -213,7 +231,7 @@ func (s *ExecutionStore) CreateExecution(ctx context.Context, execution *store.E
 
 	// Store idempotency mapping (best-effort — failure doesn't block creation).
 	if execution.IdempotencyKey != "" {
-		iKey := s.idempotencyKey(execution.UserID, execution.IdempotencyKey)
+		iKey := s.idempotencyKey(ctx, execution.UserID, execution.IdempotencyKey)
 		if err := s.client.Set(ctx, iKey, execution.ID, s.executionTTL); err != nil {
 			slog.WarnContext(ctx, "failed to store idempotency mapping",
 				slog.String("execution_id", execution.ID),
@@ -231,7 +249,7 @@ func (s *ExecutionStore) GetExecutionByIdempotencyKey(ctx context.Context, userI
 	if idempotencyKey == "" {
 		return "", storerrors.ErrExecutionNotFound
 	}
-	key := s.idempotencyKey(userID, idempotencyKey)
+	key := s.idempotencyKey(ctx, userID, idempotencyKey)
 	executionID, err := s.client.Get(ctx, key)
 	if err != nil {
 		return "", storerrors.ErrExecutionNotFound
@@ -241,7 +259,7 @@ func (s *ExecutionStore) GetExecutionByIdempotencyKey(ctx context.Context, userI
 
 // GetExecution retrieves an execution from Redis.
 func (s *ExecutionStore) GetExecution(ctx context.Context, executionID, userID string) (*store.Execution, error) {
-	key := s.executionKey(executionID)
+	key := s.executionKey(ctx, executionID)
 
 	data, err := s.client.Get(ctx, key)
 	if err != nil {
@@ -284,12 +302,31 @@ func (s *ExecutionStore) UpdateExecutionResult(ctx context.Context, executionID
 	})
 }
 
+// RestartExecution puts a finished execution back to pending with config.
+func (s *ExecutionStore) RestartExecution(ctx context.Context, executionID string, config *api.DEConfig) error {
+	if err := s.updateExecution(ctx, executionID, func(exec *store.Execution) error {
+		exec.Status = store.ExecutionStatusPending
+		exec.Config = config
+		exec.Error = ""
+		exec.CompletedAt = nil
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Clear the cancellation flag of a cancelled run, otherwise the worker
+	// claiming the restarted execution cancels it right away
+	if err := s.client.Delete(ctx, s.cancelKey(ctx, executionID)); err != nil {
+		return fmt.Errorf("failed to clear cancellation flag: %w", err)
+	}
+	return nil
+}
 
 // DeleteExecution removes an execution from Redis.
 // If userID is empty, ownership verification is skipped (used for cache invalidation).
 func (s *ExecutionStore) DeleteExecution(ctx context.Context, executionID, userID string) error {
 	// Get execution - skip ownership check if userID is empty (cache invalidation)
-	key := s.executionKey(executionID)
+	key := s.executionKey(ctx, executionID)
 	data, err := s.client.Get(ctx, key)
 	if err != nil {
 		return fmt.Errorf("%w: %s", storerrors.ErrExecutionNotFound, executionID)
@@ -311,7 +348,7 @@ func (s *ExecutionStore) DeleteExecution(ctx context.Context, executionID, userI
 	}
 
 	// Remove from user's execution set using atomic HDel
-	userKey := s.userExecutionsKey(execution.UserID)
+	userKey := s.userExecutionsKey(ctx, execution.UserID)
 	if err := s.client.HDel(ctx, userKey, executionID); err != nil {
 		slog.Error("failed to remove execution from user set",
 			slog.String("user_id", execution.UserID),
@@ -322,7 +359,7 @@ func (s *ExecutionStore) DeleteExecution(ctx context.Context, executionID, userI
 	}
 
 	// Delete progress
-	progressKey := s.progressKey(executionID)
+	progressKey := s.progressKey(ctx, executionID)
 	if delErr := s.client.Delete(ctx, progressKey); delErr != nil {
 		slog.Warn("failed to delete progress key",
 			slog.String("execution_id", executionID),
@@ -331,7 +368,7 @@ func (s *ExecutionStore) DeleteExecution(ctx context.Context, executionID, userI
 	}
 
 	// Delete cancellation flag
-	cancelKey := s.cancelKey(executionID)
+	cancelKey := s.cancelKey(ctx, executionID)
 	if delErr := s.client.Delete(ctx, cancelKey); delErr != nil {
 		slog.Warn("failed to delete cancellation key",
 			slog.String("execution_id", executionID),
@@ -13,7 +13,7 @@ import (
 
 // SaveProgress stores execution progress in Redis and publishes update via pub/sub.
 func (s *ExecutionStore) SaveProgress(ctx context.Context, progress *store.ExecutionProgress) error {
-	key := s.progressKey(progress.ExecutionID)
+	key := s.progressKey(ctx, progress.ExecutionID)
 
 	progress.UpdatedAt = time.Now()
 
@@ -27,7 +27,7 @@ func (s *ExecutionStore) SaveProgress(ctx context.Context, progress *store.Execu
 	}
 
 	// Publish progress update to pub/sub channel
-	channel := fmt.Sprintf("execution:%s:updates", progress.ExecutionID)
+	channel := tenantKey(ctx, fmt.Sprintf("execution:%s:updates", progress.ExecutionID))
 	if pubErr := s.client.Publish(ctx, channel, data); pubErr != nil {
 		slog.Warn("failed to publish progress update",
 			slog.String("execution_id", progress.ExecutionID),
@@ -41,7 +41,7 @@ func (s *ExecutionStore) SaveProgress(ctx context.Context, progress *store.Execu
 
 // GetProgress retrieves the current progress of an execution.
 func (s *ExecutionStore) GetProgress(ctx context.Context, executionID string) (*store.ExecutionProgress, error) {
-	key := s.progressKey(executionID)
+	key := s.progressKey(ctx, executionID)
 
 	data, err := s.client.Get(ctx, key)
 	if err != nil {
@@ -63,13 +63,13 @@ func (s *ExecutionStore) MarkExecutionForCancellation(ctx context.Context, execu
 		return err
 	}
 
-	key := s.cancelKey(executionID)
+	key := s.cancelKey(ctx, executionID)
 	if err := s.client.Set(ctx, key, "1", s.executionTTL); err != nil {
 		return fmt.Errorf("failed to set cancellation flag: %w", err)
 	}
 
 	// Publish cancellation event
-	channel := fmt.Sprintf("execution:%s:cancel", executionID)
+	channel := tenantKey(ctx, fmt.Sprintf("execution:%s:cancel", executionID))
 	if pubErr := s.client.Publish(ctx, channel, "cancel"); pubErr != nil {
 		slog.Warn("failed to publish cancellation event",
 			slog.String("execution_id", executionID),
@@ -83,7 +83,7 @@ func (s *ExecutionStore) MarkExecutionForCancellation(ctx context.Context, execu
 
 // IsExecutionCancelled checks if an execution has been marked for cancellation.
 func (s *ExecutionStore) IsExecutionCancelled(ctx context.Context, executionID string) (bool, error) {
-	key := s.cancelKey(executionID)
+	key := s.cancelKey(ctx, executionID)
 
 	_, err := s.client.Get(ctx, key)
 	if err != nil {
@@ -94,9 +94,10 @@ func (s *ExecutionStore) IsExecutionCancelled(ctx context.Context, executionID s
 	return true, nil
 }
 
-// Subscribe subscribes to real-time updates on a channel.
+// Subscribe subscribes to real-time updates on a channel, namespaced by the
+// tenant of ctx like the channels updates are published to.
 func (s *ExecutionStore) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
-	pubsub := s.client.Subscribe(ctx, channel)
+	pubsub := s.client.Subscribe(ctx, tenantKey(ctx, channel))
 
 	// Create output channel
 	ch := make(chan []byte, 100) // Buffer to prevent blocking
@@ -155,12 +156,45 @@ func ConfigToProto(config *api.DEConfig) map[string]any {
 		"ceil_limiter":    config.CeilLimiter,
 	}
 
-	if config.AlgorithmConfig != nil {
-		if algConfig, ok := config.AlgorithmConfig.(*api.DEConfig_Gde3); ok && algConfig.Gde3 != nil {
+	if len(config.Bounds) > 0 {
+		bounds := make([]map[string]any, len(config.Bounds))
+		for i, b := range config.Bounds {
+			bounds[i] = map[string]any{"floor": b.Floor, "ceil": b.Ceil}
+		}
+		result["bounds"] = bounds
+	}
+
+	switch algConfig := config.AlgorithmConfig.(type) {
+	case *api.DEConfig_Gde3:
+		if algConfig.Gde3 != nil {
 			result["gde3_config"] = map[string]any{
-				"cr": algConfig.Gde3.Cr,
-				"f":  algConfig.Gde3.F,
-				"p":  algConfig.Gde3.P,
+				"cr":            algConfig.Gde3.Cr,
+				"f":             algConfig.Gde3.F,
+				"p":             algConfig.Gde3.P,
+				"adaptation":    algConfig.Gde3.Adaptation.String(),
+				"learning_rate": algConfig.Gde3.LearningRate,
+				"memory_size":   algConfig.Gde3.MemorySize,
+			}
+		}
+	case *api.DEConfig_Moead:
+		if algConfig.Moead != nil {
+			result["moead_config"] = map[string]any{
+				"cr":                algConfig.Moead.Cr,
+				"f":                 algConfig.Moead.F,
+				"p":                 algConfig.Moead.P,
+				"neighborhood_size": algConfig.Moead.NeighborhoodSize,
+				"decomposition":     algConfig.Moead.Decomposition.String(),
+				"theta":             algConfig.Moead.Theta,
+				"delta":             algConfig.Moead.Delta,
+				"max_replacements":  algConfig.Moead.MaxReplacements,
+			}
+		}
+	case *api.DEConfig_Nsga2:
+		if algConfig.Nsga2 != nil {
+			result["nsga2_config"] = map[string]any{
+				"cr": algConfig.Nsga2.Cr,
+				"f":  algConfig.Nsga2.F,
+				"p":  algConfig.Nsga2.P,
 			}
 		}
 	}
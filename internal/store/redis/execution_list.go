@@ -7,9 +7,10 @@ import (
 	"github.com/nicholaspcr/GoDE/internal/store"
 )
 
-// ListExecutions returns a paginated list of executions for a user, optionally filtered by status.
+// ListExecutions returns a paginated list of executions for a user, optionally filtered by status
+// and experiment.
 // Uses HSCAN for memory-efficient iteration and supports early termination optimization.
-func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, status *store.ExecutionStatus, limit, offset int) ([]*store.Execution, int, error) {
+func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, filter store.ExecutionFilter, limit, offset int) ([]*store.Execution, int, error) {
 	// Apply defaults first
 	if limit <= 0 || limit > 100 {
 		limit = 50
@@ -18,12 +19,12 @@ func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, stat
 		offset = 0
 	}
 
-	userKey := s.userExecutionsKey(userID)
+	userKey := s.userExecutionsKey(ctx, userID)
 
-	// Optimization: when not filtering by status, get total count upfront using HLEN
+	// Optimization: when not filtering, get total count upfront using HLEN
 	// This allows early termination after collecting enough items
 	var totalCount int
-	earlyTerminationEnabled := (status == nil)
+	earlyTerminationEnabled := filter == store.ExecutionFilter{}
 
 	if earlyTerminationEnabled {
 		count, err := s.client.HLen(ctx, userKey)
@@ -55,8 +56,11 @@ func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, stat
 				continue // Skip invalid entries
 			}
 
-			// Filter by status if provided
-			if status != nil && execution.Status != *status {
+			// Filter by status and experiment if provided
+			if filter.Status != nil && execution.Status != *filter.Status {
+				continue
+			}
+			if filter.ExperimentID != "" && execution.ExperimentID != filter.ExperimentID {
 				continue
 			}
 
@@ -80,7 +84,7 @@ func (s *ExecutionStore) ListExecutions(ctx context.Context, userID string, stat
 		}
 	}
 
-	// When filtering by status, return the filtered count
+	// When filtering, return the filtered count
 	if !earlyTerminationEnabled {
 		totalCount = seen
 	}
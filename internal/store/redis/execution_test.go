@@ -13,6 +13,7 @@ import (
 
 	"github.com/nicholaspcr/GoDE/internal/cache/redis"
 	"github.com/nicholaspcr/GoDE/internal/store"
+	"github.com/nicholaspcr/GoDE/internal/tenant"
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -678,6 +679,34 @@ func TestExecutionStore_UpdateExecutionResult(t *testing.T) {
 	}
 }
 
+func TestExecutionStore_RestartExecution(t *testing.T) {
+	mock := newMockRedisClient()
+	s := NewExecutionStore(mock, 24*time.Hour, time.Hour)
+	ctx := context.Background()
+
+	exec := createTestExecution("exec-1", "user-1", store.ExecutionStatusPending)
+	require.NoError(t, s.CreateExecution(ctx, exec))
+	require.NoError(t, s.MarkExecutionForCancellation(ctx, "exec-1", "user-1"))
+	require.NoError(t, s.UpdateExecutionStatus(ctx, "exec-1", store.ExecutionStatusCancelled, ""))
+
+	config := createTestDEConfig()
+	config.Generations = 500
+	require.NoError(t, s.RestartExecution(ctx, "exec-1", config))
+
+	cancelled, err := s.IsExecutionCancelled(ctx, "exec-1")
+	require.NoError(t, err)
+	assert.False(t, cancelled, "restarting clears the cancellation flag")
+
+	got, err := s.GetExecution(ctx, "exec-1", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, store.ExecutionStatusPending, got.Status)
+	assert.Empty(t, got.Error)
+	assert.Nil(t, got.CompletedAt)
+	assert.Equal(t, int64(500), got.Config.Generations)
+
+	assert.Error(t, s.RestartExecution(ctx, "exec-nonexistent", config))
+}
+
 func TestExecutionStore_UpdateExecutionResult_SetError(t *testing.T) {
 	mock := newMockRedisClient()
 	s := NewExecutionStore(mock, 24*time.Hour, time.Hour)
@@ -831,7 +860,7 @@ func TestExecutionStore_ListExecutions(t *testing.T) {
 
 			tt.setup(s)
 
-			executions, total, err := s.ListExecutions(ctx, tt.userID, tt.status, tt.limit, tt.offset)
+			executions, total, err := s.ListExecutions(ctx, tt.userID, store.ExecutionFilter{Status: tt.status}, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -849,7 +878,7 @@ func TestExecutionStore_ListExecutions_HScanError(t *testing.T) {
 	mock.hscanErr = errors.New("hscan error")
 	s := NewExecutionStore(mock, 24*time.Hour, time.Hour)
 
-	_, _, err := s.ListExecutions(context.Background(), "user-1", nil, 50, 0)
+	_, _, err := s.ListExecutions(context.Background(), "user-1", store.ExecutionFilter{}, 50, 0)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to scan user executions")
 }
@@ -1311,6 +1340,14 @@ func TestMarshalUnmarshalExecution(t *testing.T) {
 				return exec
 			}(),
 		},
+		{
+			name: "execution with seed",
+			execution: func() *store.Execution {
+				exec := createTestExecution("exec-6", "user-1", store.ExecutionStatusPending)
+				exec.Seed = 42
+				return exec
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1326,6 +1363,7 @@ func TestMarshalUnmarshalExecution(t *testing.T) {
 			assert.Equal(t, tt.execution.UserID, unmarshaled.UserID)
 			assert.Equal(t, tt.execution.Status, unmarshaled.Status)
 			assert.Equal(t, tt.execution.Error, unmarshaled.Error)
+			assert.Equal(t, tt.execution.Seed, unmarshaled.Seed)
 
 			// Compare pointers
 			if tt.execution.ParetoID != nil {
@@ -1424,6 +1462,110 @@ func TestConfigToProto(t *testing.T) {
 				"floor_limiter":   float32(0.0),
 				"ceil_limiter":    float32(1.0),
 				"gde3_config": map[string]any{
+					"cr":            float32(0.9),
+					"f":             float32(0.5),
+					"p":             float32(0.1),
+					"adaptation":    "PARAMETER_ADAPTATION_UNSPECIFIED",
+					"learning_rate": float32(0),
+					"memory_size":   int64(0),
+				},
+			},
+		},
+		{
+			name: "config with adaptive GDE3",
+			config: &api.DEConfig{
+				Executions:     1,
+				Generations:    100,
+				PopulationSize: 50,
+				DimensionsSize: 10,
+				ObjectivesSize: 2,
+				AlgorithmConfig: &api.DEConfig_Gde3{
+					Gde3: &api.GDE3Config{
+						Cr:         0.5,
+						F:          0.5,
+						P:          0.1,
+						Adaptation: api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE,
+						MemorySize: 10,
+					},
+				},
+			},
+			want: map[string]any{
+				"executions":      int64(1),
+				"generations":     int64(100),
+				"population_size": int64(50),
+				"dimensions_size": int64(10),
+				"objectives_size": int64(2),
+				"floor_limiter":   float32(0),
+				"ceil_limiter":    float32(0),
+				"gde3_config": map[string]any{
+					"cr":            float32(0.5),
+					"f":             float32(0.5),
+					"p":             float32(0.1),
+					"adaptation":    "PARAMETER_ADAPTATION_SHADE",
+					"learning_rate": float32(0),
+					"memory_size":   int64(10),
+				},
+			},
+		},
+		{
+			name: "config with MOEA/D",
+			config: &api.DEConfig{
+				Executions:     1,
+				Generations:    100,
+				PopulationSize: 50,
+				DimensionsSize: 10,
+				ObjectivesSize: 2,
+				AlgorithmConfig: &api.DEConfig_Moead{
+					Moead: &api.MOEADConfig{
+						Cr:               0.9,
+						F:                0.5,
+						NeighborhoodSize: 10,
+						Decomposition:    api.Decomposition_DECOMPOSITION_PBI,
+						Theta:            5,
+					},
+				},
+			},
+			want: map[string]any{
+				"executions":      int64(1),
+				"generations":     int64(100),
+				"population_size": int64(50),
+				"dimensions_size": int64(10),
+				"objectives_size": int64(2),
+				"floor_limiter":   float32(0),
+				"ceil_limiter":    float32(0),
+				"moead_config": map[string]any{
+					"cr":                float32(0.9),
+					"f":                 float32(0.5),
+					"p":                 float32(0),
+					"neighborhood_size": int64(10),
+					"decomposition":     "DECOMPOSITION_PBI",
+					"theta":             float32(5),
+					"delta":             float32(0),
+					"max_replacements":  int64(0),
+				},
+			},
+		},
+		{
+			name: "config with NSGA-II",
+			config: &api.DEConfig{
+				Executions:     1,
+				Generations:    100,
+				PopulationSize: 50,
+				DimensionsSize: 10,
+				ObjectivesSize: 2,
+				AlgorithmConfig: &api.DEConfig_Nsga2{
+					Nsga2: &api.NSGA2Config{Cr: 0.9, F: 0.5, P: 0.1},
+				},
+			},
+			want: map[string]any{
+				"executions":      int64(1),
+				"generations":     int64(100),
+				"population_size": int64(50),
+				"dimensions_size": int64(10),
+				"objectives_size": int64(2),
+				"floor_limiter":   float32(0),
+				"ceil_limiter":    float32(0),
+				"nsga2_config": map[string]any{
 					"cr": float32(0.9),
 					"f":  float32(0.5),
 					"p":  float32(0.1),
@@ -1451,6 +1593,30 @@ func TestConfigToProto(t *testing.T) {
 				"ceil_limiter":    float32(1.0),
 			},
 		},
+		{
+			name: "config with bounds",
+			config: &api.DEConfig{
+				Executions:     1,
+				Generations:    10,
+				PopulationSize: 10,
+				DimensionsSize: 2,
+				ObjectivesSize: 2,
+				Bounds:         []*api.Bounds{{Floor: 0, Ceil: 1}, {Floor: -5, Ceil: 5}},
+			},
+			want: map[string]any{
+				"executions":      int64(1),
+				"generations":     int64(10),
+				"population_size": int64(10),
+				"dimensions_size": int64(2),
+				"objectives_size": int64(2),
+				"floor_limiter":   float32(0),
+				"ceil_limiter":    float32(0),
+				"bounds": []map[string]any{
+					{"floor": 0.0, "ceil": 1.0},
+					{"floor": -5.0, "ceil": 5.0},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1464,6 +1630,7 @@ func TestConfigToProto(t *testing.T) {
 			assert.Equal(t, tt.want["objectives_size"], result["objectives_size"])
 			assert.Equal(t, tt.want["floor_limiter"], result["floor_limiter"])
 			assert.Equal(t, tt.want["ceil_limiter"], result["ceil_limiter"])
+			assert.Equal(t, tt.want["bounds"], result["bounds"])
 
 			if tt.want["gde3_config"] != nil {
 				wantGde3 := tt.want["gde3_config"].(map[string]any)
@@ -1472,7 +1639,12 @@ func TestConfigToProto(t *testing.T) {
 				assert.Equal(t, wantGde3["cr"], resultGde3["cr"])
 				assert.Equal(t, wantGde3["f"], resultGde3["f"])
 				assert.Equal(t, wantGde3["p"], resultGde3["p"])
+				assert.Equal(t, wantGde3["adaptation"], resultGde3["adaptation"])
+				assert.Equal(t, wantGde3["learning_rate"], resultGde3["learning_rate"])
+				assert.Equal(t, wantGde3["memory_size"], resultGde3["memory_size"])
 			}
+			assert.Equal(t, tt.want["moead_config"], result["moead_config"])
+			assert.Equal(t, tt.want["nsga2_config"], result["nsga2_config"])
 		})
 	}
 }
@@ -1482,26 +1654,67 @@ func TestConfigToProto(t *testing.T) {
 func TestKeyGeneration(t *testing.T) {
 	mock := newMockRedisClient()
 	s := NewExecutionStore(mock, 24*time.Hour, time.Hour)
+	ctx := context.Background()
 
 	t.Run("executionKey", func(t *testing.T) {
-		key := s.executionKey("exec-123")
+		key := s.executionKey(ctx, "exec-123")
 		assert.Equal(t, "execution:exec-123", key)
 	})
 
 	t.Run("progressKey", func(t *testing.T) {
-		key := s.progressKey("exec-123")
+		key := s.progressKey(ctx, "exec-123")
 		assert.Equal(t, "execution:exec-123:progress", key)
 	})
 
 	t.Run("cancelKey", func(t *testing.T) {
-		key := s.cancelKey("exec-123")
+		key := s.cancelKey(ctx, "exec-123")
 		assert.Equal(t, "execution:exec-123:cancel", key)
 	})
 
 	t.Run("userExecutionsKey", func(t *testing.T) {
-		key := s.userExecutionsKey("user-456")
+		key := s.userExecutionsKey(ctx, "user-456")
 		assert.Equal(t, "user:user-456:executions", key)
 	})
+
+	t.Run("tenant keys", func(t *testing.T) {
+		lab := tenant.WithID(ctx, "lab")
+		assert.Equal(t, "tenant:lab:execution:exec-123", s.executionKey(lab, "exec-123"))
+		assert.Equal(t, "tenant:lab:user:user-456:executions", s.userExecutionsKey(lab, "user-456"))
+		assert.Equal(t, "execution:exec-123", s.executionKey(tenant.WithID(ctx, tenant.DefaultID), "exec-123"))
+	})
+}
+
+func TestExecutionStore_TenantIsolation(t *testing.T) {
+	mock := newMockRedisClient()
+	s := NewExecutionStore(mock, 24*time.Hour, time.Hour)
+	lab := tenant.WithID(context.Background(), "lab")
+	acme := tenant.WithID(context.Background(), "acme")
+
+	exec := &store.Execution{
+		ID:             "exec-1",
+		UserID:         "user-1",
+		Status:         store.ExecutionStatusPending,
+		Config:         &api.DEConfig{},
+		IdempotencyKey: "key-1",
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	require.NoError(t, s.CreateExecution(lab, exec))
+
+	_, err := s.GetExecution(acme, "exec-1", "user-1")
+	assert.Error(t, err)
+	_, err = s.GetExecution(lab, "exec-1", "user-1")
+	require.NoError(t, err)
+
+	_, err = s.GetExecutionByIdempotencyKey(acme, "user-1", "key-1")
+	assert.ErrorIs(t, err, store.ErrExecutionNotFound)
+
+	executions, total, err := s.ListExecutions(acme, "user-1", store.ExecutionFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+	assert.Zero(t, total)
+
+	assert.Error(t, s.UpdateExecutionStatus(acme, "exec-1", store.ExecutionStatusFailed, ""))
 }
 
 // Test edge cases
@@ -1577,7 +1790,7 @@ func TestExecutionStore_EdgeCases(t *testing.T) {
 		wg.Wait()
 
 		// Verify all executions were created
-		executions, total, err := s.ListExecutions(ctx, "user-1", nil, 50, 0)
+		executions, total, err := s.ListExecutions(ctx, "user-1", store.ExecutionFilter{}, 50, 0)
 		require.NoError(t, err)
 		assert.Equal(t, numGoroutines, total)
 		assert.Len(t, executions, numGoroutines)
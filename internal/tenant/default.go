@@ -2,5 +2,9 @@ package tenant
 
 import "github.com/nicholaspcr/GoDE/pkg/api/v1"
 
+// DefaultID identifies the tenant of requests that name none, and of the
+// rows stored before the deployment had tenants.
+const DefaultID = "default"
+
 // DefaultTenant is the default tenant value.
-var DefaultTenant = &api.Tenant{Ids: &api.TenantIDs{TenantId: "default"}}
+var DefaultTenant = &api.Tenant{Ids: &api.TenantIDs{TenantId: DefaultID}}
@@ -3,10 +3,20 @@ package tenant
 
 import (
 	"context"
+	"errors"
+	"regexp"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
 )
 
+// ErrInvalidID is returned for tenant IDs that cannot be used in the columns
+// and keys namespacing the data of a tenant.
+var ErrInvalidID = errors.New("invalid tenant id")
+
+// idPattern restricts tenant IDs to what fits the tenant_id columns and the
+// Redis keys without escaping.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
 type tenantKey = struct{}
 
 // FromContext returns the Tenant value stored in ctx, if not present it returns an error.
@@ -22,3 +32,30 @@ func FromContext(ctx context.Context) *api.Tenant {
 func NewContext(ctx context.Context, tenant *api.Tenant) context.Context {
 	return context.WithValue(ctx, tenantKey{}, tenant)
 }
+
+// IDFromContext returns the ID of the tenant stored in ctx, DefaultID when
+// there is none.
+func IDFromContext(ctx context.Context) string {
+	if id := FromContext(ctx).GetIds().GetTenantId(); id != "" {
+		return id
+	}
+	return DefaultID
+}
+
+// WithID returns a new context with the tenant of the given ID, the default
+// tenant when id is empty.
+func WithID(ctx context.Context, id string) context.Context {
+	if id == "" || id == DefaultID {
+		return NewContext(ctx, DefaultTenant)
+	}
+	return NewContext(ctx, &api.Tenant{Ids: &api.TenantIDs{TenantId: id}})
+}
+
+// ValidateID checks that id is a lowercase alphanumeric name of up to 64
+// characters, dashes and underscores allowed after the first one.
+func ValidateID(id string) error {
+	if !idPattern.MatchString(id) {
+		return ErrInvalidID
+	}
+	return nil
+}
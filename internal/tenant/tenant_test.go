@@ -2,6 +2,7 @@ package tenant
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
@@ -124,3 +125,19 @@ func TestDefaultTenant(t *testing.T) {
 	assert.NotNil(t, DefaultTenant.Ids)
 	assert.NotEmpty(t, DefaultTenant.Ids.TenantId)
 }
+
+func TestIDFromContext(t *testing.T) {
+	assert.Equal(t, DefaultID, IDFromContext(context.Background()))
+	assert.Equal(t, DefaultID, IDFromContext(NewContext(context.Background(), &api.Tenant{})))
+	assert.Equal(t, "lab", IDFromContext(WithID(context.Background(), "lab")))
+	assert.Equal(t, DefaultTenant, FromContext(WithID(context.Background(), "")))
+}
+
+func TestValidateID(t *testing.T) {
+	for _, id := range []string{"default", "lab", "acme-industrial", "team_2"} {
+		assert.NoError(t, ValidateID(id), id)
+	}
+	for _, id := range []string{"", "Lab", "-lab", "lab:prod", "a b", strings.Repeat("a", 65)} {
+		assert.ErrorIs(t, ValidateID(id), ErrInvalidID, id)
+	}
+}
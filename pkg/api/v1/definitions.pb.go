@@ -323,6 +323,108 @@ func (x *Pareto) GetMaxObjs() []float64 {
 	return nil
 }
 
+// Indicators are the quality indicators computed for a Pareto front.
+// Distance based indicators are only set when a reference front is known.
+type Indicators struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Hypervolume          float64                `protobuf:"fixed64,1,opt,name=hypervolume,proto3" json:"hypervolume,omitempty"`
+	ReferencePoint       []float64              `protobuf:"fixed64,2,rep,packed,name=reference_point,json=referencePoint,proto3" json:"reference_point,omitempty"`
+	HypervolumeEstimated bool                   `protobuf:"varint,3,opt,name=hypervolume_estimated,json=hypervolumeEstimated,proto3" json:"hypervolume_estimated,omitempty"`
+	Igd                  *float64               `protobuf:"fixed64,4,opt,name=igd,proto3,oneof" json:"igd,omitempty"`
+	IgdPlus              *float64               `protobuf:"fixed64,5,opt,name=igd_plus,json=igdPlus,proto3,oneof" json:"igd_plus,omitempty"`
+	Gd                   *float64               `protobuf:"fixed64,6,opt,name=gd,proto3,oneof" json:"gd,omitempty"`
+	Spread               float64                `protobuf:"fixed64,7,opt,name=spread,proto3" json:"spread,omitempty"`
+	Spacing              float64                `protobuf:"fixed64,8,opt,name=spacing,proto3" json:"spacing,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Indicators) Reset() {
+	*x = Indicators{}
+	mi := &file_api_v1_definitions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Indicators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Indicators) ProtoMessage() {}
+
+func (x *Indicators) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_definitions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Indicators.ProtoReflect.Descriptor instead.
+func (*Indicators) Descriptor() ([]byte, []int) {
+	return file_api_v1_definitions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Indicators) GetHypervolume() float64 {
+	if x != nil {
+		return x.Hypervolume
+	}
+	return 0
+}
+
+func (x *Indicators) GetReferencePoint() []float64 {
+	if x != nil {
+		return x.ReferencePoint
+	}
+	return nil
+}
+
+func (x *Indicators) GetHypervolumeEstimated() bool {
+	if x != nil {
+		return x.HypervolumeEstimated
+	}
+	return false
+}
+
+func (x *Indicators) GetIgd() float64 {
+	if x != nil && x.Igd != nil {
+		return *x.Igd
+	}
+	return 0
+}
+
+func (x *Indicators) GetIgdPlus() float64 {
+	if x != nil && x.IgdPlus != nil {
+		return *x.IgdPlus
+	}
+	return 0
+}
+
+func (x *Indicators) GetGd() float64 {
+	if x != nil && x.Gd != nil {
+		return *x.Gd
+	}
+	return 0
+}
+
+func (x *Indicators) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *Indicators) GetSpacing() float64 {
+	if x != nil {
+		return x.Spacing
+	}
+	return 0
+}
+
 var File_api_v1_definitions_proto protoreflect.FileDescriptor
 
 var file_api_v1_definitions_proto_rawDesc = []byte{
@@ -362,8 +464,26 @@ var file_api_v1_definitions_proto_rawDesc = []byte{
 	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
 	0x5f, 0x6f, 0x62, 0x6a, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78,
-	0x4f, 0x62, 0x6a, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x62, 0x6a, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x79, 0x70, 0x65, 0x72, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x68, 0x79, 0x70, 0x65, 0x72, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0e,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33,
+	0x0a, 0x15, 0x68, 0x79, 0x70, 0x65, 0x72, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x69, 0x67, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x03, 0x69, 0x67, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x67,
+	0x64, 0x5f, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x07,
+	0x69, 0x67, 0x64, 0x50, 0x6c, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x02, 0x67, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x67, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x67,
+	0x64, 0x5f, 0x70, 0x6c, 0x75, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x64, 0x42, 0x09, 0x5a,
+	0x07, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -378,13 +498,14 @@ func file_api_v1_definitions_proto_rawDescGZIP() []byte {
 	return file_api_v1_definitions_proto_rawDescData
 }
 
-var file_api_v1_definitions_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_definitions_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_v1_definitions_proto_goTypes = []any{
 	(*VectorIDs)(nil),            // 0: api.v1.VectorIDs
 	(*Vector)(nil),               // 1: api.v1.Vector
 	(*PopulationParameters)(nil), // 2: api.v1.PopulationParameters
 	(*ParetoIDs)(nil),            // 3: api.v1.ParetoIDs
 	(*Pareto)(nil),               // 4: api.v1.Pareto
+	(*Indicators)(nil),           // 5: api.v1.Indicators
 }
 var file_api_v1_definitions_proto_depIdxs = []int32{
 	0, // 0: api.v1.Vector.ids:type_name -> api.v1.VectorIDs
@@ -402,13 +523,14 @@ func file_api_v1_definitions_proto_init() {
 	if File_api_v1_definitions_proto != nil {
 		return
 	}
+	file_api_v1_definitions_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_definitions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -78,6 +78,48 @@ func local_request_DifferentialEvolutionService_ListSupportedVariants_0(ctx cont
 	return msg, metadata, err
 }
 
+func request_DifferentialEvolutionService_ListSupportedCrossovers_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.ListSupportedCrossovers(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DifferentialEvolutionService_ListSupportedCrossovers_0(ctx context.Context, marshaler runtime.Marshaler, server DifferentialEvolutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	msg, err := server.ListSupportedCrossovers(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_DifferentialEvolutionService_ListSupportedRepairs_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.ListSupportedRepairs(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DifferentialEvolutionService_ListSupportedRepairs_0(ctx context.Context, marshaler runtime.Marshaler, server DifferentialEvolutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	msg, err := server.ListSupportedRepairs(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_DifferentialEvolutionService_ListSupportedProblems_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq emptypb.Empty
@@ -99,6 +141,59 @@ func local_request_DifferentialEvolutionService_ListSupportedProblems_0(ctx cont
 	return msg, metadata, err
 }
 
+var filter_DifferentialEvolutionService_GetReferenceFront_0 = &utilities.DoubleArray{Encoding: map[string]int{"problem": 0}, Base: []int{1, 1, 0}, Check: []int{0, 1, 2}}
+
+func request_DifferentialEvolutionService_GetReferenceFront_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetReferenceFrontRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["problem"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "problem")
+	}
+	protoReq.Problem, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "problem", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_DifferentialEvolutionService_GetReferenceFront_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetReferenceFront(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DifferentialEvolutionService_GetReferenceFront_0(ctx context.Context, marshaler runtime.Marshaler, server DifferentialEvolutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetReferenceFrontRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["problem"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "problem")
+	}
+	protoReq.Problem, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "problem", err)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_DifferentialEvolutionService_GetReferenceFront_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetReferenceFront(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_DifferentialEvolutionService_RunAsync_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq RunAsyncRequest
@@ -313,6 +408,51 @@ func local_request_DifferentialEvolutionService_CancelExecution_0(ctx context.Co
 	return msg, metadata, err
 }
 
+func request_DifferentialEvolutionService_ResumeExecution_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ResumeExecutionRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["execution_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "execution_id")
+	}
+	protoReq.ExecutionId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "execution_id", err)
+	}
+	msg, err := client.ResumeExecution(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DifferentialEvolutionService_ResumeExecution_0(ctx context.Context, marshaler runtime.Marshaler, server DifferentialEvolutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ResumeExecutionRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	val, ok := pathParams["execution_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "execution_id")
+	}
+	protoReq.ExecutionId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "execution_id", err)
+	}
+	msg, err := server.ResumeExecution(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_DifferentialEvolutionService_DeleteExecution_0(ctx context.Context, marshaler runtime.Marshaler, client DifferentialEvolutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq DeleteExecutionRequest
@@ -398,6 +538,46 @@ func RegisterDifferentialEvolutionServiceHandlerServer(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_ListSupportedVariants_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedCrossovers_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ListSupportedCrossovers", runtime.WithHTTPPathPattern("/v1/de/supported/crossovers"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DifferentialEvolutionService_ListSupportedCrossovers_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ListSupportedCrossovers_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedRepairs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ListSupportedRepairs", runtime.WithHTTPPathPattern("/v1/de/supported/repairs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DifferentialEvolutionService_ListSupportedRepairs_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ListSupportedRepairs_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedProblems_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -418,6 +598,26 @@ func RegisterDifferentialEvolutionServiceHandlerServer(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_ListSupportedProblems_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_GetReferenceFront_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/GetReferenceFront", runtime.WithHTTPPathPattern("/v1/de/supported/problems/{problem}/reference-front"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DifferentialEvolutionService_GetReferenceFront_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_GetReferenceFront_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_DifferentialEvolutionService_RunAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -525,6 +725,26 @@ func RegisterDifferentialEvolutionServiceHandlerServer(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_CancelExecution_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_DifferentialEvolutionService_ResumeExecution_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ResumeExecution", runtime.WithHTTPPathPattern("/v1/de/executions/{execution_id}/resume"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DifferentialEvolutionService_ResumeExecution_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ResumeExecution_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodDelete, pattern_DifferentialEvolutionService_DeleteExecution_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -619,6 +839,40 @@ func RegisterDifferentialEvolutionServiceHandlerClient(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_ListSupportedVariants_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedCrossovers_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ListSupportedCrossovers", runtime.WithHTTPPathPattern("/v1/de/supported/crossovers"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DifferentialEvolutionService_ListSupportedCrossovers_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ListSupportedCrossovers_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedRepairs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ListSupportedRepairs", runtime.WithHTTPPathPattern("/v1/de/supported/repairs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DifferentialEvolutionService_ListSupportedRepairs_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ListSupportedRepairs_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_ListSupportedProblems_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -636,6 +890,23 @@ func RegisterDifferentialEvolutionServiceHandlerClient(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_ListSupportedProblems_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DifferentialEvolutionService_GetReferenceFront_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/GetReferenceFront", runtime.WithHTTPPathPattern("/v1/de/supported/problems/{problem}/reference-front"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DifferentialEvolutionService_GetReferenceFront_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_GetReferenceFront_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_DifferentialEvolutionService_RunAsync_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -738,6 +1009,23 @@ func RegisterDifferentialEvolutionServiceHandlerClient(ctx context.Context, mux
 		}
 		forward_DifferentialEvolutionService_CancelExecution_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_DifferentialEvolutionService_ResumeExecution_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/api.v1.DifferentialEvolutionService/ResumeExecution", runtime.WithHTTPPathPattern("/v1/de/executions/{execution_id}/resume"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DifferentialEvolutionService_ResumeExecution_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DifferentialEvolutionService_ResumeExecution_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodDelete, pattern_DifferentialEvolutionService_DeleteExecution_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -761,25 +1049,33 @@ func RegisterDifferentialEvolutionServiceHandlerClient(ctx context.Context, mux
 var (
 	pattern_DifferentialEvolutionService_ListSupportedAlgorithms_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "de", "supported", "algorithms"}, ""))
 	pattern_DifferentialEvolutionService_ListSupportedVariants_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "de", "supported", "variants"}, ""))
+	pattern_DifferentialEvolutionService_ListSupportedCrossovers_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "de", "supported", "crossovers"}, ""))
+	pattern_DifferentialEvolutionService_ListSupportedRepairs_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "de", "supported", "repairs"}, ""))
 	pattern_DifferentialEvolutionService_ListSupportedProblems_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "de", "supported", "problems"}, ""))
+	pattern_DifferentialEvolutionService_GetReferenceFront_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"v1", "de", "supported", "problems", "problem", "reference-front"}, ""))
 	pattern_DifferentialEvolutionService_RunAsync_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "de", "run"}, ""))
 	pattern_DifferentialEvolutionService_StreamProgress_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "de", "executions", "execution_id", "progress"}, ""))
 	pattern_DifferentialEvolutionService_GetExecutionStatus_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"v1", "de", "executions", "execution_id"}, ""))
 	pattern_DifferentialEvolutionService_GetExecutionResults_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "de", "executions", "execution_id", "results"}, ""))
 	pattern_DifferentialEvolutionService_ListExecutions_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "de", "executions"}, ""))
 	pattern_DifferentialEvolutionService_CancelExecution_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "de", "executions", "execution_id", "cancel"}, ""))
+	pattern_DifferentialEvolutionService_ResumeExecution_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"v1", "de", "executions", "execution_id", "resume"}, ""))
 	pattern_DifferentialEvolutionService_DeleteExecution_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"v1", "de", "executions", "execution_id"}, ""))
 )
 
 var (
 	forward_DifferentialEvolutionService_ListSupportedAlgorithms_0 = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_ListSupportedVariants_0   = runtime.ForwardResponseMessage
+	forward_DifferentialEvolutionService_ListSupportedCrossovers_0 = runtime.ForwardResponseMessage
+	forward_DifferentialEvolutionService_ListSupportedRepairs_0    = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_ListSupportedProblems_0   = runtime.ForwardResponseMessage
+	forward_DifferentialEvolutionService_GetReferenceFront_0       = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_RunAsync_0                = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_StreamProgress_0          = runtime.ForwardResponseStream
 	forward_DifferentialEvolutionService_GetExecutionStatus_0      = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_GetExecutionResults_0     = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_ListExecutions_0          = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_CancelExecution_0         = runtime.ForwardResponseMessage
+	forward_DifferentialEvolutionService_ResumeExecution_0         = runtime.ForwardResponseMessage
 	forward_DifferentialEvolutionService_DeleteExecution_0         = runtime.ForwardResponseMessage
 )
@@ -20,6 +20,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ParameterAdaptation is the control parameter scheme used by GDE3.
+type ParameterAdaptation int32
+
+const (
+	ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED ParameterAdaptation = 0
+	ParameterAdaptation_PARAMETER_ADAPTATION_FIXED       ParameterAdaptation = 1
+	ParameterAdaptation_PARAMETER_ADAPTATION_JADE        ParameterAdaptation = 2
+	ParameterAdaptation_PARAMETER_ADAPTATION_SHADE       ParameterAdaptation = 3
+)
+
+// Enum value maps for ParameterAdaptation.
+var (
+	ParameterAdaptation_name = map[int32]string{
+		0: "PARAMETER_ADAPTATION_UNSPECIFIED",
+		1: "PARAMETER_ADAPTATION_FIXED",
+		2: "PARAMETER_ADAPTATION_JADE",
+		3: "PARAMETER_ADAPTATION_SHADE",
+	}
+	ParameterAdaptation_value = map[string]int32{
+		"PARAMETER_ADAPTATION_UNSPECIFIED": 0,
+		"PARAMETER_ADAPTATION_FIXED":       1,
+		"PARAMETER_ADAPTATION_JADE":        2,
+		"PARAMETER_ADAPTATION_SHADE":       3,
+	}
+)
+
+func (x ParameterAdaptation) Enum() *ParameterAdaptation {
+	p := new(ParameterAdaptation)
+	*p = x
+	return p
+}
+
+func (x ParameterAdaptation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterAdaptation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_differential_evolution_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ParameterAdaptation) Type() protoreflect.EnumType {
+	return &file_api_v1_differential_evolution_config_proto_enumTypes[0]
+}
+
+func (x ParameterAdaptation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterAdaptation.Descriptor instead.
+func (ParameterAdaptation) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{0}
+}
+
+// Decomposition is the scalarizing function used by MOEA/D.
+type Decomposition int32
+
+const (
+	Decomposition_DECOMPOSITION_UNSPECIFIED Decomposition = 0
+	Decomposition_DECOMPOSITION_TCHEBYCHEFF Decomposition = 1
+	Decomposition_DECOMPOSITION_PBI         Decomposition = 2
+)
+
+// Enum value maps for Decomposition.
+var (
+	Decomposition_name = map[int32]string{
+		0: "DECOMPOSITION_UNSPECIFIED",
+		1: "DECOMPOSITION_TCHEBYCHEFF",
+		2: "DECOMPOSITION_PBI",
+	}
+	Decomposition_value = map[string]int32{
+		"DECOMPOSITION_UNSPECIFIED": 0,
+		"DECOMPOSITION_TCHEBYCHEFF": 1,
+		"DECOMPOSITION_PBI":         2,
+	}
+)
+
+func (x Decomposition) Enum() *Decomposition {
+	p := new(Decomposition)
+	*p = x
+	return p
+}
+
+func (x Decomposition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Decomposition) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_differential_evolution_config_proto_enumTypes[1].Descriptor()
+}
+
+func (Decomposition) Type() protoreflect.EnumType {
+	return &file_api_v1_differential_evolution_config_proto_enumTypes[1]
+}
+
+func (x Decomposition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Decomposition.Descriptor instead.
+func (Decomposition) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{1}
+}
+
 type DEConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Executions     int64                  `protobuf:"varint,1,opt,name=executions,proto3" json:"executions,omitempty"`
@@ -32,9 +135,39 @@ type DEConfig struct {
 	// Types that are valid to be assigned to AlgorithmConfig:
 	//
 	//	*DEConfig_Gde3
+	//	*DEConfig_Moead
+	//	*DEConfig_Nsga2
 	AlgorithmConfig isDEConfig_AlgorithmConfig `protobuf_oneof:"algorithm_config"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// seed makes the execution reproducible, the same seed and configuration
+	// produce identical Pareto sets. When unset the server picks one and
+	// records it on the execution.
+	Seed *int64 `protobuf:"varint,9,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	// bounds sets the domain of each decision variable, one entry per
+	// dimension. It takes precedence over floor_limiter and ceil_limiter; when
+	// neither is given the problem's natural bounds are used.
+	Bounds []*Bounds `protobuf:"bytes,10,rep,name=bounds,proto3" json:"bounds,omitempty"`
+	// custom_problem is the definition of the custom problem the execution
+	// optimizes. The server records it from RunAsyncRequest so the execution
+	// runs the same definition when resumed, any value sent by clients is
+	// replaced.
+	CustomProblem *CustomProblem `protobuf:"bytes,13,opt,name=custom_problem,json=customProblem,proto3" json:"custom_problem,omitempty"`
+	// crossover is the name of the operator building trial vectors from the
+	// mutants, as listed by ListSupportedCrossovers. Empty uses binomial.
+	Crossover string `protobuf:"bytes,14,opt,name=crossover,proto3" json:"crossover,omitempty"`
+	// repair is the name of the strategy bringing trial elements outside the
+	// bounds back into them, as listed by ListSupportedRepairs. Empty uses
+	// clamp.
+	Repair string `protobuf:"bytes,15,opt,name=repair,proto3" json:"repair,omitempty"`
+	// result_limit caps the size of the Pareto set merged from every
+	// execution, the most crowded solutions are dropped. Zero uses the server
+	// default.
+	ResultLimit int64 `protobuf:"varint,16,opt,name=result_limit,json=resultLimit,proto3" json:"result_limit,omitempty"`
+	// pareto_archive makes every execution keep an external archive of the
+	// non-dominated solutions found across its generations, returned merged
+	// with the results. Unset keeps no archive.
+	ParetoArchive *ParetoArchiveConfig `protobuf:"bytes,17,opt,name=pareto_archive,json=paretoArchive,proto3" json:"pareto_archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DEConfig) Reset() {
@@ -132,6 +265,73 @@ func (x *DEConfig) GetGde3() *GDE3Config {
 	return nil
 }
 
+func (x *DEConfig) GetMoead() *MOEADConfig {
+	if x != nil {
+		if x, ok := x.AlgorithmConfig.(*DEConfig_Moead); ok {
+			return x.Moead
+		}
+	}
+	return nil
+}
+
+func (x *DEConfig) GetNsga2() *NSGA2Config {
+	if x != nil {
+		if x, ok := x.AlgorithmConfig.(*DEConfig_Nsga2); ok {
+			return x.Nsga2
+		}
+	}
+	return nil
+}
+
+func (x *DEConfig) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+func (x *DEConfig) GetBounds() []*Bounds {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *DEConfig) GetCustomProblem() *CustomProblem {
+	if x != nil {
+		return x.CustomProblem
+	}
+	return nil
+}
+
+func (x *DEConfig) GetCrossover() string {
+	if x != nil {
+		return x.Crossover
+	}
+	return ""
+}
+
+func (x *DEConfig) GetRepair() string {
+	if x != nil {
+		return x.Repair
+	}
+	return ""
+}
+
+func (x *DEConfig) GetResultLimit() int64 {
+	if x != nil {
+		return x.ResultLimit
+	}
+	return 0
+}
+
+func (x *DEConfig) GetParetoArchive() *ParetoArchiveConfig {
+	if x != nil {
+		return x.ParetoArchive
+	}
+	return nil
+}
+
 type isDEConfig_AlgorithmConfig interface {
 	isDEConfig_AlgorithmConfig()
 }
@@ -140,20 +340,239 @@ type DEConfig_Gde3 struct {
 	Gde3 *GDE3Config `protobuf:"bytes,8,opt,name=gde3,proto3,oneof"`
 }
 
+type DEConfig_Moead struct {
+	Moead *MOEADConfig `protobuf:"bytes,11,opt,name=moead,proto3,oneof"`
+}
+
+type DEConfig_Nsga2 struct {
+	Nsga2 *NSGA2Config `protobuf:"bytes,12,opt,name=nsga2,proto3,oneof"`
+}
+
 func (*DEConfig_Gde3) isDEConfig_AlgorithmConfig() {}
 
-type GDE3Config struct {
+func (*DEConfig_Moead) isDEConfig_AlgorithmConfig() {}
+
+func (*DEConfig_Nsga2) isDEConfig_AlgorithmConfig() {}
+
+// ParetoArchiveConfig configures the external archive of non-dominated
+// solutions, by default it is unbounded.
+type ParetoArchiveConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_size bounds the archive by dropping its most crowded solutions once
+	// exceeded, zero keeps it unbounded.
+	MaxSize int64 `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// epsilon compares solutions with additive epsilon-dominance, keeping at
+	// most one solution per box of side epsilon in objective space. Zero uses
+	// plain dominance.
+	Epsilon       float64 `protobuf:"fixed64,2,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParetoArchiveConfig) Reset() {
+	*x = ParetoArchiveConfig{}
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParetoArchiveConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParetoArchiveConfig) ProtoMessage() {}
+
+func (x *ParetoArchiveConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParetoArchiveConfig.ProtoReflect.Descriptor instead.
+func (*ParetoArchiveConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParetoArchiveConfig) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *ParetoArchiveConfig) GetEpsilon() float64 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
+}
+
+// CustomProblem is a problem defined by expressions over the decision
+// variables x[0] ... x[n-1], such as "x[0]" and
+// "(1 + 9 * x[1]) * (1 - sqrt(x[0] / (1 + 9 * x[1])))". Expressions support
+// numbers, pi, e, + - * / ^, parentheses and the usual math functions (abs,
+// sqrt, exp, log, sin, cos, min, max, pow, ...).
+type CustomProblem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the problem, it cannot be the name of a built-in one.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// objectives are minimized, one expression per objective.
+	Objectives []string `protobuf:"bytes,3,rep,name=objectives,proto3" json:"objectives,omitempty"`
+	// constraints are expressions g(x) satisfied when g(x) <= 0.
+	Constraints []string `protobuf:"bytes,4,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	// bounds sets the domain of each decision variable and fixes the
+	// dimensions of the problem. Empty means [0, 1] in any dimensions.
+	Bounds        []*Bounds `protobuf:"bytes,5,rep,name=bounds,proto3" json:"bounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomProblem) Reset() {
+	*x = CustomProblem{}
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomProblem) ProtoMessage() {}
+
+func (x *CustomProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomProblem.ProtoReflect.Descriptor instead.
+func (*CustomProblem) Descriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CustomProblem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomProblem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomProblem) GetObjectives() []string {
+	if x != nil {
+		return x.Objectives
+	}
+	return nil
+}
+
+func (x *CustomProblem) GetConstraints() []string {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *CustomProblem) GetBounds() []*Bounds {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+// Bounds is the closed interval [floor, ceil] of a decision variable.
+type Bounds struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cr            float32                `protobuf:"fixed32,1,opt,name=cr,proto3" json:"cr,omitempty"`
-	F             float32                `protobuf:"fixed32,2,opt,name=f,proto3" json:"f,omitempty"`
-	P             float32                `protobuf:"fixed32,3,opt,name=p,proto3" json:"p,omitempty"`
+	Floor         float64                `protobuf:"fixed64,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Ceil          float64                `protobuf:"fixed64,2,opt,name=ceil,proto3" json:"ceil,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bounds) Reset() {
+	*x = Bounds{}
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bounds) ProtoMessage() {}
+
+func (x *Bounds) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bounds.ProtoReflect.Descriptor instead.
+func (*Bounds) Descriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Bounds) GetFloor() float64 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Bounds) GetCeil() float64 {
+	if x != nil {
+		return x.Ceil
+	}
+	return 0
+}
+
+type GDE3Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cr    float32                `protobuf:"fixed32,1,opt,name=cr,proto3" json:"cr,omitempty"`
+	F     float32                `protobuf:"fixed32,2,opt,name=f,proto3" json:"f,omitempty"`
+	P     float32                `protobuf:"fixed32,3,opt,name=p,proto3" json:"p,omitempty"`
+	// adaptation selects how F and CR evolve during the run. With JADE or
+	// SHADE every trial vector samples its own values and cr and f are the
+	// initial means.
+	Adaptation ParameterAdaptation `protobuf:"varint,4,opt,name=adaptation,proto3,enum=api.v1.ParameterAdaptation" json:"adaptation,omitempty"`
+	// learning_rate is JADE's c, the weight given to the latest successful
+	// values when updating the means. Zero uses 0.1.
+	LearningRate float32 `protobuf:"fixed32,5,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+	// memory_size is the amount of SHADE success-history entries. Zero uses 5.
+	MemorySize    int64 `protobuf:"varint,6,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GDE3Config) Reset() {
 	*x = GDE3Config{}
-	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[1]
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +584,7 @@ func (x *GDE3Config) String() string {
 func (*GDE3Config) ProtoMessage() {}
 
 func (x *GDE3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[1]
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +597,7 @@ func (x *GDE3Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GDE3Config.ProtoReflect.Descriptor instead.
 func (*GDE3Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GDE3Config) GetCr() float32 {
@@ -202,13 +621,207 @@ func (x *GDE3Config) GetP() float32 {
 	return 0
 }
 
+func (x *GDE3Config) GetAdaptation() ParameterAdaptation {
+	if x != nil {
+		return x.Adaptation
+	}
+	return ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED
+}
+
+func (x *GDE3Config) GetLearningRate() float32 {
+	if x != nil {
+		return x.LearningRate
+	}
+	return 0
+}
+
+func (x *GDE3Config) GetMemorySize() int64 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+// MOEADConfig configures MOEA/D with DE operators. The problem is decomposed
+// into one scalar subproblem per individual, each with its own weight vector.
+type MOEADConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Cr    float32                `protobuf:"fixed32,1,opt,name=cr,proto3" json:"cr,omitempty"`
+	F     float32                `protobuf:"fixed32,2,opt,name=f,proto3" json:"f,omitempty"`
+	// p is the fraction of the neighbourhood used by pbest variants.
+	P float32 `protobuf:"fixed32,3,opt,name=p,proto3" json:"p,omitempty"`
+	// neighborhood_size is the amount of closest weight vectors mating and
+	// replacement are restricted to. Zero uses 20, capped to the population.
+	NeighborhoodSize int64         `protobuf:"varint,4,opt,name=neighborhood_size,json=neighborhoodSize,proto3" json:"neighborhood_size,omitempty"`
+	Decomposition    Decomposition `protobuf:"varint,5,opt,name=decomposition,proto3,enum=api.v1.Decomposition" json:"decomposition,omitempty"`
+	// theta is the penalty of the PBI decomposition. Zero uses 5.
+	Theta float32 `protobuf:"fixed32,6,opt,name=theta,proto3" json:"theta,omitempty"`
+	// delta is the probability of mating within the neighbourhood instead of
+	// the whole population. Zero uses 0.9.
+	Delta float32 `protobuf:"fixed32,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	// max_replacements bounds how many subproblems a single offspring may
+	// take over. Zero uses 2.
+	MaxReplacements int64 `protobuf:"varint,8,opt,name=max_replacements,json=maxReplacements,proto3" json:"max_replacements,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MOEADConfig) Reset() {
+	*x = MOEADConfig{}
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MOEADConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MOEADConfig) ProtoMessage() {}
+
+func (x *MOEADConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MOEADConfig.ProtoReflect.Descriptor instead.
+func (*MOEADConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MOEADConfig) GetCr() float32 {
+	if x != nil {
+		return x.Cr
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetF() float32 {
+	if x != nil {
+		return x.F
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetP() float32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetNeighborhoodSize() int64 {
+	if x != nil {
+		return x.NeighborhoodSize
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetDecomposition() Decomposition {
+	if x != nil {
+		return x.Decomposition
+	}
+	return Decomposition_DECOMPOSITION_UNSPECIFIED
+}
+
+func (x *MOEADConfig) GetTheta() float32 {
+	if x != nil {
+		return x.Theta
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetDelta() float32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MOEADConfig) GetMaxReplacements() int64 {
+	if x != nil {
+		return x.MaxReplacements
+	}
+	return 0
+}
+
+// NSGA2Config configures the NSGA-II style DE, where offspring are merged
+// with their parents and survivors are picked by non-dominated rank and
+// crowding distance.
+type NSGA2Config struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cr            float32                `protobuf:"fixed32,1,opt,name=cr,proto3" json:"cr,omitempty"`
+	F             float32                `protobuf:"fixed32,2,opt,name=f,proto3" json:"f,omitempty"`
+	P             float32                `protobuf:"fixed32,3,opt,name=p,proto3" json:"p,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NSGA2Config) Reset() {
+	*x = NSGA2Config{}
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NSGA2Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NSGA2Config) ProtoMessage() {}
+
+func (x *NSGA2Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_differential_evolution_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NSGA2Config.ProtoReflect.Descriptor instead.
+func (*NSGA2Config) Descriptor() ([]byte, []int) {
+	return file_api_v1_differential_evolution_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NSGA2Config) GetCr() float32 {
+	if x != nil {
+		return x.Cr
+	}
+	return 0
+}
+
+func (x *NSGA2Config) GetF() float32 {
+	if x != nil {
+		return x.F
+	}
+	return 0
+}
+
+func (x *NSGA2Config) GetP() float32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
 var File_api_v1_differential_evolution_config_proto protoreflect.FileDescriptor
 
 var file_api_v1_differential_evolution_config_proto_rawDesc = []byte{
 	0x0a, 0x2a, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
 	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x65, 0x76, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x22, 0xcd, 0x02, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x69, 0x2e, 0x76, 0x31, 0x22, 0xcc, 0x05, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
@@ -228,11 +841,98 @@ var file_api_v1_differential_evolution_config_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x64, 0x65, 0x33, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x44,
 	0x45, 0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x67, 0x64, 0x65, 0x33,
-	0x42, 0x12, 0x0a, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x38, 0x0a, 0x0a, 0x47, 0x44, 0x45, 0x33, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02,
-	0x63, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x66,
-	0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x70, 0x42, 0x09,
+	0x12, 0x2b, 0x0a, 0x05, 0x6d, 0x6f, 0x65, 0x61, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x4f, 0x45, 0x41, 0x44, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x0a,
+	0x05, 0x6e, 0x73, 0x67, 0x61, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x53, 0x47, 0x41, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x73, 0x67, 0x61, 0x32, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x6f,
+	0x73, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x5f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x65, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x22,
+	0xaf, 0x01, 0x0a, 0x0d, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x22, 0x32, 0x0a, 0x06, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x63, 0x65, 0x69, 0x6c, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x47, 0x44, 0x45, 0x33, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x02, 0x63, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x01, 0x66, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x70,
+	0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x0b, 0x4d, 0x4f, 0x45, 0x41, 0x44, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x02, 0x63, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x66, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x70, 0x12,
+	0x2b, 0x0a, 0x11, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x68, 0x6f, 0x6f, 0x64, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a, 0x0d,
+	0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x39, 0x0a, 0x0b, 0x4e, 0x53, 0x47, 0x41, 0x32, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x63, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x63, 0x72, 0x12,
+	0x0c, 0x0a, 0x01, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x66, 0x12, 0x0c, 0x0a,
+	0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x70, 0x2a, 0x9a, 0x01, 0x0a, 0x13,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52,
+	0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4a, 0x41, 0x44, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x52, 0x41,
+	0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x48, 0x41, 0x44, 0x45, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x43,
+	0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x43, 0x4f,
+	0x4d, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x43, 0x48, 0x45, 0x42, 0x59,
+	0x43, 0x48, 0x45, 0x46, 0x46, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x42, 0x49, 0x10, 0x02, 0x42, 0x09,
 	0x5a, 0x07, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x33,
 }
@@ -249,18 +949,34 @@ func file_api_v1_differential_evolution_config_proto_rawDescGZIP() []byte {
 	return file_api_v1_differential_evolution_config_proto_rawDescData
 }
 
-var file_api_v1_differential_evolution_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_v1_differential_evolution_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_differential_evolution_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_v1_differential_evolution_config_proto_goTypes = []any{
-	(*DEConfig)(nil),   // 0: api.v1.DEConfig
-	(*GDE3Config)(nil), // 1: api.v1.GDE3Config
+	(ParameterAdaptation)(0),    // 0: api.v1.ParameterAdaptation
+	(Decomposition)(0),          // 1: api.v1.Decomposition
+	(*DEConfig)(nil),            // 2: api.v1.DEConfig
+	(*ParetoArchiveConfig)(nil), // 3: api.v1.ParetoArchiveConfig
+	(*CustomProblem)(nil),       // 4: api.v1.CustomProblem
+	(*Bounds)(nil),              // 5: api.v1.Bounds
+	(*GDE3Config)(nil),          // 6: api.v1.GDE3Config
+	(*MOEADConfig)(nil),         // 7: api.v1.MOEADConfig
+	(*NSGA2Config)(nil),         // 8: api.v1.NSGA2Config
 }
 var file_api_v1_differential_evolution_config_proto_depIdxs = []int32{
-	1, // 0: api.v1.DEConfig.gde3:type_name -> api.v1.GDE3Config
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: api.v1.DEConfig.gde3:type_name -> api.v1.GDE3Config
+	7, // 1: api.v1.DEConfig.moead:type_name -> api.v1.MOEADConfig
+	8, // 2: api.v1.DEConfig.nsga2:type_name -> api.v1.NSGA2Config
+	5, // 3: api.v1.DEConfig.bounds:type_name -> api.v1.Bounds
+	4, // 4: api.v1.DEConfig.custom_problem:type_name -> api.v1.CustomProblem
+	3, // 5: api.v1.DEConfig.pareto_archive:type_name -> api.v1.ParetoArchiveConfig
+	5, // 6: api.v1.CustomProblem.bounds:type_name -> api.v1.Bounds
+	0, // 7: api.v1.GDE3Config.adaptation:type_name -> api.v1.ParameterAdaptation
+	1, // 8: api.v1.MOEADConfig.decomposition:type_name -> api.v1.Decomposition
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_differential_evolution_config_proto_init() }
@@ -270,19 +986,22 @@ func file_api_v1_differential_evolution_config_proto_init() {
 	}
 	file_api_v1_differential_evolution_config_proto_msgTypes[0].OneofWrappers = []any{
 		(*DEConfig_Gde3)(nil),
+		(*DEConfig_Moead)(nil),
+		(*DEConfig_Nsga2)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_differential_evolution_config_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_v1_differential_evolution_config_proto_goTypes,
 		DependencyIndexes: file_api_v1_differential_evolution_config_proto_depIdxs,
+		EnumInfos:         file_api_v1_differential_evolution_config_proto_enumTypes,
 		MessageInfos:      file_api_v1_differential_evolution_config_proto_msgTypes,
 	}.Build()
 	File_api_v1_differential_evolution_config_proto = out.File
@@ -22,13 +22,17 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	DifferentialEvolutionService_ListSupportedAlgorithms_FullMethodName = "/api.v1.DifferentialEvolutionService/ListSupportedAlgorithms"
 	DifferentialEvolutionService_ListSupportedVariants_FullMethodName   = "/api.v1.DifferentialEvolutionService/ListSupportedVariants"
+	DifferentialEvolutionService_ListSupportedCrossovers_FullMethodName = "/api.v1.DifferentialEvolutionService/ListSupportedCrossovers"
+	DifferentialEvolutionService_ListSupportedRepairs_FullMethodName    = "/api.v1.DifferentialEvolutionService/ListSupportedRepairs"
 	DifferentialEvolutionService_ListSupportedProblems_FullMethodName   = "/api.v1.DifferentialEvolutionService/ListSupportedProblems"
+	DifferentialEvolutionService_GetReferenceFront_FullMethodName       = "/api.v1.DifferentialEvolutionService/GetReferenceFront"
 	DifferentialEvolutionService_RunAsync_FullMethodName                = "/api.v1.DifferentialEvolutionService/RunAsync"
 	DifferentialEvolutionService_StreamProgress_FullMethodName          = "/api.v1.DifferentialEvolutionService/StreamProgress"
 	DifferentialEvolutionService_GetExecutionStatus_FullMethodName      = "/api.v1.DifferentialEvolutionService/GetExecutionStatus"
 	DifferentialEvolutionService_GetExecutionResults_FullMethodName     = "/api.v1.DifferentialEvolutionService/GetExecutionResults"
 	DifferentialEvolutionService_ListExecutions_FullMethodName          = "/api.v1.DifferentialEvolutionService/ListExecutions"
 	DifferentialEvolutionService_CancelExecution_FullMethodName         = "/api.v1.DifferentialEvolutionService/CancelExecution"
+	DifferentialEvolutionService_ResumeExecution_FullMethodName         = "/api.v1.DifferentialEvolutionService/ResumeExecution"
 	DifferentialEvolutionService_DeleteExecution_FullMethodName         = "/api.v1.DifferentialEvolutionService/DeleteExecution"
 )
 
@@ -38,7 +42,10 @@ const (
 type DifferentialEvolutionServiceClient interface {
 	ListSupportedAlgorithms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedAlgorithmsResponse, error)
 	ListSupportedVariants(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedVariantsResponse, error)
+	ListSupportedCrossovers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedCrossoversResponse, error)
+	ListSupportedRepairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedRepairsResponse, error)
 	ListSupportedProblems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedProblemsResponse, error)
+	GetReferenceFront(ctx context.Context, in *GetReferenceFrontRequest, opts ...grpc.CallOption) (*GetReferenceFrontResponse, error)
 	// Async execution RPCs
 	RunAsync(ctx context.Context, in *RunAsyncRequest, opts ...grpc.CallOption) (*RunAsyncResponse, error)
 	StreamProgress(ctx context.Context, in *StreamProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamProgressResponse], error)
@@ -46,6 +53,9 @@ type DifferentialEvolutionServiceClient interface {
 	GetExecutionResults(ctx context.Context, in *GetExecutionResultsRequest, opts ...grpc.CallOption) (*GetExecutionResultsResponse, error)
 	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResumeExecution continues a failed or cancelled execution from its last
+	// checkpoint, or extends the generations of a completed one.
+	ResumeExecution(ctx context.Context, in *ResumeExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteExecution(ctx context.Context, in *DeleteExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -77,6 +87,26 @@ func (c *differentialEvolutionServiceClient) ListSupportedVariants(ctx context.C
 	return out, nil
 }
 
+func (c *differentialEvolutionServiceClient) ListSupportedCrossovers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedCrossoversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportedCrossoversResponse)
+	err := c.cc.Invoke(ctx, DifferentialEvolutionService_ListSupportedCrossovers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *differentialEvolutionServiceClient) ListSupportedRepairs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedRepairsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportedRepairsResponse)
+	err := c.cc.Invoke(ctx, DifferentialEvolutionService_ListSupportedRepairs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *differentialEvolutionServiceClient) ListSupportedProblems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSupportedProblemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSupportedProblemsResponse)
@@ -87,6 +117,16 @@ func (c *differentialEvolutionServiceClient) ListSupportedProblems(ctx context.C
 	return out, nil
 }
 
+func (c *differentialEvolutionServiceClient) GetReferenceFront(ctx context.Context, in *GetReferenceFrontRequest, opts ...grpc.CallOption) (*GetReferenceFrontResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferenceFrontResponse)
+	err := c.cc.Invoke(ctx, DifferentialEvolutionService_GetReferenceFront_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *differentialEvolutionServiceClient) RunAsync(ctx context.Context, in *RunAsyncRequest, opts ...grpc.CallOption) (*RunAsyncResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RunAsyncResponse)
@@ -156,6 +196,16 @@ func (c *differentialEvolutionServiceClient) CancelExecution(ctx context.Context
 	return out, nil
 }
 
+func (c *differentialEvolutionServiceClient) ResumeExecution(ctx context.Context, in *ResumeExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DifferentialEvolutionService_ResumeExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *differentialEvolutionServiceClient) DeleteExecution(ctx context.Context, in *DeleteExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -172,7 +222,10 @@ func (c *differentialEvolutionServiceClient) DeleteExecution(ctx context.Context
 type DifferentialEvolutionServiceServer interface {
 	ListSupportedAlgorithms(context.Context, *emptypb.Empty) (*ListSupportedAlgorithmsResponse, error)
 	ListSupportedVariants(context.Context, *emptypb.Empty) (*ListSupportedVariantsResponse, error)
+	ListSupportedCrossovers(context.Context, *emptypb.Empty) (*ListSupportedCrossoversResponse, error)
+	ListSupportedRepairs(context.Context, *emptypb.Empty) (*ListSupportedRepairsResponse, error)
 	ListSupportedProblems(context.Context, *emptypb.Empty) (*ListSupportedProblemsResponse, error)
+	GetReferenceFront(context.Context, *GetReferenceFrontRequest) (*GetReferenceFrontResponse, error)
 	// Async execution RPCs
 	RunAsync(context.Context, *RunAsyncRequest) (*RunAsyncResponse, error)
 	StreamProgress(*StreamProgressRequest, grpc.ServerStreamingServer[StreamProgressResponse]) error
@@ -180,6 +233,9 @@ type DifferentialEvolutionServiceServer interface {
 	GetExecutionResults(context.Context, *GetExecutionResultsRequest) (*GetExecutionResultsResponse, error)
 	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*emptypb.Empty, error)
+	// ResumeExecution continues a failed or cancelled execution from its last
+	// checkpoint, or extends the generations of a completed one.
+	ResumeExecution(context.Context, *ResumeExecutionRequest) (*emptypb.Empty, error)
 	DeleteExecution(context.Context, *DeleteExecutionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedDifferentialEvolutionServiceServer()
 }
@@ -197,9 +253,18 @@ func (UnimplementedDifferentialEvolutionServiceServer) ListSupportedAlgorithms(c
 func (UnimplementedDifferentialEvolutionServiceServer) ListSupportedVariants(context.Context, *emptypb.Empty) (*ListSupportedVariantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedVariants not implemented")
 }
+func (UnimplementedDifferentialEvolutionServiceServer) ListSupportedCrossovers(context.Context, *emptypb.Empty) (*ListSupportedCrossoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedCrossovers not implemented")
+}
+func (UnimplementedDifferentialEvolutionServiceServer) ListSupportedRepairs(context.Context, *emptypb.Empty) (*ListSupportedRepairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedRepairs not implemented")
+}
 func (UnimplementedDifferentialEvolutionServiceServer) ListSupportedProblems(context.Context, *emptypb.Empty) (*ListSupportedProblemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedProblems not implemented")
 }
+func (UnimplementedDifferentialEvolutionServiceServer) GetReferenceFront(context.Context, *GetReferenceFrontRequest) (*GetReferenceFrontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferenceFront not implemented")
+}
 func (UnimplementedDifferentialEvolutionServiceServer) RunAsync(context.Context, *RunAsyncRequest) (*RunAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunAsync not implemented")
 }
@@ -218,6 +283,9 @@ func (UnimplementedDifferentialEvolutionServiceServer) ListExecutions(context.Co
 func (UnimplementedDifferentialEvolutionServiceServer) CancelExecution(context.Context, *CancelExecutionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedDifferentialEvolutionServiceServer) ResumeExecution(context.Context, *ResumeExecutionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecution not implemented")
+}
 func (UnimplementedDifferentialEvolutionServiceServer) DeleteExecution(context.Context, *DeleteExecutionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteExecution not implemented")
 }
@@ -279,6 +347,42 @@ func _DifferentialEvolutionService_ListSupportedVariants_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DifferentialEvolutionService_ListSupportedCrossovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DifferentialEvolutionServiceServer).ListSupportedCrossovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DifferentialEvolutionService_ListSupportedCrossovers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DifferentialEvolutionServiceServer).ListSupportedCrossovers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DifferentialEvolutionService_ListSupportedRepairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DifferentialEvolutionServiceServer).ListSupportedRepairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DifferentialEvolutionService_ListSupportedRepairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DifferentialEvolutionServiceServer).ListSupportedRepairs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DifferentialEvolutionService_ListSupportedProblems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -297,6 +401,24 @@ func _DifferentialEvolutionService_ListSupportedProblems_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DifferentialEvolutionService_GetReferenceFront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferenceFrontRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DifferentialEvolutionServiceServer).GetReferenceFront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DifferentialEvolutionService_GetReferenceFront_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DifferentialEvolutionServiceServer).GetReferenceFront(ctx, req.(*GetReferenceFrontRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DifferentialEvolutionService_RunAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunAsyncRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +520,24 @@ func _DifferentialEvolutionService_CancelExecution_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DifferentialEvolutionService_ResumeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DifferentialEvolutionServiceServer).ResumeExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DifferentialEvolutionService_ResumeExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DifferentialEvolutionServiceServer).ResumeExecution(ctx, req.(*ResumeExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DifferentialEvolutionService_DeleteExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteExecutionRequest)
 	if err := dec(in); err != nil {
@@ -431,10 +571,22 @@ var DifferentialEvolutionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSupportedVariants",
 			Handler:    _DifferentialEvolutionService_ListSupportedVariants_Handler,
 		},
+		{
+			MethodName: "ListSupportedCrossovers",
+			Handler:    _DifferentialEvolutionService_ListSupportedCrossovers_Handler,
+		},
+		{
+			MethodName: "ListSupportedRepairs",
+			Handler:    _DifferentialEvolutionService_ListSupportedRepairs_Handler,
+		},
 		{
 			MethodName: "ListSupportedProblems",
 			Handler:    _DifferentialEvolutionService_ListSupportedProblems_Handler,
 		},
+		{
+			MethodName: "GetReferenceFront",
+			Handler:    _DifferentialEvolutionService_GetReferenceFront_Handler,
+		},
 		{
 			MethodName: "RunAsync",
 			Handler:    _DifferentialEvolutionService_RunAsync_Handler,
@@ -455,6 +607,10 @@ var DifferentialEvolutionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _DifferentialEvolutionService_CancelExecution_Handler,
 		},
+		{
+			MethodName: "ResumeExecution",
+			Handler:    _DifferentialEvolutionService_ResumeExecution_Handler,
+		},
 		{
 			MethodName: "DeleteExecution",
 			Handler:    _DifferentialEvolutionService_DeleteExecution_Handler,
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/oidc.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OIDCService_GetConfig_FullMethodName        = "/api.v1.OIDCService/GetConfig"
+	OIDCService_ExchangeCode_FullMethodName     = "/api.v1.OIDCService/ExchangeCode"
+	OIDCService_StartDeviceLogin_FullMethodName = "/api.v1.OIDCService/StartDeviceLogin"
+	OIDCService_PollDeviceLogin_FullMethodName  = "/api.v1.OIDCService/PollDeviceLogin"
+)
+
+// OIDCServiceClient is the client API for OIDCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OIDCService logs users in through the OpenID Connect provider configured
+// on the server, alongside the local accounts of AuthService. Users are
+// created on their first login and receive the same tokens as a local
+// login. The server talks to the provider, clients never see the client
+// secret.
+type OIDCServiceClient interface {
+	// GetConfig tells clients whether single sign-on is available and where
+	// to send users for the authorization code flow.
+	GetConfig(ctx context.Context, in *GetOIDCConfigRequest, opts ...grpc.CallOption) (*GetOIDCConfigResponse, error)
+	// ExchangeCode completes an authorization code login with PKCE, the code
+	// is redeemed with the provider and its ID token verified.
+	ExchangeCode(ctx context.Context, in *ExchangeOIDCCodeRequest, opts ...grpc.CallOption) (*AuthServiceLoginResponse, error)
+	// StartDeviceLogin starts a device code login for clients without a
+	// browser, the user completes it at the verification URI.
+	StartDeviceLogin(ctx context.Context, in *StartOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*StartOIDCDeviceLoginResponse, error)
+	// PollDeviceLogin checks whether the user completed the device login,
+	// clients poll it every interval seconds while pending is set.
+	PollDeviceLogin(ctx context.Context, in *PollOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*PollOIDCDeviceLoginResponse, error)
+}
+
+type oIDCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOIDCServiceClient(cc grpc.ClientConnInterface) OIDCServiceClient {
+	return &oIDCServiceClient{cc}
+}
+
+func (c *oIDCServiceClient) GetConfig(ctx context.Context, in *GetOIDCConfigRequest, opts ...grpc.CallOption) (*GetOIDCConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOIDCConfigResponse)
+	err := c.cc.Invoke(ctx, OIDCService_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) ExchangeCode(ctx context.Context, in *ExchangeOIDCCodeRequest, opts ...grpc.CallOption) (*AuthServiceLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthServiceLoginResponse)
+	err := c.cc.Invoke(ctx, OIDCService_ExchangeCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) StartDeviceLogin(ctx context.Context, in *StartOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*StartOIDCDeviceLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCDeviceLoginResponse)
+	err := c.cc.Invoke(ctx, OIDCService_StartDeviceLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oIDCServiceClient) PollDeviceLogin(ctx context.Context, in *PollOIDCDeviceLoginRequest, opts ...grpc.CallOption) (*PollOIDCDeviceLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PollOIDCDeviceLoginResponse)
+	err := c.cc.Invoke(ctx, OIDCService_PollDeviceLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OIDCServiceServer is the server API for OIDCService service.
+// All implementations must embed UnimplementedOIDCServiceServer
+// for forward compatibility.
+//
+// OIDCService logs users in through the OpenID Connect provider configured
+// on the server, alongside the local accounts of AuthService. Users are
+// created on their first login and receive the same tokens as a local
+// login. The server talks to the provider, clients never see the client
+// secret.
+type OIDCServiceServer interface {
+	// GetConfig tells clients whether single sign-on is available and where
+	// to send users for the authorization code flow.
+	GetConfig(context.Context, *GetOIDCConfigRequest) (*GetOIDCConfigResponse, error)
+	// ExchangeCode completes an authorization code login with PKCE, the code
+	// is redeemed with the provider and its ID token verified.
+	ExchangeCode(context.Context, *ExchangeOIDCCodeRequest) (*AuthServiceLoginResponse, error)
+	// StartDeviceLogin starts a device code login for clients without a
+	// browser, the user completes it at the verification URI.
+	StartDeviceLogin(context.Context, *StartOIDCDeviceLoginRequest) (*StartOIDCDeviceLoginResponse, error)
+	// PollDeviceLogin checks whether the user completed the device login,
+	// clients poll it every interval seconds while pending is set.
+	PollDeviceLogin(context.Context, *PollOIDCDeviceLoginRequest) (*PollOIDCDeviceLoginResponse, error)
+	mustEmbedUnimplementedOIDCServiceServer()
+}
+
+// UnimplementedOIDCServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOIDCServiceServer struct{}
+
+func (UnimplementedOIDCServiceServer) GetConfig(context.Context, *GetOIDCConfigRequest) (*GetOIDCConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedOIDCServiceServer) ExchangeCode(context.Context, *ExchangeOIDCCodeRequest) (*AuthServiceLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCode not implemented")
+}
+func (UnimplementedOIDCServiceServer) StartDeviceLogin(context.Context, *StartOIDCDeviceLoginRequest) (*StartOIDCDeviceLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDeviceLogin not implemented")
+}
+func (UnimplementedOIDCServiceServer) PollDeviceLogin(context.Context, *PollOIDCDeviceLoginRequest) (*PollOIDCDeviceLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollDeviceLogin not implemented")
+}
+func (UnimplementedOIDCServiceServer) mustEmbedUnimplementedOIDCServiceServer() {}
+func (UnimplementedOIDCServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeOIDCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OIDCServiceServer will
+// result in compilation errors.
+type UnsafeOIDCServiceServer interface {
+	mustEmbedUnimplementedOIDCServiceServer()
+}
+
+func RegisterOIDCServiceServer(s grpc.ServiceRegistrar, srv OIDCServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOIDCServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OIDCService_ServiceDesc, srv)
+}
+
+func _OIDCService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOIDCConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OIDCService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).GetConfig(ctx, req.(*GetOIDCConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_ExchangeCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeOIDCCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).ExchangeCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OIDCService_ExchangeCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).ExchangeCode(ctx, req.(*ExchangeOIDCCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_StartDeviceLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCDeviceLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).StartDeviceLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OIDCService_StartDeviceLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).StartDeviceLogin(ctx, req.(*StartOIDCDeviceLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OIDCService_PollDeviceLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollOIDCDeviceLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCServiceServer).PollDeviceLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OIDCService_PollDeviceLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCServiceServer).PollDeviceLogin(ctx, req.(*PollOIDCDeviceLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OIDCService_ServiceDesc is the grpc.ServiceDesc for OIDCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OIDCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.OIDCService",
+	HandlerType: (*OIDCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConfig",
+			Handler:    _OIDCService_GetConfig_Handler,
+		},
+		{
+			MethodName: "ExchangeCode",
+			Handler:    _OIDCService_ExchangeCode_Handler,
+		},
+		{
+			MethodName: "StartDeviceLogin",
+			Handler:    _OIDCService_StartDeviceLogin_Handler,
+		},
+		{
+			MethodName: "PollDeviceLogin",
+			Handler:    _OIDCService_PollDeviceLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/oidc.proto",
+}
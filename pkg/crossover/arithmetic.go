@@ -0,0 +1,29 @@
+package crossover
+
+import "github.com/nicholaspcr/GoDE/pkg/models"
+
+// arithmetic
+type arithmetic struct{}
+
+// Arithmetic returns the arithmetic crossover x + K(v - x), with a single K
+// drawn uniformly from [0, 1] for each trial. The trial lies on the segment
+// between target and mutant, which makes the operator rotation invariant;
+// CR is not used.
+func Arithmetic() Interface {
+	return &arithmetic{}
+}
+
+func (a *arithmetic) Name() string {
+	return "arithmetic"
+}
+
+func (a *arithmetic) Crossover(target, mutant models.Vector, p Parameters) models.Vector {
+	trial := target.Copy()
+	k := p.Random.Float64()
+
+	for i := range trial.Elements {
+		trial.Elements[i] += k * (mutant.Elements[i] - trial.Elements[i])
+	}
+
+	return trial
+}
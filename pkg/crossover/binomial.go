@@ -0,0 +1,35 @@
+package crossover
+
+import "github.com/nicholaspcr/GoDE/pkg/models"
+
+// binomial
+type binomial struct{}
+
+// Binomial returns the binomial crossover, which takes each element from the
+// mutant with probability CR. One randomly picked element always comes from
+// the mutant so the trial differs from its target.
+func Binomial() Interface {
+	return &binomial{}
+}
+
+func (b *binomial) Name() string {
+	return "binomial"
+}
+
+func (b *binomial) Crossover(target, mutant models.Vector, p Parameters) models.Vector {
+	trial := target.Copy()
+	dim := len(trial.Elements)
+
+	currInd := p.Random.Int() % dim
+	luckyIndex := p.Random.Int() % dim
+
+	for range dim {
+		changeProb := p.Random.Float64()
+		if changeProb < p.CR || currInd == luckyIndex {
+			trial.Elements[currInd] = mutant.Elements[currInd]
+		}
+		currInd = (currInd + 1) % dim
+	}
+
+	return trial
+}
@@ -0,0 +1,22 @@
+package crossover
+
+import (
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// Parameters are the values crossover operators build a trial vector with.
+type Parameters struct {
+	CR     float64    `json:"cr" yaml:"cr"`
+	Random *rand.Rand `json:"-"  yaml:"-"`
+}
+
+// Interface defines the contract for Differential Evolution crossover
+// operators.
+type Interface interface {
+	Name() string
+	// Crossover returns the trial vector of target, built from the elements
+	// of target and mutant. Neither of them is modified.
+	Crossover(target, mutant models.Vector, params Parameters) models.Vector
+}
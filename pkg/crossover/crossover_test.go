@@ -0,0 +1,117 @@
+package crossover
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fromMutant returns which elements of trial were taken from the mutant,
+// assuming target and mutant elements never coincide.
+func fromMutant(trial, mutant models.Vector) []bool {
+	taken := make([]bool, len(trial.Elements))
+	for i, e := range trial.Elements {
+		taken[i] = e == mutant.Elements[i]
+	}
+	return taken
+}
+
+func count(taken []bool) int {
+	n := 0
+	for _, t := range taken {
+		if t {
+			n++
+		}
+	}
+	return n
+}
+
+func TestBinomial(t *testing.T) {
+	target := models.Vector{Elements: []float64{0, 0, 0, 0}}
+	mutant := models.Vector{Elements: []float64{0.5, 0.5, 0.5, 0.5}}
+	b := Binomial()
+	assert.Equal(t, "binomial", b.Name())
+
+	t.Run("cr of zero takes a single element from the mutant", func(t *testing.T) {
+		trial := b.Crossover(target, mutant, Parameters{CR: 0, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, 1, count(fromMutant(trial, mutant)))
+	})
+
+	t.Run("cr of one takes the whole mutant", func(t *testing.T) {
+		trial := b.Crossover(target, mutant, Parameters{CR: 1, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, mutant.Elements, trial.Elements)
+	})
+
+	t.Run("elements outside the bounds are kept", func(t *testing.T) {
+		outside := models.Vector{Elements: []float64{-1, 2, -1, 2}}
+		trial := b.Crossover(target, outside, Parameters{CR: 1, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, outside.Elements, trial.Elements)
+	})
+
+	t.Run("target is left untouched", func(t *testing.T) {
+		b.Crossover(target, mutant, Parameters{CR: 1, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, []float64{0, 0, 0, 0}, target.Elements)
+	})
+}
+
+func TestExponential(t *testing.T) {
+	target := models.Vector{Elements: make([]float64, 8)}
+	mutant := models.Vector{Elements: []float64{1, 1, 1, 1, 1, 1, 1, 1}}
+	e := Exponential()
+	assert.Equal(t, "exponential", e.Name())
+
+	t.Run("cr of zero takes a single element from the mutant", func(t *testing.T) {
+		trial := e.Crossover(target, mutant, Parameters{CR: 0, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, 1, count(fromMutant(trial, mutant)))
+	})
+
+	t.Run("cr of one takes the whole mutant", func(t *testing.T) {
+		trial := e.Crossover(target, mutant, Parameters{CR: 1, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, mutant.Elements, trial.Elements)
+	})
+
+	t.Run("takes consecutive elements", func(t *testing.T) {
+		random := rand.New(rand.NewSource(3))
+		for range 100 {
+			taken := fromMutant(e.Crossover(target, mutant, Parameters{CR: 0.7, Random: random}), mutant)
+
+			// A circular run has a single position where taken elements start
+			starts := 0
+			for i := range taken {
+				if taken[i] && !taken[(i+len(taken)-1)%len(taken)] {
+					starts++
+				}
+			}
+			if count(taken) < len(taken) {
+				assert.Equal(t, 1, starts, "taken elements %v are not a run", taken)
+			}
+		}
+	})
+
+	t.Run("target is left untouched", func(t *testing.T) {
+		e.Crossover(target, mutant, Parameters{CR: 1, Random: rand.New(rand.NewSource(1))})
+		assert.Equal(t, make([]float64, 8), target.Elements)
+	})
+}
+
+func TestArithmetic(t *testing.T) {
+	target := models.Vector{Elements: []float64{0, 1, 2}}
+	mutant := models.Vector{Elements: []float64{2, 3, 2}}
+	a := Arithmetic()
+	assert.Equal(t, "arithmetic", a.Name())
+
+	random := rand.New(rand.NewSource(1))
+	for range 50 {
+		trial := a.Crossover(target, mutant, Parameters{Random: random})
+
+		// Every element moves the same fraction K toward the mutant
+		k := trial.Elements[0] / 2
+		assert.GreaterOrEqual(t, k, 0.0)
+		assert.LessOrEqual(t, k, 1.0)
+		assert.InDelta(t, 1+2*k, trial.Elements[1], 1e-12)
+		assert.InDelta(t, 2, trial.Elements[2], 1e-12)
+	}
+	assert.Equal(t, []float64{0, 1, 2}, target.Elements)
+}
@@ -0,0 +1,30 @@
+// Package crossover provides Differential Evolution (DE) crossover operators.
+//
+// # Overview
+//
+// Crossover combines the target vector with the mutant produced by a variant
+// into the trial vector that competes with the target in selection. The
+// operator decides which elements the trial inherits from the mutant.
+//
+// # Available Operators
+//
+//   - binomial:    each element comes from the mutant with probability CR,
+//     one random element always does
+//   - exponential: a run of consecutive elements, starting at a random one,
+//     comes from the mutant while uniform draws stay below CR
+//   - arithmetic:  u = x + K(v - x) with K uniform in [0, 1] for each trial,
+//     a rotation-invariant line recombination that ignores CR
+//
+// Binomial crossover is the default. Exponential crossover keeps neighbouring
+// elements together, which suits problems with linked consecutive variables.
+// Arithmetic crossover does not depend on the coordinate system, so rotated
+// problems are as hard as their separable originals.
+//
+// Crossover does not enforce the decision variable bounds, the trial is
+// repaired afterwards by the operators of the repair package.
+//
+// # Registration
+//
+// Operators register themselves in DefaultRegistry, which backs the
+// crossover field of DEConfig and the ListSupportedCrossovers RPC.
+package crossover
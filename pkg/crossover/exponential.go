@@ -0,0 +1,35 @@
+package crossover
+
+import "github.com/nicholaspcr/GoDE/pkg/models"
+
+// exponential
+type exponential struct{}
+
+// Exponential returns the exponential crossover, which copies consecutive
+// elements of the mutant, wrapping around, starting at a random one. The run
+// goes on while uniform draws stay below CR, so it holds at least one element
+// and L elements with probability CR^(L-1).
+func Exponential() Interface {
+	return &exponential{}
+}
+
+func (e *exponential) Name() string {
+	return "exponential"
+}
+
+func (e *exponential) Crossover(target, mutant models.Vector, p Parameters) models.Vector {
+	trial := target.Copy()
+	dim := len(trial.Elements)
+
+	currInd := p.Random.Intn(dim)
+	for copied := 0; copied < dim; copied++ {
+		trial.Elements[currInd] = mutant.Elements[currInd]
+		currInd = (currInd + 1) % dim
+
+		if p.Random.Float64() >= p.CR {
+			break
+		}
+	}
+
+	return trial
+}
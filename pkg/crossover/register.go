@@ -0,0 +1,15 @@
+package crossover
+
+func init() {
+	DefaultRegistry.Register("binomial", Binomial, Metadata{
+		Description: "Binomial - Each element comes from the mutant with probability CR",
+	})
+
+	DefaultRegistry.Register("exponential", Exponential, Metadata{
+		Description: "Exponential - A run of consecutive elements comes from the mutant while draws stay below CR",
+	})
+
+	DefaultRegistry.Register("arithmetic", Arithmetic, Metadata{
+		Description: "Arithmetic - Rotation-invariant recombination x + K(v - x) with a random K",
+	})
+}
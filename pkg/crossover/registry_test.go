@@ -0,0 +1,38 @@
+package crossover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.Register("binomial", Binomial, Metadata{Description: "Binomial"})
+
+	c, err := r.Create("binomial")
+	require.NoError(t, err)
+	assert.Equal(t, "binomial", c.Name())
+
+	meta, ok := r.Get("binomial")
+	assert.True(t, ok)
+	assert.Equal(t, "binomial", meta.Name)
+
+	_, err = r.Create("unknown")
+	assert.Error(t, err)
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	assert.Equal(t, []string{"arithmetic", "binomial", "exponential"}, DefaultRegistry.List())
+
+	for _, meta := range DefaultRegistry.ListMetadata() {
+		c, err := DefaultRegistry.Create(meta.Name)
+		require.NoError(t, err)
+		assert.Equal(t, meta.Name, c.Name())
+		assert.NotEmpty(t, meta.Description)
+	}
+
+	_, ok := DefaultRegistry.Get(Default)
+	assert.True(t, ok)
+}
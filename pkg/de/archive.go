@@ -0,0 +1,72 @@
+package de
+
+import (
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// ArchiveStateKey is the key of the archive in Checkpoint.State.
+const ArchiveStateKey = "archive"
+
+// Archive keeps the parents replaced by their offspring, as in JADE, so that
+// variants can draw difference vectors from it. Once full, every new parent
+// replaces a random one, which matches adding it and then dropping a random
+// vector. A nil Archive keeps nothing.
+type Archive struct {
+	size    int
+	vectors []models.Vector
+}
+
+// NewArchive returns an archive of at most size vectors.
+func NewArchive(size int) *Archive {
+	return &Archive{size: size, vectors: make([]models.Vector, 0, size)}
+}
+
+// Add archives the elements of a replaced parent.
+func (a *Archive) Add(parent models.Vector, random *rand.Rand) {
+	if a == nil || a.size <= 0 {
+		return
+	}
+	v := models.Vector{Elements: append([]float64(nil), parent.Elements...)}
+	if len(a.vectors) < a.size {
+		a.vectors = append(a.vectors, v)
+		return
+	}
+	if i := random.Intn(a.size + 1); i < a.size {
+		a.vectors[i] = v
+	}
+}
+
+// Vectors returns the archived vectors, which must not be modified.
+func (a *Archive) Vectors() []models.Vector {
+	if a == nil {
+		return nil
+	}
+	return a.vectors
+}
+
+// State returns the archived elements back to back, to be stored in a
+// checkpoint.
+func (a *Archive) State() []float64 {
+	if a == nil {
+		return nil
+	}
+	state := []float64{}
+	for _, v := range a.vectors {
+		state = append(state, v.Elements...)
+	}
+	return state
+}
+
+// Restore replaces the archived vectors with the ones of a State of vectors
+// with dim elements, ignoring states that do not fit the archive.
+func (a *Archive) Restore(state []float64, dim int) {
+	if a == nil || dim <= 0 || len(state)%dim != 0 || len(state)/dim > a.size {
+		return
+	}
+	a.vectors = a.vectors[:0]
+	for i := 0; i < len(state); i += dim {
+		a.vectors = append(a.vectors, models.Vector{Elements: append([]float64(nil), state[i:i+dim]...)})
+	}
+}
@@ -0,0 +1,75 @@
+package de
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	t.Run("keeps at most size vectors", func(t *testing.T) {
+		archive := NewArchive(3)
+		for i := range 10 {
+			archive.Add(models.Vector{Elements: []float64{float64(i)}, Objectives: []float64{1}}, random)
+			assert.Len(t, archive.Vectors(), min(i+1, 3))
+		}
+		for _, v := range archive.Vectors() {
+			assert.Nil(t, v.Objectives, "only the elements are archived")
+		}
+	})
+
+	t.Run("replaced vectors are random", func(t *testing.T) {
+		archive := NewArchive(2)
+		kept := map[float64]bool{}
+		for i := range 100 {
+			archive.Add(models.Vector{Elements: []float64{float64(i)}}, random)
+			for _, v := range archive.Vectors() {
+				kept[v.Elements[0]] = true
+			}
+		}
+		assert.Greater(t, len(kept), 10)
+	})
+
+	t.Run("copies the elements", func(t *testing.T) {
+		archive := NewArchive(1)
+		parent := models.Vector{Elements: []float64{1, 2}}
+		archive.Add(parent, random)
+		parent.Elements[0] = 5
+		assert.Equal(t, []float64{1, 2}, archive.Vectors()[0].Elements)
+	})
+
+	t.Run("state round trip", func(t *testing.T) {
+		archive := NewArchive(3)
+		archive.Add(models.Vector{Elements: []float64{1, 2}}, random)
+		archive.Add(models.Vector{Elements: []float64{3, 4}}, random)
+		state := archive.State()
+		assert.Equal(t, []float64{1, 2, 3, 4}, state)
+
+		restored := NewArchive(3)
+		restored.Restore(state, 2)
+		assert.Equal(t, archive.Vectors(), restored.Vectors())
+
+		restored.Restore([]float64{1, 2, 3}, 2)
+		assert.Equal(t, archive.Vectors(), restored.Vectors(), "states of other dimensions are ignored")
+		restored.Restore(make([]float64, 8), 2)
+		assert.Equal(t, archive.Vectors(), restored.Vectors(), "states larger than the archive are ignored")
+
+		empty := NewArchive(3)
+		empty.Restore(NewArchive(3).State(), 2)
+		require.NotNil(t, empty.Vectors())
+		assert.Empty(t, empty.Vectors())
+	})
+
+	t.Run("nil archive keeps nothing", func(t *testing.T) {
+		var archive *Archive
+		archive.Add(models.Vector{Elements: []float64{1}}, random)
+		assert.Nil(t, archive.Vectors())
+		assert.Nil(t, archive.State())
+		archive.Restore([]float64{1}, 1)
+	})
+}
@@ -0,0 +1,105 @@
+package de
+
+import (
+	"context"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// Checkpoint is the state of an execution after one of its generations,
+// enough to continue the execution as if it never stopped.
+type Checkpoint struct {
+	// Execution is the number of the execution within its run.
+	Execution int
+	// Generation is the amount of generations completed.
+	Generation int
+	Population []models.Vector
+	// Pareto is the non-dominated set of the last generation.
+	Pareto []models.Vector
+	// MaxObjectives are the largest objectives of the initial population.
+	MaxObjectives []float64
+	Random        RandomState
+	// State holds what else the algorithm learned during the execution,
+	// such as the memories of adaptive parameters.
+	State map[string][]float64
+	// ParetoArchive holds the external archive of the execution, when it
+	// keeps one.
+	ParetoArchive []models.Vector
+}
+
+// NewCheckpoint captures an execution after gen generations, copying the
+// vectors the algorithm keeps working on.
+func NewCheckpoint(
+	execution, gen int,
+	population models.Population,
+	pareto []models.Vector,
+	maxObjs []float64,
+	random RandomState,
+) *Checkpoint {
+	return &Checkpoint{
+		Execution:     execution,
+		Generation:    gen,
+		Population:    population.Copy(),
+		Pareto:        models.Population(pareto).Copy(),
+		MaxObjectives: append([]float64(nil), maxObjs...),
+		Random:        random,
+	}
+}
+
+// CheckpointCallback receives the checkpoints of an execution. It is called
+// every checkpoint interval, when the execution is cancelled and after its
+// last generation.
+type CheckpointCallback func(checkpoint *Checkpoint)
+
+// Checkpointer takes the checkpoints of an algorithm, a nil Checkpointer
+// takes none.
+type Checkpointer struct {
+	callback CheckpointCallback
+	interval int
+	last     int
+	archive  *ParetoArchive
+}
+
+// NewCheckpointer returns a Checkpointer calling callback every interval
+// generations, nil when callback is nil. Executions resumed from the
+// checkpoint in ctx start counting from its generation, and the checkpoints
+// carry the Pareto archive in ctx.
+func NewCheckpointer(ctx context.Context, callback CheckpointCallback, interval int) *Checkpointer {
+	if callback == nil {
+		return nil
+	}
+	c := &Checkpointer{
+		callback: callback,
+		interval: max(interval, 1),
+		archive:  FromContextParetoArchive(ctx),
+	}
+	if resumed, ok := FromContextCheckpoint(ctx); ok {
+		c.last = resumed.Generation
+	}
+	return c
+}
+
+// Due reports whether a checkpoint is taken after generation gen out of
+// total generations.
+func (c *Checkpointer) Due(gen, total int) bool {
+	if c == nil {
+		return false
+	}
+	return gen == total || gen-c.last >= c.interval
+}
+
+// Pending reports whether generations ran since the last checkpoint, the
+// ones a cancelled execution would lose.
+func (c *Checkpointer) Pending(gen int) bool {
+	return c != nil && gen > c.last
+}
+
+// Save hands the checkpoint to the callback.
+func (c *Checkpointer) Save(checkpoint *Checkpoint) {
+	if c == nil {
+		return
+	}
+	c.last = checkpoint.Generation
+	checkpoint.ParetoArchive = c.archive.Copy()
+	c.callback(checkpoint)
+}
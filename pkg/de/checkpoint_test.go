@@ -0,0 +1,64 @@
+package de
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpointer(t *testing.T) {
+	t.Run("nil callback takes no checkpoints", func(t *testing.T) {
+		c := NewCheckpointer(context.Background(), nil, 10)
+		assert.Nil(t, c)
+		assert.False(t, c.Due(10, 10))
+		assert.False(t, c.Pending(5))
+		c.Save(&Checkpoint{})
+	})
+
+	t.Run("every interval and after the last generation", func(t *testing.T) {
+		var saved []int
+		c := NewCheckpointer(context.Background(), func(cp *Checkpoint) { saved = append(saved, cp.Generation) }, 4)
+		for gen := 1; gen <= 10; gen++ {
+			if c.Due(gen, 10) {
+				c.Save(&Checkpoint{Generation: gen})
+			}
+		}
+		assert.Equal(t, []int{4, 8, 10}, saved)
+		assert.False(t, c.Pending(10))
+	})
+
+	t.Run("resumed executions count from their checkpoint", func(t *testing.T) {
+		ctx := WithContextCheckpoint(context.Background(), &Checkpoint{Generation: 6})
+		c := NewCheckpointer(ctx, func(*Checkpoint) {}, 4)
+		assert.False(t, c.Pending(6))
+		assert.False(t, c.Due(9, 20))
+		assert.True(t, c.Due(10, 20))
+	})
+
+	t.Run("checkpoints carry the pareto archive", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{})
+		archive.Add(models.Vector{Elements: []float64{1}, Objectives: []float64{1, 2}})
+		var saved *Checkpoint
+		c := NewCheckpointer(WithContextParetoArchive(context.Background(), archive), func(cp *Checkpoint) { saved = cp }, 1)
+		c.Save(&Checkpoint{Generation: 1})
+		assert.Equal(t, archive.Vectors(), saved.ParetoArchive)
+
+		archive.Add(models.Vector{Elements: []float64{2}, Objectives: []float64{0, 0}})
+		assert.Len(t, saved.ParetoArchive, 1, "the archive is copied")
+	})
+}
+
+func TestNewCheckpoint(t *testing.T) {
+	population := models.Population{{Elements: []float64{1}, Objectives: []float64{2}}}
+	maxObjs := []float64{2}
+	checkpoint := NewCheckpoint(1, 5, population, population, maxObjs, RandomState{Seed: 1, Draws: 2})
+
+	population[0].Elements[0] = 9
+	maxObjs[0] = 9
+	assert.Equal(t, 1.0, checkpoint.Population[0].Elements[0], "population is copied")
+	assert.Equal(t, 1.0, checkpoint.Pareto[0].Elements[0], "pareto is copied")
+	assert.Equal(t, []float64{2}, checkpoint.MaxObjectives)
+	assert.Equal(t, 5, checkpoint.Generation)
+}
@@ -15,7 +15,20 @@ type Config struct {
 }
 
 // ProgressCallback is called periodically during execution to report progress.
-type ProgressCallback func(generation int, totalGenerations int, paretoSize int, currentPareto []models.Vector)
+// The parameters are nil unless the algorithm adapts its control parameters.
+type ProgressCallback func(
+	generation int,
+	totalGenerations int,
+	paretoSize int,
+	currentPareto []models.Vector,
+	parameters *ControlParameters,
+)
+
+// ControlParameters are the means of self-adapted control parameters.
+type ControlParameters struct {
+	F  float64
+	CR float64
+}
 
 // Constants are the set of values that determine the behaviour of the Mode
 // execution.
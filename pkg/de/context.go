@@ -22,3 +22,47 @@ func FromContextExecutionNumber(ctx context.Context) int {
 	}
 	return n
 }
+
+type seedKey struct{}
+
+// WithContextSeed returns a context with the random seed of an execution.
+func WithContextSeed(ctx context.Context, seed int64) context.Context {
+	return context.WithValue(ctx, seedKey{}, seed)
+}
+
+// FromContextSeed returns the random seed of an execution from the context,
+// and false if no seed is set.
+func FromContextSeed(ctx context.Context) (int64, bool) {
+	seed, ok := ctx.Value(seedKey{}).(int64)
+	return seed, ok
+}
+
+type checkpointKey struct{}
+
+// WithContextCheckpoint returns a context with the checkpoint an execution
+// resumes from.
+func WithContextCheckpoint(ctx context.Context, checkpoint *Checkpoint) context.Context {
+	return context.WithValue(ctx, checkpointKey{}, checkpoint)
+}
+
+// FromContextCheckpoint returns the checkpoint an execution resumes from,
+// and false if the execution starts from scratch.
+func FromContextCheckpoint(ctx context.Context) (*Checkpoint, bool) {
+	checkpoint, ok := ctx.Value(checkpointKey{}).(*Checkpoint)
+	return checkpoint, ok && checkpoint != nil
+}
+
+type paretoArchiveKey struct{}
+
+// WithContextParetoArchive returns a context with the external archive the
+// execution adds its non-dominated solutions to.
+func WithContextParetoArchive(ctx context.Context, archive *ParetoArchive) context.Context {
+	return context.WithValue(ctx, paretoArchiveKey{}, archive)
+}
+
+// FromContextParetoArchive returns the external archive of an execution, nil
+// when it keeps none.
+func FromContextParetoArchive(ctx context.Context) *ParetoArchive {
+	archive, _ := ctx.Value(paretoArchiveKey{}).(*ParetoArchive)
+	return archive
+}
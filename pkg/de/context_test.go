@@ -35,3 +35,30 @@ func TestFromContextExecutionNumber(t *testing.T) {
 		assert.Equal(t, 0, FromContextExecutionNumber(ctx))
 	})
 }
+
+func TestContextSeed(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		_, ok := FromContextSeed(context.Background())
+		assert.False(t, ok)
+	})
+
+	t.Run("returns stored value", func(t *testing.T) {
+		seed, ok := FromContextSeed(WithContextSeed(context.Background(), 42))
+		assert.True(t, ok)
+		assert.Equal(t, int64(42), seed)
+	})
+}
+
+func TestContextCheckpoint(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		_, ok := FromContextCheckpoint(context.Background())
+		assert.False(t, ok)
+	})
+
+	t.Run("returns stored value", func(t *testing.T) {
+		checkpoint := &Checkpoint{Execution: 1, Generation: 5}
+		got, ok := FromContextCheckpoint(WithContextCheckpoint(context.Background(), checkpoint))
+		assert.True(t, ok)
+		assert.Same(t, checkpoint, got)
+	})
+}
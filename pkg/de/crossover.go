@@ -0,0 +1,25 @@
+package de
+
+import (
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+)
+
+// Trial builds the trial vector of target from its mutant with the crossover
+// operator, then brings the elements left outside [floor, ceil] back into
+// them with the repair strategy.
+func Trial(
+	target, mutant models.Vector,
+	cr float64,
+	c crossover.Interface,
+	r repair.Interface,
+	floor, ceil []float64,
+	random *rand.Rand,
+) models.Vector {
+	trial := c.Crossover(target, mutant, crossover.Parameters{CR: cr, Random: random})
+	r.Repair(trial, target, repair.Parameters{Floor: floor, Ceil: ceil, Random: random})
+	return trial
+}
@@ -0,0 +1,33 @@
+package de
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrial(t *testing.T) {
+	target := models.Vector{Elements: []float64{0.5, 0.5, 0.5, 0.5}}
+	outside := models.Vector{Elements: []float64{-1, 2, -1, 2}}
+	floor := []float64{0, 0, 0, 0}
+	ceil := []float64{1, 1, 1, 1}
+
+	t.Run("elements are repaired into the bounds", func(t *testing.T) {
+		trial := Trial(target, outside, 1, crossover.Binomial(), repair.Clamp(), floor, ceil, rand.New(rand.NewSource(1)))
+		assert.Equal(t, []float64{0, 1, 0, 1}, trial.Elements)
+	})
+
+	t.Run("repairs toward the target", func(t *testing.T) {
+		trial := Trial(target, outside, 1, crossover.Binomial(), repair.Midpoint(), floor, ceil, rand.New(rand.NewSource(1)))
+		assert.Equal(t, []float64{0.25, 0.75, 0.25, 0.75}, trial.Elements)
+	})
+
+	t.Run("target is left untouched", func(t *testing.T) {
+		Trial(target, outside, 1, crossover.Exponential(), repair.Reflect(), floor, ceil, rand.New(rand.NewSource(1)))
+		assert.Equal(t, []float64{0.5, 0.5, 0.5, 0.5}, target.Elements)
+	})
+}
@@ -31,6 +31,21 @@ type de struct {
 	config           Config
 	constants        Constants
 	progressCallback ProgressCallback
+	seed             *int64
+	checkpoints      map[int]*Checkpoint
+	paretoArchive    *ParetoArchiveConfig
+}
+
+// Result is what the executions of a DE found.
+type Result struct {
+	// Pareto is the non-dominated set of all executions, reduced by crowding
+	// distance to the result limit.
+	Pareto []models.Vector
+	// MaxObjectives holds the largest objectives of each execution.
+	MaxObjectives [][]float64
+	// ParetoArchive merges the external archives of the executions, nil
+	// unless they keep one.
+	ParetoArchive []models.Vector
 }
 
 // New creates a new DE instance based on the configuration options given.
@@ -47,7 +62,8 @@ func New(cfg Config, opts ...ModeOptions) (*de, error) {
 	return m, nil
 }
 
-func (mode *de) Execute(ctx context.Context) ([]models.Vector, [][]float64, error) {
+// Execute runs the executions of the algorithm and merges what they found.
+func (mode *de) Execute(ctx context.Context) (*Result, error) {
 	tracer := otel.Tracer("de")
 	ctx, span := tracer.Start(ctx, "de.Execute",
 		trace.WithAttributes(
@@ -62,46 +78,18 @@ func (mode *de) Execute(ctx context.Context) ([]models.Vector, [][]float64, erro
 	// Check if context is already cancelled
 	if err := ctx.Err(); err != nil {
 		span.RecordError(err)
-		return nil, nil, err
+		return nil, err
 	}
 
-	paretoCh := make(chan []models.Vector, mode.config.ParetoChannelLimiter)
-	maxObjsCh := make(chan []float64, mode.config.MaxChannelLimiter)
+	// Results are kept per execution so that they are merged in the same
+	// order regardless of which execution finishes first.
+	results := make([]executionResult, mode.constants.Executions)
 	wgExecs := &sync.WaitGroup{}
 
-	// Collect max objectives from all executions
-	allMaxObjs := make([][]float64, 0, mode.constants.Executions)
-	var maxObjsMu sync.Mutex
-
-	// Collect pareto results from all executions
-	allPareto := make([][]models.Vector, 0, mode.constants.Executions)
-	var paretoMu sync.Mutex
-
 	// Collect errors from executions
 	var execErrors []error
 	var execErrorsMu sync.Mutex
 
-	// WaitGroup for channel consumers
-	wgConsumers := &sync.WaitGroup{}
-
-	// Goroutine to consume max objectives (prevents deadlock)
-	wgConsumers.Go(func() {
-		for maxObjs := range maxObjsCh {
-			maxObjsMu.Lock()
-			allMaxObjs = append(allMaxObjs, maxObjs)
-			maxObjsMu.Unlock()
-		}
-	})
-
-	// Goroutine to consume pareto results (prevents deadlock)
-	wgConsumers.Go(func() {
-		for pareto := range paretoCh {
-			paretoMu.Lock()
-			allPareto = append(allPareto, pareto)
-			paretoMu.Unlock()
-		}
-	})
-
 	// Runs algorithm for Executions amount of times.
 	for i := range mode.constants.Executions {
 		wgExecs.Add(1)
@@ -119,12 +107,26 @@ func (mode *de) Execute(ctx context.Context) ([]models.Vector, [][]float64, erro
 					execErrorsMu.Unlock()
 				}
 			}()
+
+			execCtx := WithContextExecutionNumber(ctx, idx)
+			if mode.seed != nil {
+				execCtx = WithContextSeed(execCtx, ExecutionSeed(*mode.seed, idx))
+			}
+			checkpoint := mode.checkpoints[idx]
+			if checkpoint != nil {
+				execCtx = WithContextCheckpoint(execCtx, checkpoint)
+			}
+			if mode.paretoArchive != nil {
+				archive := NewParetoArchive(*mode.paretoArchive)
+				if checkpoint != nil {
+					archive.Add(checkpoint.ParetoArchive...)
+				}
+				results[idx].archive = archive
+				execCtx = WithContextParetoArchive(execCtx, archive)
+			}
+
 			// running the algorithm execution.
-			if err := mode.algorithm.Execute(
-				WithContextExecutionNumber(ctx, idx),
-				paretoCh,
-				maxObjsCh,
-			); err != nil {
+			if err := mode.runExecution(execCtx, &results[idx]); err != nil {
 				execErrorsMu.Lock()
 				execErrors = append(execErrors, fmt.Errorf("execution %d: %w", idx, err))
 				execErrorsMu.Unlock()
@@ -145,9 +147,13 @@ func (mode *de) Execute(ctx context.Context) ([]models.Vector, [][]float64, erro
 	}
 
 	wgExecs.Wait()
-	close(paretoCh)
-	close(maxObjsCh)
-	wgConsumers.Wait()
+
+	allPareto := make([][]models.Vector, 0, mode.constants.Executions)
+	allMaxObjs := make([][]float64, 0, mode.constants.Executions)
+	for _, result := range results {
+		allPareto = append(allPareto, result.pareto...)
+		allMaxObjs = append(allMaxObjs, result.maxObjs...)
+	}
 
 	span.SetAttributes(
 		attribute.Int("collected_pareto_sets", len(allPareto)),
@@ -159,24 +165,61 @@ func (mode *de) Execute(ctx context.Context) ([]models.Vector, [][]float64, erro
 	if len(execErrors) == mode.constants.Executions {
 		combinedErr := errors.Join(execErrors...)
 		span.RecordError(combinedErr)
-		return nil, nil, combinedErr
+		return nil, combinedErr
 	}
 
 	// Check if cancelled before filtering
 	if err := ctx.Err(); err != nil {
 		span.RecordError(err)
-		return nil, nil, err
+		return nil, err
 	}
 
 	now := time.Now()
 	finalPareto := mode.filterCollectedPareto(ctx, allPareto)
 	slog.Info("Filtering Pareto", slog.Duration("time", time.Since(now)))
 
+	result := &Result{Pareto: finalPareto, MaxObjectives: allMaxObjs}
+	if mode.paretoArchive != nil {
+		result.ParetoArchive = mode.mergeParetoArchives(results)
+	}
+
 	span.SetAttributes(
 		attribute.Int("final_pareto_size", len(finalPareto)),
+		attribute.Int("pareto_archive_size", len(result.ParetoArchive)),
 	)
 
-	return finalPareto, allMaxObjs, nil
+	return result, nil
+}
+
+// executionResult holds everything sent by a single execution.
+type executionResult struct {
+	pareto  [][]models.Vector
+	maxObjs [][]float64
+	archive *ParetoArchive
+}
+
+// runExecution runs the algorithm once, collecting what it sends into result.
+func (mode *de) runExecution(ctx context.Context, result *executionResult) error {
+	paretoCh := make(chan []models.Vector, mode.config.ParetoChannelLimiter)
+	maxObjsCh := make(chan []float64, mode.config.MaxChannelLimiter)
+
+	// Consumers drain the channels while the algorithm runs (prevents deadlock)
+	wgConsumers := &sync.WaitGroup{}
+	wgConsumers.Go(func() {
+		for maxObjs := range maxObjsCh {
+			result.maxObjs = append(result.maxObjs, maxObjs)
+		}
+	})
+	wgConsumers.Go(func() {
+		for pareto := range paretoCh {
+			result.pareto = append(result.pareto, pareto)
+		}
+	})
+	defer wgConsumers.Wait()
+	defer close(paretoCh)
+	defer close(maxObjsCh)
+
+	return mode.algorithm.Execute(ctx, paretoCh, maxObjsCh)
 }
 
 func (mode *de) filterCollectedPareto(
@@ -206,3 +249,13 @@ func (mode *de) filterCollectedPareto(
 
 	return finalPareto
 }
+
+// mergeParetoArchives adds the archives of every execution to a single one,
+// keeping what is non-dominated across executions.
+func (mode *de) mergeParetoArchives(results []executionResult) []models.Vector {
+	merged := NewParetoArchive(*mode.paretoArchive)
+	for _, result := range results {
+		merged.Add(result.archive.Vectors()...)
+	}
+	return merged.Vectors()
+}
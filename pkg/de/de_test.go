@@ -3,6 +3,7 @@ package de
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"github.com/nicholaspcr/GoDE/pkg/models"
@@ -55,11 +56,11 @@ func TestNew(t *testing.T) {
 
 	t.Run("progress callback is set", func(t *testing.T) {
 		called := false
-		cb := func(gen, total, size int, pareto []models.Vector) { called = true }
+		cb := func(gen, total, size int, pareto []models.Vector, params *ControlParameters) { called = true }
 		d, err := New(Config{}, WithAlgorithm(algo), WithProgressCallback(cb))
 		require.NoError(t, err)
 		assert.NotNil(t, d.progressCallback)
-		d.progressCallback(1, 10, 5, nil)
+		d.progressCallback(1, 10, 5, nil, nil)
 		assert.True(t, called)
 	})
 }
@@ -83,10 +84,11 @@ func TestExecute(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		pareto, maxObjs, err := d.Execute(context.Background())
+		result, err := d.Execute(context.Background())
 		require.NoError(t, err)
-		assert.NotEmpty(t, pareto)
-		assert.Len(t, maxObjs, 1)
+		assert.NotEmpty(t, result.Pareto)
+		assert.Len(t, result.MaxObjectives, 1)
+		assert.Nil(t, result.ParetoArchive, "no archive unless requested")
 	})
 
 	t.Run("returns error when all executions fail", func(t *testing.T) {
@@ -103,7 +105,7 @@ func TestExecute(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		_, _, err = d.Execute(context.Background())
+		_, err = d.Execute(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "execution failed")
 	})
@@ -125,7 +127,7 @@ func TestExecute(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
 
-		_, _, err = d.Execute(ctx)
+		_, err = d.Execute(ctx)
 		assert.ErrorIs(t, err, context.Canceled)
 	})
 
@@ -154,10 +156,10 @@ func TestExecute(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		pareto, maxObjs, err := d.Execute(context.Background())
+		result, err := d.Execute(context.Background())
 		require.NoError(t, err)
-		assert.NotEmpty(t, pareto)
-		assert.Len(t, maxObjs, 1)
+		assert.NotEmpty(t, result.Pareto)
+		assert.Len(t, result.MaxObjectives, 1)
 	})
 
 	t.Run("multiple executions merge pareto results", func(t *testing.T) {
@@ -179,10 +181,106 @@ func TestExecute(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		pareto, maxObjs, err := d.Execute(context.Background())
+		result, err := d.Execute(context.Background())
 		require.NoError(t, err)
-		assert.NotEmpty(t, pareto)
-		assert.Len(t, maxObjs, 3)
+		assert.NotEmpty(t, result.Pareto)
+		assert.Len(t, result.MaxObjectives, 3)
+	})
+
+	t.Run("results are ordered by execution number", func(t *testing.T) {
+		algo := &mockAlgorithm{
+			executeFunc: func(ctx context.Context, pareto chan<- []models.Vector, maxObj chan<- []float64) error {
+				n := FromContextExecutionNumber(ctx)
+				pareto <- []models.Vector{}
+				maxObj <- []float64{float64(n)}
+				return nil
+			},
+		}
+
+		d, err := New(
+			Config{ResultLimiter: 100},
+			WithAlgorithm(algo),
+			WithExecutions(4),
+		)
+		require.NoError(t, err)
+
+		result, err := d.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, [][]float64{{0}, {1}, {2}, {3}}, result.MaxObjectives)
+	})
+
+	t.Run("same seed gives identical results", func(t *testing.T) {
+		algo := &mockAlgorithm{
+			executeFunc: func(ctx context.Context, pareto chan<- []models.Vector, maxObj chan<- []float64) error {
+				random := RandomFromContext(ctx)
+				vectors := make([]models.Vector, 5)
+				for i := range vectors {
+					x := random.Float64()
+					vectors[i] = models.Vector{Elements: []float64{x}, Objectives: []float64{x, 1 - x}}
+				}
+				pareto <- vectors
+				maxObj <- []float64{random.Float64()}
+				return nil
+			},
+		}
+
+		run := func(seed int64) ([]models.Vector, [][]float64) {
+			d, err := New(
+				Config{ParetoChannelLimiter: 10, MaxChannelLimiter: 10, ResultLimiter: 100},
+				WithAlgorithm(algo),
+				WithExecutions(3),
+				WithSeed(seed),
+			)
+			require.NoError(t, err)
+			result, err := d.Execute(context.Background())
+			require.NoError(t, err)
+			return result.Pareto, result.MaxObjectives
+		}
+
+		pareto1, maxObjs1 := run(42)
+		pareto2, maxObjs2 := run(42)
+		assert.Equal(t, pareto1, pareto2)
+		assert.Equal(t, maxObjs1, maxObjs2)
+
+		// Each execution draws from its own stream.
+		assert.NotEqual(t, maxObjs1[0], maxObjs1[1])
+
+		_, maxObjs3 := run(43)
+		assert.NotEqual(t, maxObjs1, maxObjs3)
+	})
+
+	t.Run("merges the pareto archives of the executions", func(t *testing.T) {
+		algo := &mockAlgorithm{
+			executeFunc: func(ctx context.Context, pareto chan<- []models.Vector, maxObj chan<- []float64) error {
+				n := float64(FromContextExecutionNumber(ctx))
+				archive := FromContextParetoArchive(ctx)
+				require.NotNil(t, archive)
+				// Solutions found early and then lost by the population
+				archive.Add(
+					models.Vector{Elements: []float64{n}, Objectives: []float64{n, 3 - n}},
+					models.Vector{Elements: []float64{n}, Objectives: []float64{5, 5}},
+				)
+				pareto <- []models.Vector{{Elements: []float64{n}, Objectives: []float64{5, 5}}}
+				maxObj <- []float64{1}
+				return nil
+			},
+		}
+
+		d, err := New(
+			Config{ParetoChannelLimiter: 10, MaxChannelLimiter: 10, ResultLimiter: 100},
+			WithAlgorithm(algo),
+			WithExecutions(3),
+			WithParetoArchive(ParetoArchiveConfig{}),
+		)
+		require.NoError(t, err)
+
+		result, err := d.Execute(context.Background())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []models.Vector{
+			{Elements: []float64{0}, Objectives: []float64{0, 3}},
+			{Elements: []float64{1}, Objectives: []float64{1, 2}},
+			{Elements: []float64{2}, Objectives: []float64{2, 1}},
+		}, result.ParetoArchive)
 	})
 }
 
@@ -225,3 +323,56 @@ func TestFilterCollectedPareto(t *testing.T) {
 		assert.NotEmpty(t, result)
 	})
 }
+
+func TestExecute_WithCheckpoints(t *testing.T) {
+	var mu sync.Mutex
+	resumed := make(map[int]int)
+	algo := &mockAlgorithm{
+		executeFunc: func(ctx context.Context, pareto chan<- []models.Vector, maxObj chan<- []float64) error {
+			if checkpoint, ok := FromContextCheckpoint(ctx); ok {
+				mu.Lock()
+				resumed[FromContextExecutionNumber(ctx)] = checkpoint.Generation
+				mu.Unlock()
+			}
+			pareto <- []models.Vector{{Elements: []float64{0}, Objectives: []float64{0, 0}}}
+			maxObj <- []float64{0}
+			return nil
+		},
+	}
+
+	d, err := New(
+		Config{ParetoChannelLimiter: 10, MaxChannelLimiter: 10, ResultLimiter: 100},
+		WithAlgorithm(algo),
+		WithExecutions(3),
+		WithCheckpoints([]*Checkpoint{{Execution: 0, Generation: 4}, {Execution: 2, Generation: 8}}),
+	)
+	require.NoError(t, err)
+
+	_, err = d.Execute(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{0: 4, 2: 8}, resumed, "executions without a checkpoint start from scratch")
+}
+
+func TestExecute_RestoresParetoArchive(t *testing.T) {
+	archived := models.Vector{Elements: []float64{1}, Objectives: []float64{0, 0}}
+	algo := &mockAlgorithm{
+		executeFunc: func(ctx context.Context, pareto chan<- []models.Vector, maxObj chan<- []float64) error {
+			pareto <- []models.Vector{{Elements: []float64{0}, Objectives: []float64{1, 1}}}
+			maxObj <- []float64{0}
+			return nil
+		},
+	}
+
+	d, err := New(
+		Config{ParetoChannelLimiter: 10, MaxChannelLimiter: 10, ResultLimiter: 100},
+		WithAlgorithm(algo),
+		WithExecutions(2),
+		WithCheckpoints([]*Checkpoint{{Execution: 1, Generation: 4, ParetoArchive: []models.Vector{archived}}}),
+		WithParetoArchive(ParetoArchiveConfig{}),
+	)
+	require.NoError(t, err)
+
+	result, err := d.Execute(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []models.Vector{archived}, result.ParetoArchive)
+}
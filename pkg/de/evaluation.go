@@ -0,0 +1,115 @@
+package de
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// EvaluationPool bounds how many objective evaluations run at the same time.
+// A pool can belong to a single execution or be shared by every execution of
+// a server, the bound then applies to all of them together. A nil pool
+// evaluates sequentially.
+type EvaluationPool struct {
+	slots chan struct{}
+}
+
+// NewEvaluationPool creates a pool running up to size evaluations at once,
+// sizes below two return nil as they gain nothing over sequential
+// evaluation.
+func NewEvaluationPool(size int) *EvaluationPool {
+	if size < 2 {
+		return nil
+	}
+	return &EvaluationPool{slots: make(chan struct{}, size)}
+}
+
+// Size returns the amount of concurrent evaluations, one for a nil pool.
+func (p *EvaluationPool) Size() int {
+	if p == nil {
+		return 1
+	}
+	return cap(p.slots)
+}
+
+// Evaluate computes the objectives of every vector in place. Vectors are
+// created before evaluation starts and each is written by a single goroutine,
+// so results don't depend on scheduling. The error of the lowest index is
+// returned, as a sequential evaluation would. Problems implementing
+// problems.BatchEvaluator get all the vectors in a single call, which takes
+// one slot of the pool.
+func (p *EvaluationPool) Evaluate(
+	ctx context.Context,
+	problem problems.Interface,
+	vectors []models.Vector,
+	objectives int,
+) error {
+	if batch, ok := problem.(problems.BatchEvaluator); ok {
+		return p.evaluateBatch(ctx, batch, vectors, objectives)
+	}
+
+	if p == nil || len(vectors) < 2 {
+		for i := range vectors {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := problem.Evaluate(&vectors[i], objectives); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(vectors))
+	for i := range vectors {
+		if err := ctx.Err(); err != nil {
+			wg.Wait()
+			return err
+		}
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-p.slots }()
+			errs[i] = problem.Evaluate(&vectors[i], objectives)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evaluateBatch evaluates the vectors with a single call to the problem.
+func (p *EvaluationPool) evaluateBatch(
+	ctx context.Context,
+	problem problems.BatchEvaluator,
+	vectors []models.Vector,
+	objectives int,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p != nil {
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer func() { <-p.slots }()
+	}
+	return problem.EvaluateBatch(ctx, vectors, objectives)
+}
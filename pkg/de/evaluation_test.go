@@ -0,0 +1,144 @@
+package de
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingProblem sums the elements of a vector, tracking how many
+// evaluations run at the same time.
+type countingProblem struct {
+	running, peak atomic.Int32
+	failAt        map[float64]error
+}
+
+func (p *countingProblem) Name() string { return "counting" }
+
+func (p *countingProblem) Evaluate(v *models.Vector, objectives int) error {
+	running := p.running.Add(1)
+	defer p.running.Add(-1)
+	for {
+		peak := p.peak.Load()
+		if running <= peak || p.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+
+	if err := p.failAt[v.Elements[0]]; err != nil {
+		return err
+	}
+	sum := 0.0
+	for _, e := range v.Elements {
+		sum += e
+	}
+	v.Objectives = make([]float64, objectives)
+	for i := range v.Objectives {
+		v.Objectives[i] = sum * float64(i+1)
+	}
+	return nil
+}
+
+// batchProblem is a countingProblem evaluating whole batches, recording
+// their sizes.
+type batchProblem struct {
+	countingProblem
+	batches []int
+}
+
+func (p *batchProblem) EvaluateBatch(_ context.Context, vectors []models.Vector, objectives int) error {
+	p.batches = append(p.batches, len(vectors))
+	for i := range vectors {
+		if err := p.Evaluate(&vectors[i], objectives); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vectorsForEvaluation(n int) []models.Vector {
+	vectors := make([]models.Vector, n)
+	for i := range vectors {
+		vectors[i] = models.Vector{Elements: []float64{float64(i), 1}}
+	}
+	return vectors
+}
+
+func TestNewEvaluationPool(t *testing.T) {
+	assert.Nil(t, NewEvaluationPool(0))
+	assert.Nil(t, NewEvaluationPool(1))
+	assert.Equal(t, 1, NewEvaluationPool(1).Size())
+	assert.Equal(t, 4, NewEvaluationPool(4).Size())
+}
+
+func TestEvaluationPool_Evaluate(t *testing.T) {
+	t.Run("results match sequential evaluation", func(t *testing.T) {
+		sequential := vectorsForEvaluation(20)
+		require.NoError(t, (*EvaluationPool)(nil).Evaluate(context.Background(), &countingProblem{}, sequential, 2))
+
+		concurrent := vectorsForEvaluation(20)
+		require.NoError(t, NewEvaluationPool(4).Evaluate(context.Background(), &countingProblem{}, concurrent, 2))
+
+		assert.Equal(t, sequential, concurrent)
+	})
+
+	t.Run("concurrency is bounded by the pool size", func(t *testing.T) {
+		problem := &countingProblem{}
+		require.NoError(t, NewEvaluationPool(3).Evaluate(context.Background(), problem, vectorsForEvaluation(30), 2))
+		assert.LessOrEqual(t, problem.peak.Load(), int32(3))
+		assert.Greater(t, problem.peak.Load(), int32(1))
+	})
+
+	t.Run("shared pool bounds concurrent callers together", func(t *testing.T) {
+		pool := NewEvaluationPool(2)
+		problem := &countingProblem{}
+		done := make(chan error, 3)
+		for range 3 {
+			go func() {
+				done <- pool.Evaluate(context.Background(), problem, vectorsForEvaluation(10), 2)
+			}()
+		}
+		for range 3 {
+			require.NoError(t, <-done)
+		}
+		assert.LessOrEqual(t, problem.peak.Load(), int32(2))
+	})
+
+	t.Run("returns the error of the lowest index", func(t *testing.T) {
+		errFirst, errLast := errors.New("first"), errors.New("last")
+		problem := &countingProblem{failAt: map[float64]error{3: errFirst, 7: errLast}}
+		err := NewEvaluationPool(4).Evaluate(context.Background(), problem, vectorsForEvaluation(10), 2)
+		assert.ErrorIs(t, err, errFirst)
+	})
+
+	t.Run("cancelled context stops evaluation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, NewEvaluationPool(2).Evaluate(ctx, &countingProblem{}, vectorsForEvaluation(10), 2), context.Canceled)
+		assert.ErrorIs(t, (*EvaluationPool)(nil).Evaluate(ctx, &countingProblem{}, vectorsForEvaluation(10), 2), context.Canceled)
+	})
+
+	t.Run("batch evaluators get every vector at once", func(t *testing.T) {
+		expected := vectorsForEvaluation(10)
+		require.NoError(t, (*EvaluationPool)(nil).Evaluate(context.Background(), &countingProblem{}, expected, 2))
+
+		for _, pool := range []*EvaluationPool{nil, NewEvaluationPool(4)} {
+			problem := &batchProblem{}
+			vectors := vectorsForEvaluation(10)
+			require.NoError(t, pool.Evaluate(context.Background(), problem, vectors, 2))
+			assert.Equal(t, []int{10}, problem.batches)
+			assert.Equal(t, expected, vectors)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, NewEvaluationPool(2).Evaluate(ctx, &batchProblem{}, vectorsForEvaluation(10), 2), context.Canceled)
+	})
+}
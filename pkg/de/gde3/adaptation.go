@@ -0,0 +1,192 @@
+package gde3
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// Scales of the Cauchy and normal distributions that F and CR are sampled
+// from, the values used by both JADE and SHADE.
+const (
+	fScale  = 0.1
+	crScale = 0.1
+)
+
+// parameterAdapter chooses the F and CR of each trial vector and learns from
+// the trials that succeed.
+type parameterAdapter interface {
+	// sample returns the F and CR of the next trial vector.
+	sample(random *rand.Rand) (f, cr float64)
+	// update learns from the successful trials of a generation.
+	update(successes []success)
+	// means reports the current means of F and CR.
+	means() de.ControlParameters
+	// state returns what the adapter learned so far, for checkpoints.
+	state() map[string][]float64
+	// restore continues from the state of a checkpoint.
+	restore(state map[string][]float64)
+}
+
+// success holds the parameters of a trial vector that dominated or survived
+// alongside its parent, weighted by its improvement over the parent.
+type success struct {
+	f, cr, weight float64
+}
+
+// newParameterAdapter returns the adapter of the configured scheme, nil when
+// the parameters are fixed.
+func newParameterAdapter(c Constants) parameterAdapter {
+	switch c.Adaptation {
+	case JADE:
+		return &jadeAdapter{muF: c.F, muCR: c.CR, c: c.LearningRate}
+	case SHADE:
+		s := &shadeAdapter{
+			memF:  make([]float64, c.MemorySize),
+			memCR: make([]float64, c.MemorySize),
+		}
+		for i := range c.MemorySize {
+			s.memF[i], s.memCR[i] = c.F, c.CR
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// jadeAdapter keeps a single mean per parameter, moved by the learning rate
+// towards the Lehmer mean of the successful F and the mean of successful CR.
+type jadeAdapter struct {
+	muF, muCR, c float64
+}
+
+func (j *jadeAdapter) sample(random *rand.Rand) (float64, float64) {
+	return sampleF(random, j.muF), sampleCR(random, j.muCR)
+}
+
+func (j *jadeAdapter) update(successes []success) {
+	if len(successes) == 0 {
+		return
+	}
+	f, cr := successMeans(successes, false)
+	j.muF = (1-j.c)*j.muF + j.c*f
+	j.muCR = (1-j.c)*j.muCR + j.c*cr
+}
+
+func (j *jadeAdapter) means() de.ControlParameters {
+	return de.ControlParameters{F: j.muF, CR: j.muCR}
+}
+
+func (j *jadeAdapter) state() map[string][]float64 {
+	return map[string][]float64{"mu_f": {j.muF}, "mu_cr": {j.muCR}}
+}
+
+func (j *jadeAdapter) restore(state map[string][]float64) {
+	if muF, muCR := state["mu_f"], state["mu_cr"]; len(muF) == 1 && len(muCR) == 1 {
+		j.muF, j.muCR = muF[0], muCR[0]
+	}
+}
+
+// shadeAdapter keeps a circular memory of the improvement-weighted means of
+// past generations, each trial sampling around a random entry.
+type shadeAdapter struct {
+	memF, memCR []float64
+	next        int
+}
+
+func (s *shadeAdapter) sample(random *rand.Rand) (float64, float64) {
+	r := random.Intn(len(s.memF))
+	return sampleF(random, s.memF[r]), sampleCR(random, s.memCR[r])
+}
+
+func (s *shadeAdapter) update(successes []success) {
+	if len(successes) == 0 {
+		return
+	}
+	s.memF[s.next], s.memCR[s.next] = successMeans(successes, true)
+	s.next = (s.next + 1) % len(s.memF)
+}
+
+func (s *shadeAdapter) means() de.ControlParameters {
+	var params de.ControlParameters
+	for i := range s.memF {
+		params.F += s.memF[i]
+		params.CR += s.memCR[i]
+	}
+	params.F /= float64(len(s.memF))
+	params.CR /= float64(len(s.memCR))
+	return params
+}
+
+func (s *shadeAdapter) state() map[string][]float64 {
+	return map[string][]float64{
+		"memory_f":    append([]float64(nil), s.memF...),
+		"memory_cr":   append([]float64(nil), s.memCR...),
+		"memory_next": {float64(s.next)},
+	}
+}
+
+func (s *shadeAdapter) restore(state map[string][]float64) {
+	memF, memCR, next := state["memory_f"], state["memory_cr"], state["memory_next"]
+	if len(memF) != len(s.memF) || len(memCR) != len(s.memCR) || len(next) != 1 {
+		return
+	}
+	copy(s.memF, memF)
+	copy(s.memCR, memCR)
+	s.next = int(next[0]) % len(s.memF)
+}
+
+// sampleF draws F from a Cauchy distribution around mu, drawing again when
+// the value isn't positive and truncating it to 1.
+func sampleF(random *rand.Rand, mu float64) float64 {
+	for {
+		f := mu + fScale*math.Tan(math.Pi*(random.Float64()-0.5))
+		if f > 0 {
+			return math.Min(f, 1)
+		}
+	}
+}
+
+// sampleCR draws CR from a normal distribution around mu clamped to [0, 1].
+func sampleCR(random *rand.Rand, mu float64) float64 {
+	return math.Max(0, math.Min(1, mu+crScale*random.NormFloat64()))
+}
+
+// successMeans returns the Lehmer mean of the successful F and the
+// arithmetic mean of the successful CR. Unweighted, or when no trial
+// improved on its parent, every success has the same weight.
+func successMeans(successes []success, weighted bool) (float64, float64) {
+	total := 0.0
+	if weighted {
+		for _, s := range successes {
+			total += s.weight
+		}
+	}
+	weight := func(s success) float64 {
+		if total > 0 {
+			return s.weight / total
+		}
+		return 1 / float64(len(successes))
+	}
+
+	var fSquares, fSum, cr float64
+	for _, s := range successes {
+		w := weight(s)
+		fSquares += w * s.f * s.f
+		fSum += w * s.f
+		cr += w * s.cr
+	}
+	return fSquares / fSum, cr
+}
+
+// improvement is how much the trial improved on its parent, summed over the
+// objectives and the constraint violation.
+func improvement(parent, trial models.Vector) float64 {
+	total := math.Max(0, parent.ConstraintViolation()-trial.ConstraintViolation())
+	for i := range parent.Objectives {
+		total += math.Max(0, parent.Objectives[i]-trial.Objectives[i])
+	}
+	return total
+}
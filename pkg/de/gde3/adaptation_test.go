@@ -0,0 +1,93 @@
+package gde3
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParameterAdapter(t *testing.T) {
+	base := Constants{CR: 0.9, F: 0.5, LearningRate: 0.1, MemorySize: 3}
+
+	t.Run("fixed has no adapter", func(t *testing.T) {
+		assert.Nil(t, newParameterAdapter(base))
+	})
+
+	t.Run("adaptive schemes start at the configured values", func(t *testing.T) {
+		for _, adaptation := range []Adaptation{JADE, SHADE} {
+			c := base
+			c.Adaptation = adaptation
+			adapter := newParameterAdapter(c)
+			require.NotNil(t, adapter, adaptation.String())
+
+			means := adapter.means()
+			assert.InDelta(t, 0.5, means.F, 1e-12, adaptation.String())
+			assert.InDelta(t, 0.9, means.CR, 1e-12, adaptation.String())
+		}
+	})
+}
+
+func TestSampleParameters(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for range 1000 {
+		f := sampleF(random, 0.5)
+		assert.Greater(t, f, 0.0)
+		assert.LessOrEqual(t, f, 1.0)
+
+		cr := sampleCR(random, 0.9)
+		assert.GreaterOrEqual(t, cr, 0.0)
+		assert.LessOrEqual(t, cr, 1.0)
+	}
+}
+
+func TestJADEAdapter_Update(t *testing.T) {
+	j := &jadeAdapter{muF: 0.5, muCR: 0.5, c: 0.5}
+
+	t.Run("no successes keep the means", func(t *testing.T) {
+		j.update(nil)
+		assert.Equal(t, 0.5, j.muF)
+		assert.Equal(t, 0.5, j.muCR)
+	})
+
+	t.Run("means move towards the successes", func(t *testing.T) {
+		// JADE ignores the weights: the Lehmer mean of {0.2, 0.6} is
+		// 0.4 / 0.8 = 0.5 and the mean of the successful CR is 0.3
+		j.update([]success{
+			{f: 0.2, cr: 0.2, weight: 10},
+			{f: 0.6, cr: 0.4, weight: 0},
+		})
+		assert.InDelta(t, 0.5, j.muF, 1e-12)
+		assert.InDelta(t, 0.4, j.muCR, 1e-12)
+	})
+}
+
+func TestSHADEAdapter_Update(t *testing.T) {
+	s := newParameterAdapter(Constants{
+		CR: 0.5, F: 0.5, Adaptation: SHADE, MemorySize: 2,
+	}).(*shadeAdapter)
+
+	s.update([]success{
+		{f: 0.2, cr: 0.1, weight: 3},
+		{f: 0.8, cr: 0.9, weight: 1},
+	})
+	// Weighted Lehmer mean (0.75*0.04 + 0.25*0.64) / (0.75*0.2 + 0.25*0.8)
+	assert.InDelta(t, 0.19/0.35, s.memF[0], 1e-12)
+	assert.InDelta(t, 0.3, s.memCR[0], 1e-12)
+	assert.Equal(t, 0.5, s.memF[1], "only one entry is written per generation")
+
+	s.update([]success{{f: 0.3, cr: 0.7}})
+	s.update([]success{{f: 0.4, cr: 0.6}})
+	assert.InDeltaSlice(t, []float64{0.4, 0.3}, s.memF, 1e-12, "the memory is circular")
+	assert.InDelta(t, 0.35, s.means().F, 1e-12)
+	assert.InDelta(t, 0.65, s.means().CR, 1e-12)
+}
+
+func TestImprovement(t *testing.T) {
+	parent := models.Vector{Objectives: []float64{1, 1}, Constraints: []float64{0.5}}
+	trial := models.Vector{Objectives: []float64{0.5, 2}, Constraints: []float64{0}}
+	assert.InDelta(t, 1.0, improvement(parent, trial), 1e-12)
+	assert.Zero(t, improvement(trial, trial))
+}
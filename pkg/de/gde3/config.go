@@ -6,6 +6,40 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/de"
 )
 
+// Adaptation selects how F and CR are chosen for each trial vector.
+type Adaptation int
+
+const (
+	// Fixed uses the same F and CR for the whole run.
+	Fixed Adaptation = iota
+	// JADE samples F and CR around means that move towards the values of
+	// the successful trials of each generation.
+	JADE
+	// SHADE samples F and CR around a random entry of a success-history
+	// memory, one entry being overwritten per generation.
+	SHADE
+)
+
+// String returns the name of the adaptation scheme.
+func (a Adaptation) String() string {
+	switch a {
+	case Fixed:
+		return "fixed"
+	case JADE:
+		return "jade"
+	case SHADE:
+		return "shade"
+	default:
+		return "unknown"
+	}
+}
+
+// Default values used when an adaptation constant is left as zero.
+const (
+	DefaultLearningRate = 0.1
+	DefaultMemorySize   = 5
+)
+
 // Constants used for the gde3 algorithm.
 type Constants struct {
 	DE de.Constants
@@ -13,6 +47,14 @@ type Constants struct {
 	CR float64 `json:"cr" yaml:"cr" name:"cr"`
 	F  float64 `json:"f"  yaml:"f"  name:"f"`
 	P  float64 `json:"p"  yaml:"p"  name:"p"`
+
+	// Adaptation is the control parameter scheme, with adaptive schemes CR
+	// and F are the initial means.
+	Adaptation Adaptation `json:"adaptation" yaml:"adaptation" name:"adaptation"`
+	// LearningRate is JADE's c, the weight of the latest successful values.
+	LearningRate float64 `json:"learning_rate" yaml:"learning_rate" name:"learning_rate"`
+	// MemorySize is the amount of SHADE success-history entries.
+	MemorySize int `json:"memory_size" yaml:"memory_size" name:"memory_size"`
 }
 
 // Validate checks that all GDE3 Constants fields have valid values.
@@ -29,5 +71,18 @@ func (c *Constants) Validate() error {
 	if c.P <= 0 || c.P > 1 {
 		return errors.New("p (selection parameter) must be in range (0, 1]")
 	}
+	switch c.Adaptation {
+	case Fixed:
+	case JADE:
+		if c.LearningRate <= 0 || c.LearningRate > 1 {
+			return errors.New("learning_rate must be in range (0, 1]")
+		}
+	case SHADE:
+		if c.MemorySize < 1 {
+			return errors.New("memory_size must be at least 1")
+		}
+	default:
+		return errors.New("unknown parameter adaptation")
+	}
 	return nil
 }
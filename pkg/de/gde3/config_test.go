@@ -92,4 +92,32 @@ func TestConstants_Validate(t *testing.T) {
 		c.P = 1.0
 		require.NoError(t, c.Validate(), "P=1 should be valid")
 	})
+
+	t.Run("JADE learning rate must be in (0, 1]", func(t *testing.T) {
+		c := validGDE3
+		c.Adaptation = JADE
+		c.LearningRate = 0
+		assert.ErrorContains(t, c.Validate(), "learning_rate must be in range (0, 1]")
+
+		c.LearningRate = 1.5
+		assert.ErrorContains(t, c.Validate(), "learning_rate must be in range (0, 1]")
+
+		c.LearningRate = DefaultLearningRate
+		require.NoError(t, c.Validate())
+	})
+
+	t.Run("SHADE memory size must be positive", func(t *testing.T) {
+		c := validGDE3
+		c.Adaptation = SHADE
+		assert.ErrorContains(t, c.Validate(), "memory_size must be at least 1")
+
+		c.MemorySize = DefaultMemorySize
+		require.NoError(t, c.Validate())
+	})
+
+	t.Run("unknown adaptation", func(t *testing.T) {
+		c := validGDE3
+		c.Adaptation = Adaptation(42)
+		assert.EqualError(t, c.Validate(), "unknown parameter adaptation")
+	})
 }
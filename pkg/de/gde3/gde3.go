@@ -7,10 +7,12 @@ import (
 	"log/slog"
 	"math/rand"
 
-	"github.com/nicholaspcr/GoDE/pkg/de"
 	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/de"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -32,6 +34,19 @@ func newFromConfig(params de.AlgorithmParams, config *api.DEConfig) (de.Algorith
 		return nil, fmt.Errorf("GDE3 configuration is required")
 	}
 
+	adaptation, err := adaptationFromProto(gde3Config.Adaptation)
+	if err != nil {
+		return nil, err
+	}
+	learningRate := float64(gde3Config.LearningRate)
+	if learningRate == 0 {
+		learningRate = DefaultLearningRate
+	}
+	memorySize := int(gde3Config.MemorySize)
+	if memorySize == 0 {
+		memorySize = DefaultMemorySize
+	}
+
 	constants := Constants{
 		DE: de.Constants{
 			Executions:    int(config.Executions),
@@ -42,26 +57,58 @@ func newFromConfig(params de.AlgorithmParams, config *api.DEConfig) (de.Algorith
 		CR: float64(gde3Config.Cr),
 		F:  float64(gde3Config.F),
 		P:  float64(gde3Config.P),
+
+		Adaptation:   adaptation,
+		LearningRate: learningRate,
+		MemorySize:   memorySize,
 	}
 
 	return New(
 		WithProblem(params.Problem),
 		WithVariant(params.Variant),
+		WithCrossover(params.Crossover),
+		WithRepair(params.Repair),
 		WithPopulationParams(params.PopulationParams),
 		WithConstants(constants),
 		WithInitialPopulation(params.InitialPopulation),
 		WithProgressCallback(params.ProgressCallback),
+		WithEvaluationPool(params.EvaluationPool),
+		WithCheckpoints(params.CheckpointCallback, params.CheckpointInterval),
+		WithMetrics(params.Metrics),
 	), nil
 }
 
+// adaptationFromProto maps the API adaptation scheme, unspecified meaning
+// fixed parameters.
+func adaptationFromProto(a api.ParameterAdaptation) (Adaptation, error) {
+	switch a {
+	case api.ParameterAdaptation_PARAMETER_ADAPTATION_UNSPECIFIED,
+		api.ParameterAdaptation_PARAMETER_ADAPTATION_FIXED:
+		return Fixed, nil
+	case api.ParameterAdaptation_PARAMETER_ADAPTATION_JADE:
+		return JADE, nil
+	case api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE:
+		return SHADE, nil
+	default:
+		return 0, fmt.Errorf("unknown parameter adaptation: %v", a)
+	}
+}
+
 // gde3 type that contains the definition of the GDE3 algorithm.
 type gde3 struct {
 	problem           problems.Interface
 	variant           variants.Interface
+	crossover         crossover.Interface
+	repair            repair.Interface
 	initialPopulation models.Population
 	populationParams  models.PopulationParams
 	constants         Constants
 	progressCallback  de.ProgressCallback
+	evaluationPool    *de.EvaluationPool
+	metrics           de.MetricsObserver
+
+	checkpointCallback de.CheckpointCallback
+	checkpointInterval int
 }
 
 // Option is a functional option for configuring the GDE3 algorithm.
@@ -75,6 +122,12 @@ func New(opts ...Option) de.Algorithm {
 	for _, opt := range opts {
 		opt(d)
 	}
+	if d.crossover == nil {
+		d.crossover = crossover.Binomial()
+	}
+	if d.repair == nil {
+		d.repair = repair.Clamp()
+	}
 	return d
 }
 
@@ -94,31 +147,70 @@ func (g *gde3) Execute(
 			attribute.Int("generations", g.constants.DE.Generations),
 			attribute.String("variant", g.variant.Name()),
 			attribute.String("problem", g.problem.Name()),
+			attribute.String("adaptation", g.constants.Adaptation.String()),
 		),
 	)
 	defer span.End()
 
 	logger := slog.Default()
-	random := rand.New(rand.NewSource(rand.Int63()))
+	random := de.RandomFromContext(ctx)
+	resumed, _ := de.FromContextCheckpoint(ctx)
+	checkpointer := de.NewCheckpointer(ctx, g.checkpointCallback, g.checkpointInterval)
+	paretoArchive := de.FromContextParetoArchive(ctx)
 
 	execNum := de.FromContextExecutionNumber(ctx)
 	logger.Debug("Starting GDE3", slog.Int("execution", execNum))
 	span.SetAttributes(attribute.Int("execution_number", execNum))
 
-	population := g.initialPopulation.Copy()
+	// Adaptive F and CR are learned per execution, nil when they are fixed
+	adapter := newParameterAdapter(g.constants)
 
-	maxObjs, err := g.initializePopulation(ctx, population)
-	if err != nil {
-		span.RecordError(err)
-		return err
+	// Parents replaced by their offspring, only kept for variants using them
+	var archive *de.Archive
+	if variants.UsesArchive(g.variant) {
+		archive = de.NewArchive(g.populationParams.PopulationSize)
 	}
 
 	// Track current generation's rank-zero for progress reporting
-	var currentRankZero []models.Vector
+	var (
+		population      models.Population
+		maxObjs         []float64
+		currentRankZero []models.Vector
+		start           int
+		// Evaluations of the initial population, reported along with the
+		// first generation
+		initialEvaluations int
+	)
+	if resumed != nil {
+		population, maxObjs = resumed.Population, resumed.MaxObjectives
+		currentRankZero, start = resumed.Pareto, resumed.Generation
+		if adapter != nil {
+			adapter.restore(resumed.State)
+		}
+		archive.Restore(resumed.State[de.ArchiveStateKey], g.populationParams.DimensionSize)
+		span.SetAttributes(attribute.Int("resumed_generation", start))
+	} else {
+		population = g.initialPopulation.Copy()
+
+		var err error
+		maxObjs, err = g.initializePopulation(ctx, population)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		initialEvaluations = len(population)
+	}
+
+	for gen := start; gen < g.constants.DE.Generations; gen++ {
+		// The random state before the generation matches the population
+		// should the generation be interrupted
+		randomState := random.State()
 
-	for gen := range g.constants.DE.Generations {
 		// Check for cancellation at the start of each generation
 		if err := ctx.Err(); err != nil {
+			if checkpointer.Pending(gen) {
+				checkpointer.Save(g.checkpoint(execNum, gen, population, currentRankZero, maxObjs, randomState, adapter, archive))
+			}
 			wrappedErr := fmt.Errorf("gde3 cancelled at generation %d: %w", gen, err)
 			span.RecordError(wrappedErr)
 			return wrappedErr
@@ -129,17 +221,35 @@ func (g *gde3) Execute(
 			slog.Int("generation_n", gen),
 		)
 
-		newPopulation, rankZero, err := g.runGeneration(ctx, population, random)
+		newPopulation, rankZero, improved, err := g.runGeneration(ctx, population, adapter, archive, random.Rand)
 		if err != nil {
+			if ctx.Err() != nil && checkpointer.Pending(gen) {
+				checkpointer.Save(g.checkpoint(execNum, gen, population, currentRankZero, maxObjs, randomState, adapter, archive))
+			}
 			span.RecordError(err)
 			return err
 		}
+		if g.metrics != nil {
+			trials := len(population)
+			g.observeGeneration(ctx, trials, initialEvaluations+trials, improved, newPopulation, rankZero)
+			initialEvaluations = 0
+		}
 		population = newPopulation
 		currentRankZero = rankZero
+		paretoArchive.Add(currentRankZero...)
 
 		// Call progress callback with current generation's rank-zero elements
 		if g.progressCallback != nil {
-			g.progressCallback(gen+1, g.constants.DE.Generations, len(currentRankZero), currentRankZero)
+			var params *de.ControlParameters
+			if adapter != nil {
+				means := adapter.means()
+				params = &means
+			}
+			g.progressCallback(gen+1, g.constants.DE.Generations, len(currentRankZero), currentRankZero, params)
+		}
+
+		if checkpointer.Due(gen+1, g.constants.DE.Generations) {
+			checkpointer.Save(g.checkpoint(execNum, gen+1, population, currentRankZero, maxObjs, random.State(), adapter, archive))
 		}
 	}
 
@@ -151,6 +261,54 @@ func (g *gde3) Execute(
 	return nil
 }
 
+// checkpoint captures the state of the execution after gen generations.
+func (g *gde3) checkpoint(
+	execNum, gen int,
+	population models.Population,
+	rankZero []models.Vector,
+	maxObjs []float64,
+	random de.RandomState,
+	adapter parameterAdapter,
+	archive *de.Archive,
+) *de.Checkpoint {
+	checkpoint := de.NewCheckpoint(execNum, gen, population, rankZero, maxObjs, random)
+	if adapter != nil {
+		checkpoint.State = adapter.state()
+	}
+	if archive != nil {
+		if checkpoint.State == nil {
+			checkpoint.State = make(map[string][]float64, 1)
+		}
+		checkpoint.State[de.ArchiveStateKey] = archive.State()
+	}
+	return checkpoint
+}
+
+// observeGeneration reports a generation that created trials vectors, of
+// which improved dominate their parent, and left population. The rank zero
+// vectors lead the reduced population, so their crowding distances are read
+// from it.
+func (g *gde3) observeGeneration(
+	ctx context.Context,
+	trials, evaluations, improved int,
+	population models.Population,
+	rankZero []models.Vector,
+) {
+	front := population[:min(len(rankZero), len(population))]
+	metrics := de.GenerationMetrics{
+		Evaluations:         evaluations,
+		Mutations:           trials,
+		Crossovers:          trials,
+		RankZeroSize:        len(rankZero),
+		CrowdingDistanceAvg: de.AverageCrowdingDistance(front),
+		Diversity:           de.PopulationDiversity(population),
+	}
+	if trials > 0 {
+		metrics.ConvergenceRate = float64(improved) / float64(trials)
+	}
+	g.metrics.ObserveGeneration(ctx, metrics)
+}
+
 func (g *gde3) initializePopulation(ctx context.Context, population models.Population) ([]float64, error) {
 	tracer := otel.Tracer("gde3")
 	ctx, span := tracer.Start(ctx, "gde3.initializePopulation",
@@ -160,17 +318,15 @@ func (g *gde3) initializePopulation(ctx context.Context, population models.Popul
 	)
 	defer span.End()
 
+	if err := g.evaluationPool.Evaluate(
+		ctx, g.problem, population, g.populationParams.ObjectivesSize,
+	); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	maxObjs := make([]float64, g.populationParams.ObjectivesSize)
 	for i := range population {
-		// Check for cancellation between evaluations
-		if err := ctx.Err(); err != nil {
-			span.RecordError(err)
-			return nil, err
-		}
-		if err := g.problem.Evaluate(&population[i], g.populationParams.ObjectivesSize); err != nil {
-			span.RecordError(err)
-			return nil, err
-		}
 		for j, obj := range population[i].Objectives {
 			if obj > maxObjs[j] {
 				maxObjs[j] = obj
@@ -183,8 +339,10 @@ func (g *gde3) initializePopulation(ctx context.Context, population models.Popul
 func (g *gde3) runGeneration(
 	ctx context.Context,
 	population models.Population,
+	adapter parameterAdapter,
+	archive *de.Archive,
 	random *rand.Rand,
-) (models.Population, []models.Vector, error) {
+) (models.Population, []models.Vector, int, error) {
 	tracer := otel.Tracer("gde3")
 	ctx, span := tracer.Start(ctx, "gde3.runGeneration",
 		trace.WithAttributes(
@@ -197,40 +355,63 @@ func (g *gde3) runGeneration(
 	genRankZero, _ := de.FilterDominated(population)
 
 	// Phase 1: Create and evaluate ALL offspring first (like pymoode)
-	// This ensures all mutations use the same population state
+	// This ensures all mutations use the same population state, and as the
+	// random draws all happen here evaluation can run concurrently
+	// Each trial samples its own F and CR when they are adaptive
 	offspring := make([]models.Vector, popSize)
+	fs, crs := make([]float64, popSize), make([]float64, popSize)
 	for i := range popSize {
-		trial, err := g.mutateAndCrossover(ctx, population, genRankZero, i, random)
-		if err != nil {
-			span.RecordError(err)
-			return nil, nil, err
+		fs[i], crs[i] = g.constants.F, g.constants.CR
+		if adapter != nil {
+			fs[i], crs[i] = adapter.sample(random)
 		}
 
-		if err := g.problem.Evaluate(&trial, g.populationParams.ObjectivesSize); err != nil {
+		trial, err := g.mutateAndCrossover(ctx, population, genRankZero, archive.Vectors(), i, fs[i], crs[i], random)
+		if err != nil {
 			span.RecordError(err)
-			return nil, nil, err
+			return nil, nil, 0, err
 		}
-
 		offspring[i] = trial
 	}
 
+	if err := g.evaluationPool.Evaluate(
+		ctx, g.problem, offspring, g.populationParams.ObjectivesSize,
+	); err != nil {
+		span.RecordError(err)
+		return nil, nil, 0, err
+	}
+
 	// Phase 2: Selection - compare each offspring with its parent
 	// Build survivors list (like pymoode's _advance)
 	survivors := make([]models.Vector, 0, popSize*2)
+	var successes []success
+	improved := 0
 	for i := range popSize {
 		parent := population[i]
 		off := offspring[i]
 
-		comp := de.DominanceTest(parent.Objectives, off.Objectives)
+		// Deb's feasibility rules, plain dominance for unconstrained problems
+		comp := de.ConstrainedDominanceTest(parent, off)
 
 		switch comp {
 		case 0: // Neither dominates - keep both
 			survivors = append(survivors, parent.Copy(), off.Copy())
 		case 1: // Offspring dominates parent - keep offspring
 			survivors = append(survivors, off.Copy())
+			archive.Add(parent, random)
+			improved++
 		case -1: // Parent dominates offspring - keep parent
 			survivors = append(survivors, parent.Copy())
 		}
+
+		if adapter != nil && comp != -1 {
+			successes = append(successes, success{
+				f: fs[i], cr: crs[i], weight: improvement(parent, off),
+			})
+		}
+	}
+	if adapter != nil {
+		adapter.update(successes)
 	}
 
 	// Phase 3: Reduce survivors to population size via RankAndCrowding
@@ -241,13 +422,14 @@ func (g *gde3) runGeneration(
 		attribute.Int("rank_zero_size", len(rankZero)),
 		attribute.Int("reduced_population_size", len(reducedPop)),
 	)
-	return reducedPop, rankZero, nil
+	return reducedPop, rankZero, improved, nil
 }
 
 func (g *gde3) mutateAndCrossover(
 	ctx context.Context,
-	population, genRankZero []models.Vector,
+	population, genRankZero, archive []models.Vector,
 	currentIdx int,
+	f, cr float64,
 	random *rand.Rand,
 ) (models.Vector, error) {
 	tracer := otel.Tracer("gde3")
@@ -261,10 +443,11 @@ func (g *gde3) mutateAndCrossover(
 		genRankZero,
 		variants.Parameters{
 			DIM:     popuParams.DimensionSize,
-			F:       g.constants.F,
+			F:       f,
 			CurrPos: currentIdx,
 			P:       g.constants.P,
 			Random:  random,
+			Archive: archive,
 		},
 	)
 	if err != nil {
@@ -272,25 +455,8 @@ func (g *gde3) mutateAndCrossover(
 		return models.Vector{}, err
 	}
 
-	trial := population[currentIdx].Copy()
-
-	currInd := random.Int() % popuParams.DimensionSize
-	luckyIndex := random.Int() % popuParams.DimensionSize
-
-	for range popuParams.DimensionSize {
-		changeProb := random.Float64()
-		if changeProb < g.constants.CR || currInd == luckyIndex {
-			trial.Elements[currInd] = vr.Elements[currInd]
-		}
-
-		if trial.Elements[currInd] < popuParams.FloorRange[currInd] {
-			trial.Elements[currInd] = popuParams.FloorRange[currInd]
-		}
-		if trial.Elements[currInd] > popuParams.CeilRange[currInd] {
-			trial.Elements[currInd] = popuParams.CeilRange[currInd]
-		}
-		currInd = (currInd + 1) % popuParams.DimensionSize
-	}
-
-	return trial, nil
+	return de.Trial(
+		population[currentIdx], vr, cr, g.crossover, g.repair,
+		popuParams.FloorRange, popuParams.CeilRange, random,
+	), nil
 }
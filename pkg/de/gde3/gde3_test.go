@@ -8,6 +8,7 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/de"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"github.com/nicholaspcr/GoDE/pkg/problems/multi"
+	"github.com/nicholaspcr/GoDE/pkg/variants/pbest"
 	variantsrand "github.com/nicholaspcr/GoDE/pkg/variants/rand"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -159,6 +160,143 @@ func TestGDE3_Execute(t *testing.T) {
 	})
 }
 
+func TestGDE3_Execute_Seeded(t *testing.T) {
+	run := func(seed int64, opts ...Option) []models.Vector {
+		population, params := createTestPopulation(10, 5, 2)
+		algorithm := New(append([]Option{
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 10}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+		}, opts...)...)
+
+		ctx := de.WithContextSeed(context.Background(), seed)
+		paretoCh := make(chan []models.Vector, 1)
+		maxObjCh := make(chan []float64, 1)
+		require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+		return <-paretoCh
+	}
+
+	t.Run("same seed gives identical pareto front", func(t *testing.T) {
+		assert.Equal(t, run(42), run(42))
+	})
+
+	t.Run("different seeds give different pareto fronts", func(t *testing.T) {
+		assert.NotEqual(t, run(42), run(43))
+	})
+
+	t.Run("concurrent evaluation gives identical pareto front", func(t *testing.T) {
+		assert.Equal(t, run(42), run(42, WithEvaluationPool(de.NewEvaluationPool(4))))
+	})
+}
+
+func TestGDE3_Execute_Constrained(t *testing.T) {
+	// SRN has x in [-20, 20]^2 and a feasible region that random points
+	// often miss, the final front must only keep feasible solutions
+	params := models.PopulationParams{
+		PopulationSize: 20,
+		DimensionSize:  2,
+		ObjectivesSize: 2,
+		FloorRange:     []float64{-20, -20},
+		CeilRange:      []float64{20, 20},
+	}
+	population, err := models.GeneratePopulation(params, rand.New(rand.NewSource(1)))
+	require.NoError(t, err)
+
+	algorithm := New(
+		WithProblem(multi.Srn()),
+		WithVariant(variantsrand.Rand1()),
+		WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 30}}),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+	)
+
+	ctx := de.WithContextSeed(context.Background(), 1)
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+
+	pareto := <-paretoCh
+	require.NotEmpty(t, pareto)
+	for _, v := range pareto {
+		assert.True(t, v.Feasible(), "constraints %v should be satisfied", v.Constraints)
+	}
+}
+
+func TestGDE3_Execute_ParetoArchive(t *testing.T) {
+	population, params := createTestPopulation(10, 5, 2)
+	algorithm := New(
+		WithProblem(multi.Zdt1()),
+		WithVariant(variantsrand.Rand1()),
+		WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 20}}),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+	)
+
+	archive := de.NewParetoArchive(de.ParetoArchiveConfig{})
+	ctx := de.WithContextParetoArchive(de.WithContextSeed(context.Background(), 1), archive)
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+
+	// The final front is either archived or dominated by the archive
+	require.NotEmpty(t, archive.Vectors())
+	for _, v := range <-paretoCh {
+		covered := false
+		for _, w := range archive.Vectors() {
+			if de.DominanceTest(w.Objectives, v.Objectives) == -1 ||
+				assert.ObjectsAreEqual(w.Objectives, v.Objectives) {
+				covered = true
+			}
+		}
+		assert.True(t, covered, "%v should be covered by the archive", v.Objectives)
+	}
+}
+
+// generationRecorder keeps the metrics of every generation it observes.
+type generationRecorder struct {
+	generations []de.GenerationMetrics
+}
+
+func (r *generationRecorder) ObserveGeneration(_ context.Context, m de.GenerationMetrics) {
+	r.generations = append(r.generations, m)
+}
+
+func TestGDE3_Execute_Metrics(t *testing.T) {
+	population, params := createTestPopulation(10, 5, 2)
+	recorder := &generationRecorder{}
+	algorithm := New(
+		WithProblem(multi.Zdt1()),
+		WithVariant(variantsrand.Rand1()),
+		WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 5}}),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+		WithMetrics(recorder),
+	)
+
+	ctx := de.WithContextSeed(context.Background(), 1)
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+
+	require.Len(t, recorder.generations, 5)
+	assert.Equal(t, 20, recorder.generations[0].Evaluations, "the initial population is counted once")
+	for _, m := range recorder.generations {
+		assert.Equal(t, 10, m.Mutations)
+		assert.Equal(t, 10, m.Crossovers)
+		assert.Positive(t, m.RankZeroSize)
+		assert.Positive(t, m.Diversity)
+		assert.GreaterOrEqual(t, m.CrowdingDistanceAvg, 0.0)
+		assert.GreaterOrEqual(t, m.ConvergenceRate, 0.0)
+		assert.LessOrEqual(t, m.ConvergenceRate, 1.0)
+	}
+	for _, m := range recorder.generations[1:] {
+		assert.Equal(t, 10, m.Evaluations)
+	}
+	assert.Len(t, <-paretoCh, recorder.generations[4].RankZeroSize)
+}
+
 func TestGDE3_InitializePopulation(t *testing.T) {
 	t.Run("initialize population evaluates all individuals", func(t *testing.T) {
 		problem := multi.Zdt1()
@@ -233,7 +371,7 @@ func TestGDE3_RunGeneration(t *testing.T) {
 
 		random := rand.New(rand.NewSource(1))
 		ctx := context.Background()
-		newPop, rankZero, err := algorithm.runGeneration(ctx, population, random)
+		newPop, rankZero, _, err := algorithm.runGeneration(ctx, population, nil, nil, random)
 		require.NoError(t, err)
 
 		assert.NotNil(t, newPop)
@@ -322,3 +460,208 @@ func TestGDE3_EdgeCases(t *testing.T) {
 		assert.Len(t, maxObjs, 2)
 	})
 }
+
+func TestGDE3_Execute_Adaptive(t *testing.T) {
+	for _, adaptation := range []Adaptation{JADE, SHADE} {
+		t.Run(adaptation.String(), func(t *testing.T) {
+			population, params := createTestPopulation(20, 5, 2)
+			var reported []de.ControlParameters
+			algorithm := New(
+				WithProblem(multi.Zdt1()),
+				WithVariant(variantsrand.Rand1()),
+				WithConstants(Constants{
+					CR: 0.5, F: 0.5, P: 0.1,
+					DE:           de.Constants{Generations: 10},
+					Adaptation:   adaptation,
+					LearningRate: DefaultLearningRate,
+					MemorySize:   DefaultMemorySize,
+				}),
+				WithInitialPopulation(population),
+				WithPopulationParams(params),
+				WithProgressCallback(func(_, _, _ int, _ []models.Vector, p *de.ControlParameters) {
+					require.NotNil(t, p)
+					reported = append(reported, *p)
+				}),
+			)
+
+			ctx := de.WithContextSeed(context.Background(), 1)
+			paretoCh := make(chan []models.Vector, 1)
+			maxObjCh := make(chan []float64, 1)
+			require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+			assert.NotEmpty(t, <-paretoCh)
+
+			require.Len(t, reported, 10)
+			assert.NotEqual(t, de.ControlParameters{F: 0.5, CR: 0.5}, reported[len(reported)-1],
+				"means should evolve from the initial values")
+			for _, p := range reported {
+				assert.Greater(t, p.F, 0.0)
+				assert.LessOrEqual(t, p.F, 1.0)
+				assert.GreaterOrEqual(t, p.CR, 0.0)
+				assert.LessOrEqual(t, p.CR, 1.0)
+			}
+		})
+	}
+
+	t.Run("fixed parameters report no means", func(t *testing.T) {
+		population, params := createTestPopulation(10, 5, 2)
+		called := false
+		algorithm := New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 2}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithProgressCallback(func(_, _, _ int, _ []models.Vector, p *de.ControlParameters) {
+				called = true
+				assert.Nil(t, p)
+			}),
+		)
+
+		paretoCh := make(chan []models.Vector, 1)
+		maxObjCh := make(chan []float64, 1)
+		require.NoError(t, algorithm.Execute(context.Background(), paretoCh, maxObjCh))
+		assert.True(t, called)
+	})
+}
+
+func TestGDE3_Execute_Resume(t *testing.T) {
+	newAlgorithm := func(adaptation Adaptation, generations int, checkpoints *[]*de.Checkpoint) de.Algorithm {
+		population, params := createTestPopulation(10, 5, 2)
+		return New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(Constants{
+				CR: 0.9, F: 0.5, P: 0.1,
+				DE:           de.Constants{Generations: generations},
+				Adaptation:   adaptation,
+				LearningRate: DefaultLearningRate,
+				MemorySize:   DefaultMemorySize,
+			}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithCheckpoints(func(c *de.Checkpoint) { *checkpoints = append(*checkpoints, c) }, 4),
+		)
+	}
+	run := func(algorithm de.Algorithm, checkpoint *de.Checkpoint) []models.Vector {
+		ctx := de.WithContextSeed(context.Background(), 42)
+		if checkpoint != nil {
+			ctx = de.WithContextCheckpoint(ctx, checkpoint)
+		}
+		paretoCh := make(chan []models.Vector, 1)
+		maxObjCh := make(chan []float64, 1)
+		require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+		return <-paretoCh
+	}
+
+	for _, adaptation := range []Adaptation{Fixed, SHADE} {
+		t.Run(adaptation.String(), func(t *testing.T) {
+			var checkpoints []*de.Checkpoint
+			want := run(newAlgorithm(adaptation, 10, &checkpoints), nil)
+
+			var generations []int
+			for _, c := range checkpoints {
+				generations = append(generations, c.Generation)
+			}
+			require.Equal(t, []int{4, 8, 10}, generations, "every interval and after the last generation")
+
+			t.Run("from a checkpoint", func(t *testing.T) {
+				var resumed []*de.Checkpoint
+				got := run(newAlgorithm(adaptation, 10, &resumed), checkpoints[0])
+				assert.Equal(t, want, got)
+				require.NotEmpty(t, resumed)
+				assert.Equal(t, 8, resumed[0].Generation)
+			})
+
+			t.Run("extending the generations", func(t *testing.T) {
+				var short []*de.Checkpoint
+				run(newAlgorithm(adaptation, 6, &short), nil)
+				last := short[len(short)-1]
+				require.Equal(t, 6, last.Generation)
+
+				var extended []*de.Checkpoint
+				assert.Equal(t, want, run(newAlgorithm(adaptation, 10, &extended), last))
+			})
+		})
+	}
+
+	t.Run("cancelled execution checkpoints its last generation", func(t *testing.T) {
+		var checkpoints []*de.Checkpoint
+		population, params := createTestPopulation(10, 5, 2)
+		ctx, cancel := context.WithCancel(de.WithContextSeed(context.Background(), 42))
+		algorithm := New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 10}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithProgressCallback(func(gen, _, _ int, _ []models.Vector, _ *de.ControlParameters) {
+				if gen == 3 {
+					cancel()
+				}
+			}),
+			WithCheckpoints(func(c *de.Checkpoint) { checkpoints = append(checkpoints, c) }, 100),
+		)
+
+		err := algorithm.Execute(ctx, make(chan []models.Vector, 1), make(chan []float64, 1))
+		require.ErrorIs(t, err, context.Canceled)
+		require.Len(t, checkpoints, 1)
+		assert.Equal(t, 3, checkpoints[0].Generation)
+		assert.Len(t, checkpoints[0].Population, 10)
+	})
+}
+
+func TestGDE3_Execute_Archive(t *testing.T) {
+	newAlgorithm := func(checkpoints *[]*de.Checkpoint) de.Algorithm {
+		population, params := createTestPopulation(10, 5, 2)
+		return New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(pbest.CurrToPBest1()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.2, DE: de.Constants{Generations: 10}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithCheckpoints(func(c *de.Checkpoint) { *checkpoints = append(*checkpoints, c) }, 4),
+		)
+	}
+	run := func(algorithm de.Algorithm, checkpoint *de.Checkpoint) []models.Vector {
+		ctx := de.WithContextSeed(context.Background(), 42)
+		if checkpoint != nil {
+			ctx = de.WithContextCheckpoint(ctx, checkpoint)
+		}
+		paretoCh := make(chan []models.Vector, 1)
+		maxObjCh := make(chan []float64, 1)
+		require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+		return <-paretoCh
+	}
+
+	var checkpoints []*de.Checkpoint
+	want := run(newAlgorithm(&checkpoints), nil)
+	require.Len(t, checkpoints, 3)
+
+	// Replaced parents are archived, at most one population of them
+	for _, c := range checkpoints {
+		archived := c.State[de.ArchiveStateKey]
+		assert.NotEmpty(t, archived)
+		assert.LessOrEqual(t, len(archived), 10*5)
+		assert.Zero(t, len(archived)%5)
+	}
+
+	t.Run("resumes with the archive", func(t *testing.T) {
+		var resumed []*de.Checkpoint
+		assert.Equal(t, want, run(newAlgorithm(&resumed), checkpoints[0]))
+	})
+
+	t.Run("variants without archive keep none", func(t *testing.T) {
+		var plain []*de.Checkpoint
+		population, params := createTestPopulation(10, 5, 2)
+		run(New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(pbest.Pbest()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.2, DE: de.Constants{Generations: 4}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithCheckpoints(func(c *de.Checkpoint) { plain = append(plain, c) }, 4),
+		), nil)
+		require.NotEmpty(t, plain)
+		assert.Nil(t, plain[0].State)
+	})
+}
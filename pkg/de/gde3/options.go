@@ -1,9 +1,11 @@
 package gde3
 
 import (
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
 	"github.com/nicholaspcr/GoDE/pkg/de"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
 )
 
@@ -23,6 +25,22 @@ func WithVariant(v variants.Interface) Option {
 	}
 }
 
+// WithCrossover attaches the crossover operator that builds trial vectors,
+// binomial crossover when unset.
+func WithCrossover(c crossover.Interface) Option {
+	return func(m *gde3) {
+		m.crossover = c
+	}
+}
+
+// WithRepair attaches the strategy that brings trial elements back into
+// their bounds, clamping when unset.
+func WithRepair(r repair.Interface) Option {
+	return func(m *gde3) {
+		m.repair = r
+	}
+}
+
 // WithPopulationParams determines the contants used to create the initial
 // population of an execution.
 func WithPopulationParams(params models.PopulationParams) Option {
@@ -45,6 +63,29 @@ func WithProgressCallback(callback de.ProgressCallback) Option {
 	}
 }
 
+// WithEvaluationPool sets the pool used to evaluate vectors concurrently.
+func WithEvaluationPool(pool *de.EvaluationPool) Option {
+	return func(m *gde3) {
+		m.evaluationPool = pool
+	}
+}
+
+// WithMetrics sets the observer receiving the metrics of every generation.
+func WithMetrics(observer de.MetricsObserver) Option {
+	return func(m *gde3) {
+		m.metrics = observer
+	}
+}
+
+// WithCheckpoints sets a callback receiving the state of each execution
+// every interval generations.
+func WithCheckpoints(callback de.CheckpointCallback, interval int) Option {
+	return func(m *gde3) {
+		m.checkpointCallback = callback
+		m.checkpointInterval = interval
+	}
+}
+
 // WithInitialPopulation determines the initial population of an execution.
 func WithInitialPopulation(p models.Population) Option {
 	return func(m *gde3) {
@@ -0,0 +1,76 @@
+package de
+
+import (
+	"context"
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// GenerationMetrics describes the work done by a generation of an execution
+// and the state of the population it left.
+type GenerationMetrics struct {
+	// Evaluations of the objective functions, the first generation of an
+	// execution also counts those of the initial population.
+	Evaluations int
+	Mutations   int
+	Crossovers  int
+	// RankZeroSize is the size of the non-dominated set of the population.
+	RankZeroSize int
+	// CrowdingDistanceAvg averages the finite crowding distances of the
+	// non-dominated set, the boundary solutions are left out.
+	CrowdingDistanceAvg float64
+	// Diversity is the mean standard deviation of each objective over the
+	// population, see PopulationDiversity.
+	Diversity float64
+	// ConvergenceRate is the share of trial vectors dominating their parent.
+	ConvergenceRate float64
+}
+
+// MetricsObserver receives the metrics of every generation. The executions of
+// a run share the observer, so it must be safe for concurrent use.
+type MetricsObserver interface {
+	ObserveGeneration(ctx context.Context, metrics GenerationMetrics)
+}
+
+// PopulationDiversity returns the mean over the objectives of their standard
+// deviation in the population, a single pass estimate that stays cheap next
+// to the evaluations of a generation.
+func PopulationDiversity(population []models.Vector) float64 {
+	if len(population) < 2 || len(population[0].Objectives) == 0 {
+		return 0
+	}
+
+	objectives := len(population[0].Objectives)
+	total := 0.0
+	for m := range objectives {
+		// Welford's algorithm, numerically stable in a single pass
+		var mean, sq float64
+		for i, v := range population {
+			delta := v.Objectives[m] - mean
+			mean += delta / float64(i+1)
+			sq += delta * (v.Objectives[m] - mean)
+		}
+		total += math.Sqrt(sq / float64(len(population)-1))
+	}
+	return total / float64(objectives)
+}
+
+// AverageCrowdingDistance averages the crowding distances of vectors that are
+// finite, boundary solutions have an infinite one. It returns zero when no
+// distance is finite.
+func AverageCrowdingDistance(vectors []models.Vector) float64 {
+	var sum float64
+	var n int
+	for _, v := range vectors {
+		if v.CrowdingDistance >= INF {
+			continue
+		}
+		sum += v.CrowdingDistance
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
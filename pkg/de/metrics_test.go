@@ -0,0 +1,48 @@
+package de
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopulationDiversity(t *testing.T) {
+	t.Run("too small", func(t *testing.T) {
+		assert.Zero(t, PopulationDiversity(nil))
+		assert.Zero(t, PopulationDiversity([]models.Vector{{Objectives: []float64{1, 2}}}))
+	})
+
+	t.Run("identical objectives", func(t *testing.T) {
+		population := []models.Vector{
+			{Objectives: []float64{1, 2}},
+			{Objectives: []float64{1, 2}},
+		}
+		assert.Zero(t, PopulationDiversity(population))
+	})
+
+	t.Run("mean of the standard deviations", func(t *testing.T) {
+		population := []models.Vector{
+			{Objectives: []float64{1, 10}},
+			{Objectives: []float64{2, 10}},
+			{Objectives: []float64{3, 10}},
+			{Objectives: []float64{4, 10}},
+		}
+		// The first objective has a sample standard deviation of sqrt(5/3)
+		assert.InDelta(t, math.Sqrt(5.0/3)/2, PopulationDiversity(population), 1e-12)
+	})
+}
+
+func TestAverageCrowdingDistance(t *testing.T) {
+	assert.Zero(t, AverageCrowdingDistance(nil))
+	assert.Zero(t, AverageCrowdingDistance([]models.Vector{{CrowdingDistance: INF}}))
+
+	vectors := []models.Vector{
+		{CrowdingDistance: INF},
+		{CrowdingDistance: 0.5},
+		{CrowdingDistance: 1.5},
+		{CrowdingDistance: math.MaxFloat64},
+	}
+	assert.Equal(t, 1.0, AverageCrowdingDistance(vectors))
+}
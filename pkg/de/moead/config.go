@@ -0,0 +1,92 @@
+package moead
+
+import (
+	"errors"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+)
+
+// Decomposition scalarizes the objectives of a vector for one subproblem.
+type Decomposition int
+
+const (
+	// Tchebycheff minimizes the largest weighted distance to the ideal point.
+	Tchebycheff Decomposition = iota
+	// PBI is the penalty-based boundary intersection, which balances the
+	// distance along the weight vector against the distance away from it.
+	PBI
+)
+
+// String returns the name of the decomposition.
+func (d Decomposition) String() string {
+	switch d {
+	case Tchebycheff:
+		return "tchebycheff"
+	case PBI:
+		return "pbi"
+	default:
+		return "unknown"
+	}
+}
+
+// Default values used when a constant is left as zero.
+const (
+	DefaultNeighborhoodSize = 20
+	DefaultTheta            = 5.0
+	DefaultDelta            = 0.9
+	DefaultMaxReplacements  = 2
+)
+
+// Constants used for the MOEA/D algorithm.
+type Constants struct {
+	DE de.Constants
+
+	CR float64 `json:"cr" yaml:"cr" name:"cr"`
+	F  float64 `json:"f"  yaml:"f"  name:"f"`
+	P  float64 `json:"p"  yaml:"p"  name:"p"`
+
+	// NeighborhoodSize is the amount of closest weight vectors that mating
+	// and replacement are restricted to, including the subproblem itself.
+	NeighborhoodSize int `json:"neighborhood_size" yaml:"neighborhood_size" name:"neighborhood_size"`
+	// Decomposition is the scalarizing function of each subproblem.
+	Decomposition Decomposition `json:"decomposition" yaml:"decomposition" name:"decomposition"`
+	// Theta is the penalty of the PBI decomposition.
+	Theta float64 `json:"theta" yaml:"theta" name:"theta"`
+	// Delta is the probability of mating within the neighbourhood instead of
+	// the whole population.
+	Delta float64 `json:"delta" yaml:"delta" name:"delta"`
+	// MaxReplacements bounds how many subproblems one offspring may take over.
+	MaxReplacements int `json:"max_replacements" yaml:"max_replacements" name:"max_replacements"`
+}
+
+// Validate checks that all MOEA/D Constants fields have valid values.
+func (c *Constants) Validate() error {
+	if err := c.DE.Validate(); err != nil {
+		return err
+	}
+	if c.CR < 0 || c.CR > 1 {
+		return errors.New("cr (crossover rate) must be in range [0, 1]")
+	}
+	if c.F <= 0 || c.F > 2 {
+		return errors.New("f (scaling factor) must be in range (0, 2]")
+	}
+	if c.P <= 0 || c.P > 1 {
+		return errors.New("p (selection parameter) must be in range (0, 1]")
+	}
+	if c.NeighborhoodSize < 2 {
+		return errors.New("neighborhood size must be at least 2")
+	}
+	if c.Decomposition != Tchebycheff && c.Decomposition != PBI {
+		return errors.New("decomposition must be tchebycheff or pbi")
+	}
+	if c.Theta < 0 {
+		return errors.New("theta must not be negative")
+	}
+	if c.Delta < 0 || c.Delta > 1 {
+		return errors.New("delta must be in range [0, 1]")
+	}
+	if c.MaxReplacements < 1 {
+		return errors.New("max replacements must be positive")
+	}
+	return nil
+}
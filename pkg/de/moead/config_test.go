@@ -0,0 +1,61 @@
+package moead
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstants_Validate(t *testing.T) {
+	valid := Constants{
+		DE: de.Constants{
+			Executions:    5,
+			Generations:   100,
+			Dimensions:    10,
+			ObjFuncAmount: 2,
+		},
+		CR:               0.9,
+		F:                0.5,
+		P:                0.1,
+		NeighborhoodSize: DefaultNeighborhoodSize,
+		Decomposition:    PBI,
+		Theta:            DefaultTheta,
+		Delta:            DefaultDelta,
+		MaxReplacements:  DefaultMaxReplacements,
+	}
+
+	t.Run("valid constants", func(t *testing.T) {
+		require.NoError(t, valid.Validate())
+	})
+
+	tests := []struct {
+		name    string
+		modify  func(c *Constants)
+		wantErr string
+	}{
+		{"DE constants", func(c *Constants) { c.DE.Executions = 0 }, "executions must be positive"},
+		{"CR", func(c *Constants) { c.CR = 1.5 }, "cr (crossover rate)"},
+		{"F", func(c *Constants) { c.F = 0 }, "f (scaling factor)"},
+		{"P", func(c *Constants) { c.P = 0 }, "p (selection parameter)"},
+		{"neighborhood size", func(c *Constants) { c.NeighborhoodSize = 1 }, "neighborhood size must be at least 2"},
+		{"decomposition", func(c *Constants) { c.Decomposition = Decomposition(7) }, "decomposition must be"},
+		{"theta", func(c *Constants) { c.Theta = -1 }, "theta must not be negative"},
+		{"delta", func(c *Constants) { c.Delta = 1.5 }, "delta must be in range"},
+		{"max replacements", func(c *Constants) { c.MaxReplacements = 0 }, "max replacements must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid
+			tt.modify(&c)
+			assert.ErrorContains(t, c.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestDecomposition_String(t *testing.T) {
+	assert.Equal(t, "tchebycheff", Tchebycheff.String())
+	assert.Equal(t, "pbi", PBI.String())
+	assert.Equal(t, "unknown", Decomposition(7).String())
+}
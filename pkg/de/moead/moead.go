@@ -0,0 +1,524 @@
+// Package moead implements MOEA/D with Differential Evolution operators
+// (MOEA/D-DE, Li and Zhang 2009).
+//
+// The problem is decomposed into one scalar subproblem per individual, each
+// defined by a weight vector and a decomposition (Tchebycheff or PBI). An
+// offspring is bred from the subproblem's neighbourhood, or from the whole
+// population with probability 1-delta, and replaces at most MaxReplacements
+// neighbours it improves upon. Infeasible solutions are compared by their
+// constraint violation first.
+package moead
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand"
+	"sort"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	de.DefaultRegistry.Register("moead", de.AlgorithmMetadata{
+		Name:        "moead",
+		Description: "MOEA/D-DE - Decomposition based Differential Evolution with Tchebycheff or PBI subproblems",
+	})
+	de.DefaultRegistry.RegisterFactory("moead", newFromConfig)
+}
+
+// newFromConfig creates a MOEA/D algorithm instance from execution parameters
+// and DEConfig, filling unset parameters with their defaults.
+func newFromConfig(params de.AlgorithmParams, config *api.DEConfig) (de.Algorithm, error) {
+	moeadConfig := config.GetMoead()
+	if moeadConfig == nil {
+		return nil, fmt.Errorf("MOEA/D configuration is required")
+	}
+
+	constants := Constants{
+		DE: de.Constants{
+			Executions:    int(config.Executions),
+			Generations:   int(config.Generations),
+			Dimensions:    int(config.DimensionsSize),
+			ObjFuncAmount: int(config.ObjectivesSize),
+		},
+		CR:               float64(moeadConfig.Cr),
+		F:                float64(moeadConfig.F),
+		P:                float64(moeadConfig.P),
+		NeighborhoodSize: int(moeadConfig.NeighborhoodSize),
+		Theta:            float64(moeadConfig.Theta),
+		Delta:            float64(moeadConfig.Delta),
+		MaxReplacements:  int(moeadConfig.MaxReplacements),
+	}
+
+	switch moeadConfig.Decomposition {
+	case api.Decomposition_DECOMPOSITION_UNSPECIFIED, api.Decomposition_DECOMPOSITION_TCHEBYCHEFF:
+		constants.Decomposition = Tchebycheff
+	case api.Decomposition_DECOMPOSITION_PBI:
+		constants.Decomposition = PBI
+	default:
+		return nil, fmt.Errorf("unsupported decomposition: %s", moeadConfig.Decomposition)
+	}
+
+	if constants.NeighborhoodSize == 0 {
+		constants.NeighborhoodSize = min(DefaultNeighborhoodSize, int(config.PopulationSize))
+	}
+	if constants.Theta == 0 {
+		constants.Theta = DefaultTheta
+	}
+	if constants.Delta == 0 {
+		constants.Delta = DefaultDelta
+	}
+	if constants.MaxReplacements == 0 {
+		constants.MaxReplacements = DefaultMaxReplacements
+	}
+
+	if err := constants.Validate(); err != nil {
+		return nil, err
+	}
+
+	return New(
+		WithProblem(params.Problem),
+		WithVariant(params.Variant),
+		WithCrossover(params.Crossover),
+		WithRepair(params.Repair),
+		WithPopulationParams(params.PopulationParams),
+		WithConstants(constants),
+		WithInitialPopulation(params.InitialPopulation),
+		WithProgressCallback(params.ProgressCallback),
+		WithEvaluationPool(params.EvaluationPool),
+		WithCheckpoints(params.CheckpointCallback, params.CheckpointInterval),
+	), nil
+}
+
+// moead type that contains the definition of the MOEA/D algorithm.
+type moead struct {
+	problem           problems.Interface
+	variant           variants.Interface
+	crossover         crossover.Interface
+	repair            repair.Interface
+	initialPopulation models.Population
+	populationParams  models.PopulationParams
+	constants         Constants
+	progressCallback  de.ProgressCallback
+	evaluationPool    *de.EvaluationPool
+
+	checkpointCallback de.CheckpointCallback
+	checkpointInterval int
+}
+
+// Option is a functional option for configuring the MOEA/D algorithm.
+type Option func(*moead)
+
+// New creates a new MOEA/D algorithm instance with the given configuration
+// options.
+func New(opts ...Option) de.Algorithm {
+	m := &moead{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.crossover == nil {
+		m.crossover = crossover.Binomial()
+	}
+	if m.repair == nil {
+		m.repair = repair.Clamp()
+	}
+	return m
+}
+
+// subproblems holds the decomposition shared by every generation of an
+// execution.
+type subproblems struct {
+	weights   [][]float64
+	neighbors [][]int
+	ideal     []float64
+}
+
+// Execute runs the algorithm for the configured amount of generations and
+// sends the non-dominated vectors of the final population through paretoCh.
+func (m *moead) Execute(
+	ctx context.Context,
+	paretoCh chan<- []models.Vector,
+	maxObjCh chan<- []float64,
+) error {
+	tracer := otel.Tracer("moead")
+	ctx, span := tracer.Start(ctx, "moead.Execute",
+		trace.WithAttributes(
+			attribute.Int("population_size", m.populationParams.PopulationSize),
+			attribute.Int("dimensions", m.populationParams.DimensionSize),
+			attribute.Int("objectives", m.populationParams.ObjectivesSize),
+			attribute.Int("generations", m.constants.DE.Generations),
+			attribute.Int("neighborhood_size", m.constants.NeighborhoodSize),
+			attribute.String("decomposition", m.constants.Decomposition.String()),
+			attribute.String("variant", m.variant.Name()),
+			attribute.String("problem", m.problem.Name()),
+		),
+	)
+	defer span.End()
+
+	logger := slog.Default()
+	random := de.RandomFromContext(ctx)
+	resumed, _ := de.FromContextCheckpoint(ctx)
+	checkpointer := de.NewCheckpointer(ctx, m.checkpointCallback, m.checkpointInterval)
+	paretoArchive := de.FromContextParetoArchive(ctx)
+
+	execNum := de.FromContextExecutionNumber(ctx)
+	logger.Debug("Starting MOEA/D", slog.Int("execution", execNum))
+	span.SetAttributes(attribute.Int("execution_number", execNum))
+
+	var (
+		population models.Population
+		maxObjs    []float64
+		sp         *subproblems
+		rankZero   []models.Vector
+		start      int
+	)
+	if resumed != nil {
+		population, maxObjs = resumed.Population, resumed.MaxObjectives
+		rankZero, start = resumed.Pareto, resumed.Generation
+		sp = restoreSubproblems(resumed.State, m.populationParams.ObjectivesSize)
+		span.SetAttributes(attribute.Int("resumed_generation", start))
+	} else {
+		population = m.initialPopulation.Copy()
+
+		var err error
+		maxObjs, err = m.evaluate(ctx, population)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		sp = &subproblems{
+			weights: weightVectors(len(population), m.populationParams.ObjectivesSize, random.Rand),
+			ideal:   idealPoint(population, m.populationParams.ObjectivesSize),
+		}
+	}
+	sp.neighbors = neighborhoods(sp.weights, min(m.constants.NeighborhoodSize, len(population)))
+
+	// Generations update the population in place, so unlike the other
+	// algorithms an interrupted generation leaves nothing to checkpoint
+	for gen := start; gen < m.constants.DE.Generations; gen++ {
+		if err := ctx.Err(); err != nil {
+			if checkpointer.Pending(gen) {
+				checkpointer.Save(m.checkpoint(execNum, gen, population, rankZero, maxObjs, random.State(), sp))
+			}
+			wrappedErr := fmt.Errorf("moead cancelled at generation %d: %w", gen, err)
+			span.RecordError(wrappedErr)
+			return wrappedErr
+		}
+
+		logger.Debug("Running generation",
+			slog.Int("execution_n", execNum),
+			slog.Int("generation_n", gen),
+		)
+
+		if err := m.runGeneration(ctx, population, sp, random.Rand); err != nil {
+			span.RecordError(err)
+			return err
+		}
+		rankZero, _ = de.FilterDominated(population)
+		paretoArchive.Add(rankZero...)
+
+		if m.progressCallback != nil {
+			m.progressCallback(gen+1, m.constants.DE.Generations, len(rankZero), rankZero, nil)
+		}
+
+		if checkpointer.Due(gen+1, m.constants.DE.Generations) {
+			checkpointer.Save(m.checkpoint(execNum, gen+1, population, rankZero, maxObjs, random.State(), sp))
+		}
+	}
+
+	span.SetAttributes(attribute.Int("pareto_size", len(rankZero)))
+	maxObjCh <- maxObjs
+	paretoCh <- rankZero
+	return nil
+}
+
+// checkpoint captures the state of the execution after gen generations, the
+// weight vectors are kept as they are drawn at random for some sizes.
+func (m *moead) checkpoint(
+	execNum, gen int,
+	population models.Population,
+	rankZero []models.Vector,
+	maxObjs []float64,
+	random de.RandomState,
+	sp *subproblems,
+) *de.Checkpoint {
+	checkpoint := de.NewCheckpoint(execNum, gen, population, rankZero, maxObjs, random)
+	weights := make([]float64, 0, len(sp.weights)*len(sp.ideal))
+	for _, w := range sp.weights {
+		weights = append(weights, w...)
+	}
+	checkpoint.State = map[string][]float64{
+		"weights": weights,
+		"ideal":   append([]float64(nil), sp.ideal...),
+	}
+	return checkpoint
+}
+
+// restoreSubproblems rebuilds the weight vectors and the ideal point of a
+// checkpoint, the neighborhoods are derived from the weights.
+func restoreSubproblems(state map[string][]float64, objs int) *subproblems {
+	flat := state["weights"]
+	var weights [][]float64
+	for i := 0; objs > 0 && i+objs <= len(flat); i += objs {
+		weights = append(weights, append([]float64(nil), flat[i:i+objs]...))
+	}
+	return &subproblems{
+		weights: weights,
+		ideal:   append([]float64(nil), state["ideal"]...),
+	}
+}
+
+// evaluate computes the objectives of every individual, returning the
+// largest value seen for each objective.
+func (m *moead) evaluate(ctx context.Context, population models.Population) ([]float64, error) {
+	if err := m.evaluationPool.Evaluate(
+		ctx, m.problem, population, m.populationParams.ObjectivesSize,
+	); err != nil {
+		return nil, err
+	}
+
+	maxObjs := make([]float64, m.populationParams.ObjectivesSize)
+	for i := range population {
+		for j, obj := range population[i].Objectives {
+			if obj > maxObjs[j] {
+				maxObjs[j] = obj
+			}
+		}
+	}
+	return maxObjs, nil
+}
+
+// runGeneration breeds one offspring per subproblem, updating population in
+// place as offspring replace the solutions they improve upon.
+func (m *moead) runGeneration(
+	ctx context.Context,
+	population models.Population,
+	sp *subproblems,
+	random *rand.Rand,
+) error {
+	tracer := otel.Tracer("moead")
+	_, span := tracer.Start(ctx, "moead.runGeneration",
+		trace.WithAttributes(
+			attribute.Int("population_size", len(population)),
+		),
+	)
+	defer span.End()
+
+	params := m.populationParams
+	everyone := make([]int, len(population))
+	for i := range everyone {
+		everyone[i] = i
+	}
+
+	replacements := 0
+	for i := range population {
+		// The mating pool always starts with the subproblem itself
+		pool := sp.neighbors[i]
+		if random.Float64() >= m.constants.Delta {
+			pool = append([]int{i}, without(everyone, i)...)
+		}
+
+		mates := make([]models.Vector, len(pool))
+		for j, idx := range pool {
+			mates[j] = population[idx]
+		}
+		mateRankZero, _ := de.FilterDominated(mates)
+
+		mutant, err := m.variant.Mutate(
+			mates,
+			mateRankZero,
+			variants.Parameters{
+				DIM:     params.DimensionSize,
+				F:       m.constants.F,
+				CurrPos: 0,
+				P:       m.constants.P,
+				Random:  random,
+			},
+		)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		trial := de.Trial(
+			population[i], mutant, m.constants.CR, m.crossover, m.repair,
+			params.FloorRange, params.CeilRange, random,
+		)
+		if err := m.problem.Evaluate(&trial, params.ObjectivesSize); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		for k, obj := range trial.Objectives {
+			sp.ideal[k] = math.Min(sp.ideal[k], obj)
+		}
+
+		// Neighbours are visited in random order so the replacement limit
+		// does not always favour the closest ones
+		replaced := 0
+		for _, j := range random.Perm(len(pool)) {
+			if replaced >= m.constants.MaxReplacements {
+				break
+			}
+			idx := pool[j]
+			if m.improves(trial, population[idx], sp.weights[idx], sp.ideal) {
+				population[idx] = trial.Copy()
+				replaced++
+			}
+		}
+		replacements += replaced
+	}
+
+	span.SetAttributes(attribute.Int("replacements", replacements))
+	return nil
+}
+
+// improves reports whether candidate is at least as good as current for the
+// subproblem with the given weight. Smaller constraint violation wins first,
+// then the decomposed objective value.
+func (m *moead) improves(candidate, current models.Vector, weight, ideal []float64) bool {
+	cvCandidate, cvCurrent := candidate.ConstraintViolation(), current.ConstraintViolation()
+	if cvCandidate != cvCurrent {
+		return cvCandidate < cvCurrent
+	}
+	return m.scalarize(candidate.Objectives, weight, ideal) <= m.scalarize(current.Objectives, weight, ideal)
+}
+
+// scalarize returns the value of objectives for the subproblem with the given
+// weight, smaller is better.
+func (m *moead) scalarize(objectives, weight, ideal []float64) float64 {
+	if m.constants.Decomposition == PBI {
+		return pbi(objectives, weight, ideal, m.constants.Theta)
+	}
+	return tchebycheff(objectives, weight, ideal)
+}
+
+// minWeight replaces zero weights so that Tchebycheff keeps every objective
+// in play.
+const minWeight = 1e-6
+
+// tchebycheff is max_i w_i |f_i - z_i|.
+func tchebycheff(objectives, weight, ideal []float64) float64 {
+	value := math.Inf(-1)
+	for i, obj := range objectives {
+		value = math.Max(value, math.Max(weight[i], minWeight)*math.Abs(obj-ideal[i]))
+	}
+	return value
+}
+
+// pbi is d1 + theta*d2, where d1 is the distance from the ideal point along
+// the weight vector and d2 the distance away from that line.
+func pbi(objectives, weight, ideal []float64, theta float64) float64 {
+	norm := 0.0
+	for _, w := range weight {
+		norm += w * w
+	}
+	norm = math.Sqrt(norm)
+
+	d1 := 0.0
+	for i, obj := range objectives {
+		d1 += (obj - ideal[i]) * weight[i]
+	}
+	d1 = math.Abs(d1) / norm
+
+	d2 := 0.0
+	for i, obj := range objectives {
+		diff := obj - ideal[i] - d1*weight[i]/norm
+		d2 += diff * diff
+	}
+	return d1 + theta*math.Sqrt(d2)
+}
+
+// weightVectors returns n weight vectors on the unit simplex. The structured
+// Das and Dennis points are used first and the remainder, if any, is drawn
+// uniformly at random from the simplex.
+func weightVectors(n, objs int, random *rand.Rand) [][]float64 {
+	lattice := problems.SimplexLattice(n, objs)
+	if len(lattice) >= n {
+		return lattice[:n]
+	}
+
+	weights := lattice
+	for len(weights) < n {
+		w := make([]float64, objs)
+		sum := 0.0
+		for i := range w {
+			w[i] = -math.Log(1 - random.Float64())
+			sum += w[i]
+		}
+		for i := range w {
+			w[i] /= sum
+		}
+		weights = append(weights, w)
+	}
+	return weights
+}
+
+// neighborhoods returns, for every weight vector, the indices of the size
+// closest ones. Each neighbourhood starts with the weight vector itself.
+func neighborhoods(weights [][]float64, size int) [][]int {
+	neighbors := make([][]int, len(weights))
+	for i := range weights {
+		others := make([]int, 0, len(weights)-1)
+		for j := range weights {
+			if j != i {
+				others = append(others, j)
+			}
+		}
+		distances := make([]float64, len(weights))
+		for _, j := range others {
+			distances[j] = squaredDistance(weights[i], weights[j])
+		}
+		sort.SliceStable(others, func(a, b int) bool {
+			return distances[others[a]] < distances[others[b]]
+		})
+		neighbors[i] = append([]int{i}, others[:size-1]...)
+	}
+	return neighbors
+}
+
+// idealPoint returns the smallest value of each objective in population.
+func idealPoint(population models.Population, objs int) []float64 {
+	ideal := make([]float64, objs)
+	for i := range ideal {
+		ideal[i] = math.Inf(1)
+	}
+	for _, v := range population {
+		for i, obj := range v.Objectives {
+			ideal[i] = math.Min(ideal[i], obj)
+		}
+	}
+	return ideal
+}
+
+func squaredDistance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// without returns the indices in all except skip.
+func without(all []int, skip int) []int {
+	rest := make([]int, 0, len(all)-1)
+	for _, idx := range all {
+		if idx != skip {
+			rest = append(rest, idx)
+		}
+	}
+	return rest
+}
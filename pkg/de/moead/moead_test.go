@@ -0,0 +1,273 @@
+package moead
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz"
+	"github.com/nicholaspcr/GoDE/pkg/problems/multi"
+	variantsrand "github.com/nicholaspcr/GoDE/pkg/variants/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPopulation(popSize, dimSize, objSize int) (models.Population, models.PopulationParams) {
+	params := models.PopulationParams{
+		PopulationSize: popSize,
+		DimensionSize:  dimSize,
+		ObjectivesSize: objSize,
+		FloorRange:     make([]float64, dimSize),
+		CeilRange:      make([]float64, dimSize),
+	}
+	for i := range params.CeilRange {
+		params.CeilRange[i] = 1.0
+	}
+
+	random := rand.New(rand.NewSource(1))
+	population, _ := models.GeneratePopulation(params, random)
+	return population, params
+}
+
+func testConstants(generations int, decomposition Decomposition) Constants {
+	return Constants{
+		DE:               de.Constants{Generations: generations},
+		CR:               0.9,
+		F:                0.5,
+		P:                0.1,
+		NeighborhoodSize: 5,
+		Decomposition:    decomposition,
+		Theta:            DefaultTheta,
+		Delta:            DefaultDelta,
+		MaxReplacements:  DefaultMaxReplacements,
+	}
+}
+
+func execute(t *testing.T, algorithm de.Algorithm, seed int64) ([]models.Vector, []float64) {
+	t.Helper()
+	ctx := de.WithContextSeed(context.Background(), seed)
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+	return <-paretoCh, <-maxObjCh
+}
+
+func TestMOEAD_Registered(t *testing.T) {
+	assert.True(t, de.DefaultRegistry.IsSupported("moead"))
+
+	factory, err := de.DefaultRegistry.GetFactory("moead")
+	require.NoError(t, err)
+
+	population, params := createTestPopulation(10, 5, 2)
+	algoParams := de.AlgorithmParams{
+		Problem:           multi.Zdt1(),
+		Variant:           variantsrand.Rand1(),
+		PopulationParams:  params,
+		InitialPopulation: population,
+	}
+	config := func(moead *api.MOEADConfig) *api.DEConfig {
+		return &api.DEConfig{
+			Executions:      1,
+			Generations:     3,
+			PopulationSize:  10,
+			DimensionsSize:  5,
+			ObjectivesSize:  2,
+			AlgorithmConfig: &api.DEConfig_Moead{Moead: moead},
+		}
+	}
+
+	t.Run("requires its own configuration", func(t *testing.T) {
+		_, err := factory(algoParams, &api.DEConfig{})
+		assert.ErrorContains(t, err, "MOEA/D configuration is required")
+	})
+
+	t.Run("fills defaults", func(t *testing.T) {
+		algorithm, err := factory(algoParams, config(&api.MOEADConfig{Cr: 0.9, F: 0.5, P: 0.1}))
+		require.NoError(t, err)
+
+		m := algorithm.(*moead)
+		assert.Equal(t, 10, m.constants.NeighborhoodSize, "capped to the population")
+		assert.Equal(t, Tchebycheff, m.constants.Decomposition)
+		assert.Equal(t, DefaultTheta, m.constants.Theta)
+		assert.Equal(t, DefaultDelta, m.constants.Delta)
+		assert.Equal(t, DefaultMaxReplacements, m.constants.MaxReplacements)
+
+		pareto, _ := execute(t, algorithm, 1)
+		assert.NotEmpty(t, pareto)
+	})
+
+	t.Run("PBI decomposition", func(t *testing.T) {
+		algorithm, err := factory(algoParams, config(&api.MOEADConfig{
+			Cr: 0.9, F: 0.5, P: 0.1,
+			Decomposition: api.Decomposition_DECOMPOSITION_PBI,
+			Theta:         3,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, PBI, algorithm.(*moead).constants.Decomposition)
+		assert.InDelta(t, 3, algorithm.(*moead).constants.Theta, 1e-9)
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		_, err := factory(algoParams, config(&api.MOEADConfig{Cr: 0.9, F: 0.5, P: 0.1, Delta: 2}))
+		assert.ErrorContains(t, err, "delta must be in range")
+	})
+}
+
+func TestMOEAD_Execute(t *testing.T) {
+	for _, decomposition := range []Decomposition{Tchebycheff, PBI} {
+		t.Run(decomposition.String(), func(t *testing.T) {
+			population, params := createTestPopulation(20, 7, 3)
+
+			var generations int
+			algorithm := New(
+				WithProblem(dtlz.Dtlz2()),
+				WithVariant(variantsrand.Rand1()),
+				WithConstants(testConstants(10, decomposition)),
+				WithInitialPopulation(population),
+				WithPopulationParams(params),
+				WithProgressCallback(func(gen, _, _ int, _ []models.Vector, _ *de.ControlParameters) {
+					generations = gen
+				}),
+			)
+
+			pareto, maxObjs := execute(t, algorithm, 1)
+			require.NotEmpty(t, pareto)
+			assert.Len(t, maxObjs, 3)
+			assert.Equal(t, 10, generations)
+			for i := range pareto {
+				assert.Len(t, pareto[i].Objectives, 3)
+				for j := range pareto {
+					assert.NotEqual(t, 1, de.DominanceTest(pareto[i].Objectives, pareto[j].Objectives))
+				}
+			}
+		})
+	}
+}
+
+func TestMOEAD_Execute_Seeded(t *testing.T) {
+	run := func(seed int64) []models.Vector {
+		population, params := createTestPopulation(10, 5, 2)
+		pareto, _ := execute(t, New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(testConstants(10, Tchebycheff)),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+		), seed)
+		return pareto
+	}
+
+	assert.Equal(t, run(42), run(42))
+	assert.NotEqual(t, run(42), run(43))
+}
+
+func TestMOEAD_Execute_Constrained(t *testing.T) {
+	params := models.PopulationParams{
+		PopulationSize: 20,
+		DimensionSize:  2,
+		ObjectivesSize: 2,
+		FloorRange:     []float64{-20, -20},
+		CeilRange:      []float64{20, 20},
+	}
+	population, err := models.GeneratePopulation(params, rand.New(rand.NewSource(1)))
+	require.NoError(t, err)
+
+	pareto, _ := execute(t, New(
+		WithProblem(multi.Srn()),
+		WithVariant(variantsrand.Rand1()),
+		WithConstants(testConstants(30, Tchebycheff)),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+	), 1)
+
+	require.NotEmpty(t, pareto)
+	for _, v := range pareto {
+		assert.True(t, v.Feasible(), "constraints %v should be satisfied", v.Constraints)
+	}
+}
+
+func TestTchebycheff(t *testing.T) {
+	ideal := []float64{0, 0}
+	assert.InDelta(t, 0.4, tchebycheff([]float64{0.8, 0.2}, []float64{0.5, 0.5}, ideal), 1e-9)
+	assert.InDelta(t, 0.2, tchebycheff([]float64{0.8, 0.2}, []float64{0, 1}, ideal), 1e-6,
+		"zero weights still count a little")
+}
+
+func TestPBI(t *testing.T) {
+	weight := []float64{1, 0}
+	ideal := []float64{0, 0}
+
+	// On the weight line only d1 counts
+	assert.InDelta(t, 0.5, pbi([]float64{0.5, 0}, weight, ideal, 5), 1e-9)
+	// Off the line d2 is penalized by theta
+	assert.InDelta(t, 0.5+5*0.5, pbi([]float64{0.5, 0.5}, weight, ideal, 5), 1e-9)
+}
+
+func TestWeightVectors(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, tc := range []struct{ n, objs int }{{10, 2}, {20, 3}, {3, 5}, {100, 3}} {
+		weights := weightVectors(tc.n, tc.objs, random)
+		require.Len(t, weights, tc.n)
+		for _, w := range weights {
+			require.Len(t, w, tc.objs)
+			sum := 0.0
+			for _, v := range w {
+				assert.GreaterOrEqual(t, v, 0.0)
+				sum += v
+			}
+			assert.InDelta(t, 1, sum, 1e-9)
+		}
+	}
+}
+
+func TestNeighborhoods(t *testing.T) {
+	weights := [][]float64{{0, 1}, {0.25, 0.75}, {0.5, 0.5}, {0.75, 0.25}, {1, 0}}
+	neighbors := neighborhoods(weights, 3)
+
+	require.Len(t, neighbors, 5)
+	assert.Equal(t, []int{0, 1, 2}, neighbors[0])
+	assert.Equal(t, 2, neighbors[2][0], "a neighbourhood starts with itself")
+	assert.ElementsMatch(t, []int{1, 2, 3}, neighbors[2])
+	assert.Equal(t, []int{4, 3, 2}, neighbors[4])
+}
+
+func TestIdealPoint(t *testing.T) {
+	population := models.Population{
+		{Objectives: []float64{1, 4}},
+		{Objectives: []float64{3, 2}},
+	}
+	assert.Equal(t, []float64{1, 2}, idealPoint(population, 2))
+	assert.Equal(t, []float64{math.Inf(1)}, idealPoint(nil, 1))
+}
+
+func TestMOEAD_Execute_Resume(t *testing.T) {
+	var checkpoints []*de.Checkpoint
+	newAlgorithm := func() de.Algorithm {
+		// More subproblems than the simplex lattice holds draws random weights
+		population, params := createTestPopulation(12, 5, 3)
+		return New(
+			WithProblem(dtlz.Dtlz2()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(testConstants(10, Tchebycheff)),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithCheckpoints(func(c *de.Checkpoint) { checkpoints = append(checkpoints, c) }, 5),
+		)
+	}
+
+	want, _ := execute(t, newAlgorithm(), 42)
+	require.Len(t, checkpoints, 2)
+	assert.Equal(t, 5, checkpoints[0].Generation)
+	assert.Len(t, checkpoints[0].State["weights"], 12*3)
+
+	ctx := de.WithContextCheckpoint(de.WithContextSeed(context.Background(), 42), checkpoints[0])
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, newAlgorithm().Execute(ctx, paretoCh, maxObjCh))
+	assert.Equal(t, want, <-paretoCh, "resumed execution should match the uninterrupted one")
+}
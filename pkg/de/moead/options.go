@@ -0,0 +1,87 @@
+package moead
+
+import (
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+)
+
+// WithProblem attaches the Problem interface
+// implementation that will be ran on DE execution.
+func WithProblem(p problems.Interface) Option {
+	return func(m *moead) {
+		m.problem = p
+	}
+}
+
+// WithVariant attaches the Variant interface
+// implementation that will be ran on DE execution.
+func WithVariant(v variants.Interface) Option {
+	return func(m *moead) {
+		m.variant = v
+	}
+}
+
+// WithCrossover attaches the crossover operator that builds trial vectors,
+// binomial crossover when unset.
+func WithCrossover(c crossover.Interface) Option {
+	return func(m *moead) {
+		m.crossover = c
+	}
+}
+
+// WithRepair attaches the strategy that brings trial elements back into
+// their bounds, clamping when unset.
+func WithRepair(r repair.Interface) Option {
+	return func(m *moead) {
+		m.repair = r
+	}
+}
+
+// WithPopulationParams determines the contants used to create the initial
+// population of an execution.
+func WithPopulationParams(params models.PopulationParams) Option {
+	return func(m *moead) {
+		m.populationParams = params
+	}
+}
+
+// WithConstants sets the constants used on DE execution.
+func WithConstants(c Constants) Option {
+	return func(m *moead) {
+		m.constants = c
+	}
+}
+
+// WithProgressCallback sets a callback function to receive progress updates.
+func WithProgressCallback(callback de.ProgressCallback) Option {
+	return func(m *moead) {
+		m.progressCallback = callback
+	}
+}
+
+// WithEvaluationPool sets the pool used to evaluate vectors concurrently.
+func WithEvaluationPool(pool *de.EvaluationPool) Option {
+	return func(m *moead) {
+		m.evaluationPool = pool
+	}
+}
+
+// WithCheckpoints sets a callback receiving the state of each execution
+// every interval generations.
+func WithCheckpoints(callback de.CheckpointCallback, interval int) Option {
+	return func(m *moead) {
+		m.checkpointCallback = callback
+		m.checkpointInterval = interval
+	}
+}
+
+// WithInitialPopulation determines the initial population of an execution.
+func WithInitialPopulation(p models.Population) Option {
+	return func(m *moead) {
+		m.initialPopulation = p
+	}
+}
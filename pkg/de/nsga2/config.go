@@ -0,0 +1,33 @@
+package nsga2
+
+import (
+	"errors"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+)
+
+// Constants used for the NSGA-II style DE algorithm.
+type Constants struct {
+	DE de.Constants
+
+	CR float64 `json:"cr" yaml:"cr" name:"cr"`
+	F  float64 `json:"f"  yaml:"f"  name:"f"`
+	P  float64 `json:"p"  yaml:"p"  name:"p"`
+}
+
+// Validate checks that all NSGA-II Constants fields have valid values.
+func (c *Constants) Validate() error {
+	if err := c.DE.Validate(); err != nil {
+		return err
+	}
+	if c.CR < 0 || c.CR > 1 {
+		return errors.New("cr (crossover rate) must be in range [0, 1]")
+	}
+	if c.F <= 0 || c.F > 2 {
+		return errors.New("f (scaling factor) must be in range (0, 2]")
+	}
+	if c.P <= 0 || c.P > 1 {
+		return errors.New("p (selection parameter) must be in range (0, 1]")
+	}
+	return nil
+}
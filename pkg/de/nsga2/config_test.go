@@ -0,0 +1,51 @@
+package nsga2
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstants_Validate(t *testing.T) {
+	valid := Constants{
+		DE: de.Constants{
+			Executions:    5,
+			Generations:   100,
+			Dimensions:    10,
+			ObjFuncAmount: 2,
+		},
+		CR: 0.9,
+		F:  0.5,
+		P:  0.1,
+	}
+
+	t.Run("valid constants", func(t *testing.T) {
+		require.NoError(t, valid.Validate())
+	})
+
+	t.Run("propagates DE validation errors", func(t *testing.T) {
+		c := valid
+		c.DE.Generations = 0
+		assert.EqualError(t, c.Validate(), "generations must be positive")
+	})
+
+	t.Run("CR must be in [0, 1]", func(t *testing.T) {
+		c := valid
+		c.CR = 1.1
+		assert.ErrorContains(t, c.Validate(), "cr (crossover rate)")
+	})
+
+	t.Run("F must be in (0, 2]", func(t *testing.T) {
+		c := valid
+		c.F = 0
+		assert.ErrorContains(t, c.Validate(), "f (scaling factor)")
+	})
+
+	t.Run("P must be in (0, 1]", func(t *testing.T) {
+		c := valid
+		c.P = 0
+		assert.ErrorContains(t, c.Validate(), "p (selection parameter)")
+	})
+}
@@ -0,0 +1,283 @@
+// Package nsga2 implements an NSGA-II style multi-objective Differential
+// Evolution algorithm.
+//
+// Every generation each individual produces one offspring through DE
+// mutation and crossover. Parents and offspring are merged and the
+// next population is picked by non-dominated rank and crowding distance,
+// instead of GDE3's one-to-one comparison between parent and offspring.
+package nsga2
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	de.DefaultRegistry.Register("nsga2", de.AlgorithmMetadata{
+		Name:        "nsga2",
+		Description: "NSGA-II DE - Differential Evolution with NSGA-II rank and crowding survival",
+	})
+	de.DefaultRegistry.RegisterFactory("nsga2", newFromConfig)
+}
+
+// newFromConfig creates an NSGA-II algorithm instance from execution
+// parameters and DEConfig.
+func newFromConfig(params de.AlgorithmParams, config *api.DEConfig) (de.Algorithm, error) {
+	nsga2Config := config.GetNsga2()
+	if nsga2Config == nil {
+		return nil, fmt.Errorf("NSGA-II configuration is required")
+	}
+
+	constants := Constants{
+		DE: de.Constants{
+			Executions:    int(config.Executions),
+			Generations:   int(config.Generations),
+			Dimensions:    int(config.DimensionsSize),
+			ObjFuncAmount: int(config.ObjectivesSize),
+		},
+		CR: float64(nsga2Config.Cr),
+		F:  float64(nsga2Config.F),
+		P:  float64(nsga2Config.P),
+	}
+
+	return New(
+		WithProblem(params.Problem),
+		WithVariant(params.Variant),
+		WithCrossover(params.Crossover),
+		WithRepair(params.Repair),
+		WithPopulationParams(params.PopulationParams),
+		WithConstants(constants),
+		WithInitialPopulation(params.InitialPopulation),
+		WithProgressCallback(params.ProgressCallback),
+		WithEvaluationPool(params.EvaluationPool),
+		WithCheckpoints(params.CheckpointCallback, params.CheckpointInterval),
+	), nil
+}
+
+// nsga2 type that contains the definition of the NSGA-II style DE.
+type nsga2 struct {
+	problem           problems.Interface
+	variant           variants.Interface
+	crossover         crossover.Interface
+	repair            repair.Interface
+	initialPopulation models.Population
+	populationParams  models.PopulationParams
+	constants         Constants
+	progressCallback  de.ProgressCallback
+	evaluationPool    *de.EvaluationPool
+
+	checkpointCallback de.CheckpointCallback
+	checkpointInterval int
+}
+
+// Option is a functional option for configuring the NSGA-II algorithm.
+type Option func(*nsga2)
+
+// New creates a new NSGA-II style DE instance with the given configuration
+// options.
+func New(opts ...Option) de.Algorithm {
+	n := &nsga2{}
+	for _, opt := range opts {
+		opt(n)
+	}
+	if n.crossover == nil {
+		n.crossover = crossover.Binomial()
+	}
+	if n.repair == nil {
+		n.repair = repair.Clamp()
+	}
+	return n
+}
+
+// Execute runs the algorithm for the configured amount of generations and
+// sends the final non-dominated set through paretoCh.
+func (n *nsga2) Execute(
+	ctx context.Context,
+	paretoCh chan<- []models.Vector,
+	maxObjCh chan<- []float64,
+) error {
+	tracer := otel.Tracer("nsga2")
+	ctx, span := tracer.Start(ctx, "nsga2.Execute",
+		trace.WithAttributes(
+			attribute.Int("population_size", n.populationParams.PopulationSize),
+			attribute.Int("dimensions", n.populationParams.DimensionSize),
+			attribute.Int("objectives", n.populationParams.ObjectivesSize),
+			attribute.Int("generations", n.constants.DE.Generations),
+			attribute.String("variant", n.variant.Name()),
+			attribute.String("problem", n.problem.Name()),
+		),
+	)
+	defer span.End()
+
+	logger := slog.Default()
+	random := de.RandomFromContext(ctx)
+	resumed, _ := de.FromContextCheckpoint(ctx)
+	checkpointer := de.NewCheckpointer(ctx, n.checkpointCallback, n.checkpointInterval)
+	paretoArchive := de.FromContextParetoArchive(ctx)
+
+	execNum := de.FromContextExecutionNumber(ctx)
+	logger.Debug("Starting NSGA-II", slog.Int("execution", execNum))
+	span.SetAttributes(attribute.Int("execution_number", execNum))
+
+	var (
+		population models.Population
+		rankZero   []models.Vector
+		maxObjs    []float64
+		start      int
+	)
+	if resumed != nil {
+		population, rankZero = resumed.Population, resumed.Pareto
+		maxObjs, start = resumed.MaxObjectives, resumed.Generation
+		span.SetAttributes(attribute.Int("resumed_generation", start))
+	} else {
+		population = n.initialPopulation.Copy()
+
+		var err error
+		maxObjs, err = n.evaluate(ctx, population)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		// Ranks the initial population so pbest variants have a front to pick from
+		population, rankZero = de.ReduceByCrowdDistance(ctx, population, n.populationParams.PopulationSize)
+	}
+
+	for gen := start; gen < n.constants.DE.Generations; gen++ {
+		randomState := random.State()
+
+		if err := ctx.Err(); err != nil {
+			if checkpointer.Pending(gen) {
+				checkpointer.Save(de.NewCheckpoint(execNum, gen, population, rankZero, maxObjs, randomState))
+			}
+			wrappedErr := fmt.Errorf("nsga2 cancelled at generation %d: %w", gen, err)
+			span.RecordError(wrappedErr)
+			return wrappedErr
+		}
+
+		logger.Debug("Running generation",
+			slog.Int("execution_n", execNum),
+			slog.Int("generation_n", gen),
+		)
+
+		nextPopulation, nextRankZero, err := n.runGeneration(ctx, population, rankZero, random.Rand)
+		if err != nil {
+			if ctx.Err() != nil && checkpointer.Pending(gen) {
+				checkpointer.Save(de.NewCheckpoint(execNum, gen, population, rankZero, maxObjs, randomState))
+			}
+			span.RecordError(err)
+			return err
+		}
+		population, rankZero = nextPopulation, nextRankZero
+		paretoArchive.Add(rankZero...)
+
+		if n.progressCallback != nil {
+			n.progressCallback(gen+1, n.constants.DE.Generations, len(rankZero), rankZero, nil)
+		}
+
+		if checkpointer.Due(gen+1, n.constants.DE.Generations) {
+			checkpointer.Save(de.NewCheckpoint(execNum, gen+1, population, rankZero, maxObjs, random.State()))
+		}
+	}
+
+	span.SetAttributes(attribute.Int("pareto_size", len(rankZero)))
+	maxObjCh <- maxObjs
+	paretoCh <- rankZero
+	return nil
+}
+
+// evaluate computes the objectives of every individual, returning the
+// largest value seen for each objective.
+func (n *nsga2) evaluate(ctx context.Context, population models.Population) ([]float64, error) {
+	if err := n.evaluationPool.Evaluate(
+		ctx, n.problem, population, n.populationParams.ObjectivesSize,
+	); err != nil {
+		return nil, err
+	}
+
+	maxObjs := make([]float64, n.populationParams.ObjectivesSize)
+	for i := range population {
+		for j, obj := range population[i].Objectives {
+			if obj > maxObjs[j] {
+				maxObjs[j] = obj
+			}
+		}
+	}
+	return maxObjs, nil
+}
+
+func (n *nsga2) runGeneration(
+	ctx context.Context,
+	population models.Population,
+	rankZero []models.Vector,
+	random *rand.Rand,
+) (models.Population, []models.Vector, error) {
+	tracer := otel.Tracer("nsga2")
+	ctx, span := tracer.Start(ctx, "nsga2.runGeneration",
+		trace.WithAttributes(
+			attribute.Int("population_size", len(population)),
+		),
+	)
+	defer span.End()
+
+	params := n.populationParams
+	popSize := len(population)
+
+	// Parents and offspring compete together for the next population
+	merged := make([]models.Vector, 0, popSize*2)
+	for i := range popSize {
+		merged = append(merged, population[i].Copy())
+	}
+
+	// Offspring are bred first and evaluated together, keeping the random
+	// draws independent of the evaluation order
+	offspring := make([]models.Vector, popSize)
+	for i := range popSize {
+		mutant, err := n.variant.Mutate(
+			population,
+			rankZero,
+			variants.Parameters{
+				DIM:     params.DimensionSize,
+				F:       n.constants.F,
+				CurrPos: i,
+				P:       n.constants.P,
+				Random:  random,
+			},
+		)
+		if err != nil {
+			span.RecordError(err)
+			return nil, nil, err
+		}
+
+		offspring[i] = de.Trial(
+			population[i], mutant, n.constants.CR, n.crossover, n.repair,
+			params.FloorRange, params.CeilRange, random,
+		)
+	}
+
+	if err := n.evaluationPool.Evaluate(ctx, n.problem, offspring, params.ObjectivesSize); err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	merged = append(merged, offspring...)
+
+	reducedPop, newRankZero := de.ReduceByCrowdDistance(ctx, merged, params.PopulationSize)
+	span.SetAttributes(
+		attribute.Int("rank_zero_size", len(newRankZero)),
+		attribute.Int("reduced_population_size", len(reducedPop)),
+	)
+	return reducedPop, newRankZero, nil
+}
@@ -0,0 +1,175 @@
+package nsga2
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/de"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems/multi"
+	"github.com/nicholaspcr/GoDE/pkg/variants/pbest"
+	variantsrand "github.com/nicholaspcr/GoDE/pkg/variants/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPopulation(popSize, dimSize, objSize int) (models.Population, models.PopulationParams) {
+	params := models.PopulationParams{
+		PopulationSize: popSize,
+		DimensionSize:  dimSize,
+		ObjectivesSize: objSize,
+		FloorRange:     make([]float64, dimSize),
+		CeilRange:      make([]float64, dimSize),
+	}
+	for i := range params.CeilRange {
+		params.CeilRange[i] = 1.0
+	}
+
+	random := rand.New(rand.NewSource(1))
+	population, _ := models.GeneratePopulation(params, random)
+	return population, params
+}
+
+func execute(t *testing.T, algorithm de.Algorithm, seed int64) ([]models.Vector, []float64) {
+	t.Helper()
+	ctx := de.WithContextSeed(context.Background(), seed)
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, algorithm.Execute(ctx, paretoCh, maxObjCh))
+	return <-paretoCh, <-maxObjCh
+}
+
+func TestNSGA2_Registered(t *testing.T) {
+	assert.True(t, de.DefaultRegistry.IsSupported("nsga2"))
+
+	factory, err := de.DefaultRegistry.GetFactory("nsga2")
+	require.NoError(t, err)
+
+	population, params := createTestPopulation(10, 5, 2)
+	algoParams := de.AlgorithmParams{
+		Problem:           multi.Zdt1(),
+		Variant:           variantsrand.Rand1(),
+		PopulationParams:  params,
+		InitialPopulation: population,
+	}
+
+	t.Run("requires its own configuration", func(t *testing.T) {
+		_, err := factory(algoParams, &api.DEConfig{
+			AlgorithmConfig: &api.DEConfig_Gde3{Gde3: &api.GDE3Config{Cr: 0.9, F: 0.5, P: 0.1}},
+		})
+		assert.ErrorContains(t, err, "NSGA-II configuration is required")
+	})
+
+	t.Run("builds from DEConfig", func(t *testing.T) {
+		algorithm, err := factory(algoParams, &api.DEConfig{
+			Executions:      1,
+			Generations:     3,
+			PopulationSize:  10,
+			DimensionsSize:  5,
+			ObjectivesSize:  2,
+			AlgorithmConfig: &api.DEConfig_Nsga2{Nsga2: &api.NSGA2Config{Cr: 0.9, F: 0.5, P: 0.1}},
+		})
+		require.NoError(t, err)
+
+		pareto, _ := execute(t, algorithm, 1)
+		assert.NotEmpty(t, pareto)
+	})
+}
+
+func TestNSGA2_Execute(t *testing.T) {
+	population, params := createTestPopulation(20, 5, 2)
+
+	var generations []int
+	algorithm := New(
+		WithProblem(multi.Zdt1()),
+		WithVariant(pbest.Pbest()),
+		WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.2, DE: de.Constants{Generations: 10}}),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+		WithProgressCallback(func(gen, _, _ int, _ []models.Vector, _ *de.ControlParameters) {
+			generations = append(generations, gen)
+		}),
+	)
+
+	pareto, maxObjs := execute(t, algorithm, 1)
+	require.NotEmpty(t, pareto)
+	// The front is ranked among the parents and their offspring
+	assert.LessOrEqual(t, len(pareto), 2*params.PopulationSize)
+	assert.Len(t, maxObjs, 2)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, generations)
+
+	for i := range pareto {
+		assert.Len(t, pareto[i].Elements, 5)
+		for j := range pareto {
+			assert.NotEqual(t, 1, de.DominanceTest(pareto[i].Objectives, pareto[j].Objectives),
+				"front members must not dominate each other")
+		}
+	}
+
+	t.Run("initial population is left untouched", func(t *testing.T) {
+		fresh, _ := createTestPopulation(20, 5, 2)
+		assert.Equal(t, fresh, population)
+	})
+}
+
+func TestNSGA2_Execute_Seeded(t *testing.T) {
+	run := func(seed int64, opts ...Option) []models.Vector {
+		population, params := createTestPopulation(10, 5, 2)
+		pareto, _ := execute(t, New(append([]Option{
+			WithProblem(multi.Zdt1()),
+			WithVariant(variantsrand.Rand1()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 10}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+		}, opts...)...), seed)
+		return pareto
+	}
+
+	assert.Equal(t, run(42), run(42))
+	assert.NotEqual(t, run(42), run(43))
+	assert.Equal(t, run(42), run(42, WithEvaluationPool(de.NewEvaluationPool(4))),
+		"concurrent evaluation should not change the result")
+}
+
+func TestNSGA2_Execute_Cancelled(t *testing.T) {
+	population, params := createTestPopulation(10, 5, 2)
+	algorithm := New(
+		WithProblem(multi.Zdt1()),
+		WithVariant(variantsrand.Rand1()),
+		WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.1, DE: de.Constants{Generations: 10}}),
+		WithInitialPopulation(population),
+		WithPopulationParams(params),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := algorithm.Execute(ctx, make(chan []models.Vector, 1), make(chan []float64, 1))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNSGA2_Execute_Resume(t *testing.T) {
+	var checkpoints []*de.Checkpoint
+	newAlgorithm := func() de.Algorithm {
+		population, params := createTestPopulation(10, 5, 2)
+		return New(
+			WithProblem(multi.Zdt1()),
+			WithVariant(pbest.Pbest()),
+			WithConstants(Constants{CR: 0.9, F: 0.5, P: 0.2, DE: de.Constants{Generations: 10}}),
+			WithInitialPopulation(population),
+			WithPopulationParams(params),
+			WithCheckpoints(func(c *de.Checkpoint) { checkpoints = append(checkpoints, c) }, 5),
+		)
+	}
+
+	want, _ := execute(t, newAlgorithm(), 42)
+	require.Len(t, checkpoints, 2)
+	assert.Equal(t, 5, checkpoints[0].Generation)
+
+	ctx := de.WithContextCheckpoint(de.WithContextSeed(context.Background(), 42), checkpoints[0])
+	paretoCh := make(chan []models.Vector, 1)
+	maxObjCh := make(chan []float64, 1)
+	require.NoError(t, newAlgorithm().Execute(ctx, paretoCh, maxObjCh))
+	assert.Equal(t, want, <-paretoCh, "resumed execution should match the uninterrupted one")
+}
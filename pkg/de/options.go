@@ -51,3 +51,33 @@ func WithProgressCallback(callback ProgressCallback) ModeOptions {
 		return m
 	}
 }
+
+// WithSeed makes the executions reproducible, each execution receives a seed
+// derived from seed through its context.
+func WithSeed(seed int64) ModeOptions {
+	return func(m *de) *de {
+		m.seed = &seed
+		return m
+	}
+}
+
+// WithCheckpoints resumes each execution with a checkpoint from it, the
+// executions without one start from scratch.
+func WithCheckpoints(checkpoints []*Checkpoint) ModeOptions {
+	return func(m *de) *de {
+		m.checkpoints = make(map[int]*Checkpoint, len(checkpoints))
+		for _, checkpoint := range checkpoints {
+			m.checkpoints[checkpoint.Execution] = checkpoint
+		}
+		return m
+	}
+}
+
+// WithParetoArchive makes every execution keep an external archive of the
+// non-dominated solutions found across its generations, see ParetoArchive.
+func WithParetoArchive(cfg ParetoArchiveConfig) ModeOptions {
+	return func(m *de) *de {
+		m.paretoArchive = &cfg
+		return m
+	}
+}
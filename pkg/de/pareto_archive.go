@@ -0,0 +1,136 @@
+package de
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// ParetoArchiveConfig configures a ParetoArchive, its zero value keeps every
+// non-dominated solution.
+type ParetoArchiveConfig struct {
+	// MaxSize bounds the archive, the most crowded solutions are pruned once
+	// it is exceeded. Zero keeps the archive unbounded.
+	MaxSize int
+	// Epsilon compares feasible solutions with additive epsilon-dominance,
+	// keeping at most one solution per box of side Epsilon in objective
+	// space. Zero uses plain dominance.
+	Epsilon float64
+}
+
+// ParetoArchive is an external archive of the non-dominated solutions found
+// across the generations of an execution, so that good solutions crowded out
+// of the population are not lost. A nil ParetoArchive keeps nothing.
+type ParetoArchive struct {
+	config  ParetoArchiveConfig
+	vectors []models.Vector
+}
+
+// NewParetoArchive returns an empty archive.
+func NewParetoArchive(cfg ParetoArchiveConfig) *ParetoArchive {
+	return &ParetoArchive{config: cfg}
+}
+
+// Add inserts copies of the vectors not dominated by the archive, removing
+// the archived vectors they dominate.
+func (a *ParetoArchive) Add(vectors ...models.Vector) {
+	if a == nil {
+		return
+	}
+	for _, v := range vectors {
+		a.add(v)
+	}
+	a.prune()
+}
+
+func (a *ParetoArchive) add(v models.Vector) {
+	for _, w := range a.vectors {
+		if a.dominance(v, w) == 1 || slices.Equal(v.Objectives, w.Objectives) && slices.Equal(v.Constraints, w.Constraints) {
+			return
+		}
+	}
+	a.vectors = slices.DeleteFunc(a.vectors, func(w models.Vector) bool {
+		return a.dominance(v, w) == -1
+	})
+	a.vectors = append(a.vectors, v.Copy())
+}
+
+// dominance compares two vectors like ConstrainedDominanceTest, using
+// epsilon boxes for feasible vectors when configured. Between vectors of the
+// same box the dominating one, or else the closest to the box corner, wins.
+func (a *ParetoArchive) dominance(x, y models.Vector) int {
+	if a.config.Epsilon <= 0 || !x.Feasible() || !y.Feasible() {
+		return ConstrainedDominanceTest(x, y)
+	}
+	bx, by := a.box(x), a.box(y)
+	if !slices.Equal(bx, by) {
+		return DominanceTest(bx, by)
+	}
+	if result := DominanceTest(x.Objectives, y.Objectives); result != 0 {
+		return result
+	}
+	if a.cornerDistance(x, bx) <= a.cornerDistance(y, by) {
+		return -1
+	}
+	return 1
+}
+
+// box returns the epsilon box of the objectives of v.
+func (a *ParetoArchive) box(v models.Vector) []float64 {
+	box := make([]float64, len(v.Objectives))
+	for i, obj := range v.Objectives {
+		box[i] = math.Floor(obj / a.config.Epsilon)
+	}
+	return box
+}
+
+// cornerDistance is the squared distance of v to the lower corner of its box.
+func (a *ParetoArchive) cornerDistance(v models.Vector, box []float64) float64 {
+	distance := 0.0
+	for i, obj := range v.Objectives {
+		d := obj - box[i]*a.config.Epsilon
+		distance += d * d
+	}
+	return distance
+}
+
+// prune drops the most crowded vectors of an archive larger than MaxSize,
+// one at a time so that the crowding distances stay accurate.
+func (a *ParetoArchive) prune() {
+	for a.config.MaxSize > 0 && len(a.vectors) > a.config.MaxSize {
+		_ = CalculateCrwdDist(context.Background(), a.vectors)
+		crowded := 0
+		for i, v := range a.vectors {
+			if v.CrowdingDistance < a.vectors[crowded].CrowdingDistance {
+				crowded = i
+			}
+		}
+		a.vectors = slices.Delete(a.vectors, crowded, crowded+1)
+	}
+}
+
+// Vectors returns the archived vectors, which must not be modified.
+func (a *ParetoArchive) Vectors() []models.Vector {
+	if a == nil {
+		return nil
+	}
+	return a.vectors
+}
+
+// Len returns the amount of archived vectors.
+func (a *ParetoArchive) Len() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.vectors)
+}
+
+// Copy returns copies of the archived vectors.
+func (a *ParetoArchive) Copy() []models.Vector {
+	if a == nil {
+		return nil
+	}
+	return models.Population(a.vectors).Copy()
+}
@@ -0,0 +1,92 @@
+package de
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func objectives(vectors []models.Vector) [][]float64 {
+	objs := make([][]float64, len(vectors))
+	for i, v := range vectors {
+		objs[i] = v.Objectives
+	}
+	return objs
+}
+
+func TestParetoArchive(t *testing.T) {
+	vector := func(objs ...float64) models.Vector {
+		return models.Vector{Elements: []float64{0}, Objectives: objs}
+	}
+
+	t.Run("nil archive keeps nothing", func(t *testing.T) {
+		var archive *ParetoArchive
+		archive.Add(vector(1, 1))
+		assert.Nil(t, archive.Vectors())
+		assert.Zero(t, archive.Len())
+		assert.Nil(t, archive.Copy())
+	})
+
+	t.Run("keeps only non-dominated vectors", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{})
+		archive.Add(vector(2, 2), vector(1, 3))
+		archive.Add(vector(3, 3), vector(2, 2))
+		assert.ElementsMatch(t, [][]float64{{2, 2}, {1, 3}}, objectives(archive.Vectors()),
+			"dominated and duplicated vectors are rejected")
+
+		archive.Add(vector(1, 1))
+		assert.Equal(t, [][]float64{{1, 1}}, objectives(archive.Vectors()),
+			"dominated archived vectors are removed")
+	})
+
+	t.Run("is unbounded by default", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{})
+		for i := range 2000 {
+			x := float64(i) / 2000
+			archive.Add(vector(x, 1-x))
+		}
+		assert.Equal(t, 2000, archive.Len())
+	})
+
+	t.Run("prunes the most crowded vectors", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{MaxSize: 3})
+		archive.Add(vector(0, 1), vector(0.5, 0.5), vector(0.51, 0.49), vector(1, 0))
+		assert.Equal(t, 3, archive.Len())
+		objs := objectives(archive.Vectors())
+		assert.Contains(t, objs, []float64{0, 1}, "extremes are kept")
+		assert.Contains(t, objs, []float64{1, 0}, "extremes are kept")
+	})
+
+	t.Run("keeps one vector per epsilon box", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{Epsilon: 0.5})
+		archive.Add(vector(0.4, 0.6), vector(0.1, 0.95), vector(0.2, 0.7))
+		assert.Equal(t, [][]float64{{0.2, 0.7}}, objectives(archive.Vectors()),
+			"the vector closest to the box corner is kept")
+
+		archive.Add(vector(0.6, 0.2))
+		assert.Equal(t, [][]float64{{0.2, 0.7}, {0.6, 0.2}}, objectives(archive.Vectors()))
+
+		archive.Add(vector(0.4, 0.4))
+		assert.Equal(t, [][]float64{{0.4, 0.4}}, objectives(archive.Vectors()),
+			"dominating boxes remove the dominated ones")
+	})
+
+	t.Run("feasible vectors dominate infeasible ones", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{Epsilon: 0.5})
+		archive.Add(models.Vector{Objectives: []float64{0, 0}, Constraints: []float64{1}})
+		assert.Equal(t, 1, archive.Len())
+		archive.Add(vector(5, 5))
+		assert.Equal(t, [][]float64{{5, 5}}, objectives(archive.Vectors()))
+	})
+
+	t.Run("copies the vectors", func(t *testing.T) {
+		archive := NewParetoArchive(ParetoArchiveConfig{})
+		v := vector(1, 2)
+		archive.Add(v)
+		v.Objectives[0] = 5
+		copied := archive.Copy()
+		copied[0].Objectives[1] = 5
+		assert.Equal(t, []float64{1, 2}, archive.Vectors()[0].Objectives)
+	})
+}
@@ -0,0 +1,89 @@
+package de
+
+import (
+	"context"
+	"math/rand"
+)
+
+// ExecutionSeed derives the seed of the n-th execution from the seed of a
+// run, so that every execution draws from its own reproducible stream.
+//
+// The derivation is the SplitMix64 finalizer, which spreads consecutive
+// execution numbers over unrelated seeds.
+func ExecutionSeed(seed int64, n int) int64 {
+	z := uint64(seed) + uint64(n+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// RandomState is the position of a seeded random stream, its seed and how
+// many values were drawn from it.
+type RandomState struct {
+	Seed  int64
+	Draws uint64
+}
+
+// Random is a random stream that keeps track of its position, so that an
+// execution can be checkpointed and resumed drawing the same values it would
+// have drawn had it never stopped.
+type Random struct {
+	*rand.Rand
+	source *countingSource
+}
+
+// NewRandom returns the random stream positioned at state, the values drawn
+// before it are drawn again and discarded.
+func NewRandom(state RandomState) *Random {
+	// #nosec G404 - Using math/rand for DE algorithm randomness, not cryptographic purposes
+	source := &countingSource{src: rand.NewSource(state.Seed).(rand.Source64), seed: state.Seed}
+	for range state.Draws {
+		source.Uint64()
+	}
+	return &Random{Rand: rand.New(source), source: source}
+}
+
+// State returns the current position of the stream.
+func (r *Random) State() RandomState {
+	return RandomState{Seed: r.source.seed, Draws: r.source.draws}
+}
+
+// countingSource counts the values drawn from a source, every Int63 and
+// Uint64 call advances the underlying generator by a single step.
+type countingSource struct {
+	src   rand.Source64
+	seed  int64
+	draws uint64
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.draws++
+	return s.src.Uint64()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.seed, s.draws = seed, 0
+}
+
+// RandomFromContext returns a random stream seeded with the execution seed
+// of the context, or positioned where the checkpoint of the context left it.
+// Without either the stream is seeded randomly and the execution is not
+// reproducible.
+func RandomFromContext(ctx context.Context) *Random {
+	if checkpoint, ok := FromContextCheckpoint(ctx); ok {
+		return NewRandom(checkpoint.Random)
+	}
+
+	seed, ok := FromContextSeed(ctx)
+	if !ok {
+		// #nosec G404 - Using math/rand for DE algorithm randomness, not cryptographic purposes
+		seed = rand.Int63()
+	}
+	return NewRandom(RandomState{Seed: seed})
+}
@@ -0,0 +1,71 @@
+package de
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionSeed(t *testing.T) {
+	t.Run("deterministic", func(t *testing.T) {
+		assert.Equal(t, ExecutionSeed(42, 3), ExecutionSeed(42, 3))
+	})
+
+	t.Run("distinct per execution and seed", func(t *testing.T) {
+		seen := make(map[int64]bool)
+		for seed := range int64(10) {
+			for n := range 10 {
+				s := ExecutionSeed(seed, n)
+				assert.False(t, seen[s], "seed %d execution %d collides", seed, n)
+				seen[s] = true
+			}
+		}
+	})
+}
+
+func TestRandomFromContext(t *testing.T) {
+	t.Run("seeded context is reproducible", func(t *testing.T) {
+		ctx := WithContextSeed(context.Background(), 7)
+		a, b := RandomFromContext(ctx), RandomFromContext(ctx)
+		for range 10 {
+			assert.Equal(t, a.Int63(), b.Int63())
+		}
+	})
+
+	t.Run("unseeded context still returns a source", func(t *testing.T) {
+		assert.NotNil(t, RandomFromContext(context.Background()))
+	})
+}
+
+func TestNewRandom(t *testing.T) {
+	original := NewRandom(RandomState{Seed: 7})
+	for range 10 {
+		original.Float64()
+		original.NormFloat64()
+		original.Intn(5)
+	}
+
+	resumed := NewRandom(original.State())
+	assert.Equal(t, original.State(), resumed.State())
+	for range 10 {
+		assert.Equal(t, original.Float64(), resumed.Float64())
+		assert.Equal(t, original.Uint64(), resumed.Uint64())
+	}
+
+	t.Run("matches an untracked source", func(t *testing.T) {
+		plain := rand.New(rand.NewSource(7))
+		tracked := NewRandom(RandomState{Seed: 7})
+		for range 10 {
+			assert.Equal(t, plain.Float64(), tracked.Float64())
+		}
+	})
+}
+
+func TestRandomFromContext_Checkpoint(t *testing.T) {
+	ctx := WithContextSeed(context.Background(), 7)
+	ctx = WithContextCheckpoint(ctx, &Checkpoint{Random: RandomState{Seed: 3, Draws: 4}})
+	assert.Equal(t, RandomState{Seed: 3, Draws: 4}, RandomFromContext(ctx).State(),
+		"the checkpoint wins over the seed")
+}
@@ -5,8 +5,10 @@ import (
 	"sync"
 
 	api "github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
 	"github.com/nicholaspcr/GoDE/pkg/models"
 	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
 	"github.com/nicholaspcr/GoDE/pkg/util"
 	"github.com/nicholaspcr/GoDE/pkg/variants"
 )
@@ -19,11 +21,24 @@ type AlgorithmMetadata struct {
 
 // AlgorithmParams holds all parameters needed to instantiate an algorithm.
 type AlgorithmParams struct {
-	Problem           problems.Interface
-	Variant           variants.Interface
+	Problem problems.Interface
+	Variant variants.Interface
+	// Crossover and Repair build trial vectors from the mutants, nil uses
+	// binomial crossover and clamping.
+	Crossover         crossover.Interface
+	Repair            repair.Interface
 	PopulationParams  models.PopulationParams
 	InitialPopulation models.Population
 	ProgressCallback  ProgressCallback
+	// EvaluationPool evaluates trial vectors concurrently, nil evaluates
+	// them sequentially.
+	EvaluationPool *EvaluationPool
+	// CheckpointCallback receives the state of each execution every
+	// CheckpointInterval generations, nil disables checkpoints.
+	CheckpointCallback CheckpointCallback
+	CheckpointInterval int
+	// Metrics receives the metrics of every generation, nil records none.
+	Metrics MetricsObserver
 }
 
 // AlgorithmFactory creates an Algorithm from execution parameters and config.
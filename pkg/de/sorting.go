@@ -0,0 +1,515 @@
+package de
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// smallSortSize is the population size up to which the quadratic procedure
+// of Deb et al. outruns the asymptotically faster sorters.
+const smallSortSize = 32
+
+// NonDominatedSort ranks elems into Pareto fronts and returns the indices of
+// the vectors of each front, best front first and indices ascending within
+// a front. Vectors are compared like ConstrainedDominanceTest: feasible
+// vectors are ranked by their objectives ahead of the infeasible ones, which
+// form a front per amount of constraint violation.
+//
+// The sorting procedure is picked by the size of the problem:
+//   - up to smallSortSize vectors, the O(M * N^2) procedure of NSGA-II.
+//   - up to three objectives, the O(N * log^(M-1) N) divide-and-conquer of
+//     Jensen (2003), generalized to ties by Fortin et al. (2013).
+//   - otherwise the efficient non-dominated sort with binary search (ENS-BS)
+//     of Zhang et al. (2015), O(M * N^2) in the worst case but close to
+//     O(M * N * sqrt(N)) on typical populations.
+func NonDominatedSort(ctx context.Context, elems []models.Vector) [][]int {
+	tracer := otel.Tracer("de")
+	_, span := tracer.Start(ctx, "de.NonDominatedSort",
+		trace.WithAttributes(
+			attribute.Int("population_size", len(elems)),
+		),
+	)
+	defer span.End()
+
+	if len(elems) == 0 {
+		return nil
+	}
+	span.SetAttributes(attribute.Int("objectives_count", len(elems[0].Objectives)))
+
+	feasible := make([]int, 0, len(elems))
+	var infeasible []int
+	for i, v := range elems {
+		if v.ConstraintViolation() == 0 {
+			feasible = append(feasible, i)
+		} else {
+			infeasible = append(infeasible, i)
+		}
+	}
+
+	var fronts [][]int
+	if len(feasible) > 0 {
+		objs := make([][]float64, len(feasible))
+		for i, p := range feasible {
+			objs[i] = elems[p].Objectives
+		}
+		for i, rank := range nonDominatedRanks(objs) {
+			for rank >= len(fronts) {
+				fronts = append(fronts, nil)
+			}
+			fronts[rank] = append(fronts[rank], feasible[i])
+		}
+	}
+
+	// Infeasible vectors only compare by violation, the smallest dominates
+	violations := make([]float64, len(elems))
+	for _, p := range infeasible {
+		violations[p] = elems[p].ConstraintViolation()
+	}
+	slices.SortStableFunc(infeasible, func(a, b int) int {
+		return cmp.Compare(violations[a], violations[b])
+	})
+	for i, p := range infeasible {
+		if i == 0 || violations[p] != violations[infeasible[i-1]] {
+			fronts = append(fronts, nil)
+		}
+		fronts[len(fronts)-1] = append(fronts[len(fronts)-1], p)
+	}
+
+	span.SetAttributes(
+		attribute.Int("num_fronts", len(fronts)),
+		attribute.Int("front_0_size", len(fronts[0])),
+	)
+	return fronts
+}
+
+// nonDominatedRanks returns the front of each objective vector, starting
+// from zero, picking the sorting procedure as described in NonDominatedSort.
+func nonDominatedRanks(objs [][]float64) []int {
+	switch {
+	case len(objs) <= smallSortSize:
+		return fastNonDominatedRanks(objs)
+	case len(objs[0]) <= 3:
+		return divideAndConquerRanks(objs)
+	default:
+		return efficientNonDominatedRanks(objs)
+	}
+}
+
+// fastNonDominatedRanks is the fast non-dominated sorting procedure from
+// "A Fast and Elitist Multiobjective Genetic Algorithm: NSGA-II" by Deb et
+// al. (2002):
+//  1. For each solution p, calculate:
+//     - S_p: Set of solutions that p dominates
+//     - N_p: Number of solutions that dominate p
+//  2. Front 0 = all solutions with N_p = 0
+//  3. For each front F_i, create next front F_(i+1):
+//     - For each p in F_i, for each q in S_p, decrement N_q
+//     - If N_q becomes 0, add q to F_(i+1)
+func fastNonDominatedRanks(objs [][]float64) []int {
+	ranks := make([]int, len(objs))
+	dominatingIth := make([]int, len(objs))  // N_p equivalent
+	ithDominated := make([][]int, len(objs)) // S_p equivalent
+	front := make([]int, 0, len(objs))       // F_i equivalent
+
+	for p := range objs {
+		for q := p + 1; q < len(objs); q++ {
+			switch DominanceTest(objs[p], objs[q]) {
+			case -1:
+				ithDominated[p] = append(ithDominated[p], q)
+				dominatingIth[q]++
+			case 1:
+				ithDominated[q] = append(ithDominated[q], p)
+				dominatingIth[p]++
+			}
+		}
+	}
+	for p := range objs {
+		if dominatingIth[p] == 0 {
+			front = append(front, p)
+		}
+	}
+
+	for rank := 0; len(front) > 0; rank++ {
+		var nextFront []int
+		for _, p := range front {
+			ranks[p] = rank
+			for _, q := range ithDominated[p] {
+				dominatingIth[q]--
+				if dominatingIth[q] == 0 {
+					nextFront = append(nextFront, q)
+				}
+			}
+		}
+		front = nextFront
+	}
+	return ranks
+}
+
+// efficientNonDominatedRanks is the efficient non-dominated sort with binary
+// search (ENS-BS) from "An Efficient Approach to Nondominated Sorting for
+// Evolutionary Multiobjective Optimization" by Zhang et al. (2015).
+//
+// Solutions are visited in lexicographic order, so that none can be
+// dominated by a later one, and each is placed in the first front with no
+// solution dominating it. Every solution of a front is dominated by one of
+// the previous front, which allows that front to be binary searched.
+func efficientNonDominatedRanks(objs [][]float64) []int {
+	unique, positions := uniqueObjectives(objs)
+	last := len(objs[0]) - 1
+
+	uniqueRanks := make([]int, len(unique))
+	var fronts [][]int
+	for p := range unique {
+		lo, hi := 0, len(fronts)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if dominatedByFront(unique, fronts[mid], p, last) {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo == len(fronts) {
+			fronts = append(fronts, nil)
+		}
+		fronts[lo] = append(fronts[lo], p)
+		uniqueRanks[p] = lo
+	}
+
+	ranks := make([]int, len(objs))
+	for i, pos := range positions {
+		ranks[i] = uniqueRanks[pos]
+	}
+	return ranks
+}
+
+// dominatedByFront reports whether a solution of front dominates p. The
+// most recent solutions of a front are the closest to p, so they are the
+// most likely to dominate it and are checked first.
+func dominatedByFront(objs [][]float64, front []int, p, k int) bool {
+	for i := len(front) - 1; i >= 0; i-- {
+		if weaklyDominates(objs[front[i]], objs[p], k) {
+			return true
+		}
+	}
+	return false
+}
+
+// divideAndConquerRanks is the divide-and-conquer non-dominated sort from
+// "Reducing the run-time complexity of multiobjective EAs: The NSGA-II and
+// other algorithms" by Jensen (2003), handling equal objective values as in
+// "Generalizing the improved run-time complexity algorithm for
+// non-dominated sorting" by Fortin et al. (2013).
+func divideAndConquerRanks(objs [][]float64) []int {
+	unique, positions := uniqueObjectives(objs)
+	s := newDivideAndConquerSorter(unique)
+
+	set := make([]int, len(unique))
+	for i := range set {
+		set[i] = i
+	}
+	s.helperA(set, len(objs[0])-1)
+
+	ranks := make([]int, len(objs))
+	for i, pos := range positions {
+		ranks[i] = s.ranks[pos]
+	}
+	return ranks
+}
+
+// divideAndConquerSorter holds the state of divideAndConquerRanks. Solutions
+// are identified by their position in the lexicographically sorted and
+// duplicate free objectives, so that keeping sets of solutions in ascending
+// order keeps them in lexicographic order and a solution weakly dominating
+// another one dominates it. Objective values are replaced by their ordinal
+// among the values of the objective.
+type divideAndConquerSorter struct {
+	ordinals [][]int // ordinals[k][p] is the ordinal of objective k of p
+	ranks    []int
+	tree     maxTree // shared by the sweeps, cleared after each one
+	scratch  []int
+}
+
+func newDivideAndConquerSorter(objs [][]float64) *divideAndConquerSorter {
+	s := &divideAndConquerSorter{
+		ordinals: make([][]int, len(objs[0])),
+		ranks:    make([]int, len(objs)),
+		tree:     newMaxTree(len(objs)),
+		scratch:  make([]int, len(objs)),
+	}
+
+	order := make([]int, len(objs))
+	for k := range s.ordinals {
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortFunc(order, func(a, b int) int {
+			return cmp.Compare(objs[a][k], objs[b][k])
+		})
+
+		s.ordinals[k] = make([]int, len(objs))
+		ordinal := 0
+		for i, p := range order {
+			if i > 0 && cmp.Compare(objs[p][k], objs[order[i-1]][k]) != 0 {
+				ordinal++
+			}
+			s.ordinals[k][p] = ordinal
+		}
+	}
+	return s
+}
+
+// helperA ranks the solutions of set, sorted and equal in the objectives
+// after k, among themselves. The ranks of the solutions dominating them from
+// outside set must already be accounted.
+func (s *divideAndConquerSorter) helperA(set []int, k int) {
+	switch {
+	case len(set) < 2:
+		return
+	case len(set) == 2:
+		s.compare(set[0], set[1], k)
+		return
+	case k == 0:
+		// Distinct solutions equal in every other objective form a chain
+		for i := 1; i < len(set); i++ {
+			s.raise(set[i], s.ranks[set[i-1]])
+		}
+		return
+	case k == 1:
+		s.sweepA(set)
+		return
+	}
+
+	median, ok := s.median(k, set)
+	if !ok {
+		s.helperA(set, k-1)
+		return
+	}
+
+	// Solutions equal to the median go to the smaller side, so that both
+	// sides shrink and none of high dominates a solution of low.
+	var less, greater int
+	for _, p := range set {
+		switch cmp.Compare(s.ordinals[k][p], median) {
+		case -1:
+			less++
+		case 1:
+			greater++
+		}
+	}
+	equalLow := less == 0 || (greater > 0 && less <= greater)
+	low, high := s.split(set, k, median, equalLow)
+
+	s.helperA(low, k)
+	s.helperB(low, high, k-1)
+	s.helperA(high, k)
+}
+
+// helperB raises the ranks of the solutions of high dominated by solutions
+// of low, whose ranks are final. Every solution of low is no worse than
+// every solution of high in the objectives after k, which must be at least 1.
+func (s *divideAndConquerSorter) helperB(low, high []int, k int) {
+	switch {
+	case len(low) == 0 || len(high) == 0:
+		return
+	case len(low) == 1 || len(high) == 1:
+		for _, h := range high {
+			for _, l := range low {
+				s.compare(l, h, k)
+			}
+		}
+		return
+	case k == 1:
+		s.sweepB(low, high)
+		return
+	}
+
+	lowMin, lowMax := s.bounds(k, low)
+	highMin, highMax := s.bounds(k, high)
+	switch {
+	case lowMin > highMax:
+		return
+	case lowMax <= highMin:
+		s.helperB(low, high, k-1)
+		return
+	}
+
+	median, _ := s.median(k, low, high)
+	lowLess, _ := s.split(low, k, median, false)
+	lowAtMost, lowGreater := s.split(low, k, median, true)
+	highLess, highAtLeast := s.split(high, k, median, false)
+	_, highGreater := s.split(high, k, median, true)
+
+	s.helperB(lowLess, highLess, k)
+	s.helperB(lowAtMost, highAtLeast, k-1)
+	s.helperB(lowGreater, highGreater, k)
+}
+
+// sweepA ranks set, sorted and equal in the objectives after the second,
+// sweeping it by the first objective and querying the best rank with a
+// second objective no worse than each solution's.
+func (s *divideAndConquerSorter) sweepA(set []int) {
+	for _, p := range set {
+		if best := s.tree.query(s.ordinals[1][p]); best >= 0 {
+			s.raise(p, best)
+		}
+		s.tree.update(s.ordinals[1][p], s.ranks[p])
+	}
+	for _, p := range set {
+		s.tree.clear(s.ordinals[1][p])
+	}
+}
+
+// sweepB is the sweep of sweepA for helperB, where only the solutions of
+// low can dominate the ones of high.
+func (s *divideAndConquerSorter) sweepB(low, high []int) {
+	next := 0
+	for _, h := range high {
+		for ; next < len(low) && s.ordinals[0][low[next]] <= s.ordinals[0][h]; next++ {
+			s.tree.update(s.ordinals[1][low[next]], s.ranks[low[next]])
+		}
+		if best := s.tree.query(s.ordinals[1][h]); best >= 0 {
+			s.raise(h, best)
+		}
+	}
+	for _, l := range low[:next] {
+		s.tree.clear(s.ordinals[1][l])
+	}
+}
+
+// compare raises the rank of q when p is no worse in the objectives up to k.
+func (s *divideAndConquerSorter) compare(p, q, k int) {
+	for i := 0; i <= k; i++ {
+		if s.ordinals[i][q] < s.ordinals[i][p] {
+			return
+		}
+	}
+	s.raise(q, s.ranks[p])
+}
+
+// raise places p in a front after the one of rank.
+func (s *divideAndConquerSorter) raise(p, rank int) {
+	s.ranks[p] = max(s.ranks[p], rank+1)
+}
+
+// split partitions set by objective k against median, keeping the order of
+// set. Solutions equal to the median go to low when equalLow is set.
+func (s *divideAndConquerSorter) split(set []int, k, median int, equalLow bool) (low, high []int) {
+	isLow := func(p int) bool {
+		return s.ordinals[k][p] < median || (s.ordinals[k][p] == median && equalLow)
+	}
+
+	size := 0
+	for _, p := range set {
+		if isLow(p) {
+			size++
+		}
+	}
+	parts := make([]int, len(set))
+	low, high = parts[:0:size], parts[size:size]
+	for _, p := range set {
+		if isLow(p) {
+			low = append(low, p)
+		} else {
+			high = append(high, p)
+		}
+	}
+	return low, high
+}
+
+// median returns the median of objective k over the sets, and false when
+// every solution has the same value.
+func (s *divideAndConquerSorter) median(k int, sets ...[]int) (int, bool) {
+	values := s.scratch[:0]
+	for _, set := range sets {
+		for _, p := range set {
+			values = append(values, s.ordinals[k][p])
+		}
+	}
+	slices.Sort(values)
+	return values[len(values)/2], values[0] != values[len(values)-1]
+}
+
+// bounds returns the smallest and largest value of objective k in set.
+func (s *divideAndConquerSorter) bounds(k int, set []int) (minVal, maxVal int) {
+	minVal, maxVal = s.ordinals[k][set[0]], s.ordinals[k][set[0]]
+	for _, p := range set[1:] {
+		minVal = min(minVal, s.ordinals[k][p])
+		maxVal = max(maxVal, s.ordinals[k][p])
+	}
+	return minVal, maxVal
+}
+
+// maxTree is a Fenwick tree answering the largest rank stored at or before
+// a position, -1 when there is none.
+type maxTree []int
+
+func newMaxTree(size int) maxTree {
+	tree := make(maxTree, size+1)
+	for i := range tree {
+		tree[i] = -1
+	}
+	return tree
+}
+
+func (t maxTree) update(i, rank int) {
+	for i++; i < len(t); i += i & -i {
+		t[i] = max(t[i], rank)
+	}
+}
+
+func (t maxTree) query(i int) int {
+	best := -1
+	for i++; i > 0; i -= i & -i {
+		best = max(best, t[i])
+	}
+	return best
+}
+
+// clear undoes every update made at position i.
+func (t maxTree) clear(i int) {
+	for i++; i < len(t); i += i & -i {
+		t[i] = -1
+	}
+}
+
+// uniqueObjectives sorts objs lexicographically and drops duplicates, which
+// never dominate each other and so share a front. It returns the unique
+// objectives and the position of each of objs among them.
+func uniqueObjectives(objs [][]float64) ([][]float64, []int) {
+	order := make([]int, len(objs))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		return slices.Compare(objs[a], objs[b])
+	})
+
+	unique := make([][]float64, 0, len(objs))
+	positions := make([]int, len(objs))
+	for i, p := range order {
+		if i == 0 || slices.Compare(objs[p], objs[order[i-1]]) != 0 {
+			unique = append(unique, objs[p])
+		}
+		positions[p] = len(unique) - 1
+	}
+	return unique, positions
+}
+
+// weaklyDominates reports whether x is no worse than y in the objectives up
+// to k. Between distinct solutions where x is no worse in the remaining
+// objectives, this means x dominates y.
+func weaklyDominates(x, y []float64, k int) bool {
+	for i := 0; i <= k; i++ {
+		if cmp.Less(y[i], x[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package de
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bruteForceRanks ranks objs by repeatedly peeling off the solutions no
+// remaining solution dominates.
+func bruteForceRanks(objs [][]float64) []int {
+	ranks := make([]int, len(objs))
+	ranked := make([]bool, len(objs))
+	for rank, remaining := 0, len(objs); remaining > 0; rank++ {
+		var front []int
+		for p := range objs {
+			dominated := false
+			for q := range objs {
+				dominated = dominated || !ranked[q] && DominanceTest(objs[q], objs[p]) == -1
+			}
+			if !ranked[p] && !dominated {
+				front = append(front, p)
+			}
+		}
+		for _, p := range front {
+			ranks[p], ranked[p] = rank, true
+		}
+		remaining -= len(front)
+	}
+	return ranks
+}
+
+func randomObjectives(r *rand.Rand, size, objectives, values int) [][]float64 {
+	objs := make([][]float64, size)
+	for i := range objs {
+		objs[i] = make([]float64, objectives)
+		for j := range objs[i] {
+			// Few distinct values force ties and duplicates
+			objs[i][j] = float64(r.IntN(values))
+		}
+	}
+	return objs
+}
+
+func TestNonDominatedRanks(t *testing.T) {
+	sorters := map[string]func([][]float64) []int{
+		"fast":               fastNonDominatedRanks,
+		"divide and conquer": divideAndConquerRanks,
+		"efficient":          efficientNonDominatedRanks,
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for name, sorter := range sorters {
+		t.Run(name, func(t *testing.T) {
+			for _, objectives := range []int{1, 2, 3, 4, 6} {
+				for _, values := range []int{3, 10, 1000} {
+					for _, size := range []int{1, 2, 5, 40, 200} {
+						objs := randomObjectives(r, size, objectives, values)
+						require.Equal(t, bruteForceRanks(objs), sorter(objs),
+							"%d solutions, %d objectives, %d values", size, objectives, values)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("points on a line", func(t *testing.T) {
+		objs := make([][]float64, 100)
+		for i := range objs {
+			objs[i] = []float64{float64(i), float64(i), float64(i)}
+		}
+		ranks := divideAndConquerRanks(objs)
+		for i, rank := range ranks {
+			assert.Equal(t, i, rank)
+		}
+	})
+}
+
+func TestNonDominatedSort(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, NonDominatedSort(context.Background(), nil))
+	})
+
+	t.Run("returns the indices of each front", func(t *testing.T) {
+		elems := []models.Vector{
+			{Objectives: []float64{3, 3}},
+			{Objectives: []float64{1, 2}},
+			{Objectives: []float64{2, 1}},
+			{Objectives: []float64{2, 2}},
+			{Objectives: []float64{1, 2}},
+		}
+		fronts := NonDominatedSort(context.Background(), elems)
+		assert.Equal(t, [][]int{{1, 2, 4}, {3}, {0}}, fronts)
+	})
+
+	t.Run("infeasible vectors rank by violation", func(t *testing.T) {
+		elems := []models.Vector{
+			{Objectives: []float64{0, 0}, Constraints: []float64{2}},
+			{Objectives: []float64{5, 5}},
+			{Objectives: []float64{0, 0}, Constraints: []float64{1}},
+			{Objectives: []float64{1, 0}, Constraints: []float64{2}},
+		}
+		fronts := NonDominatedSort(context.Background(), elems)
+		assert.Equal(t, [][]int{{1}, {2}, {0, 3}}, fronts)
+	})
+
+	t.Run("matches the pairwise comparison on large populations", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(3, 4))
+		for _, objectives := range []int{2, 3, 5} {
+			elems := make([]models.Vector, 500)
+			for i, objs := range randomObjectives(r, len(elems), objectives, 50) {
+				elems[i] = models.Vector{Objectives: objs}
+				if i%7 == 0 {
+					elems[i].Constraints = []float64{float64(i % 3)}
+				}
+			}
+
+			fronts := NonDominatedSort(context.Background(), elems)
+			ranks := make([]int, len(elems))
+			for rank, front := range fronts {
+				for _, p := range front {
+					ranks[p] = rank
+				}
+			}
+			for p := range elems {
+				for q := range elems {
+					if ConstrainedDominanceTest(elems[p], elems[q]) == -1 {
+						require.Less(t, ranks[p], ranks[q])
+					}
+				}
+			}
+			// Every vector past the first front is dominated by the previous one
+			for rank := 1; rank < len(fronts); rank++ {
+				for _, q := range fronts[rank] {
+					dominated := false
+					for _, p := range fronts[rank-1] {
+						dominated = dominated || ConstrainedDominanceTest(elems[p], elems[q]) == -1
+					}
+					require.True(t, dominated)
+				}
+			}
+		}
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"sort"
 
 	"github.com/nicholaspcr/GoDE/pkg/models"
-	"github.com/nicholaspcr/GoDE/pkg/util"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -29,30 +28,43 @@ func ReduceByCrowdDistance(
 	)
 	defer span.End()
 
-	ranks := FastNonDominatedRanking(ctx, elems)
+	fronts := NonDominatedSort(ctx, elems)
 
 	// Deep copy rank 0 vectors early to avoid reprocessing
-	zero := util.CopySlice(ranks[0])
+	var zero []models.Vector
+	if len(fronts) > 0 {
+		zero = make([]models.Vector, len(fronts[0]))
+		for i, p := range fronts[0] {
+			zero[i] = elems[p].Copy()
+		}
+	}
 
 	// Optimization: Copy directly to result to avoid intermediate collection
 	result := make([]models.Vector, 0, np)
-	for i := 0; i < len(ranks); i++ {
+	for _, front := range fronts {
+		// Shallow copies suffice, CalculateCrwdDist only reorders them and
+		// sets their crowding distance
+		rank := make([]models.Vector, len(front))
+		for i, p := range front {
+			rank[i] = elems[p]
+		}
+
 		// Check for cancellation and calculate crowding distance
-		if err := CalculateCrwdDist(ctx, ranks[i]); err != nil {
+		if err := CalculateCrwdDist(ctx, rank); err != nil {
 			// Return what we have so far on cancellation
 			return result, nil
 		}
-		sort.SliceStable(ranks[i], func(l, r int) bool {
-			return ranks[i][l].CrowdingDistance > ranks[i][r].CrowdingDistance
+		sort.SliceStable(rank, func(l, r int) bool {
+			return rank[l].CrowdingDistance > rank[r].CrowdingDistance
 		})
 
 		// Copy vectors directly to result
-		for _, v := range ranks[i] {
+		for _, v := range rank {
 			if len(result) >= np {
 				span.SetAttributes(
 					attribute.Int("result_size", len(result)),
 					attribute.Int("rank_zero_size", len(zero)),
-					attribute.Int("num_ranks", len(ranks)),
+					attribute.Int("num_ranks", len(fronts)),
 				)
 				return result, zero
 			}
@@ -63,116 +75,34 @@ func ReduceByCrowdDistance(
 	span.SetAttributes(
 		attribute.Int("result_size", len(result)),
 		attribute.Int("rank_zero_size", len(zero)),
-		attribute.Int("num_ranks", len(ranks)),
+		attribute.Int("num_ranks", len(fronts)),
 	)
 	return result, zero
 }
 
-// FastNonDominatedRanking ranks solutions into Pareto fronts using the NSGA-II algorithm.
-//
-// This implements the fast non-dominated sorting procedure from:
-// "A Fast and Elitist Multiobjective Genetic Algorithm: NSGA-II" by Deb et al. (2002)
-//
-// The algorithm assigns each solution to a front (rank):
+// FastNonDominatedRanking ranks solutions into Pareto fronts:
 //   - Front 0 (Rank 0): Non-dominated solutions (Pareto optimal in the current population)
 //   - Front 1 (Rank 1): Solutions dominated only by Front 0
 //   - Front 2 (Rank 2): Solutions dominated only by Fronts 0 and 1
 //   - And so on...
 //
-// Algorithm steps:
-//  1. For each solution p, calculate:
-//     - S_p: Set of solutions that p dominates
-//     - N_p: Number of solutions that dominate p
-//  2. Front 0 = all solutions with N_p = 0
-//  3. For each front F_i, create next front F_(i+1):
-//     - For each p in F_i, for each q in S_p, decrement N_q
-//     - If N_q becomes 0, add q to F_(i+1)
-//
-// Time complexity: O(M * N^2) where M is number of objectives, N is population size
+// Solutions are compared with ConstrainedDominanceTest, so feasible solutions
+// always rank ahead of infeasible ones.
 //
-// Returns: Map of rank -> solutions in that rank (deep copies)
+// Returns: Map of rank -> solutions in that rank (deep copies). Callers that
+// do not need copies should use NonDominatedSort, which returns indices.
 func FastNonDominatedRanking(
 	ctx context.Context, elems []models.Vector,
 ) map[int][]models.Vector {
-	tracer := otel.Tracer("de")
-	_, span := tracer.Start(ctx, "de.FastNonDominatedRanking",
-		trace.WithAttributes(
-			attribute.Int("population_size", len(elems)),
-		),
-	)
-	defer span.End()
-
-	if len(elems) > 0 {
-		span.SetAttributes(attribute.Int("objectives_count", len(elems[0].Objectives)))
-	}
-
-	dominatingIth := make([]int, len(elems))  // N_p equivalent
-	ithDominated := make([][]int, len(elems)) // S_p equivalent
-	fronts := make([][]int, 1)                // F equivalent
-	fronts[0] = []int{}                       // initializes first front
-
-	for p := range len(elems) {
-		ithDominated[p] = make([]int, 0) // S_p size 0
-		dominatingIth[p] = 0             // N_p = 0
-
-		for q := range len(elems) {
-			dominanceTestResult := DominanceTest(
-				elems[p].Objectives, elems[q].Objectives,
-			)
-
-			switch dominanceTestResult {
-			case -1:
-				// p dominates q
-				// add q to the set of solutions dominated by p
-				ithDominated[p] = append(ithDominated[p], q)
-			case 1:
-				// q dominates p
-				// increment the domination counter of p
-				dominatingIth[p]++
-			}
-		}
-		if dominatingIth[p] == 0 {
-			// adds p to the first front
-			fronts[0] = append(fronts[0], p)
-		}
-	}
-
-	// used to go through the existent fronts
-	for i := 0; len(fronts[i]) > 0; i++ {
-		// slice to be added to the next front
-		nextFront := []int{}
-
-		// for each p in F_i
-		for _, p := range fronts[i] {
-			// for each q in S_p
-			for _, q := range ithDominated[p] {
-				dominatingIth[q]--
-				if dominatingIth[q] == 0 {
-					nextFront = append(nextFront, q)
-				}
-			}
-		}
-
-		// adds the next front to the matrix
-		fronts = append(fronts, nextFront)
-	}
+	fronts := NonDominatedSort(ctx, elems)
 
-	// getting ranked api.elements from their index
-	rankedSubList := make(map[int][]models.Vector)
-	for i := 0; i < len(fronts); i++ {
-		for m := range fronts[i] {
-			rankedSubList[i] = append(
-				rankedSubList[i],
-				elems[fronts[i][m]].Copy(),
-			)
+	rankedSubList := make(map[int][]models.Vector, len(fronts))
+	for i, front := range fronts {
+		rankedSubList[i] = make([]models.Vector, len(front))
+		for m, p := range front {
+			rankedSubList[i][m] = elems[p].Copy()
 		}
 	}
-
-	span.SetAttributes(
-		attribute.Int("num_fronts", len(fronts)-1), // -1 because last front is always empty
-		attribute.Int("front_0_size", len(rankedSubList[0])),
-	)
-
 	return rankedSubList
 }
 
@@ -220,12 +150,33 @@ func DominanceTest(x, y []float64) int {
 	return result
 }
 
+// ConstrainedDominanceTest compares two vectors with Deb's feasibility rules:
+//  1. A feasible vector dominates an infeasible one.
+//  2. Between infeasible vectors, the smaller constraint violation dominates.
+//  3. Between feasible vectors, the usual DominanceTest applies.
+//
+// Vectors of unconstrained problems are always feasible, so for them this is
+// the same as DominanceTest. Return values follow DominanceTest.
+func ConstrainedDominanceTest(x, y models.Vector) int {
+	cvX, cvY := x.ConstraintViolation(), y.ConstraintViolation()
+	switch {
+	case cvX == 0 && cvY == 0:
+		return DominanceTest(x.Objectives, y.Objectives)
+	case cvX < cvY:
+		return -1
+	case cvY < cvX:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // FilterDominated -> returns api.elements that are not dominated in the set
 func FilterDominated(
 	elems []models.Vector,
 ) ([]models.Vector, []models.Vector) {
 	tracer := otel.Tracer("de")
-	_, span := tracer.Start(context.Background(), "de.FilterDominated",
+	ctx, span := tracer.Start(context.Background(), "de.FilterDominated",
 		trace.WithAttributes(
 			attribute.Int("population_size", len(elems)),
 		),
@@ -235,18 +186,15 @@ func FilterDominated(
 	nonDominated := make([]models.Vector, 0)
 	dominated := make([]models.Vector, 0)
 
-	for p := range len(elems) {
-		counter := 0
-		for q := range len(elems) {
-			if p == q {
-				continue
-			}
-			// q dominates the p element
-			if DominanceTest(elems[p].Objectives, elems[q].Objectives) == 1 {
-				counter++
-			}
+	fronts := NonDominatedSort(ctx, elems)
+	rankZero := make([]bool, len(elems))
+	if len(fronts) > 0 {
+		for _, p := range fronts[0] {
+			rankZero[p] = true
 		}
-		if counter == 0 {
+	}
+	for p := range elems {
+		if rankZero[p] {
 			nonDominated = append(nonDominated, elems[p].Copy())
 		} else {
 			dominated = append(dominated, elems[p].Copy())
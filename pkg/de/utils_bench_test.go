@@ -2,6 +2,8 @@ package de
 
 import (
 	"context"
+	"fmt"
+	"math/rand/v2"
 	"testing"
 
 	"github.com/nicholaspcr/GoDE/pkg/models"
@@ -140,6 +142,74 @@ func BenchmarkFastNonDominatedRanking(b *testing.B) {
 	}
 }
 
+// BenchmarkNonDominatedSort benchmarks each non-dominated sorting procedure
+// and the automatic choice between them on random populations
+func BenchmarkNonDominatedSort(b *testing.B) {
+	ctx := context.Background()
+
+	sorters := []struct {
+		name string
+		sort func([][]float64) []int
+	}{
+		{"fast", fastNonDominatedRanks},
+		{"divide_and_conquer", divideAndConquerRanks},
+		{"efficient", efficientNonDominatedRanks},
+	}
+
+	// Random populations spread over many fronts, converged ones lie close
+	// to a single front as happens in late generations
+	shapes := []struct {
+		name      string
+		objective func(r *rand.Rand, objs []float64, j int) float64
+	}{
+		{"random", func(r *rand.Rand, _ []float64, _ int) float64 { return r.Float64() }},
+		{"converged", func(r *rand.Rand, objs []float64, j int) float64 {
+			if j < len(objs)-1 {
+				return r.Float64() / float64(len(objs))
+			}
+			sum := 0.0
+			for _, v := range objs[:j] {
+				sum += v
+			}
+			return 1 - sum + r.Float64()*0.01
+		}},
+	}
+
+	for _, shape := range shapes {
+		for _, popSize := range []int{32, 200, 1000, 5000} {
+			for _, objSize := range []int{2, 3, 5, 8} {
+				r := rand.New(rand.NewPCG(uint64(popSize), uint64(objSize)))
+				elems := make([]models.Vector, popSize)
+				objs := make([][]float64, popSize)
+				for i := range elems {
+					objs[i] = make([]float64, objSize)
+					for j := range objs[i] {
+						objs[i][j] = shape.objective(r, objs[i], j)
+					}
+					elems[i] = models.Vector{Objectives: objs[i]}
+				}
+
+				name := fmt.Sprintf("%s_%d_individuals_%dobj", shape.name, popSize, objSize)
+				b.Run(name+"/auto", func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						_ = NonDominatedSort(ctx, elems)
+					}
+				})
+				for _, sorter := range sorters {
+					if sorter.name == "fast" && popSize > 1000 {
+						continue // quadratic, takes seconds per run
+					}
+					b.Run(name+"/"+sorter.name, func(b *testing.B) {
+						for i := 0; i < b.N; i++ {
+							_ = sorter.sort(objs)
+						}
+					})
+				}
+			}
+		}
+	}
+}
+
 // BenchmarkCalculateCrwdDist benchmarks crowding distance calculation
 func BenchmarkCalculateCrwdDist(b *testing.B) {
 	sizes := []struct {
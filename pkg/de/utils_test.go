@@ -63,6 +63,92 @@ func TestFilterDominated(t *testing.T) {
 	assert.Len(t, dominated, 3)
 }
 
+func TestConstrainedDominanceTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        models.Vector
+		y        models.Vector
+		expected int
+	}{
+		{
+			name:     "unconstrained falls back to dominance",
+			x:        models.Vector{Objectives: []float64{0, 0}},
+			y:        models.Vector{Objectives: []float64{1, 1}},
+			expected: -1,
+		},
+		{
+			name:     "both feasible",
+			x:        models.Vector{Objectives: []float64{1, 0}, Constraints: []float64{0}},
+			y:        models.Vector{Objectives: []float64{0, 1}, Constraints: []float64{0}},
+			expected: 0,
+		},
+		{
+			name:     "feasible dominates infeasible with better objectives",
+			x:        models.Vector{Objectives: []float64{5, 5}, Constraints: []float64{0}},
+			y:        models.Vector{Objectives: []float64{0, 0}, Constraints: []float64{0.1}},
+			expected: -1,
+		},
+		{
+			name:     "infeasible is dominated by feasible",
+			x:        models.Vector{Objectives: []float64{0, 0}, Constraints: []float64{2}},
+			y:        models.Vector{Objectives: []float64{5, 5}},
+			expected: 1,
+		},
+		{
+			name:     "smaller violation wins between infeasible",
+			x:        models.Vector{Objectives: []float64{5, 5}, Constraints: []float64{0.5, 0.5}},
+			y:        models.Vector{Objectives: []float64{0, 0}, Constraints: []float64{2}},
+			expected: -1,
+		},
+		{
+			name:     "equal violation",
+			x:        models.Vector{Objectives: []float64{0, 0}, Constraints: []float64{1}},
+			y:        models.Vector{Objectives: []float64{5, 5}, Constraints: []float64{1}},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConstrainedDominanceTest(tt.x, tt.y))
+		})
+	}
+}
+
+func TestFilterDominated_Constrained(t *testing.T) {
+	elems := []models.Vector{
+		{Objectives: []float64{0, 0}, Constraints: []float64{1}},
+		{Objectives: []float64{1, 2}, Constraints: []float64{0}},
+		{Objectives: []float64{2, 1}, Constraints: []float64{0}},
+		{Objectives: []float64{3, 3}, Constraints: []float64{0}},
+	}
+
+	nonDominated, dominated := FilterDominated(elems)
+
+	assert.Len(t, nonDominated, 2)
+	for _, v := range nonDominated {
+		assert.True(t, v.Feasible())
+	}
+	assert.Len(t, dominated, 2)
+}
+
+func TestFastNonDominatedRanking_Constrained(t *testing.T) {
+	elems := []models.Vector{
+		{Objectives: []float64{0, 0}, Constraints: []float64{2}},
+		{Objectives: []float64{0, 0}, Constraints: []float64{1}},
+		{Objectives: []float64{3, 3}, Constraints: []float64{0}},
+		{Objectives: []float64{1, 1}, Constraints: []float64{0}},
+	}
+
+	ranks := FastNonDominatedRanking(context.Background(), elems)
+
+	// Feasible vectors rank first, infeasible ones by their violation
+	assert.Equal(t, []float64{1, 1}, ranks[0][0].Objectives)
+	assert.Equal(t, []float64{3, 3}, ranks[1][0].Objectives)
+	assert.Equal(t, []float64{1}, ranks[2][0].Constraints)
+	assert.Equal(t, []float64{2}, ranks[3][0].Constraints)
+}
+
 func TestCalculateCrwdDist(t *testing.T) {
 	elems := []models.Vector{
 		{Objectives: []float64{1, 5}},
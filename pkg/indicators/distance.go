@@ -0,0 +1,51 @@
+package indicators
+
+import "math"
+
+// GD returns the generational distance: the mean Euclidean distance from
+// each point of the front to its nearest point in the reference front.
+func GD(points, reference [][]float64) float64 {
+	return meanNearest(points, reference, euclidean)
+}
+
+// IGD returns the inverted generational distance: the mean Euclidean
+// distance from each point of the reference front to its nearest point in
+// the front.
+func IGD(points, reference [][]float64) float64 {
+	return meanNearest(reference, points, euclidean)
+}
+
+// IGDPlus returns the IGD+ indicator (Ishibuchi et al., 2015), which only
+// measures the components in which a front point is worse than a reference
+// point. Unlike IGD it is weakly Pareto compliant.
+func IGDPlus(points, reference [][]float64) float64 {
+	return meanNearest(reference, points, func(ref, p []float64) float64 {
+		sum := 0.0
+		for i := range ref {
+			d := math.Max(p[i]-ref[i], 0)
+			sum += d * d
+		}
+		return math.Sqrt(sum)
+	})
+}
+
+// meanNearest returns the mean, over from, of the distance to the nearest
+// point in to. It returns +Inf when to is empty and from is not.
+func meanNearest(from, to [][]float64, distance func(a, b []float64) float64) float64 {
+	if len(from) == 0 {
+		return 0
+	}
+	if len(to) == 0 {
+		return math.Inf(1)
+	}
+
+	total := 0.0
+	for _, a := range from {
+		nearest := math.Inf(1)
+		for _, b := range to {
+			nearest = math.Min(nearest, distance(a, b))
+		}
+		total += nearest
+	}
+	return total / float64(len(from))
+}
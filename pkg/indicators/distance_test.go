@@ -0,0 +1,46 @@
+package indicators
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceIndicators(t *testing.T) {
+	reference := [][]float64{{0, 1}, {0.5, 0.5}, {1, 0}}
+
+	t.Run("front equal to reference", func(t *testing.T) {
+		assert.Zero(t, GD(reference, reference))
+		assert.Zero(t, IGD(reference, reference))
+		assert.Zero(t, IGDPlus(reference, reference))
+	})
+
+	t.Run("shifted front", func(t *testing.T) {
+		front := [][]float64{{0.1, 1.1}, {0.6, 0.6}, {1.1, 0.1}}
+		shift := math.Sqrt(0.02)
+
+		assert.InDelta(t, shift, GD(front, reference), 1e-12)
+		assert.InDelta(t, shift, IGD(front, reference), 1e-12)
+		assert.InDelta(t, shift, IGDPlus(front, reference), 1e-12)
+	})
+
+	t.Run("IGD+ ignores improvements over the reference", func(t *testing.T) {
+		front := [][]float64{{-0.1, 0.9}, {0.4, 0.4}, {0.9, -0.1}}
+
+		assert.Positive(t, IGD(front, reference))
+		assert.Zero(t, IGDPlus(front, reference))
+	})
+
+	t.Run("partial coverage", func(t *testing.T) {
+		front := [][]float64{{0, 1}}
+
+		assert.Zero(t, GD(front, reference))
+		assert.InDelta(t, (math.Sqrt(0.5)+math.Sqrt(2))/3, IGD(front, reference), 1e-12)
+	})
+
+	t.Run("empty front", func(t *testing.T) {
+		assert.Zero(t, GD(nil, reference))
+		assert.True(t, math.IsInf(IGD(nil, reference), 1))
+	})
+}
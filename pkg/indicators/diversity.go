@@ -0,0 +1,94 @@
+package indicators
+
+import "math"
+
+// Spacing returns Schott's spacing metric: the standard deviation of the
+// Manhattan distance from each point to its nearest neighbour. A value of
+// zero means the points are evenly spaced.
+func Spacing(points [][]float64) float64 {
+	if len(points) < 2 {
+		return 0
+	}
+
+	nearest := nearestNeighbour(points, func(a, b []float64) float64 {
+		sum := 0.0
+		for i := range a {
+			sum += math.Abs(a[i] - b[i])
+		}
+		return sum
+	})
+	mean := average(nearest)
+
+	sum := 0.0
+	for _, d := range nearest {
+		sum += (mean - d) * (mean - d)
+	}
+	return math.Sqrt(sum / float64(len(points)-1))
+}
+
+// Spread returns the generalized spread Δ (Zhou et al., 2006), which
+// measures both the extent and the uniformity of the front.
+//
+// The extreme points are taken from the reference front when one is given.
+// Without a reference front only the uniformity term is measured. Lower is
+// better, with zero meaning an evenly distributed front that reaches every
+// extreme.
+func Spread(points, reference [][]float64) float64 {
+	if len(points) < 2 {
+		return 0
+	}
+
+	extremes := 0.0
+	if len(reference) > 0 {
+		for m := range len(reference[0]) {
+			extreme := reference[0]
+			for _, r := range reference {
+				if r[m] < extreme[m] {
+					extreme = r
+				}
+			}
+			nearest := math.Inf(1)
+			for _, p := range points {
+				nearest = math.Min(nearest, euclidean(extreme, p))
+			}
+			extremes += nearest
+		}
+	}
+
+	nearest := nearestNeighbour(points, euclidean)
+	mean := average(nearest)
+
+	deviation := 0.0
+	for _, d := range nearest {
+		deviation += math.Abs(d - mean)
+	}
+
+	denominator := extremes + float64(len(points))*mean
+	if denominator == 0 {
+		return 0
+	}
+	return (extremes + deviation) / denominator
+}
+
+// nearestNeighbour returns, for each point, the distance to the closest
+// other point.
+func nearestNeighbour(points [][]float64, distance func(a, b []float64) float64) []float64 {
+	nearest := make([]float64, len(points))
+	for i := range points {
+		nearest[i] = math.Inf(1)
+		for j := range points {
+			if i != j {
+				nearest[i] = math.Min(nearest[i], distance(points[i], points[j]))
+			}
+		}
+	}
+	return nearest
+}
+
+func average(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,43 @@
+package indicators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpacing(t *testing.T) {
+	even := [][]float64{{0, 1}, {0.25, 0.75}, {0.5, 0.5}, {0.75, 0.25}, {1, 0}}
+	uneven := [][]float64{{0, 1}, {0.1, 0.9}, {0.5, 0.5}, {1, 0}}
+
+	assert.Zero(t, Spacing(nil))
+	assert.Zero(t, Spacing(even[:1]))
+	assert.InDelta(t, 0, Spacing(even), 1e-12)
+	assert.Positive(t, Spacing(uneven))
+}
+
+func TestSpread(t *testing.T) {
+	reference := [][]float64{{0, 1}, {0.25, 0.75}, {0.5, 0.5}, {0.75, 0.25}, {1, 0}}
+
+	t.Run("evenly distributed front reaching the extremes", func(t *testing.T) {
+		assert.InDelta(t, 0, Spread(reference, reference), 1e-12)
+	})
+
+	t.Run("front missing the extremes", func(t *testing.T) {
+		front := [][]float64{{0.25, 0.75}, {0.5, 0.5}, {0.75, 0.25}}
+
+		assert.InDelta(t, 0, Spread(front, nil), 1e-12)
+		assert.Positive(t, Spread(front, reference))
+	})
+
+	t.Run("uneven front", func(t *testing.T) {
+		front := [][]float64{{0, 1}, {0.1, 0.9}, {0.5, 0.5}, {1, 0}}
+
+		assert.Positive(t, Spread(front, reference))
+	})
+
+	t.Run("degenerate fronts", func(t *testing.T) {
+		assert.Zero(t, Spread(nil, reference))
+		assert.Zero(t, Spread([][]float64{{1, 1}, {1, 1}}, nil))
+	})
+}
@@ -0,0 +1,217 @@
+package indicators
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+// Hypervolume returns the volume of the objective space dominated by points
+// and bounded by the reference point ref.
+//
+// The exact value is computed with a sweep for 2 objectives, slicing for 3
+// objectives and the WFG algorithm (While, Bradstreet and Barone, 2012) for
+// more objectives. Points that do not strictly dominate ref contribute
+// nothing.
+func Hypervolume(points [][]float64, ref []float64) float64 {
+	inside := clip(points, ref)
+	if len(inside) == 0 {
+		return 0
+	}
+
+	switch len(ref) {
+	case 1:
+		best := inside[0][0]
+		for _, p := range inside {
+			best = math.Min(best, p[0])
+		}
+		return ref[0] - best
+	case 2:
+		return hv2d(inside, ref)
+	case 3:
+		return hv3d(inside, ref)
+	default:
+		return wfg(nondominated(inside), ref)
+	}
+}
+
+// MonteCarloHypervolume estimates the hypervolume by uniformly sampling the
+// box between the ideal point of the front and ref.
+func MonteCarloHypervolume(
+	points [][]float64, ref []float64, samples int, random *rand.Rand,
+) float64 {
+	inside := nondominated(clip(points, ref))
+	if len(inside) == 0 || samples <= 0 {
+		return 0
+	}
+
+	lower, _ := bounds(inside)
+	box := 1.0
+	for i := range ref {
+		box *= ref[i] - lower[i]
+	}
+
+	sample := make([]float64, len(ref))
+	hits := 0
+	for range samples {
+		for i := range sample {
+			sample[i] = lower[i] + random.Float64()*(ref[i]-lower[i])
+		}
+		for _, p := range inside {
+			if weaklyDominates(p, sample) {
+				hits++
+				break
+			}
+		}
+	}
+	return box * float64(hits) / float64(samples)
+}
+
+// clip returns the points that strictly dominate the reference point.
+func clip(points [][]float64, ref []float64) [][]float64 {
+	inside := make([][]float64, 0, len(points))
+	for _, p := range points {
+		if len(p) != len(ref) {
+			continue
+		}
+		better := true
+		for i := range p {
+			if p[i] >= ref[i] {
+				better = false
+				break
+			}
+		}
+		if better {
+			inside = append(inside, p)
+		}
+	}
+	return inside
+}
+
+// hv2d computes the exact 2-objective hypervolume with a sweep along the
+// first objective.
+func hv2d(points [][]float64, ref []float64) float64 {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b []float64) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a[1], b[1])
+	})
+
+	volume := 0.0
+	bestY := ref[1]
+	for _, p := range sorted {
+		if p[1] < bestY {
+			volume += (ref[0] - p[0]) * (bestY - p[1])
+			bestY = p[1]
+		}
+	}
+	return volume
+}
+
+// hv3d computes the exact 3-objective hypervolume by slicing along the third
+// objective and summing the 2-objective area of each slice.
+func hv3d(points [][]float64, ref []float64) float64 {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b []float64) int {
+		return cmp.Compare(a[2], b[2])
+	})
+
+	volume := 0.0
+	for i := range sorted {
+		top := ref[2]
+		if i+1 < len(sorted) {
+			top = sorted[i+1][2]
+		}
+		depth := top - sorted[i][2]
+		if depth <= 0 {
+			continue
+		}
+		volume += hv2d(sorted[:i+1], ref[:2]) * depth
+	}
+	return volume
+}
+
+// wfg computes the exact hypervolume of a non-dominated set as the sum of
+// the exclusive hypervolume of each point.
+func wfg(points [][]float64, ref []float64) float64 {
+	switch {
+	case len(points) == 0:
+		return 0
+	case len(points) == 1:
+		return inclusive(points[0], ref)
+	case len(ref) == 2:
+		return hv2d(points, ref)
+	case len(ref) == 3:
+		return hv3d(points, ref)
+	}
+
+	// Sorting by the last objective keeps the limit sets small.
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b []float64) int {
+		return cmp.Compare(b[len(b)-1], a[len(a)-1])
+	})
+
+	volume := 0.0
+	for k := range sorted {
+		volume += inclusive(sorted[k], ref) - wfg(nondominated(limitSet(sorted, k)), ref)
+	}
+	return volume
+}
+
+// limitSet returns the points after k, each limited to be no better than the
+// k-th point in every objective.
+func limitSet(points [][]float64, k int) [][]float64 {
+	limited := make([][]float64, 0, len(points)-k-1)
+	for _, p := range points[k+1:] {
+		q := make([]float64, len(p))
+		for i := range p {
+			q[i] = math.Max(points[k][i], p[i])
+		}
+		limited = append(limited, q)
+	}
+	return limited
+}
+
+// inclusive returns the volume dominated by a single point.
+func inclusive(p, ref []float64) float64 {
+	volume := 1.0
+	for i := range p {
+		volume *= ref[i] - p[i]
+	}
+	return volume
+}
+
+// nondominated removes dominated and duplicated points.
+func nondominated(points [][]float64) [][]float64 {
+	result := make([][]float64, 0, len(points))
+	for i, p := range points {
+		dominated := false
+		for j, q := range points {
+			if i == j || !weaklyDominates(q, p) {
+				continue
+			}
+			// Keep only the first of a set of duplicates.
+			if !slices.Equal(p, q) || j < i {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// weaklyDominates reports whether a is no worse than b in every objective.
+func weaklyDominates(a, b []float64) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+	}
+	return true
+}
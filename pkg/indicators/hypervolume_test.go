@@ -0,0 +1,138 @@
+package indicators
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHypervolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   [][]float64
+		ref      []float64
+		expected float64
+	}{
+		{
+			name:     "empty front",
+			points:   nil,
+			ref:      []float64{1, 1},
+			expected: 0,
+		},
+		{
+			name:     "single objective",
+			points:   [][]float64{{0.5}, {0.25}},
+			ref:      []float64{1},
+			expected: 0.75,
+		},
+		{
+			name:     "two objectives",
+			points:   [][]float64{{1, 2}, {2, 1}},
+			ref:      []float64{3, 3},
+			expected: 3,
+		},
+		{
+			name:     "two objectives with dominated and duplicated points",
+			points:   [][]float64{{1, 2}, {2, 1}, {2, 2}, {1, 2}},
+			ref:      []float64{3, 3},
+			expected: 3,
+		},
+		{
+			name:     "points outside the reference point are ignored",
+			points:   [][]float64{{1, 2}, {4, 0}},
+			ref:      []float64{3, 3},
+			expected: 2,
+		},
+		{
+			name:     "three objectives",
+			points:   [][]float64{{0, 0, 1}, {1, 1, 0}},
+			ref:      []float64{2, 2, 2},
+			expected: 4 + 2 - 1,
+		},
+		{
+			name:     "four objectives",
+			points:   [][]float64{{0, 1, 1, 1}, {1, 0, 1, 1}},
+			ref:      []float64{2, 2, 2, 2},
+			expected: 2 + 2 - 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, Hypervolume(tt.points, tt.ref), 1e-12)
+		})
+	}
+}
+
+func TestHypervolume_MatchesInclusionExclusion(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+
+	for _, objs := range []int{2, 3, 4, 5} {
+		points := randomFront(random, 8, objs)
+		ref := make([]float64, objs)
+		for i := range ref {
+			ref[i] = 1.1
+		}
+
+		assert.InDelta(t, inclusionExclusion(points, ref), Hypervolume(points, ref), 1e-9,
+			"objectives: %d", objs)
+	}
+}
+
+func TestMonteCarloHypervolume(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	points := randomFront(random, 20, 4)
+	ref := []float64{1.1, 1.1, 1.1, 1.1}
+
+	exact := Hypervolume(points, ref)
+	estimate := MonteCarloHypervolume(points, ref, 200_000, rand.New(rand.NewSource(1)))
+
+	assert.InEpsilon(t, exact, estimate, 0.02)
+	assert.Zero(t, MonteCarloHypervolume(points, ref, 0, random))
+}
+
+// randomFront returns points on the positive part of the unit sphere, which
+// are mutually non-dominated.
+func randomFront(random *rand.Rand, n, objs int) [][]float64 {
+	points := make([][]float64, n)
+	for i := range points {
+		p := make([]float64, objs)
+		norm := 0.0
+		for j := range p {
+			p[j] = random.Float64()
+			norm += p[j] * p[j]
+		}
+		for j := range p {
+			p[j] /= math.Sqrt(norm)
+		}
+		points[i] = p
+	}
+	return points
+}
+
+// inclusionExclusion computes the hypervolume by the inclusion-exclusion
+// principle over every subset of points.
+func inclusionExclusion(points [][]float64, ref []float64) float64 {
+	volume := 0.0
+	for mask := 1; mask < 1<<len(points); mask++ {
+		corner := make([]float64, len(ref))
+		count := 0
+		for i, p := range points {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			count++
+			for j := range p {
+				corner[j] = math.Max(corner[j], p[j])
+			}
+		}
+		sign := -1.0
+		if count%2 == 1 {
+			sign = 1
+		}
+		volume += sign * inclusive(corner, ref)
+	}
+	return volume
+}
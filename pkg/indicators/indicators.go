@@ -0,0 +1,195 @@
+// Package indicators provides quality indicators for approximations of a
+// Pareto front.
+//
+// All indicators assume minimization of every objective, matching the
+// dominance relation used by pkg/de.
+//
+// Supported indicators:
+//   - Hypervolume: exact for 2 and 3 objectives (sweep and slicing), WFG for
+//     more objectives, with a Monte-Carlo estimator for large many-objective
+//     fronts.
+//   - GD, IGD and IGD+: distances between the front and a reference front.
+//   - Spread (generalized Δ) and Spacing (Schott): distribution of the front.
+package indicators
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+const (
+	// DefaultReferenceMargin is the relative margin added beyond the nadir
+	// point of a front when no hypervolume reference point is supplied.
+	DefaultReferenceMargin = 0.1
+
+	// DefaultMonteCarloSamples is the number of samples used when the
+	// hypervolume has to be estimated.
+	DefaultMonteCarloSamples = 100_000
+
+	// DefaultExactPointLimit is the largest front size, for more than three
+	// objectives, for which the exact WFG hypervolume is computed.
+	DefaultExactPointLimit = 256
+)
+
+// Values holds the indicator values computed for a single front.
+//
+// Distance based indicators require a reference front and are nil when none
+// was provided.
+type Values struct {
+	IGD            *float64  `json:"igd,omitempty"`
+	IGDPlus        *float64  `json:"igd_plus,omitempty"`
+	GD             *float64  `json:"gd,omitempty"`
+	ReferencePoint []float64 `json:"reference_point"`
+	Hypervolume    float64   `json:"hypervolume"`
+	Spread         float64   `json:"spread"`
+	Spacing        float64   `json:"spacing"`
+	// HypervolumeEstimated is true when the hypervolume was obtained by
+	// Monte-Carlo sampling instead of an exact algorithm.
+	HypervolumeEstimated bool `json:"hypervolume_estimated,omitempty"`
+}
+
+type options struct {
+	random            *rand.Rand
+	referencePoint    []float64
+	referenceFront    [][]float64
+	monteCarloSamples int
+	exactPointLimit   int
+}
+
+// Option configures how indicators are computed.
+type Option func(*options)
+
+// WithReferencePoint sets the hypervolume reference point. By default it is
+// derived from the nadir point of the front.
+func WithReferencePoint(ref []float64) Option {
+	return func(o *options) { o.referencePoint = ref }
+}
+
+// WithReferenceFront sets the reference (true) Pareto front used by the
+// distance based indicators and by Spread.
+func WithReferenceFront(front []models.Vector) Option {
+	return func(o *options) { o.referenceFront = objectives(front) }
+}
+
+// WithMonteCarloSamples sets the number of samples used when estimating the
+// hypervolume.
+func WithMonteCarloSamples(samples int) Option {
+	return func(o *options) { o.monteCarloSamples = samples }
+}
+
+// WithExactPointLimit sets the largest front size for which the exact WFG
+// hypervolume is used with more than three objectives. Larger fronts fall
+// back to the Monte-Carlo estimator.
+func WithExactPointLimit(limit int) Option {
+	return func(o *options) { o.exactPointLimit = limit }
+}
+
+// WithRandom sets the random source used by the Monte-Carlo estimator.
+func WithRandom(r *rand.Rand) Option {
+	return func(o *options) { o.random = r }
+}
+
+// Compute calculates every indicator for the given front.
+func Compute(front []models.Vector, opts ...Option) Values {
+	o := &options{
+		monteCarloSamples: DefaultMonteCarloSamples,
+		exactPointLimit:   DefaultExactPointLimit,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	points := objectives(front)
+	values := Values{}
+	if len(points) == 0 {
+		return values
+	}
+
+	ref := o.referencePoint
+	if len(ref) == 0 {
+		ref = ReferencePoint(points, DefaultReferenceMargin)
+	}
+	values.ReferencePoint = ref
+
+	if len(ref) > 3 && len(points) > o.exactPointLimit {
+		random := o.random
+		if random == nil {
+			random = rand.New(rand.NewSource(1))
+		}
+		values.Hypervolume = MonteCarloHypervolume(points, ref, o.monteCarloSamples, random)
+		values.HypervolumeEstimated = true
+	} else {
+		values.Hypervolume = Hypervolume(points, ref)
+	}
+
+	values.Spacing = Spacing(points)
+	values.Spread = Spread(points, o.referenceFront)
+
+	if len(o.referenceFront) > 0 {
+		igd := IGD(points, o.referenceFront)
+		igdPlus := IGDPlus(points, o.referenceFront)
+		gd := GD(points, o.referenceFront)
+		values.IGD, values.IGDPlus, values.GD = &igd, &igdPlus, &gd
+	}
+
+	return values
+}
+
+// ReferencePoint returns the nadir point of the front shifted by margin times
+// the range of each objective. Objectives with no range are shifted by margin.
+func ReferencePoint(points [][]float64, margin float64) []float64 {
+	if len(points) == 0 {
+		return nil
+	}
+	ideal, nadir := bounds(points)
+	ref := make([]float64, len(nadir))
+	for i := range nadir {
+		spread := nadir[i] - ideal[i]
+		if spread <= 0 {
+			spread = 1
+		}
+		ref[i] = nadir[i] + margin*spread
+	}
+	return ref
+}
+
+// objectives extracts the objective values of the given vectors.
+func objectives(front []models.Vector) [][]float64 {
+	points := make([][]float64, 0, len(front))
+	for _, v := range front {
+		if len(v.Objectives) > 0 {
+			points = append(points, v.Objectives)
+		}
+	}
+	return points
+}
+
+// bounds returns the ideal and nadir points of a non-empty set of points.
+func bounds(points [][]float64) (ideal, nadir []float64) {
+	m := len(points[0])
+	ideal = make([]float64, m)
+	nadir = make([]float64, m)
+	for i := range m {
+		ideal[i] = math.Inf(1)
+		nadir[i] = math.Inf(-1)
+	}
+	for _, p := range points {
+		for i := range m {
+			ideal[i] = math.Min(ideal[i], p[i])
+			nadir[i] = math.Max(nadir[i], p[i])
+		}
+	}
+	return ideal, nadir
+}
+
+// euclidean returns the Euclidean distance between a and b.
+func euclidean(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
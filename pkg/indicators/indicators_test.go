@@ -0,0 +1,71 @@
+package indicators
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompute(t *testing.T) {
+	front := []models.Vector{
+		{Objectives: []float64{0, 1}},
+		{Objectives: []float64{0.5, 0.5}},
+		{Objectives: []float64{1, 0}},
+	}
+
+	t.Run("without reference front", func(t *testing.T) {
+		values := Compute(front)
+
+		assert.InDeltaSlice(t, []float64{1.1, 1.1}, values.ReferencePoint, 1e-12)
+		assert.InDelta(t, Hypervolume([][]float64{{0, 1}, {0.5, 0.5}, {1, 0}}, values.ReferencePoint),
+			values.Hypervolume, 1e-12)
+		assert.False(t, values.HypervolumeEstimated)
+		assert.Nil(t, values.IGD)
+		assert.Nil(t, values.IGDPlus)
+		assert.Nil(t, values.GD)
+	})
+
+	t.Run("with reference front and point", func(t *testing.T) {
+		values := Compute(front,
+			WithReferencePoint([]float64{2, 2}),
+			WithReferenceFront(front),
+		)
+
+		assert.Equal(t, []float64{2, 2}, values.ReferencePoint)
+		assert.InDelta(t, 3.25, values.Hypervolume, 1e-12)
+		require.NotNil(t, values.IGD)
+		require.NotNil(t, values.IGDPlus)
+		require.NotNil(t, values.GD)
+		assert.Zero(t, *values.IGD)
+		assert.Zero(t, *values.IGDPlus)
+		assert.Zero(t, *values.GD)
+		assert.InDelta(t, 0, values.Spread, 1e-12)
+	})
+
+	t.Run("many objectives beyond the exact limit", func(t *testing.T) {
+		many := []models.Vector{
+			{Objectives: []float64{0, 1, 1, 1}},
+			{Objectives: []float64{1, 0, 1, 1}},
+		}
+		values := Compute(many,
+			WithReferencePoint([]float64{2, 2, 2, 2}),
+			WithExactPointLimit(1),
+		)
+
+		assert.True(t, values.HypervolumeEstimated)
+		assert.InEpsilon(t, 3, values.Hypervolume, 0.05)
+	})
+
+	t.Run("empty front", func(t *testing.T) {
+		assert.Equal(t, Values{}, Compute(nil))
+	})
+}
+
+func TestReferencePoint(t *testing.T) {
+	points := [][]float64{{0, 2}, {1, 2}}
+
+	assert.Equal(t, []float64{1.5, 2.5}, ReferencePoint(points, 0.5))
+	assert.Nil(t, ReferencePoint(nil, 0.5))
+}
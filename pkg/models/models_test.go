@@ -37,6 +37,30 @@ func TestVector_Copy_Empty(t *testing.T) {
 	assert.Empty(t, c.Objectives)
 }
 
+func TestVector_Copy_Constraints(t *testing.T) {
+	v := Vector{Constraints: []float64{0, 0.5}}
+	c := v.Copy()
+	assert.Equal(t, v.Constraints, c.Constraints)
+
+	c.Constraints[1] = 99.9
+	assert.Equal(t, 0.5, v.Constraints[1], "Copy constraints should be independent")
+
+	assert.Nil(t, Vector{}.Copy().Constraints, "unconstrained vectors keep nil constraints")
+}
+
+func TestVector_ConstraintViolation(t *testing.T) {
+	assert.Equal(t, 0.0, Vector{}.ConstraintViolation())
+	assert.True(t, Vector{}.Feasible())
+
+	v := Vector{Constraints: []float64{0.5, 0, 1.5}}
+	assert.Equal(t, 2.0, v.ConstraintViolation())
+	assert.False(t, v.Feasible())
+
+	// Negative values are satisfied constraints and do not offset violations
+	v = Vector{Constraints: []float64{-3, 1}}
+	assert.Equal(t, 1.0, v.ConstraintViolation())
+}
+
 // --- Population tests ---
 
 func TestPopulation_Copy(t *testing.T) {
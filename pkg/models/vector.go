@@ -2,8 +2,11 @@ package models
 
 // Vector is the element of a population in a DE.
 type Vector struct {
-	Elements         []float64
-	Objectives       []float64
+	Elements   []float64
+	Objectives []float64
+	// Constraints holds the violation of each constraint of a constrained
+	// problem, zero when the constraint is satisfied.
+	Constraints      []float64
 	CrowdingDistance float64
 }
 
@@ -16,5 +19,26 @@ func (v Vector) Copy() Vector {
 	}
 	copy(vec.Elements, v.Elements)
 	copy(vec.Objectives, v.Objectives)
+	if v.Constraints != nil {
+		vec.Constraints = make([]float64, len(v.Constraints))
+		copy(vec.Constraints, v.Constraints)
+	}
 	return vec
 }
+
+// ConstraintViolation returns the overall constraint violation of the vector,
+// the sum of its positive constraint values.
+func (v Vector) ConstraintViolation() float64 {
+	total := 0.0
+	for _, c := range v.Constraints {
+		if c > 0 {
+			total += c
+		}
+	}
+	return total
+}
+
+// Feasible reports whether the vector satisfies every constraint.
+func (v Vector) Feasible() bool {
+	return v.ConstraintViolation() == 0
+}
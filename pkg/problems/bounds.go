@@ -0,0 +1,27 @@
+package problems
+
+// BoundsFunc returns the lower and upper bound of every decision variable of
+// a problem with dim variables.
+type BoundsFunc func(dim int) (floor, ceil []float64)
+
+// UniformBounds returns bounds that are the same in every dimension.
+func UniformBounds(floor, ceil float64) BoundsFunc {
+	return func(dim int) ([]float64, []float64) {
+		floors := make([]float64, dim)
+		ceils := make([]float64, dim)
+		for i := range dim {
+			floors[i], ceils[i] = floor, ceil
+		}
+		return floors, ceils
+	}
+}
+
+// DefaultBounds returns the natural domain of the problem's decision
+// variables, which is [0, 1] in every dimension unless the problem declares
+// its own bounds.
+func (m ProblemMetadata) DefaultBounds(dim int) (floor, ceil []float64) {
+	if m.Bounds == nil {
+		return UniformBounds(0, 1)(dim)
+	}
+	return m.Bounds(dim)
+}
@@ -0,0 +1,17 @@
+package custom
+
+import "github.com/nicholaspcr/GoDE/pkg/api/v1"
+
+// FromProto returns the definition of a custom problem of the API.
+func FromProto(p *api.CustomProblem) Definition {
+	def := Definition{
+		Name:        p.GetName(),
+		Objectives:  p.GetObjectives(),
+		Constraints: p.GetConstraints(),
+	}
+	for _, b := range p.GetBounds() {
+		def.Floor = append(def.Floor, b.GetFloor())
+		def.Ceil = append(def.Ceil, b.GetCeil())
+	}
+	return def
+}
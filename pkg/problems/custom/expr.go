@@ -0,0 +1,498 @@
+package custom
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Limits of an expression, which keep the cost of evaluating it bounded. The
+// language has no loops or recursion, so an expression evaluates in time
+// linear to its compiled size.
+const (
+	// MaxExpressionLength is the longest expression source accepted.
+	MaxExpressionLength = 1024
+	// MaxInstructions is the largest size of a compiled expression.
+	MaxInstructions = 256
+	// maxNesting bounds the nesting of operators, parentheses and calls.
+	maxNesting = 64
+)
+
+// unaryFuncs are the functions of one argument.
+var unaryFuncs = map[string]func(float64) float64{
+	"abs":   math.Abs,
+	"acos":  math.Acos,
+	"asin":  math.Asin,
+	"atan":  math.Atan,
+	"ceil":  math.Ceil,
+	"cos":   math.Cos,
+	"cosh":  math.Cosh,
+	"exp":   math.Exp,
+	"floor": math.Floor,
+	"log":   math.Log,
+	"log10": math.Log10,
+	"log2":  math.Log2,
+	"sin":   math.Sin,
+	"sinh":  math.Sinh,
+	"sqrt":  math.Sqrt,
+	"tan":   math.Tan,
+	"tanh":  math.Tanh,
+}
+
+// binaryFuncs are the functions of two arguments, min and max take two or
+// more.
+var binaryFuncs = map[string]func(float64, float64) float64{
+	"atan2": math.Atan2,
+	"hypot": math.Hypot,
+	"max":   math.Max,
+	"min":   math.Min,
+	"pow":   math.Pow,
+}
+
+// variadicFuncs are the binary functions that fold any number of arguments.
+var variadicFuncs = map[string]bool{"max": true, "min": true}
+
+var constants = map[string]float64{
+	"e":  math.E,
+	"pi": math.Pi,
+}
+
+type opcode uint8
+
+const (
+	opConst opcode = iota
+	opVar
+	opNeg
+	opAdd
+	opSub
+	opMul
+	opDiv
+	opPow
+	opCall1
+	opCall2
+)
+
+// instruction is a step of a compiled expression, which runs on a stack.
+type instruction struct {
+	op    opcode
+	value float64 // opConst
+	index int     // opVar
+	fn1   func(float64) float64
+	fn2   func(float64, float64) float64
+}
+
+// Expression is a compiled arithmetic expression over the decision
+// variables x[0] ... x[n-1]. It supports numbers, the constants pi and e, the
+// operators + - * / and ^ (or **), parentheses and the functions abs, acos,
+// asin, atan, atan2, ceil, cos, cosh, exp, floor, hypot, log, log10, log2,
+// max, min, pow, sin, sinh, sqrt, tan and tanh. Expressions are safe for
+// concurrent use.
+type Expression struct {
+	source   string
+	code     []instruction
+	stack    int
+	maxIndex int
+}
+
+// Compile parses an expression.
+func Compile(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	if len(source) > MaxExpressionLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", MaxExpressionLength)
+	}
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, maxIndex: -1}
+	if err := p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+	}
+
+	return &Expression{
+		source:   source,
+		code:     p.code,
+		stack:    p.maxDepth,
+		maxIndex: p.maxIndex,
+	}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// MaxIndex returns the largest index of x used by the expression, -1 when it
+// uses none.
+func (e *Expression) MaxIndex() int {
+	return e.maxIndex
+}
+
+// Eval evaluates the expression at x, which must have more than MaxIndex
+// elements.
+func (e *Expression) Eval(x []float64) float64 {
+	return e.eval(x, make([]float64, e.stack))
+}
+
+// eval evaluates the expression using stack, which must have room for
+// e.stack values.
+func (e *Expression) eval(x, stack []float64) float64 {
+	top := -1
+	for i := range e.code {
+		in := &e.code[i]
+		switch in.op {
+		case opConst:
+			top++
+			stack[top] = in.value
+		case opVar:
+			top++
+			stack[top] = x[in.index]
+		case opNeg:
+			stack[top] = -stack[top]
+		case opCall1:
+			stack[top] = in.fn1(stack[top])
+		default:
+			a, b := stack[top-1], stack[top]
+			top--
+			switch in.op {
+			case opAdd:
+				stack[top] = a + b
+			case opSub:
+				stack[top] = a - b
+			case opMul:
+				stack[top] = a * b
+			case opDiv:
+				stack[top] = a / b
+			case opPow:
+				stack[top] = math.Pow(a, b)
+			case opCall2:
+				stack[top] = in.fn2(a, b)
+			}
+		}
+	}
+	return stack[0]
+}
+
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || (c == '.' && i+1 < len(source) && isDigit(source[i+1])):
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			// Exponent, only when digits follow so "2e" stays invalid
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				j := i + 1
+				if j < len(source) && (source[j] == '+' || source[j] == '-') {
+					j++
+				}
+				if j < len(source) && isDigit(source[j]) {
+					for j < len(source) && isDigit(source[j]) {
+						j++
+					}
+					i = j
+				}
+			}
+			value, err := strconv.ParseFloat(source[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", source[start:i], start+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], value: value, pos: start})
+		case isLetter(c):
+			start := i
+			for i < len(source) && (isLetter(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		case c == '*' && i+1 < len(source) && source[i+1] == '*':
+			tokens = append(tokens, token{kind: tokenOp, text: "^", pos: i})
+			i += 2
+		case strings.IndexByte("+-*/^(),[]", c) >= 0:
+			tokens = append(tokens, token{kind: tokenOp, text: string(c), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+// parser is a recursive descent parser that emits the instructions of the
+// expression in postfix order.
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("-" | "+") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | constant | "x" "[" integer "]" | name "(" expr { "," expr } ")" | "(" expr ")"
+type parser struct {
+	tokens   []token
+	pos      int
+	nesting  int
+	code     []instruction
+	depth    int
+	maxDepth int
+	maxIndex int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token when it is the operator op.
+func (p *parser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == tokenOp && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, got %s", op, tok.pos+1, tok)
+	}
+	return nil
+}
+
+// emit appends an instruction that changes the stack depth by delta.
+func (p *parser) emit(in instruction, delta int) error {
+	if len(p.code) == MaxInstructions {
+		return fmt.Errorf("expression is too complex, the limit is %d operations", MaxInstructions)
+	}
+	p.code = append(p.code, in)
+	p.depth += delta
+	p.maxDepth = max(p.maxDepth, p.depth)
+	return nil
+}
+
+// enter tracks the nesting of the parser, bounding its recursion.
+func (p *parser) enter() error {
+	p.nesting++
+	if p.nesting > maxNesting {
+		return fmt.Errorf("expression is nested more than %d levels deep", maxNesting)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.nesting--
+}
+
+func (p *parser) parseExpr() error {
+	if err := p.enter(); err != nil {
+		return err
+	}
+	defer p.leave()
+
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+	for {
+		var op opcode
+		switch {
+		case p.accept("+"):
+			op = opAdd
+		case p.accept("-"):
+			op = opSub
+		default:
+			return nil
+		}
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+		if err := p.emit(instruction{op: op}, -1); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) parseTerm() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for {
+		var op opcode
+		switch {
+		case p.accept("*"):
+			op = opMul
+		case p.accept("/"):
+			op = opDiv
+		default:
+			return nil
+		}
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+		if err := p.emit(instruction{op: op}, -1); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) parseUnary() error {
+	if err := p.enter(); err != nil {
+		return err
+	}
+	defer p.leave()
+
+	switch {
+	case p.accept("-"):
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+		return p.emit(instruction{op: opNeg}, 0)
+	case p.accept("+"):
+		return p.parseUnary()
+	}
+	return p.parsePower()
+}
+
+// parsePower parses exponentiation, which is right associative and binds
+// tighter than a unary minus on its left: -x^2 is -(x^2).
+func (p *parser) parsePower() error {
+	if err := p.parsePrimary(); err != nil {
+		return err
+	}
+	if !p.accept("^") {
+		return nil
+	}
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	return p.emit(instruction{op: opPow}, -1)
+}
+
+func (p *parser) parsePrimary() error {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		return p.emit(instruction{op: opConst, value: tok.value}, 1)
+	case tokenIdent:
+		if tok.text == "x" {
+			return p.parseVariable()
+		}
+		if p.accept("(") {
+			return p.parseCall(tok)
+		}
+		value, ok := constants[tok.text]
+		if !ok {
+			return fmt.Errorf("unknown identifier %q at position %d", tok.text, tok.pos+1)
+		}
+		return p.emit(instruction{op: opConst, value: value}, 1)
+	case tokenOp:
+		if tok.text == "(" {
+			if err := p.parseExpr(); err != nil {
+				return err
+			}
+			return p.expect(")")
+		}
+	}
+	return fmt.Errorf("unexpected %s at position %d", tok, tok.pos+1)
+}
+
+func (p *parser) parseVariable() error {
+	if err := p.expect("["); err != nil {
+		return err
+	}
+	tok := p.next()
+	index, err := strconv.Atoi(tok.text)
+	if tok.kind != tokenNumber || err != nil || index < 0 {
+		return fmt.Errorf("index of x must be a non-negative integer at position %d, got %s", tok.pos+1, tok)
+	}
+	if err := p.expect("]"); err != nil {
+		return err
+	}
+	p.maxIndex = max(p.maxIndex, index)
+	return p.emit(instruction{op: opVar, index: index}, 1)
+}
+
+func (p *parser) parseCall(name token) error {
+	var args int
+	for {
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		args++
+		if !p.accept(",") {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+
+	if fn, ok := unaryFuncs[name.text]; ok {
+		if args != 1 {
+			return fmt.Errorf("%s takes 1 argument, got %d at position %d", name.text, args, name.pos+1)
+		}
+		return p.emit(instruction{op: opCall1, fn1: fn}, 0)
+	}
+	fn, ok := binaryFuncs[name.text]
+	if !ok {
+		return fmt.Errorf("unknown function %q at position %d", name.text, name.pos+1)
+	}
+	if args != 2 && !(variadicFuncs[name.text] && args > 2) {
+		return fmt.Errorf("%s takes 2 arguments, got %d at position %d", name.text, args, name.pos+1)
+	}
+	// Variadic functions fold their arguments from the left
+	for range args - 1 {
+		if err := p.emit(instruction{op: opCall2, fn2: fn}, -1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
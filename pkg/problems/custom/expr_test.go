@@ -0,0 +1,137 @@
+package custom
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile_Eval(t *testing.T) {
+	x := []float64{0.5, 2, -3}
+
+	tests := []struct {
+		source   string
+		expected float64
+	}{
+		{"1", 1},
+		{"1.5e2", 150},
+		{".25", 0.25},
+		{"x[0]", 0.5},
+		{"x[1] + x[2]", -1},
+		{"x[1] - x[2] - 1", 4},
+		{"2 * 3 + 4", 10},
+		{"2 + 3 * 4", 14},
+		{"(2 + 3) * 4", 20},
+		{"8 / 4 / 2", 1},
+		{"2 ^ 3 ^ 2", 512},
+		{"2 ** 3", 8},
+		{"-2 ^ 2", -4},
+		{"(-2) ^ 2", 4},
+		{"2 ^ -1", 0.5},
+		{"--x[2]", -3},
+		{"+x[1]", 2},
+		{"pi", math.Pi},
+		{"e", math.E},
+		{"sqrt(x[1] * 8)", 4},
+		{"abs(x[2])", 3},
+		{"exp(0) + log(1)", 1},
+		{"sin(pi / 2)", 1},
+		{"pow(x[1], 3)", 8},
+		{"hypot(3, 4)", 5},
+		{"min(x[0], x[1], x[2])", -3},
+		{"max(x[0], x[1], x[2], 7)", 7},
+		{"x[0] * (1 - sqrt(x[0] / x[1]))", 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expr, err := Compile(tt.source)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, expr.Eval(x), 1e-12)
+			assert.Equal(t, tt.source, expr.String())
+		})
+	}
+}
+
+func TestCompile_MaxIndex(t *testing.T) {
+	expr, err := Compile("x[3] + x[10] * x[0]")
+	require.NoError(t, err)
+	assert.Equal(t, 10, expr.MaxIndex())
+
+	expr, err = Compile("1 + pi")
+	require.NoError(t, err)
+	assert.Equal(t, -1, expr.MaxIndex())
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []struct {
+		source  string
+		wantErr string
+	}{
+		{"", "expression is empty"},
+		{"   ", "expression is empty"},
+		{"1 +", "unexpected end of expression at position 4"},
+		{"(1 + 2", `expected ")" at position 7, got end of expression`},
+		{"1 2", `unexpected "2" at position 3`},
+		{"y", `unknown identifier "y" at position 1`},
+		{"x1", `unknown identifier "x1" at position 1`},
+		{"x", `expected "[" at position 2`},
+		{"x[-1]", "index of x must be a non-negative integer at position 3"},
+		{"x[1.5]", "index of x must be a non-negative integer at position 3"},
+		{"x[0", `expected "]" at position 4`},
+		{"foo(1)", `unknown function "foo" at position 1`},
+		{"sqrt(1, 2)", "sqrt takes 1 argument, got 2"},
+		{"pow(1)", "pow takes 2 arguments, got 1"},
+		{"min(1)", "min takes 2 arguments, got 1"},
+		{"1 $ 2", `unexpected character '$' at position 3`},
+		{"1.2.3", `invalid number "1.2.3" at position 1`},
+		{"2e", `unexpected "e" at position 2`},
+		{strings.Repeat("(", 40) + "1" + strings.Repeat(")", 40), "nested more than 64 levels deep"},
+		{strings.Repeat("1+", 130) + "1", "expression is too complex, the limit is 256 operations"},
+		{strings.Repeat("1", MaxExpressionLength+1), "longer than 1024 characters"},
+	}
+	for _, tt := range tests {
+		name := tt.source
+		if len(name) > 20 {
+			name = name[:20] + "..."
+		}
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile(tt.source)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExpression_ConcurrentEval(t *testing.T) {
+	expr, err := Compile("x[0] * x[0] + sin(x[1]) / (1 + x[2] ^ 2)")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			x := []float64{float64(i), 1, 2}
+			expected := float64(i*i) + math.Sin(1)/5
+			for range 100 {
+				assert.InDelta(t, expected, expr.Eval(x), 1e-12)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func BenchmarkExpression_Eval(b *testing.B) {
+	expr, err := Compile("(1 + 9 * (x[1] + x[2] + x[3]) / 3) * (1 - sqrt(x[0] / (1 + 9 * (x[1] + x[2] + x[3]) / 3)))")
+	require.NoError(b, err)
+	x := []float64{0.5, 0.1, 0.2, 0.3}
+	stack := make([]float64, expr.stack)
+
+	b.ResetTimer()
+	for range b.N {
+		expr.eval(x, stack)
+	}
+}
@@ -0,0 +1,218 @@
+// Package custom implements problems defined by closed-form expressions over
+// the decision variables, compiled into a sandboxed evaluator. Expressions
+// can only do arithmetic on the variables, with no I/O, loops or recursion,
+// and their size is bounded so every evaluation has a bounded cost.
+package custom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// Category is the category of custom problems.
+const Category = "custom"
+
+const (
+	// MaxObjectives is the most objectives a custom problem can have.
+	MaxObjectives = 10
+	// MaxConstraints is the most constraints a custom problem can have.
+	MaxConstraints = 20
+
+	defaultMaxDim = 1000
+)
+
+// Definition describes a custom problem.
+type Definition struct {
+	Name string
+	// Objectives are the expressions of the minimized objectives.
+	Objectives []string
+	// Constraints are expressions g(x) that are satisfied when g(x) <= 0.
+	Constraints []string
+	// Floor and Ceil are the bounds of each decision variable, which fix
+	// the dimensions of the problem. Empty means [0, 1] in any dimensions.
+	Floor []float64
+	Ceil  []float64
+}
+
+// compiled holds the compiled expressions of a definition.
+type compiled struct {
+	objectives  []*Expression
+	constraints []*Expression
+	maxIndex    int
+	stack       int
+}
+
+func (d Definition) compile() (*compiled, error) {
+	if len(d.Objectives) == 0 {
+		return nil, errors.New("custom problem needs at least one objective")
+	}
+	if len(d.Objectives) > MaxObjectives {
+		return nil, fmt.Errorf("custom problem has %d objectives, the limit is %d", len(d.Objectives), MaxObjectives)
+	}
+	if len(d.Constraints) > MaxConstraints {
+		return nil, fmt.Errorf("custom problem has %d constraints, the limit is %d", len(d.Constraints), MaxConstraints)
+	}
+
+	c := &compiled{maxIndex: -1}
+	add := func(kind string, i int, source string) (*Expression, error) {
+		expr, err := Compile(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s %d: %w", kind, i+1, err)
+		}
+		c.maxIndex = max(c.maxIndex, expr.MaxIndex())
+		c.stack = max(c.stack, expr.stack)
+		return expr, nil
+	}
+	for i, source := range d.Objectives {
+		expr, err := add("objective", i, source)
+		if err != nil {
+			return nil, err
+		}
+		c.objectives = append(c.objectives, expr)
+	}
+	for i, source := range d.Constraints {
+		expr, err := add("constraint", i, source)
+		if err != nil {
+			return nil, err
+		}
+		c.constraints = append(c.constraints, expr)
+	}
+
+	if len(d.Floor) != len(d.Ceil) {
+		return nil, fmt.Errorf("custom problem has %d floors and %d ceils", len(d.Floor), len(d.Ceil))
+	}
+	for i := range d.Floor {
+		if math.IsNaN(d.Floor[i]) || math.IsInf(d.Floor[i], 0) || math.IsNaN(d.Ceil[i]) || math.IsInf(d.Ceil[i], 0) {
+			return nil, fmt.Errorf("bounds of x[%d] must be finite", i)
+		}
+		if d.Floor[i] > d.Ceil[i] {
+			return nil, fmt.Errorf("floor of x[%d] is above its ceil", i)
+		}
+	}
+	if len(d.Floor) > 0 && c.maxIndex >= len(d.Floor) {
+		return nil, fmt.Errorf("expressions use x[%d] but the bounds have %d dimensions", c.maxIndex, len(d.Floor))
+	}
+	return c, nil
+}
+
+// Validate checks that the expressions compile and the bounds are
+// consistent.
+func (d Definition) Validate() error {
+	_, err := d.compile()
+	return err
+}
+
+// Metadata returns the metadata of the problem. Its dimensions range from
+// the largest variable the expressions use, or are fixed by the bounds.
+func (d Definition) Metadata() (problems.ProblemMetadata, error) {
+	c, err := d.compile()
+	if err != nil {
+		return problems.ProblemMetadata{}, err
+	}
+	return d.metadata(c), nil
+}
+
+func (d Definition) metadata(c *compiled) problems.ProblemMetadata {
+	meta := problems.ProblemMetadata{
+		Name:     d.Name,
+		MinDim:   max(1, c.maxIndex+1),
+		MaxDim:   defaultMaxDim,
+		NumObjs:  len(d.Objectives),
+		Category: Category,
+	}
+	if len(d.Floor) > 0 {
+		floor, ceil := d.Floor, d.Ceil
+		meta.MinDim, meta.MaxDim = len(floor), len(floor)
+		meta.Bounds = func(int) ([]float64, []float64) {
+			return append([]float64(nil), floor...), append([]float64(nil), ceil...)
+		}
+	}
+	return meta
+}
+
+// Problem evaluates the compiled expressions of a definition.
+type Problem struct {
+	name        string
+	dim         int
+	objectives  []*Expression
+	constraints []*Expression
+	stack       int
+}
+
+// New compiles the definition into a problem with dim decision variables and
+// objs objectives.
+func New(def Definition, dim, objs int) (*Problem, error) {
+	c, err := def.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := def.metadata(c)
+	if dim < meta.MinDim || dim > meta.MaxDim {
+		return nil, fmt.Errorf("custom problem %s supports %d to %d dimensions, got %d", def.Name, meta.MinDim, meta.MaxDim, dim)
+	}
+	if objs != meta.NumObjs {
+		return nil, fmt.Errorf("custom problem %s has %d objectives, got %d", def.Name, meta.NumObjs, objs)
+	}
+
+	return &Problem{
+		name:        def.Name,
+		dim:         dim,
+		objectives:  c.objectives,
+		constraints: c.constraints,
+		stack:       c.stack,
+	}, nil
+}
+
+// Name returns the name of the problem.
+func (p *Problem) Name() string {
+	return p.name
+}
+
+// NumConstraints returns the amount of constraint expressions.
+func (p *Problem) NumConstraints() int {
+	return len(p.constraints)
+}
+
+// Evaluate computes the objectives and the constraint violations of the
+// vector. Objectives that are not finite numbers fail the evaluation.
+func (p *Problem) Evaluate(e *models.Vector, M int) error {
+	if len(e.Elements) != p.dim {
+		return fmt.Errorf("custom problem %s expects %d dimensions, got %d", p.name, p.dim, len(e.Elements))
+	}
+	if M != len(p.objectives) {
+		return fmt.Errorf("custom problem %s has %d objectives, got %d", p.name, len(p.objectives), M)
+	}
+
+	stack := make([]float64, p.stack)
+	objectives := make([]float64, M)
+	for i, expr := range p.objectives {
+		v := expr.eval(e.Elements, stack)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("objective %d of custom problem %s is %v at %v", i+1, p.name, v, e.Elements)
+		}
+		objectives[i] = v
+	}
+
+	var constraints []float64
+	if len(p.constraints) > 0 {
+		constraints = make([]float64, len(p.constraints))
+		for i, expr := range p.constraints {
+			v := expr.eval(e.Elements, stack)
+			if math.IsNaN(v) {
+				return fmt.Errorf("constraint %d of custom problem %s is NaN at %v", i+1, p.name, e.Elements)
+			}
+			constraints[i] = math.Max(0, v)
+		}
+	}
+
+	e.Objectives = objectives
+	e.Constraints = constraints
+	return nil
+}
+
+var _ problems.Constrained = (*Problem)(nil)
@@ -0,0 +1,142 @@
+package custom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems/multi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// srn is the SRN benchmark written as a custom problem.
+func srn() Definition {
+	return Definition{
+		Name: "my-srn",
+		Objectives: []string{
+			"2 + (x[0] - 2)^2 + (x[1] - 1)^2",
+			"9 * x[0] - (x[1] - 1)^2",
+		},
+		Constraints: []string{
+			"x[0]^2 + x[1]^2 - 225",
+			"x[0] - 3 * x[1] + 10",
+		},
+		Floor: []float64{-20, -20},
+		Ceil:  []float64{20, 20},
+	}
+}
+
+func TestProblem_MatchesBuiltIn(t *testing.T) {
+	p, err := New(srn(), 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "my-srn", p.Name())
+	assert.Equal(t, 2, p.NumConstraints())
+
+	builtin := multi.Srn()
+	for _, x := range [][]float64{{0, 0}, {-2.5, 10}, {15, -15}, {19, 19}} {
+		expected := models.Vector{Elements: x}
+		require.NoError(t, builtin.Evaluate(&expected, 2))
+
+		actual := models.Vector{Elements: x}
+		require.NoError(t, p.Evaluate(&actual, 2))
+		assert.InDeltaSlice(t, expected.Objectives, actual.Objectives, 1e-9)
+		assert.InDeltaSlice(t, expected.Constraints, actual.Constraints, 1e-9)
+	}
+}
+
+func TestProblem_Evaluate(t *testing.T) {
+	p, err := New(Definition{Name: "sphere", Objectives: []string{"x[0]^2 + x[1]^2", "(x[0] - 1)^2"}}, 3, 2)
+	require.NoError(t, err)
+
+	v := models.Vector{Elements: []float64{0.5, 0.5, 0.9}}
+	require.NoError(t, p.Evaluate(&v, 2))
+	assert.Equal(t, []float64{0.5, 0.25}, v.Objectives)
+	assert.Nil(t, v.Constraints)
+
+	assert.ErrorContains(t, p.Evaluate(&models.Vector{Elements: []float64{1, 2}}, 2), "expects 3 dimensions, got 2")
+	assert.ErrorContains(t, p.Evaluate(&v, 3), "has 2 objectives, got 3")
+
+	p, err = New(Definition{Name: "log", Objectives: []string{"log(x[0])"}}, 1, 1)
+	require.NoError(t, err)
+	assert.ErrorContains(t, p.Evaluate(&models.Vector{Elements: []float64{0}}, 1), "objective 1 of custom problem log is -Inf")
+	assert.ErrorContains(t, p.Evaluate(&models.Vector{Elements: []float64{-1}}, 1), "is NaN")
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(srn(), 3, 2)
+	assert.ErrorContains(t, err, "supports 2 to 2 dimensions, got 3")
+
+	_, err = New(srn(), 2, 3)
+	assert.ErrorContains(t, err, "has 2 objectives, got 3")
+
+	_, err = New(Definition{Name: "bad", Objectives: []string{"x["}}, 2, 1)
+	assert.ErrorContains(t, err, "objective 1: index of x must be a non-negative integer")
+
+	_, err = New(Definition{Name: "wide", Objectives: []string{"x[4]"}}, 4, 1)
+	assert.ErrorContains(t, err, "supports 5 to 1000 dimensions, got 4")
+}
+
+func TestDefinition_Validate(t *testing.T) {
+	require.NoError(t, srn().Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(*Definition)
+		wantErr string
+	}{
+		{"no objectives", func(d *Definition) { d.Objectives = nil }, "needs at least one objective"},
+		{"too many objectives", func(d *Definition) { d.Objectives = make([]string, 11) }, "has 11 objectives, the limit is 10"},
+		{"too many constraints", func(d *Definition) { d.Constraints = make([]string, 21) }, "has 21 constraints, the limit is 20"},
+		{"invalid constraint", func(d *Definition) { d.Constraints[1] = "x[0] <= 1" }, `constraint 2: unexpected character '<'`},
+		{"mismatched bounds", func(d *Definition) { d.Ceil = d.Ceil[:1] }, "has 2 floors and 1 ceils"},
+		{"inverted bounds", func(d *Definition) { d.Floor = []float64{-20, 30} }, "floor of x[1] is above its ceil"},
+		{"infinite bounds", func(d *Definition) { d.Ceil = []float64{math.Inf(1), 20} }, "bounds of x[0] must be finite"},
+		{"bounds smaller than the expressions", func(d *Definition) { d.Floor, d.Ceil = d.Floor[:1], d.Ceil[:1] }, "use x[1] but the bounds have 1 dimensions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := srn()
+			def.Constraints = append([]string(nil), def.Constraints...)
+			tt.modify(&def)
+			assert.ErrorContains(t, def.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestDefinition_Metadata(t *testing.T) {
+	meta, err := srn().Metadata()
+	require.NoError(t, err)
+	assert.Equal(t, "my-srn", meta.Name)
+	assert.Equal(t, Category, meta.Category)
+	assert.Equal(t, 2, meta.MinDim)
+	assert.Equal(t, 2, meta.MaxDim)
+	assert.Equal(t, 2, meta.NumObjs)
+	floor, ceil := meta.DefaultBounds(2)
+	assert.Equal(t, []float64{-20, -20}, floor)
+	assert.Equal(t, []float64{20, 20}, ceil)
+
+	meta, err = Definition{Name: "free", Objectives: []string{"x[2]", "1"}}.Metadata()
+	require.NoError(t, err)
+	assert.Equal(t, 3, meta.MinDim)
+	assert.Equal(t, 1000, meta.MaxDim)
+	floor, ceil = meta.DefaultBounds(4)
+	assert.Equal(t, []float64{0, 0, 0, 0}, floor)
+	assert.Equal(t, []float64{1, 1, 1, 1}, ceil)
+
+	_, err = Definition{Name: "empty"}.Metadata()
+	assert.Error(t, err)
+}
+
+func TestFromProto(t *testing.T) {
+	def := FromProto(&api.CustomProblem{
+		Name:        "my-srn",
+		Description: "ignored",
+		Objectives:  srn().Objectives,
+		Constraints: srn().Constraints,
+		Bounds:      []*api.Bounds{{Floor: -20, Ceil: 20}, {Floor: -20, Ceil: 20}},
+	})
+	assert.Equal(t, srn(), def)
+	assert.Equal(t, Definition{}, FromProto(nil))
+}
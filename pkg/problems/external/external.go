@@ -0,0 +1,134 @@
+// Package external implements problems whose objectives are computed by an
+// evaluator running as a separate process, such as a Python or C++
+// simulator.
+//
+// The evaluator reads requests from its stdin and writes one response to its
+// stdout for each of them, one JSON document per line:
+//
+//	{"id":1,"objectives":2,"vectors":[[0.1,0.2,0.3],[0.4,0.5,0.6]]}
+//	{"id":1,"objectives":[[0.1,2.9],[0.4,2.1]],"constraints":[[0],[0.25]]}
+//
+// A request holds a batch of decision vectors and the number of objectives
+// to compute. The response holds the objectives of each vector, in the same
+// order, and for problems with constraints the violation of each constraint,
+// zero when it is satisfied. A response with an "error" message fails the
+// batch. The evaluator exits when its stdin is closed, and what it writes to
+// stderr is logged.
+package external
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// Category is the category of the problems registered by Register.
+const Category = "external"
+
+const (
+	defaultTimeout = time.Minute
+	defaultMaxDim  = 1000
+)
+
+// Config describes an external problem and the evaluator computing it.
+type Config struct {
+	// Name is the name the problem is registered with.
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	// Command and Args start the evaluator, in Dir when set. The evaluator
+	// only gets PATH, HOME, LANG, LC_ALL and TMPDIR from the environment of
+	// the server, which holds its secrets, plus Env ("KEY=value"). InheritEnv
+	// passes the whole environment of the server instead.
+	Command    string   `mapstructure:"command"`
+	Args       []string `mapstructure:"args"`
+	Dir        string   `mapstructure:"dir"`
+	Env        []string `mapstructure:"env"`
+	InheritEnv bool     `mapstructure:"inherit_env"`
+	// Objectives is the number of objectives of the problem, 0 accepts any.
+	Objectives int `mapstructure:"objectives"`
+	// Constraints is the number of constraint violations the evaluator
+	// reports for each vector.
+	Constraints int `mapstructure:"constraints"`
+	MinDim      int `mapstructure:"min_dim"`
+	MaxDim      int `mapstructure:"max_dim"`
+	// Floor and Ceil are the default bounds of every decision variable,
+	// both zero means [0, 1].
+	Floor float64 `mapstructure:"floor"`
+	Ceil  float64 `mapstructure:"ceil"`
+	// Processes is how many evaluator processes each execution starts to
+	// evaluate batches concurrently, 0 starts one.
+	Processes int `mapstructure:"processes"`
+	// Timeout bounds the evaluation of a batch, the evaluator is restarted
+	// when it runs out. 0 means one minute.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Validate checks that the configuration describes a usable problem.
+func (c Config) Validate() error {
+	if c.Name == "" {
+		return errors.New("external problem name cannot be empty")
+	}
+	if c.Command == "" {
+		return fmt.Errorf("external problem %s: command cannot be empty", c.Name)
+	}
+	if c.Objectives < 0 || c.Constraints < 0 || c.Processes < 0 || c.Timeout < 0 {
+		return fmt.Errorf("external problem %s: objectives, constraints, processes and timeout cannot be negative", c.Name)
+	}
+	if c.MinDim < 0 || (c.MaxDim != 0 && c.MaxDim < c.MinDim) {
+		return fmt.Errorf("external problem %s: invalid dimension range [%d, %d]", c.Name, c.MinDim, c.MaxDim)
+	}
+	if c.Floor > c.Ceil {
+		return fmt.Errorf("external problem %s: floor %v is above ceil %v", c.Name, c.Floor, c.Ceil)
+	}
+	return nil
+}
+
+func (c Config) withDefaults() Config {
+	if c.MinDim == 0 {
+		c.MinDim = 1
+	}
+	if c.MaxDim == 0 {
+		c.MaxDim = defaultMaxDim
+	}
+	if c.Processes == 0 {
+		c.Processes = 1
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	return c
+}
+
+// Register adds the problem described by cfg to the registry. Registering a
+// name again replaces the previous external problem, but built-in problems
+// cannot be replaced.
+func Register(r *problems.Registry, cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if meta, ok := r.Get(cfg.Name); ok && meta.Category != Category {
+		return fmt.Errorf("external problem %s: a built-in problem has the same name", cfg.Name)
+	}
+	cfg = cfg.withDefaults()
+
+	meta := problems.ProblemMetadata{
+		Description: cfg.Description,
+		MinDim:      cfg.MinDim,
+		MaxDim:      cfg.MaxDim,
+		NumObjs:     cfg.Objectives,
+		Category:    Category,
+	}
+	if meta.Description == "" {
+		meta.Description = fmt.Sprintf("External problem evaluated by %s", cfg.Command)
+	}
+	if cfg.Floor != 0 || cfg.Ceil != 0 {
+		meta.Bounds = problems.UniformBounds(cfg.Floor, cfg.Ceil)
+	}
+
+	r.Register(cfg.Name, func(dim, objs int) (problems.Interface, error) {
+		return New(cfg, dim, objs)
+	}, meta)
+	return nil
+}
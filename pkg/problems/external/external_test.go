@@ -0,0 +1,357 @@
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/problems/multi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubEnv selects the behaviour of the test binary when it runs as a stub
+// evaluator.
+const stubEnv = "GODE_EXTERNAL_STUB"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(stubEnv); mode != "" {
+		runStub(mode)
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runStub evaluates ZDT1 with the constraint sum(x) <= 1 following the
+// protocol, misbehaving as mode asks.
+func runStub(mode string) {
+	if mode == "deaf" {
+		time.Sleep(time.Hour)
+	}
+	reader := bufio.NewReader(os.Stdin)
+	out := json.NewEncoder(os.Stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			fmt.Fprintln(os.Stderr, "bad request:", err)
+			return
+		}
+
+		switch {
+		case mode == "crash":
+			os.Exit(1)
+		case mode == "hang":
+			time.Sleep(time.Hour)
+		case mode == "fail-odd" && req.ID%2 == 1:
+			_ = out.Encode(response{ID: req.ID, Error: "odd request"})
+			continue
+		}
+
+		resp := response{ID: req.ID}
+		for _, x := range req.Vectors {
+			g := 0.0
+			sum := x[0]
+			for _, xi := range x[1:] {
+				g += xi
+				sum += xi
+			}
+			g = 1 + 9*g/float64(len(x)-1)
+			objs := []float64{x[0], g * (1 - math.Sqrt(x[0]/g))}
+			if mode == "short" {
+				objs = objs[:1]
+			}
+			resp.Objectives = append(resp.Objectives, objs)
+			resp.Constraints = append(resp.Constraints, []float64{sum - 1})
+		}
+		_ = out.Encode(resp)
+	}
+}
+
+// stubConfig returns the configuration of a problem evaluated by the stub.
+func stubConfig(mode string) Config {
+	return Config{
+		Name:        "stub",
+		Command:     os.Args[0],
+		Env:         []string{stubEnv + "=" + mode},
+		Objectives:  2,
+		Constraints: 1,
+		MinDim:      2,
+		MaxDim:      30,
+		Timeout:     10 * time.Second,
+	}
+}
+
+func newStubProblem(t *testing.T, cfg Config, dim int) *Problem {
+	t.Helper()
+	p, err := New(cfg, dim, 2)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Close() })
+	return p
+}
+
+func stubVectors(n, dim int) []models.Vector {
+	vectors := make([]models.Vector, n)
+	for i := range vectors {
+		elements := make([]float64, dim)
+		for j := range elements {
+			elements[j] = float64((i+j)%10) / 10
+		}
+		vectors[i] = models.Vector{Elements: elements}
+	}
+	return vectors
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := stubConfig("zdt1")
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{"empty name", func(c *Config) { c.Name = "" }, "name cannot be empty"},
+		{"empty command", func(c *Config) { c.Command = "" }, "command cannot be empty"},
+		{"negative processes", func(c *Config) { c.Processes = -1 }, "cannot be negative"},
+		{"negative timeout", func(c *Config) { c.Timeout = -time.Second }, "cannot be negative"},
+		{"max below min dimensions", func(c *Config) { c.MinDim, c.MaxDim = 5, 2 }, "invalid dimension range"},
+		{"floor above ceil", func(c *Config) { c.Floor, c.Ceil = 1, -1 }, "floor 1 is above ceil -1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := stubConfig("zdt1")
+			tt.modify(&cfg)
+			assert.ErrorContains(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	r := problems.NewRegistry()
+	cfg := stubConfig("zdt1")
+	cfg.Floor, cfg.Ceil = -1, 1
+	require.NoError(t, Register(r, cfg))
+
+	meta, ok := r.Get("stub")
+	require.True(t, ok)
+	assert.Equal(t, Category, meta.Category)
+	assert.Equal(t, 2, meta.NumObjs)
+	assert.Equal(t, 2, meta.MinDim)
+	assert.Equal(t, 30, meta.MaxDim)
+	assert.Contains(t, meta.Description, os.Args[0])
+	floor, ceil := meta.DefaultBounds(3)
+	assert.Equal(t, []float64{-1, -1, -1}, floor)
+	assert.Equal(t, []float64{1, 1, 1}, ceil)
+
+	p, err := r.Create("stub", 3, 2)
+	require.NoError(t, err)
+	require.Implements(t, (*problems.BatchEvaluator)(nil), p)
+	assert.NoError(t, p.(*Problem).Close())
+
+	// External problems can be registered again, built-in ones are kept
+	require.NoError(t, Register(r, cfg))
+	r.Register("builtin", func(int, int) (problems.Interface, error) { return nil, nil }, problems.ProblemMetadata{Category: "multi"})
+	cfg.Name = "builtin"
+	assert.ErrorContains(t, Register(r, cfg), "built-in problem has the same name")
+
+	assert.Error(t, Register(r, Config{Name: "no-command"}))
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(stubConfig("zdt1"), 1, 2)
+	assert.ErrorContains(t, err, "supports 2 to 30 dimensions, got 1")
+
+	_, err = New(stubConfig("zdt1"), 5, 3)
+	assert.ErrorContains(t, err, "has 2 objectives, got 3")
+
+	p, err := New(stubConfig("zdt1"), 5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "stub", p.Name())
+	assert.Equal(t, 1, p.NumConstraints())
+	assert.NoError(t, p.Close())
+}
+
+func TestProblem_EvaluateBatch(t *testing.T) {
+	ctx := context.Background()
+	p := newStubProblem(t, stubConfig("zdt1"), 5)
+
+	vectors := stubVectors(20, 5)
+	require.NoError(t, p.EvaluateBatch(ctx, vectors, 2))
+
+	zdt1 := multi.Zdt1()
+	for _, v := range vectors {
+		expected := models.Vector{Elements: v.Elements}
+		require.NoError(t, zdt1.Evaluate(&expected, 2))
+		assert.InDeltaSlice(t, expected.Objectives, v.Objectives, 1e-12)
+
+		sum := 0.0
+		for _, x := range v.Elements {
+			sum += x
+		}
+		assert.Equal(t, []float64{math.Max(0, sum-1)}, v.Constraints, "violations are never negative")
+	}
+
+	single := models.Vector{Elements: vectors[3].Elements}
+	require.NoError(t, p.Evaluate(&single, 2))
+	assert.Equal(t, vectors[3], single)
+
+	assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(1, 4), 2), "expects 5 dimensions, got 4")
+	assert.NoError(t, p.EvaluateBatch(ctx, nil, 2))
+}
+
+func TestProblem_ConcurrentBatches(t *testing.T) {
+	ctx := context.Background()
+	cfg := stubConfig("zdt1")
+	cfg.Processes = 3
+	p := newStubProblem(t, cfg, 5)
+
+	expected := stubVectors(10, 5)
+	require.NoError(t, p.EvaluateBatch(ctx, expected, 2))
+
+	var wg sync.WaitGroup
+	results := make([][]models.Vector, 8)
+	errs := make([]error, len(results))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = stubVectors(10, 5)
+			errs[i] = p.EvaluateBatch(ctx, results[i], 2)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range results {
+		require.NoError(t, errs[i])
+		assert.Equal(t, expected, results[i])
+	}
+}
+
+func TestProblem_EvaluatorFailures(t *testing.T) {
+	ctx := context.Background()
+	t.Run("reported errors keep the evaluator", func(t *testing.T) {
+		p := newStubProblem(t, stubConfig("fail-odd"), 3)
+
+		err := p.EvaluateBatch(ctx, stubVectors(2, 3), 2)
+		var evalErr *EvaluationError
+		require.ErrorAs(t, err, &evalErr)
+		assert.Equal(t, "odd request", evalErr.Message)
+
+		// The same process answers the second request
+		assert.NoError(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2))
+	})
+
+	t.Run("crashed evaluators are restarted", func(t *testing.T) {
+		p := newStubProblem(t, stubConfig("crash"), 3)
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), "evaluator exited before responding")
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), "evaluator exited before responding")
+	})
+
+	t.Run("evaluators out of time are killed", func(t *testing.T) {
+		cfg := stubConfig("hang")
+		cfg.Timeout = 100 * time.Millisecond
+		p := newStubProblem(t, cfg, 3)
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), "did not respond within 100ms")
+	})
+
+	t.Run("evaluators not reading their requests are killed", func(t *testing.T) {
+		cfg := stubConfig("deaf")
+		cfg.MaxDim = 1000
+		cfg.Timeout = 100 * time.Millisecond
+		p := newStubProblem(t, cfg, 1000)
+		// The request is larger than the pipe buffer, writing it blocks
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(100, 1000), 2), "did not respond within 100ms")
+	})
+
+	t.Run("cancelled evaluations are killed", func(t *testing.T) {
+		p := newStubProblem(t, stubConfig("hang"), 3)
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		assert.ErrorIs(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second, "the timeout of the problem is not waited for")
+	})
+
+	t.Run("responses must match the request", func(t *testing.T) {
+		p := newStubProblem(t, stubConfig("short"), 3)
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), "returned 1 objectives for vector 0, expected 2")
+	})
+
+	t.Run("missing commands fail to start", func(t *testing.T) {
+		cfg := stubConfig("zdt1")
+		cfg.Command = "/nonexistent/evaluator"
+		p := newStubProblem(t, cfg, 3)
+		assert.ErrorContains(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), "failed to start evaluator")
+	})
+}
+
+func TestProblem_ExampleEvaluator(t *testing.T) {
+	ctx := context.Background()
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 is not installed")
+	}
+
+	cfg := stubConfig("")
+	cfg.Command = python
+	cfg.Args = []string{filepath.Join("..", "..", "..", "examples", "external-evaluator", "evaluator.py")}
+	cfg.Env = nil
+	p := newStubProblem(t, cfg, 5)
+
+	vectors := stubVectors(10, 5)
+	require.NoError(t, p.EvaluateBatch(ctx, vectors, 2))
+
+	expected := stubVectors(10, 5)
+	stub := newStubProblem(t, stubConfig("zdt1"), 5)
+	require.NoError(t, stub.EvaluateBatch(ctx, expected, 2))
+	for i := range vectors {
+		assert.InDeltaSlice(t, expected[i].Objectives, vectors[i].Objectives, 1e-12)
+		assert.InDeltaSlice(t, expected[i].Constraints, vectors[i].Constraints, 1e-12)
+	}
+
+	var evalErr *EvaluationError
+	assert.ErrorAs(t, p.EvaluateBatch(ctx, stubVectors(1, 5), 3), &evalErr, "the example only has 2 objectives")
+}
+
+func TestProblem_Close(t *testing.T) {
+	ctx := context.Background()
+	p, err := New(stubConfig("zdt1"), 3, 2)
+	require.NoError(t, err)
+	require.NoError(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2))
+
+	require.NoError(t, p.Close())
+	assert.NoError(t, p.Close(), "closing twice is a no-op")
+	assert.ErrorIs(t, p.EvaluateBatch(ctx, stubVectors(2, 3), 2), ErrClosed)
+}
+
+func TestEvaluatorEnv(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	t.Setenv("JWT_SECRET", "secret")
+
+	t.Run("server secrets are not passed", func(t *testing.T) {
+		env := evaluatorEnv(Config{Env: []string{"SIMULATOR_HOME=/opt/sim"}})
+		assert.Contains(t, env, "PATH=/usr/bin")
+		assert.Contains(t, env, "SIMULATOR_HOME=/opt/sim")
+		assert.NotContains(t, env, "JWT_SECRET=secret")
+	})
+
+	t.Run("inherit env passes the server environment", func(t *testing.T) {
+		env := evaluatorEnv(Config{Env: []string{"SIMULATOR_HOME=/opt/sim"}, InheritEnv: true})
+		assert.Contains(t, env, "JWT_SECRET=secret")
+		assert.Contains(t, env, "SIMULATOR_HOME=/opt/sim")
+	})
+}
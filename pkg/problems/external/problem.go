@@ -0,0 +1,208 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// ErrClosed is returned when evaluating with a closed problem.
+var ErrClosed = errors.New("external problem is closed")
+
+// Problem is a problem evaluated by external processes. The processes start
+// with the first evaluation and are stopped by Close. It is safe for
+// concurrent use, each process evaluates one batch at a time.
+type Problem struct {
+	cfg       Config
+	dim, objs int
+
+	// slots holds the idle processes, nil for the ones not started yet
+	slots  chan *process
+	mu     sync.Mutex
+	closed bool
+}
+
+// New returns the external problem described by cfg with dim decision
+// variables and objs objectives.
+func New(cfg Config, dim, objs int) (*Problem, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg = cfg.withDefaults()
+
+	if dim < cfg.MinDim || dim > cfg.MaxDim {
+		return nil, fmt.Errorf("problem %s supports %d to %d dimensions, got %d", cfg.Name, cfg.MinDim, cfg.MaxDim, dim)
+	}
+	if cfg.Objectives != 0 && objs != cfg.Objectives {
+		return nil, fmt.Errorf("problem %s has %d objectives, got %d", cfg.Name, cfg.Objectives, objs)
+	}
+
+	p := &Problem{
+		cfg:   cfg,
+		dim:   dim,
+		objs:  objs,
+		slots: make(chan *process, cfg.Processes),
+	}
+	for range cfg.Processes {
+		p.slots <- nil
+	}
+	return p, nil
+}
+
+// Name returns the name the problem is registered with.
+func (p *Problem) Name() string {
+	return p.cfg.Name
+}
+
+// NumConstraints returns how many constraint violations the evaluator
+// reports.
+func (p *Problem) NumConstraints() int {
+	return p.cfg.Constraints
+}
+
+// Evaluate computes the objectives of a single vector, prefer EvaluateBatch
+// as every call is a round trip to an evaluator.
+func (p *Problem) Evaluate(v *models.Vector, objs int) error {
+	batch := []models.Vector{*v}
+	if err := p.EvaluateBatch(context.Background(), batch, objs); err != nil {
+		return err
+	}
+	*v = batch[0]
+	return nil
+}
+
+// EvaluateBatch sends the vectors to an idle evaluator and sets their
+// objectives, and constraint violations, from its response. The evaluator is
+// killed when ctx is done before it responds.
+func (p *Problem) EvaluateBatch(ctx context.Context, vectors []models.Vector, objs int) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	for i := range vectors {
+		if len(vectors[i].Elements) != p.dim {
+			return fmt.Errorf("problem %s expects %d dimensions, got %d", p.cfg.Name, p.dim, len(vectors[i].Elements))
+		}
+	}
+
+	proc, err := p.acquire()
+	if err != nil {
+		return err
+	}
+
+	elements := make([][]float64, len(vectors))
+	for i := range vectors {
+		elements[i] = vectors[i].Elements
+	}
+	resp, err := proc.call(ctx, elements, objs, p.cfg.Timeout)
+	if err == nil {
+		err = p.checkResponse(resp, len(vectors), objs)
+	}
+	if err != nil {
+		// A process that failed mid-call may be out of sync with its
+		// responses, the next batch starts a new one
+		var evalErr *EvaluationError
+		if errors.As(err, &evalErr) {
+			p.release(proc)
+		} else {
+			proc.stop()
+			p.release(nil)
+		}
+		return fmt.Errorf("problem %s: %w", p.cfg.Name, err)
+	}
+	p.release(proc)
+
+	for i := range vectors {
+		vectors[i].Objectives = resp.Objectives[i]
+		if p.cfg.Constraints > 0 {
+			violations := make([]float64, p.cfg.Constraints)
+			for j, c := range resp.Constraints[i] {
+				violations[j] = math.Max(0, c)
+			}
+			vectors[i].Constraints = violations
+		}
+	}
+	return nil
+}
+
+// checkResponse verifies that the response has the shape of the request.
+func (p *Problem) checkResponse(resp *response, vectors, objs int) error {
+	if len(resp.Objectives) != vectors {
+		return fmt.Errorf("evaluator returned objectives for %d vectors, expected %d", len(resp.Objectives), vectors)
+	}
+	for i, o := range resp.Objectives {
+		if len(o) != objs {
+			return fmt.Errorf("evaluator returned %d objectives for vector %d, expected %d", len(o), i, objs)
+		}
+	}
+	if p.cfg.Constraints == 0 {
+		return nil
+	}
+	if len(resp.Constraints) != vectors {
+		return fmt.Errorf("evaluator returned constraints for %d vectors, expected %d", len(resp.Constraints), vectors)
+	}
+	for i, c := range resp.Constraints {
+		if len(c) != p.cfg.Constraints {
+			return fmt.Errorf("evaluator returned %d constraints for vector %d, expected %d", len(c), i, p.cfg.Constraints)
+		}
+	}
+	return nil
+}
+
+// acquire waits for an idle process, starting it when needed.
+func (p *Problem) acquire() (*process, error) {
+	proc := <-p.slots
+
+	p.mu.Lock()
+	closed := p.closed
+	p.mu.Unlock()
+	if closed {
+		p.slots <- proc
+		return nil, ErrClosed
+	}
+
+	if proc == nil {
+		var err error
+		proc, err = startProcess(p.cfg)
+		if err != nil {
+			p.slots <- nil
+			return nil, fmt.Errorf("problem %s: %w", p.cfg.Name, err)
+		}
+	}
+	return proc, nil
+}
+
+func (p *Problem) release(proc *process) {
+	p.slots <- proc
+}
+
+// Close stops the evaluator processes, waiting for the batches being
+// evaluated to finish.
+func (p *Problem) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	p.mu.Unlock()
+
+	for range cap(p.slots) {
+		if proc := <-p.slots; proc != nil {
+			proc.stop()
+		}
+	}
+	for range cap(p.slots) {
+		p.slots <- nil
+	}
+	return nil
+}
+
+var (
+	_ problems.Constrained    = (*Problem)(nil)
+	_ problems.BatchEvaluator = (*Problem)(nil)
+)
@@ -0,0 +1,221 @@
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// stopTimeout is how long an evaluator has to exit once its stdin is closed
+// before it is killed.
+const stopTimeout = 5 * time.Second
+
+// baseEnv lists the variables of the server environment evaluators get
+// unless they inherit all of it.
+var baseEnv = []string{"PATH", "HOME", "LANG", "LC_ALL", "TMPDIR"}
+
+// request is a batch of decision vectors sent to an evaluator.
+type request struct {
+	ID         uint64      `json:"id"`
+	Objectives int         `json:"objectives"`
+	Vectors    [][]float64 `json:"vectors"`
+}
+
+// response is the reply of an evaluator to a request.
+type response struct {
+	ID          uint64      `json:"id"`
+	Objectives  [][]float64 `json:"objectives"`
+	Constraints [][]float64 `json:"constraints,omitempty"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// EvaluationError is the error an evaluator reported for a batch.
+type EvaluationError struct {
+	Message string
+}
+
+func (e *EvaluationError) Error() string {
+	return "evaluator error: " + e.Message
+}
+
+// process is a running evaluator.
+type process struct {
+	name   string
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	reader *os.File
+	nextID uint64
+	exited chan struct{}
+}
+
+// evaluatorEnv returns the environment the evaluator of cfg runs with.
+func evaluatorEnv(cfg Config) []string {
+	if cfg.InheritEnv {
+		return append(os.Environ(), cfg.Env...)
+	}
+	env := make([]string, 0, len(baseEnv)+len(cfg.Env))
+	for _, key := range baseEnv {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	return append(env, cfg.Env...)
+}
+
+// startProcess starts the evaluator of cfg.
+func startProcess(cfg Config) (*process, error) {
+	// #nosec G204 - the evaluator command comes from the server configuration
+	cmd := exec.Command(cfg.Command, cfg.Args...)
+	cmd.Dir = cfg.Dir
+	cmd.Env = evaluatorEnv(cfg)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	// cmd.Wait closes the pipes it creates, which would race with reading the
+	// last response, so the stdout pipe is owned by the process instead.
+	stdout, writer, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = writer
+	err = cmd.Start()
+	_ = writer.Close()
+	if err != nil {
+		_ = stdout.Close()
+		return nil, fmt.Errorf("failed to start evaluator: %w", err)
+	}
+
+	proc := &process{
+		name:   cfg.Name,
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: bufio.NewReader(stdout),
+		reader: stdout,
+		exited: make(chan struct{}),
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			slog.Info("external evaluator",
+				slog.String("problem", proc.name),
+				slog.Int("pid", cmd.Process.Pid),
+				slog.String("stderr", scanner.Text()),
+			)
+		}
+		err := cmd.Wait()
+		if err != nil {
+			slog.Debug("external evaluator exited",
+				slog.String("problem", proc.name),
+				slog.String("error", err.Error()),
+			)
+		}
+		close(proc.exited)
+	}()
+
+	slog.Debug("started external evaluator",
+		slog.String("problem", cfg.Name),
+		slog.Int("pid", cmd.Process.Pid),
+	)
+	return proc, nil
+}
+
+// call sends a batch to the evaluator and waits up to timeout for its
+// response. The evaluator is killed when it runs out of time or ctx is done,
+// the caller must not use it afterwards.
+func (p *process) call(ctx context.Context, vectors [][]float64, objectives int, timeout time.Duration) (*response, error) {
+	p.nextID++
+	req := request{ID: p.nextID, Objectives: objectives, Vectors: vectors}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	type result struct {
+		line []byte
+		err  error
+	}
+	// The request is written by the goroutine reading the response, so that
+	// the timeout also covers an evaluator that stops reading its stdin
+	done := make(chan result, 1)
+	go func() {
+		if _, err := p.stdin.Write(append(data, '\n')); err != nil {
+			done <- result{err: fmt.Errorf("failed to send request to evaluator: %w", err)}
+			return
+		}
+		line, err := p.stdout.ReadBytes('\n')
+		if err != nil {
+			err = fmt.Errorf("failed to read evaluator response: %w", err)
+		}
+		done <- result{line, err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var res result
+	select {
+	case res = <-done:
+	case <-timer.C:
+		p.kill()
+		return nil, fmt.Errorf("evaluator did not respond within %s", timeout)
+	case <-ctx.Done():
+		p.kill()
+		return nil, ctx.Err()
+	}
+	if res.err != nil {
+		if errors.Is(res.err, io.EOF) {
+			return nil, errors.New("evaluator exited before responding")
+		}
+		return nil, res.err
+	}
+
+	var resp response
+	if err := json.Unmarshal(res.line, &resp); err != nil {
+		return nil, fmt.Errorf("invalid evaluator response: %w", err)
+	}
+	if resp.ID != req.ID {
+		return nil, fmt.Errorf("evaluator answered request %d, expected %d", resp.ID, req.ID)
+	}
+	if resp.Error != "" {
+		return nil, &EvaluationError{Message: resp.Error}
+	}
+	return &resp, nil
+}
+
+// stop closes the stdin of the evaluator and kills it if it does not exit in
+// time.
+func (p *process) stop() {
+	_ = p.stdin.Close()
+	select {
+	case <-p.exited:
+		_ = p.reader.Close()
+	case <-time.After(stopTimeout):
+		p.kill()
+	}
+}
+
+func (p *process) kill() {
+	_ = p.cmd.Process.Kill()
+	select {
+	case <-p.exited:
+	case <-time.After(stopTimeout):
+		// Children of the evaluator may keep its stderr open
+	}
+	_ = p.reader.Close()
+}
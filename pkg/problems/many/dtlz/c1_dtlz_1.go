@@ -0,0 +1,44 @@
+package dtlz
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// c1dtlz1 reuses the DTLZ1 objectives and reference front, which the
+// constraint leaves untouched.
+type c1dtlz1 struct {
+	dtlz1
+}
+
+// C1Dtlz1 returns the C1-DTLZ1 test problem, DTLZ1 with a constraint that
+// keeps only a narrow band above the Pareto front feasible.
+// Domain: [0,1]^n, Objectives: m (configurable), Constraints: 1
+func C1Dtlz1() problems.Constrained {
+	return &c1dtlz1{}
+}
+
+func (v *c1dtlz1) Name() string {
+	return "c1dtlz1"
+}
+
+func (v *c1dtlz1) NumConstraints() int {
+	return 1
+}
+
+func (v *c1dtlz1) Evaluate(e *models.Vector, m int) error {
+	if err := v.dtlz1.Evaluate(e, m); err != nil {
+		return err
+	}
+
+	// c(x) = 1 - f_M/0.6 - sum(f_i/0.5, i < M) >= 0
+	c := 1 - e.Objectives[m-1]/0.6
+	for _, f := range e.Objectives[:m-1] {
+		c -= f / 0.5
+	}
+	e.Constraints = []float64{math.Max(0, -c)}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package dtlz
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestC1DTLZ1(t *testing.T) {
+	var p problems.Interface = C1Dtlz1()
+	_, ok := p.(problems.Constrained)
+	assert.True(t, ok, "C1-DTLZ1 should be constrained")
+	assert.Equal(t, "c1dtlz1", p.Name())
+	assert.Equal(t, 1, C1Dtlz1().NumConstraints())
+
+	t.Run("points on the front are feasible", func(t *testing.T) {
+		e := &models.Vector{Elements: []float64{0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5}}
+		require.NoError(t, p.Evaluate(e, 3))
+		assert.InDelta(t, 0.5, e.Objectives[0]+e.Objectives[1]+e.Objectives[2], 1e-9)
+		assert.Equal(t, []float64{0}, e.Constraints)
+	})
+
+	t.Run("points far from the front are infeasible", func(t *testing.T) {
+		e := &models.Vector{Elements: make([]float64, 7)}
+		require.NoError(t, p.Evaluate(e, 3))
+		require.Len(t, e.Constraints, 1)
+		assert.Greater(t, e.Constraints[0], 0.0)
+	})
+
+	t.Run("reference front is the DTLZ1 front", func(t *testing.T) {
+		front, ok := problems.ReferenceFront(p, 91, 3)
+		require.True(t, ok)
+		assert.Equal(t, linearFront(91, 3), front)
+	})
+}
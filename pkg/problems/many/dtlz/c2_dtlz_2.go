@@ -0,0 +1,87 @@
+package dtlz
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+type c2dtlz2 struct {
+	dtlz2
+}
+
+// C2Dtlz2 returns the C2-DTLZ2 test problem, DTLZ2 where only the regions
+// around the extreme points and the centre of the front are feasible.
+// Domain: [0,1]^n, Objectives: m (configurable), Constraints: 1
+func C2Dtlz2() problems.Constrained {
+	return &c2dtlz2{}
+}
+
+func (v *c2dtlz2) Name() string {
+	return "c2dtlz2"
+}
+
+func (v *c2dtlz2) NumConstraints() int {
+	return 1
+}
+
+func (v *c2dtlz2) Evaluate(e *models.Vector, m int) error {
+	if err := v.dtlz2.Evaluate(e, m); err != nil {
+		return err
+	}
+	e.Constraints = []float64{c2dtlz2Violation(e.Objectives)}
+	return nil
+}
+
+// c2dtlz2Radius is the radius of the feasible regions, following pymoo.
+func c2dtlz2Radius(m int) float64 {
+	switch m {
+	case 2:
+		return 0.2
+	case 3:
+		return 0.4
+	default:
+		return 0.5
+	}
+}
+
+// c2dtlz2Violation returns how far f is from the nearest feasible region.
+func c2dtlz2Violation(f []float64) float64 {
+	m := len(f)
+	r2 := c2dtlz2Radius(m) * c2dtlz2Radius(m)
+
+	sumSq := 0.0
+	for _, fi := range f {
+		sumSq += fi * fi
+	}
+
+	// Spheres of radius r around each extreme point of the front
+	c := math.Inf(1)
+	for _, fi := range f {
+		c = math.Min(c, (fi-1)*(fi-1)+sumSq-fi*fi-r2)
+	}
+
+	// Sphere of radius r around the centre of the front
+	centre := 1 / math.Sqrt(float64(m))
+	mid := 0.0
+	for _, fi := range f {
+		mid += (fi - centre) * (fi - centre)
+	}
+	c = math.Min(c, mid-r2)
+
+	return math.Max(0, c)
+}
+
+// ReferenceFront returns the feasible part of about n points of the unit
+// hypersphere.
+func (v *c2dtlz2) ReferenceFront(n, objs int) []models.Vector {
+	sphere := sphericalFront(n, objs)
+	front := make([]models.Vector, 0, len(sphere))
+	for _, p := range sphere {
+		if c2dtlz2Violation(p.Objectives) == 0 {
+			front = append(front, p)
+		}
+	}
+	return front
+}
@@ -0,0 +1,43 @@
+package dtlz
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestC2DTLZ2(t *testing.T) {
+	var p problems.Interface = C2Dtlz2()
+	_, ok := p.(problems.Constrained)
+	assert.True(t, ok, "C2-DTLZ2 should be constrained")
+	assert.Equal(t, "c2dtlz2", p.Name())
+
+	distance := []float64{0.5, 0.5, 0.5, 0.5, 0.5}
+
+	t.Run("extreme point is feasible", func(t *testing.T) {
+		e := &models.Vector{Elements: append([]float64{0, 0}, distance...)}
+		require.NoError(t, p.Evaluate(e, 3))
+		assert.InDelta(t, 1, e.Objectives[0], 1e-9)
+		assert.Equal(t, []float64{0}, e.Constraints)
+	})
+
+	t.Run("point between the feasible regions is infeasible", func(t *testing.T) {
+		e := &models.Vector{Elements: append([]float64{0, 0.5}, distance...)}
+		require.NoError(t, p.Evaluate(e, 3))
+		require.Len(t, e.Constraints, 1)
+		assert.Greater(t, e.Constraints[0], 0.0)
+	})
+
+	t.Run("reference front keeps only feasible points", func(t *testing.T) {
+		front, ok := problems.ReferenceFront(p, 200, 3)
+		require.True(t, ok)
+		require.NotEmpty(t, front)
+		assert.Less(t, len(front), len(sphericalFront(200, 3)))
+		for _, v := range front {
+			assert.Zero(t, c2dtlz2Violation(v.Objectives))
+		}
+	})
+}
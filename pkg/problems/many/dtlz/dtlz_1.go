@@ -59,3 +59,8 @@ func (v *dtlz1) Evaluate(e *models.Vector, m int) error {
 
 	return nil
 }
+
+// ReferenceFront returns about n points of the hyperplane sum(f) = 0.5.
+func (v *dtlz1) ReferenceFront(n, objs int) []models.Vector {
+	return linearFront(n, objs)
+}
@@ -56,3 +56,8 @@ func (v *dtlz2) Evaluate(e *models.Vector, m int) error {
 
 	return nil
 }
+
+// ReferenceFront returns about n points of the unit hypersphere.
+func (v *dtlz2) ReferenceFront(n, objs int) []models.Vector {
+	return sphericalFront(n, objs)
+}
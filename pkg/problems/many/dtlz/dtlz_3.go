@@ -55,3 +55,8 @@ func (v *dtlz3) Evaluate(e *models.Vector, m int) error {
 	copy(e.Objectives, objs)
 	return nil
 }
+
+// ReferenceFront returns about n points of the unit hypersphere.
+func (v *dtlz3) ReferenceFront(n, objs int) []models.Vector {
+	return sphericalFront(n, objs)
+}
@@ -55,3 +55,8 @@ func (v *dtlz4) Evaluate(e *models.Vector, m int) error {
 
 	return nil
 }
+
+// ReferenceFront returns about n points of the unit hypersphere.
+func (v *dtlz4) ReferenceFront(n, objs int) []models.Vector {
+	return sphericalFront(n, objs)
+}
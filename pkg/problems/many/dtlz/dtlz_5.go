@@ -63,3 +63,8 @@ func (v *dtlz5) Evaluate(e *models.Vector, m int) error {
 
 	return nil
 }
+
+// ReferenceFront returns n points of the degenerate curve front.
+func (v *dtlz5) ReferenceFront(n, objs int) []models.Vector {
+	return degenerateFront(v, n, objs, 0.5)
+}
@@ -63,3 +63,8 @@ func (v *dtlz6) Evaluate(e *models.Vector, m int) error {
 	copy(e.Objectives, objs)
 	return nil
 }
+
+// ReferenceFront returns n points of the degenerate curve front.
+func (v *dtlz6) ReferenceFront(n, objs int) []models.Vector {
+	return degenerateFront(v, n, objs, 0)
+}
@@ -55,3 +55,10 @@ func (v *dtlz7) Evaluate(e *models.Vector, m int) error {
 
 	return nil
 }
+
+// ReferenceFront returns the non-dominated points obtained from a regular
+// grid of about n position vectors, which covers the 2^(m-1) disconnected
+// regions of the front.
+func (v *dtlz7) ReferenceFront(n, objs int) []models.Vector {
+	return problems.NonDominated(evaluateFront(v, problems.Grid(n, objs-1), objs, 0))
+}
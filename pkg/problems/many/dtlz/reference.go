@@ -0,0 +1,78 @@
+package dtlz
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// referenceDistanceVariables is the number of distance variables used when a
+// reference front is sampled by evaluating optimal decision vectors.
+const referenceDistanceVariables = 10
+
+// linearFront returns about n points of the hyperplane sum(f) = 0.5.
+func linearFront(n, objs int) []models.Vector {
+	points := problems.SimplexLattice(n, objs)
+	for _, p := range points {
+		for i := range p {
+			p[i] *= 0.5
+		}
+	}
+	return problems.FromObjectives(points)
+}
+
+// sphericalFront returns about n points of the unit hypersphere in the
+// positive orthant.
+func sphericalFront(n, objs int) []models.Vector {
+	points := problems.SimplexLattice(n, objs)
+	for _, p := range points {
+		norm := 0.0
+		for _, v := range p {
+			norm += v * v
+		}
+		norm = math.Sqrt(norm)
+		for i := range p {
+			p[i] /= norm
+		}
+	}
+	return problems.FromObjectives(points)
+}
+
+// degenerateFront returns n points of the curve that forms the front of
+// DTLZ5 and DTLZ6, obtained by varying only the first position variable.
+func degenerateFront(p problems.Interface, n, objs int, optimal float64) []models.Vector {
+	if objs < 2 {
+		return nil
+	}
+	positions := make([][]float64, 0, n)
+	for _, t := range problems.Linspace(0, 1, n) {
+		position := make([]float64, objs-1)
+		position[0] = t
+		for i := 1; i < len(position); i++ {
+			position[i] = 0.5
+		}
+		positions = append(positions, position)
+	}
+	return evaluateFront(p, positions, objs, optimal)
+}
+
+// evaluateFront evaluates p on decision vectors made of the given position
+// variables followed by distance variables set to their optimal value.
+func evaluateFront(p problems.Interface, positions [][]float64, objs int, optimal float64) []models.Vector {
+	front := make([]models.Vector, 0, len(positions))
+	for _, position := range positions {
+		elements := make([]float64, 0, len(position)+referenceDistanceVariables)
+		elements = append(elements, position...)
+		for range referenceDistanceVariables {
+			elements = append(elements, optimal)
+		}
+
+		vec := models.Vector{Elements: elements}
+		if err := p.Evaluate(&vec, objs); err != nil {
+			continue
+		}
+		front = append(front, models.Vector{Objectives: vec.Objectives})
+	}
+	return front
+}
@@ -0,0 +1,80 @@
+package dtlz
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceFront_Linear(t *testing.T) {
+	front, ok := problems.ReferenceFront(Dtlz1(), 100, 3)
+	require.True(t, ok)
+	assert.Len(t, front, 91)
+
+	for _, v := range front {
+		sum := 0.0
+		for _, f := range v.Objectives {
+			sum += f
+		}
+		assert.InDelta(t, 0.5, sum, 1e-12)
+	}
+}
+
+func TestReferenceFront_Spherical(t *testing.T) {
+	for _, p := range []problems.Interface{Dtlz2(), Dtlz3(), Dtlz4(), Dtlz5(), Dtlz6()} {
+		t.Run(p.Name(), func(t *testing.T) {
+			for _, objs := range []int{2, 3, 5} {
+				front, ok := problems.ReferenceFront(p, 50, objs)
+				require.True(t, ok)
+				require.NotEmpty(t, front)
+				for _, v := range front {
+					require.Len(t, v.Objectives, objs)
+					assert.InDelta(t, 1, norm(v.Objectives), 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestReferenceFront_MatchesOptimalSolutions(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for range 10 {
+		x := make([]float64, 12)
+		for i := range x {
+			x[i] = 0.5
+		}
+		x[0], x[1] = random.Float64(), random.Float64()
+
+		vec := models.Vector{Elements: x}
+		require.NoError(t, Dtlz2().Evaluate(&vec, 3))
+		assert.InDelta(t, 1, norm(vec.Objectives), 1e-9)
+	}
+}
+
+func TestReferenceFront_Disconnected(t *testing.T) {
+	front, ok := problems.ReferenceFront(Dtlz7(), 400, 3)
+	require.True(t, ok)
+	require.NotEmpty(t, front)
+	assert.Len(t, problems.NonDominated(front), len(front))
+
+	// The front spans four disconnected regions in three objectives.
+	regions := map[[2]bool]bool{}
+	for _, v := range front {
+		regions[[2]bool{v.Objectives[0] > 0.5, v.Objectives[1] > 0.5}] = true
+	}
+	assert.Len(t, regions, 4)
+}
+
+func norm(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v * v
+	}
+	return math.Sqrt(sum)
+}
@@ -1,11 +1,17 @@
 package dtlz
 
-import "github.com/nicholaspcr/GoDE/pkg/problems"
+import (
+	"fmt"
+
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
 
-//nolint:revive // Factory functions have unused parameters matching registry interface
 func init() {
 	// Register DTLZ problems
 	problems.DefaultRegistry.Register("dtlz1", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz1(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ1 - Linear Pareto front with (11^k - 1) local fronts",
@@ -16,6 +22,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz2", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz2(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ2 - Concave Pareto front",
@@ -26,6 +35,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz3", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz3(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ3 - Concave front with (3^k - 1) local fronts",
@@ -36,6 +48,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz4", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz4(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ4 - Concave front with biased density",
@@ -46,6 +61,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz5", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz5(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ5 - Degenerate Pareto front",
@@ -56,6 +74,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz6", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz6(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ6 - Degenerate front with (3^k - 1) local fronts",
@@ -66,6 +87,9 @@ func init() {
 	})
 
 	problems.DefaultRegistry.Register("dtlz7", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
 		return Dtlz7(), nil
 	}, problems.ProblemMetadata{
 		Description: "DTLZ7 - Disconnected Pareto regions",
@@ -74,4 +98,43 @@ func init() {
 		NumObjs:     0,
 		Category:    "many",
 	})
+
+	// Register constrained DTLZ problems
+	problems.DefaultRegistry.Register("c1dtlz1", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
+		return C1Dtlz1(), nil
+	}, problems.ProblemMetadata{
+		Description: "C1-DTLZ1 - DTLZ1 with a feasible band above the front",
+		MinDim:      3,
+		MaxDim:      1000,
+		NumObjs:     0,
+		Category:    "many",
+	})
+
+	problems.DefaultRegistry.Register("c2dtlz2", func(dim, objs int) (problems.Interface, error) {
+		if err := checkSize(dim, objs); err != nil {
+			return nil, err
+		}
+		return C2Dtlz2(), nil
+	}, problems.ProblemMetadata{
+		Description: "C2-DTLZ2 - DTLZ2 with disconnected feasible regions",
+		MinDim:      3,
+		MaxDim:      1000,
+		NumObjs:     0,
+		Category:    "many",
+	})
+}
+
+// checkSize reports whether a DTLZ problem can be built for dim variables and
+// objs objectives, which needs at least one distance variable.
+func checkSize(dim, objs int) error {
+	if objs < 2 {
+		return fmt.Errorf("dtlz problems need at least 2 objectives, got %d", objs)
+	}
+	if dim <= objs {
+		return fmt.Errorf("dtlz problems with %d objectives need more than %d dimensions, got %d", objs, objs, dim)
+	}
+	return nil
 }
@@ -0,0 +1,31 @@
+package dtlz
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredFactories(t *testing.T) {
+	t.Run("creates problems sized for the execution", func(t *testing.T) {
+		p, err := problems.DefaultRegistry.Create("dtlz2", 12, 5)
+		require.NoError(t, err)
+
+		vec := models.Vector{Elements: make([]float64, 12)}
+		require.NoError(t, p.Evaluate(&vec, 5))
+		assert.Len(t, vec.Objectives, 5)
+	})
+
+	t.Run("rejects dimensions not above the objectives", func(t *testing.T) {
+		_, err := problems.DefaultRegistry.Create("dtlz1", 3, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a single objective", func(t *testing.T) {
+		_, err := problems.DefaultRegistry.Create("dtlz1", 10, 1)
+		assert.Error(t, err)
+	})
+}
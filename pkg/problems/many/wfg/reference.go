@@ -0,0 +1,47 @@
+package wfg
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// The WFG front is reached when the distance variables are optimal, which
+// makes the last transformed parameter zero. Objectives then reduce to
+// f_m = S_m * h_m(x) with S_m = 2m and x the post-processed position
+// parameters in [0,1]^(M-1), so reference fronts are sampled directly in that
+// space through the shape functions.
+
+// shapeFront evaluates the shape function h over a set of position
+// parameters and scales the result by S_m = 2m.
+func shapeFront(positions [][]float64, objs int, h func(x []float64, m int) float64) []models.Vector {
+	s := arange(2, 2*objs+1, 2)
+	front := make([]models.Vector, len(positions))
+	for i, x := range positions {
+		objectives := make([]float64, objs)
+		for m := range objs {
+			objectives[m] = s[m] * h(x, m+1)
+		}
+		front[i] = models.Vector{Objectives: objectives}
+	}
+	return front
+}
+
+// concaveFront returns about n points of the scaled hypersphere shared by
+// WFG4 to WFG9.
+func concaveFront(n, objs int) []models.Vector {
+	points := problems.SimplexLattice(n, objs)
+	s := arange(2, 2*objs+1, 2)
+	for _, p := range points {
+		norm := 0.0
+		for _, v := range p {
+			norm += v * v
+		}
+		norm = math.Sqrt(norm)
+		for i := range p {
+			p[i] = s[i] * p[i] / norm
+		}
+	}
+	return problems.FromObjectives(points)
+}
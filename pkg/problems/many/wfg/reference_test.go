@@ -0,0 +1,103 @@
+package wfg
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// optimalSolution returns a Pareto optimal decision vector with random
+// position parameters and distance parameters at their optimum 0.35*z_max.
+func optimalSolution(random *rand.Rand, k, l int) []float64 {
+	x := make([]float64, k+l)
+	for i := range x {
+		upper := 2 * float64(i+1)
+		if i < k {
+			x[i] = random.Float64() * upper
+		} else {
+			x[i] = 0.35 * upper
+		}
+	}
+	return x
+}
+
+func TestReferenceFront_Concave(t *testing.T) {
+	const objs = 3
+	random := rand.New(rand.NewSource(1))
+
+	for _, p := range []problems.Interface{Wfg4(), Wfg5(), Wfg6(), Wfg7()} {
+		t.Run(p.Name(), func(t *testing.T) {
+			front, ok := problems.ReferenceFront(p, 100, objs)
+			require.True(t, ok)
+			require.NotEmpty(t, front)
+			for _, v := range front {
+				assert.InDelta(t, 1, scaledNorm(v.Objectives), 1e-9)
+			}
+
+			for range 10 {
+				vec := models.Vector{Elements: optimalSolution(random, 2*(objs-1), 20)}
+				require.NoError(t, p.Evaluate(&vec, objs))
+				assert.InDelta(t, 1, scaledNorm(vec.Objectives), 1e-6)
+			}
+		})
+	}
+}
+
+func TestReferenceFront_Sampled(t *testing.T) {
+	const objs = 3
+	random := rand.New(rand.NewSource(1))
+
+	for _, p := range []problems.Interface{Wfg1(), Wfg2(), Wfg3()} {
+		t.Run(p.Name(), func(t *testing.T) {
+			front, ok := problems.ReferenceFront(p, 2000, objs)
+			require.True(t, ok)
+			require.NotEmpty(t, front)
+			assert.Len(t, problems.NonDominated(front), len(front))
+
+			// Optimal solutions must be covered by the sampled front up to
+			// the sampling resolution.
+			for range 10 {
+				vec := models.Vector{Elements: optimalSolution(random, 2*(objs-1), 20)}
+				require.NoError(t, p.Evaluate(&vec, objs))
+				assert.Less(t, epsilon(front, vec.Objectives), 0.25)
+			}
+		})
+	}
+}
+
+func TestReferenceFront_TooFewObjectives(t *testing.T) {
+	for _, p := range []problems.Interface{Wfg1(), Wfg2(), Wfg3()} {
+		front, ok := problems.ReferenceFront(p, 10, 1)
+		assert.True(t, ok)
+		assert.Empty(t, front)
+	}
+}
+
+// scaledNorm returns the norm of the objectives divided by S_m = 2m.
+func scaledNorm(objectives []float64) float64 {
+	sum := 0.0
+	for m, f := range objectives {
+		v := f / (2 * float64(m+1))
+		sum += v * v
+	}
+	return math.Sqrt(sum)
+}
+
+// epsilon returns the smallest shift needed for some point of the front to
+// weakly dominate point.
+func epsilon(front []models.Vector, point []float64) float64 {
+	best := math.Inf(1)
+	for _, v := range front {
+		worst := math.Inf(-1)
+		for i := range point {
+			worst = math.Max(worst, v.Objectives[i]-point[i])
+		}
+		best = math.Min(best, worst)
+	}
+	return best
+}
@@ -6,41 +6,61 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-//nolint:revive // Factory functions have unused parameters matching registry interface
+// bounds is the WFG decision space, where x_i lies in [0, 2i].
+func bounds(dim int) (floor, ceil []float64) {
+	floor = make([]float64, dim)
+	ceil = make([]float64, dim)
+	for i := range dim {
+		ceil[i] = 2 * float64(i+1)
+	}
+	return floor, ceil
+}
+
 func init() {
 	// Register WFG problems
 	for i := 1; i <= 9; i++ {
 		num := i // Capture for closure
 		name := fmt.Sprintf("wfg%d", num)
 
-		var factory problems.ProblemFactory
+		var newProblem func(k int) problems.Interface
 		switch num {
 		case 1:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg1(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg1{k: k} }
 		case 2:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg2(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg2{k: k} }
 		case 3:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg3(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg3{k: k} }
 		case 4:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg4(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg4{k: k} }
 		case 5:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg5(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg5{k: k} }
 		case 6:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg6(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg6{k: k} }
 		case 7:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg7(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg7{k: k} }
 		case 8:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg8(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg8{k: k} }
 		case 9:
-			factory = func(dim, objs int) (problems.Interface, error) { return Wfg9(), nil }
+			newProblem = func(k int) problems.Interface { return &wfg9{k: k} }
+		}
+
+		// WFG2 and WFG3 reduce the distance parameters in pairs
+		evenDistance := num == 2 || num == 3
+		factory := func(dim, objs int) (problems.Interface, error) {
+			k, err := newPositionParams(dim, objs, evenDistance)
+			if err != nil {
+				return nil, err
+			}
+			return newProblem(k), nil
 		}
 
 		problems.DefaultRegistry.Register(name, factory, problems.ProblemMetadata{
 			Description: fmt.Sprintf("WFG%d - Walking Fish Group test problem %d", num, num),
-			MinDim:      2,
+			MinDim:      3,
 			MaxDim:      1000,
 			NumObjs:     0,
 			Category:    "many",
+			Bounds:      bounds,
 		})
 	}
 }
@@ -0,0 +1,66 @@
+package wfg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredFactories(t *testing.T) {
+	t.Run("position parameters follow the objectives", func(t *testing.T) {
+		p, err := problems.DefaultRegistry.Create("wfg4", 12, 4)
+		require.NoError(t, err)
+		assert.Equal(t, 6, p.(*wfg4).k)
+
+		vec := models.Vector{Elements: make([]float64, 12)}
+		require.NoError(t, p.Evaluate(&vec, 4))
+		assert.Len(t, vec.Objectives, 4)
+	})
+
+	t.Run("every problem evaluates at its minimum size", func(t *testing.T) {
+		for i := 1; i <= 9; i++ {
+			name := fmt.Sprintf("wfg%d", i)
+			meta, ok := problems.DefaultRegistry.Get(name)
+			require.True(t, ok, name)
+
+			p, err := problems.DefaultRegistry.Create(name, 4, 2)
+			require.NoError(t, err, name)
+
+			floor, _ := meta.DefaultBounds(4)
+			vec := models.Vector{Elements: floor}
+			require.NoError(t, p.Evaluate(&vec, 2), name)
+			assert.Len(t, vec.Objectives, 2, name)
+		}
+	})
+
+	t.Run("rejects sizes without distance parameters", func(t *testing.T) {
+		_, err := problems.DefaultRegistry.Create("wfg1", 4, 3)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a single objective", func(t *testing.T) {
+		_, err := problems.DefaultRegistry.Create("wfg1", 10, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("wfg2 and wfg3 need an even number of distance parameters", func(t *testing.T) {
+		_, err := problems.DefaultRegistry.Create("wfg2", 5, 2)
+		assert.Error(t, err)
+		_, err = problems.DefaultRegistry.Create("wfg3", 5, 2)
+		assert.Error(t, err)
+		_, err = problems.DefaultRegistry.Create("wfg4", 5, 2)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRegisteredBounds(t *testing.T) {
+	meta, ok := problems.DefaultRegistry.Get("wfg4")
+	require.True(t, ok)
+	floor, ceil := meta.DefaultBounds(4)
+	assert.Equal(t, []float64{0, 0, 0, 0}, floor)
+	assert.Equal(t, []float64{2, 4, 6, 8}, ceil)
+}
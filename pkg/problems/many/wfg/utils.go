@@ -1,6 +1,7 @@
 package wfg
 
 import (
+	"fmt"
 	"math"
 )
 
@@ -233,3 +234,31 @@ func shapeDisconnected(X, alpha, beta, A float64) float64 {
 	aux := math.Cos(A * math.Pi * math.Pow(X, beta))
 	return correctTo01(1.0 - math.Pow(X, alpha)*aux*aux)
 }
+
+// positionParams returns the number of position-related parameters k, using
+// the usual 2(M-1) when the problem was not given one.
+func positionParams(k, m int) int {
+	if k > 0 {
+		return k
+	}
+	return 2 * (m - 1)
+}
+
+// newPositionParams picks k for a problem with dim variables and objs
+// objectives, leaving at least one distance-related parameter. Problems built
+// on reductionNonSep also need an even number of distance parameters.
+func newPositionParams(dim, objs int, evenDistance bool) (int, error) {
+	if objs < 2 {
+		return 0, fmt.Errorf("wfg problems need at least 2 objectives, got %d", objs)
+	}
+
+	k := positionParams(0, objs)
+	l := dim - k
+	if l < 1 {
+		return 0, fmt.Errorf("wfg problems with %d objectives need more than %d dimensions, got %d", objs, k, dim)
+	}
+	if evenDistance && l%2 != 0 {
+		return 0, fmt.Errorf("dimensions minus %d position parameters must be even, got %d", k, dim)
+	}
+	return k, nil
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg1 struct{}
+type wfg1 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg1 returns the WFG1 test problem, a many-objective benchmark with bias and mixed shape.
 // Objectives: m (configurable)
@@ -21,7 +23,7 @@ func (w *wfg1) Name() string {
 func (w *wfg1) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -110,3 +112,17 @@ func wfg1_t4(X []float64, m, n, k int) []float64 {
 
 	return t
 }
+
+// ReferenceFront returns the convex and mixed front sampled over a regular
+// grid of about n position vectors.
+func (w *wfg1) ReferenceFront(n, objs int) []models.Vector {
+	if objs < 2 {
+		return nil
+	}
+	return shapeFront(problems.Grid(n, objs-1), objs, func(x []float64, m int) float64 {
+		if m == objs {
+			return shapeMixed(x[0], 5.0, 1.0)
+		}
+		return shapeConvex(x, m)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg2 struct{}
+type wfg2 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg2 returns the WFG2 test problem, a many-objective benchmark with disconnected Pareto regions.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg2) Name() string {
 func (w *wfg2) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -86,3 +88,17 @@ func wfg2_t3(X []float64, m, n, k int) []float64 {
 
 	return t
 }
+
+// ReferenceFront returns the non-dominated part of the convex and
+// disconnected shape sampled over a regular grid of about n position vectors.
+func (w *wfg2) ReferenceFront(n, objs int) []models.Vector {
+	if objs < 2 {
+		return nil
+	}
+	return problems.NonDominated(shapeFront(problems.Grid(n, objs-1), objs, func(x []float64, m int) float64 {
+		if m == objs {
+			return shapeDisconnected(x[0], 1, 1, 5)
+		}
+		return shapeConvex(x, m)
+	}))
+}
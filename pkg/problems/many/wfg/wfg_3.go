@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg3 struct{}
+type wfg3 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg3 returns the WFG3 test problem, a many-objective benchmark with a linear degenerate Pareto front.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg3) Name() string {
 func (w *wfg3) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -52,3 +54,21 @@ func (w *wfg3) Evaluate(e *models.Vector, m int) error {
 	copy(e.Objectives, newObjs)
 	return nil
 }
+
+// ReferenceFront returns n points of the degenerate linear front, where only
+// the first position parameter varies.
+func (w *wfg3) ReferenceFront(n, objs int) []models.Vector {
+	if objs < 2 {
+		return nil
+	}
+	positions := make([][]float64, 0, n)
+	for _, t := range problems.Linspace(0, 1, n) {
+		x := make([]float64, objs-1)
+		x[0] = t
+		for i := 1; i < len(x); i++ {
+			x[i] = 0.5
+		}
+		positions = append(positions, x)
+	}
+	return shapeFront(positions, objs, shapeLinear)
+}
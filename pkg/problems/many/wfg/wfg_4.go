@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg4 struct{}
+type wfg4 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg4 returns the WFG4 test problem, a many-objective benchmark with multi-modality.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg4) Name() string {
 func (w *wfg4) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -79,3 +81,8 @@ func wfg4_t2(X []float64, m, k int) []float64 {
 
 	return t
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg4) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
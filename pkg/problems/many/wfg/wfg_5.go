@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg5 struct{}
+type wfg5 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg5 returns the WFG5 test problem, a many-objective benchmark with deceptive properties.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg5) Name() string {
 func (w *wfg5) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -59,3 +61,8 @@ func wfg5_t1(X []float64) []float64 {
 	}
 	return ret
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg5) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
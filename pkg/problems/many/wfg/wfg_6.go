@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg6 struct{}
+type wfg6 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg6 returns the WFG6 test problem, a many-objective benchmark with non-separable reduction.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg6) Name() string {
 func (w *wfg6) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -59,3 +61,8 @@ func wfg6_t2(X []float64, m, n, k int) []float64 {
 	ret = append(ret, reductionNonSep(X[k:], n-k))
 	return ret
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg6) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg7 struct{}
+type wfg7 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg7 returns the WFG7 test problem, a many-objective benchmark with parameter dependencies.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg7) Name() string {
 func (w *wfg7) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -67,3 +69,8 @@ func wfg7_t1(X []float64, k int) []float64 {
 	}
 	return x
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg7) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
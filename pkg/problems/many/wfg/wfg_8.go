@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg8 struct{}
+type wfg8 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg8 returns the WFG8 test problem, a many-objective benchmark with non-separable position parameters.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg8) Name() string {
 func (w *wfg8) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -66,3 +68,8 @@ func wfg8_t1(X []float64, k, n int) []float64 {
 	}
 	return ret
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg8) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/nicholaspcr/GoDE/pkg/problems"
 )
 
-type wfg9 struct{}
+type wfg9 struct {
+	k int // position parameters, zero uses 2(M-1)
+}
 
 // Wfg9 returns the WFG9 test problem, a many-objective benchmark with deceptive and non-separable properties.
 // Objectives: m (configurable)
@@ -20,7 +22,7 @@ func (w *wfg9) Name() string {
 func (w *wfg9) Evaluate(e *models.Vector, m int) error {
 	n_var := len(e.Elements)
 	n_obj := m
-	k := 2 * (n_obj - 1)
+	k := positionParams(w.k, n_obj)
 
 	var y []float64
 	xu := arange(2, 2*n_var+1, 2)
@@ -98,3 +100,8 @@ func wfg9_t3(X []float64, m, n, k int) []float64 {
 
 	return ret
 }
+
+// ReferenceFront returns about n points of the concave front.
+func (w *wfg9) ReferenceFront(n, objs int) []models.Vector {
+	return concaveFront(n, objs)
+}
@@ -461,3 +461,135 @@ func TestZDT_EdgeCase_FirstElementOne(t *testing.T) {
 		})
 	}
 }
+
+func TestZDT_ReferenceFront(t *testing.T) {
+	probs := []struct {
+		name    string
+		problem func() problems.Interface
+	}{
+		{"ZDT1", Zdt1},
+		{"ZDT2", Zdt2},
+		{"ZDT3", Zdt3},
+		{"ZDT4", Zdt4},
+	}
+
+	for _, p := range probs {
+		t.Run(p.name, func(t *testing.T) {
+			problem := p.problem()
+			front, ok := problems.ReferenceFront(problem, 100, 2)
+			require.True(t, ok)
+			require.NotEmpty(t, front)
+			assert.Len(t, problems.NonDominated(front), len(front))
+
+			// Every point is reached by setting x1 = f1 and the rest to zero.
+			for _, v := range front {
+				vector := &models.Vector{Elements: []float64{v.Objectives[0], 0, 0}}
+				require.NoError(t, problem.Evaluate(vector, 2))
+				assert.InDeltaSlice(t, v.Objectives, vector.Objectives, 1e-9)
+			}
+		})
+	}
+}
+
+func TestZdt6_ReferenceFront(t *testing.T) {
+	front, ok := problems.ReferenceFront(Zdt6(), 100, 2)
+	require.True(t, ok)
+	assert.Len(t, front, 100)
+
+	for _, v := range front {
+		assert.GreaterOrEqual(t, v.Objectives[0], zdt6MinF1)
+		assert.InDelta(t, 1-v.Objectives[0]*v.Objectives[0], v.Objectives[1], 1e-12)
+	}
+}
+
+func TestVnt1_ReferenceFront(t *testing.T) {
+	front, ok := problems.ReferenceFront(Vnt1(), 400, 3)
+	require.True(t, ok)
+	require.NotEmpty(t, front)
+	assert.Len(t, problems.NonDominated(front), len(front))
+	for _, v := range front {
+		assert.Len(t, v.Objectives, 3)
+	}
+}
+
+func TestRegisteredBounds(t *testing.T) {
+	meta, ok := problems.DefaultRegistry.Get("zdt4")
+	require.True(t, ok)
+	floor, ceil := meta.DefaultBounds(3)
+	assert.Equal(t, []float64{0, -5, -5}, floor)
+	assert.Equal(t, []float64{1, 5, 5}, ceil)
+
+	meta, ok = problems.DefaultRegistry.Get("vnt1")
+	require.True(t, ok)
+	floor, ceil = meta.DefaultBounds(2)
+	assert.Equal(t, []float64{-3, -3}, floor)
+	assert.Equal(t, []float64{3, 3}, ceil)
+
+	meta, ok = problems.DefaultRegistry.Get("zdt1")
+	require.True(t, ok)
+	floor, ceil = meta.DefaultBounds(2)
+	assert.Equal(t, []float64{0, 0}, floor)
+	assert.Equal(t, []float64{1, 1}, ceil)
+}
+
+// Tests for constrained problems
+
+func TestConstrainedProblems(t *testing.T) {
+	tests := []struct {
+		name        string
+		problem     problems.Constrained
+		constraints int
+	}{
+		{"srn", Srn(), 2},
+		{"tnk", Tnk(), 2},
+		{"osy", Osy(), 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.name, tt.problem.Name())
+			assert.Equal(t, tt.constraints, tt.problem.NumConstraints())
+		})
+	}
+}
+
+func TestSrn_Evaluate(t *testing.T) {
+	e := &models.Vector{Elements: []float64{0, 5}}
+	require.NoError(t, Srn().Evaluate(e, 2))
+	assert.Equal(t, []float64{22, -16}, e.Objectives)
+	assert.True(t, e.Feasible())
+
+	e = &models.Vector{Elements: []float64{5, 0}}
+	require.NoError(t, Srn().Evaluate(e, 2))
+	assert.Equal(t, []float64{0, 15}, e.Constraints)
+
+	assert.Error(t, Srn().Evaluate(&models.Vector{Elements: []float64{1}}, 2))
+}
+
+func TestTnk_Evaluate(t *testing.T) {
+	e := &models.Vector{Elements: []float64{1, 1}}
+	require.NoError(t, Tnk().Evaluate(e, 2))
+	assert.Equal(t, []float64{1, 1}, e.Objectives)
+	assert.True(t, e.Feasible())
+
+	e = &models.Vector{Elements: []float64{0.1, 0.1}}
+	require.NoError(t, Tnk().Evaluate(e, 2))
+	assert.InDelta(t, 1.08, e.Constraints[0], 1e-9)
+	assert.Zero(t, e.Constraints[1])
+}
+
+func TestOsy_Evaluate(t *testing.T) {
+	e := &models.Vector{Elements: []float64{5, 1, 5, 0, 5, 10}}
+	require.NoError(t, Osy().Evaluate(e, 2))
+	assert.Equal(t, []float64{-274, 176}, e.Objectives)
+	assert.True(t, e.Feasible())
+
+	e = &models.Vector{Elements: []float64{0, 0, 1, 0, 1, 0}}
+	require.NoError(t, Osy().Evaluate(e, 2))
+	assert.Equal(t, []float64{2, 0, 0, 0, 0, 0}, e.Constraints)
+
+	meta, ok := problems.DefaultRegistry.Get("osy")
+	require.True(t, ok)
+	floor, ceil := meta.DefaultBounds(6)
+	assert.Equal(t, []float64{0, 0, 1, 0, 1, 0}, floor)
+	assert.Equal(t, []float64{10, 10, 5, 6, 5, 10}, ceil)
+}
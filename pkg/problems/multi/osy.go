@@ -0,0 +1,65 @@
+package multi
+
+import (
+	"errors"
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+type osy struct{}
+
+// Osy returns the OSY test problem of Osyczka and Kundu, a bi-objective
+// benchmark with six constraints whose front lies on their boundaries.
+// Domain: x1,x2,x6 in [0,10], x3,x5 in [1,5], x4 in [0,6], Objectives: 2,
+// Constraints: 6
+func Osy() problems.Constrained {
+	return &osy{}
+}
+
+func (v *osy) Name() string {
+	return "osy"
+}
+
+func (v *osy) NumConstraints() int {
+	return 6
+}
+
+func (v *osy) Evaluate(e *models.Vector, M int) error {
+	if len(e.Elements) != 6 {
+		return errors.New("need to have exactly six variables/dimensions")
+	}
+
+	x := e.Elements
+
+	f1 := -(25*(x[0]-2)*(x[0]-2) + (x[1]-2)*(x[1]-2) + (x[2]-1)*(x[2]-1) +
+		(x[3]-4)*(x[3]-4) + (x[4]-1)*(x[4]-1))
+	f2 := 0.0
+	for _, xi := range x {
+		f2 += xi * xi
+	}
+
+	// Every constraint is written as c(x) >= 0
+	c := []float64{
+		x[0] + x[1] - 2,
+		6 - x[0] - x[1],
+		2 - x[1] + x[0],
+		2 - x[0] + 3*x[1],
+		4 - (x[2]-3)*(x[2]-3) - x[3],
+		(x[4]-3)*(x[4]-3) + x[5] - 4,
+	}
+
+	e.Objectives = []float64{f1, f2}
+	e.Constraints = make([]float64, len(c))
+	for i, ci := range c {
+		e.Constraints[i] = math.Max(0, -ci)
+	}
+
+	return nil
+}
+
+// osyBounds is the domain of the six OSY variables.
+func osyBounds(int) (floor, ceil []float64) {
+	return []float64{0, 0, 1, 0, 1, 0}, []float64{10, 10, 5, 6, 5, 10}
+}
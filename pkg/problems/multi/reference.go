@@ -0,0 +1,17 @@
+package multi
+
+import (
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+// curve samples n points of a bi-objective front where f2 is a function of
+// f1 over [start, end].
+func curve(n int, start, end float64, f2 func(f1 float64) float64) []models.Vector {
+	f1s := problems.Linspace(start, end, n)
+	front := make([]models.Vector, len(f1s))
+	for i, f1 := range f1s {
+		front[i] = models.Vector{Objectives: []float64{f1, f2(f1)}}
+	}
+	return front
+}
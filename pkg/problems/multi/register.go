@@ -1,6 +1,10 @@
 package multi
 
-import "github.com/nicholaspcr/GoDE/pkg/problems"
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
 
 //nolint:revive // Factory functions have unused parameters matching registry interface
 func init() {
@@ -43,6 +47,7 @@ func init() {
 		MaxDim:      1000,
 		NumObjs:     2,
 		Category:    "multi",
+		Bounds:      zdt4Bounds,
 	})
 
 	problems.DefaultRegistry.Register("zdt6", func(dim, _ int) (problems.Interface, error) {
@@ -64,5 +69,40 @@ func init() {
 		MaxDim:      2,
 		NumObjs:     3,
 		Category:    "multi",
+		Bounds:      problems.UniformBounds(-vnt1Bound, vnt1Bound),
+	})
+
+	// Register constrained problems
+	problems.DefaultRegistry.Register("srn", func(dim, _ int) (problems.Interface, error) {
+		return Srn(), nil
+	}, problems.ProblemMetadata{
+		Description: "SRN - Constrained, front cut by a linear constraint",
+		MinDim:      2,
+		MaxDim:      2,
+		NumObjs:     2,
+		Category:    "multi",
+		Bounds:      problems.UniformBounds(-20, 20),
+	})
+
+	problems.DefaultRegistry.Register("tnk", func(dim, _ int) (problems.Interface, error) {
+		return Tnk(), nil
+	}, problems.ProblemMetadata{
+		Description: "TNK - Constrained, disconnected feasible front",
+		MinDim:      2,
+		MaxDim:      2,
+		NumObjs:     2,
+		Category:    "multi",
+		Bounds:      problems.UniformBounds(0, math.Pi),
+	})
+
+	problems.DefaultRegistry.Register("osy", func(dim, _ int) (problems.Interface, error) {
+		return Osy(), nil
+	}, problems.ProblemMetadata{
+		Description: "OSY - Constrained, front on six constraint boundaries",
+		MinDim:      6,
+		MaxDim:      6,
+		NumObjs:     2,
+		Category:    "multi",
+		Bounds:      osyBounds,
 	})
 }
@@ -0,0 +1,46 @@
+package multi
+
+import (
+	"errors"
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+type srn struct{}
+
+// Srn returns the SRN test problem of Srinivas and Deb, a bi-objective
+// benchmark with two constraints.
+// Domain: [-20,20]^2, Objectives: 2, Constraints: 2
+func Srn() problems.Constrained {
+	return &srn{}
+}
+
+func (v *srn) Name() string {
+	return "srn"
+}
+
+func (v *srn) NumConstraints() int {
+	return 2
+}
+
+func (v *srn) Evaluate(e *models.Vector, M int) error {
+	if len(e.Elements) != 2 {
+		return errors.New("need to have exactly two variables/dimensions")
+	}
+
+	x1, x2 := e.Elements[0], e.Elements[1]
+
+	f1 := 2 + (x1-2)*(x1-2) + (x2-1)*(x2-1)
+	f2 := 9*x1 - (x2-1)*(x2-1)
+
+	// g1: x1^2 + x2^2 <= 225, g2: x1 - 3*x2 + 10 <= 0
+	g1 := x1*x1 + x2*x2 - 225
+	g2 := x1 - 3*x2 + 10
+
+	e.Objectives = []float64{f1, f2}
+	e.Constraints = []float64{math.Max(0, g1), math.Max(0, g2)}
+
+	return nil
+}
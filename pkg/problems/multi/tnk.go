@@ -0,0 +1,44 @@
+package multi
+
+import (
+	"errors"
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+)
+
+type tnk struct{}
+
+// Tnk returns the TNK test problem of Tanaka, a bi-objective benchmark whose
+// feasible front is disconnected.
+// Domain: [0,pi]^2, Objectives: 2, Constraints: 2
+func Tnk() problems.Constrained {
+	return &tnk{}
+}
+
+func (v *tnk) Name() string {
+	return "tnk"
+}
+
+func (v *tnk) NumConstraints() int {
+	return 2
+}
+
+func (v *tnk) Evaluate(e *models.Vector, M int) error {
+	if len(e.Elements) != 2 {
+		return errors.New("need to have exactly two variables/dimensions")
+	}
+
+	x1, x2 := e.Elements[0], e.Elements[1]
+
+	// g1: x1^2 + x2^2 - 1 - 0.1*cos(16*atan(x1/x2)) >= 0
+	g1 := -(x1*x1 + x2*x2 - 1 - 0.1*math.Cos(16*math.Atan2(x1, x2)))
+	// g2: (x1 - 0.5)^2 + (x2 - 0.5)^2 <= 0.5
+	g2 := (x1-0.5)*(x1-0.5) + (x2-0.5)*(x2-0.5) - 0.5
+
+	e.Objectives = []float64{x1, x2}
+	e.Constraints = []float64{math.Max(0, g1), math.Max(0, g2)}
+
+	return nil
+}
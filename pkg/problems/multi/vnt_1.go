@@ -42,3 +42,25 @@ func (v *vnt1) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// vnt1Bound is the limit of the VNT1 decision space [-3, 3]^2.
+const vnt1Bound = 3.0
+
+// ReferenceFront has no closed form for VNT1, so it returns the
+// non-dominated points of a regular grid of about n points over the
+// decision space.
+func (v *vnt1) ReferenceFront(n, _ int) []models.Vector {
+	grid := problems.Grid(n, 2)
+	front := make([]models.Vector, 0, len(grid))
+	for _, p := range grid {
+		vec := models.Vector{Elements: []float64{
+			-vnt1Bound + 2*vnt1Bound*p[0],
+			-vnt1Bound + 2*vnt1Bound*p[1],
+		}}
+		if err := v.Evaluate(&vec, 3); err != nil {
+			continue
+		}
+		front = append(front, models.Vector{Objectives: vec.Objectives})
+	}
+	return problems.NonDominated(front)
+}
@@ -48,3 +48,8 @@ func (v *zdt1) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// ReferenceFront returns n points of the front f2 = 1 - sqrt(f1).
+func (v *zdt1) ReferenceFront(n, _ int) []models.Vector {
+	return curve(n, 0, 1, func(f1 float64) float64 { return 1 - math.Sqrt(f1) })
+}
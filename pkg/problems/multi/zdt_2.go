@@ -42,3 +42,8 @@ func (v *zdt2) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// ReferenceFront returns n points of the front f2 = 1 - f1^2.
+func (v *zdt2) ReferenceFront(n, _ int) []models.Vector {
+	return curve(n, 0, 1, func(f1 float64) float64 { return 1 - f1*f1 })
+}
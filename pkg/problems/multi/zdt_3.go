@@ -46,3 +46,31 @@ func (v *zdt3) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// zdt3Segments are the ranges of f1 of the disconnected ZDT3 front.
+var zdt3Segments = [][2]float64{
+	{0, 0.0830015349},
+	{0.1822287280, 0.2577623634},
+	{0.4093136748, 0.4538821041},
+	{0.6183967944, 0.6525117038},
+	{0.8233317983, 0.8518328654},
+}
+
+// ReferenceFront returns about n points spread over the five ZDT3 segments
+// proportionally to their length. The segment bounds are rounded, so the
+// few boundary points they dominate are dropped.
+func (v *zdt3) ReferenceFront(n, _ int) []models.Vector {
+	total := 0.0
+	for _, s := range zdt3Segments {
+		total += s[1] - s[0]
+	}
+
+	front := make([]models.Vector, 0, n)
+	for _, s := range zdt3Segments {
+		points := max(2, int(math.Round(float64(n)*(s[1]-s[0])/total)))
+		front = append(front, curve(points, s[0], s[1], func(f1 float64) float64 {
+			return 1 - math.Sqrt(f1) - f1*math.Sin(10*math.Pi*f1)
+		})...)
+	}
+	return problems.NonDominated(front)
+}
@@ -43,3 +43,17 @@ func (v *zdt4) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// zdt4Bounds keeps x1 in [0,1] and every other variable in [-5,5].
+func zdt4Bounds(dim int) (floor, ceil []float64) {
+	floor, ceil = problems.UniformBounds(-5, 5)(dim)
+	if dim > 0 {
+		floor[0], ceil[0] = 0, 1
+	}
+	return floor, ceil
+}
+
+// ReferenceFront returns n points of the global front f2 = 1 - sqrt(f1).
+func (v *zdt4) ReferenceFront(n, _ int) []models.Vector {
+	return curve(n, 0, 1, func(f1 float64) float64 { return 1 - math.Sqrt(f1) })
+}
@@ -51,3 +51,11 @@ func (v *zdt6) Evaluate(e *models.Vector, M int) error {
 
 	return nil
 }
+
+// zdt6MinF1 is the smallest value of f1 reachable on the ZDT6 front.
+const zdt6MinF1 = 0.2807753191
+
+// ReferenceFront returns n points of the front f2 = 1 - f1^2.
+func (v *zdt6) ReferenceFront(n, _ int) []models.Vector {
+	return curve(n, zdt6MinF1, 1, func(f1 float64) float64 { return 1 - f1*f1 })
+}
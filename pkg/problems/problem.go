@@ -1,7 +1,11 @@
 // Package problems defines the optimization problem interface and common utilities.
 package problems
 
-import "github.com/nicholaspcr/GoDE/pkg/models"
+import (
+	"context"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
 
 // Interface defines the contract for optimization problems.
 // Implementations must provide an evaluation function that computes objective values
@@ -14,3 +18,22 @@ type Interface interface {
 	// be modified by this func
 	Evaluate(*models.Vector, int) error
 }
+
+// Constrained is an optional capability of problems with constraints. Their
+// Evaluate also fills the vector's Constraints with the violation of each
+// constraint, zero when it is satisfied, and solutions are then compared
+// with Deb's feasibility rules.
+type Constrained interface {
+	Interface
+	// NumConstraints returns how many constraints Evaluate reports.
+	NumConstraints() int
+}
+
+// BatchEvaluator is an optional capability of problems that evaluate a batch
+// of vectors faster than one at a time, such as those evaluated by external
+// processes. EvaluateBatch has the effect of calling Evaluate on each vector,
+// and gives up once ctx is done.
+type BatchEvaluator interface {
+	Interface
+	EvaluateBatch(ctx context.Context, vectors []models.Vector, objs int) error
+}
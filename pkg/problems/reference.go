@@ -0,0 +1,158 @@
+package problems
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// ReferenceFrontProvider is an optional capability of problems whose true
+// Pareto front is known.
+type ReferenceFrontProvider interface {
+	// ReferenceFront returns about n points sampled from the Pareto front of
+	// the problem with objs objectives. Only the Objectives of the returned
+	// vectors are set.
+	ReferenceFront(n, objs int) []models.Vector
+}
+
+// ReferenceFront returns the reference front of p, and false when the
+// problem does not provide one.
+func ReferenceFront(p Interface, n, objs int) ([]models.Vector, bool) {
+	provider, ok := p.(ReferenceFrontProvider)
+	if !ok {
+		return nil, false
+	}
+	return provider.ReferenceFront(n, objs), true
+}
+
+// Linspace returns n evenly spaced values over [start, end].
+func Linspace(start, end float64, n int) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	if n == 1 {
+		return []float64{start}
+	}
+	values := make([]float64, n)
+	step := (end - start) / float64(n-1)
+	for i := range values {
+		values[i] = start + float64(i)*step
+	}
+	return values
+}
+
+// SimplexLattice returns the structured points of Das and Dennis on the unit
+// simplex with objs coordinates. The number of divisions is the largest one
+// producing no more than n points, with a minimum of one division.
+func SimplexLattice(n, objs int) [][]float64 {
+	if objs <= 0 {
+		return nil
+	}
+	if objs == 1 {
+		return [][]float64{{1}}
+	}
+
+	divisions := 1
+	for binomial(divisions+objs, objs-1) <= n {
+		divisions++
+	}
+
+	points := make([][]float64, 0, binomial(divisions+objs-1, objs-1))
+	current := make([]float64, objs)
+	var fill func(index, left int)
+	fill = func(index, left int) {
+		if index == objs-1 {
+			current[index] = float64(left) / float64(divisions)
+			point := make([]float64, objs)
+			copy(point, current)
+			points = append(points, point)
+			return
+		}
+		for i := 0; i <= left; i++ {
+			current[index] = float64(i) / float64(divisions)
+			fill(index+1, left-i)
+		}
+	}
+	fill(0, divisions)
+	return points
+}
+
+// Grid returns the points of a regular grid over [0,1]^dims with at most n
+// points, using at least two points per dimension.
+func Grid(n, dims int) [][]float64 {
+	if dims <= 0 {
+		return [][]float64{{}}
+	}
+	side := max(2, int(math.Floor(math.Pow(float64(n), 1/float64(dims))+1e-9)))
+	axis := Linspace(0, 1, side)
+
+	total := 1
+	for range dims {
+		total *= side
+	}
+	points := make([][]float64, total)
+	for i := range points {
+		point := make([]float64, dims)
+		index := i
+		for d := range dims {
+			point[d] = axis[index%side]
+			index /= side
+		}
+		points[i] = point
+	}
+	return points
+}
+
+// FromObjectives wraps objective values into vectors.
+func FromObjectives(points [][]float64) []models.Vector {
+	vectors := make([]models.Vector, len(points))
+	for i, p := range points {
+		vectors[i] = models.Vector{Objectives: p}
+	}
+	return vectors
+}
+
+// NonDominated returns the vectors whose objectives are not dominated by any
+// other vector, assuming minimization.
+func NonDominated(vectors []models.Vector) []models.Vector {
+	result := make([]models.Vector, 0, len(vectors))
+	for i := range vectors {
+		dominated := false
+		for j := range vectors {
+			if i != j && dominates(vectors[j].Objectives, vectors[i].Objectives) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, vectors[i])
+		}
+	}
+	return result
+}
+
+// dominates reports whether a is no worse than b in every objective and
+// strictly better in at least one.
+func dominates(a, b []float64) bool {
+	better := false
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+		if a[i] < b[i] {
+			better = true
+		}
+	}
+	return better
+}
+
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+	}
+	return result
+}
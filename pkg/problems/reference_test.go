@@ -0,0 +1,84 @@
+package problems_test
+
+import (
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/stretchr/testify/assert"
+)
+
+// frontProblem is a mockProblem that knows its reference front.
+type frontProblem struct{ mockProblem }
+
+func (f *frontProblem) ReferenceFront(n, objs int) []models.Vector {
+	return make([]models.Vector, n)
+}
+
+func TestReferenceFront(t *testing.T) {
+	front, ok := problems.ReferenceFront(&frontProblem{}, 5, 2)
+	assert.True(t, ok)
+	assert.Len(t, front, 5)
+
+	front, ok = problems.ReferenceFront(&mockProblem{}, 5, 2)
+	assert.False(t, ok)
+	assert.Nil(t, front)
+}
+
+func TestLinspace(t *testing.T) {
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75, 1}, problems.Linspace(0, 1, 5))
+	assert.Equal(t, []float64{2}, problems.Linspace(2, 3, 1))
+	assert.Nil(t, problems.Linspace(0, 1, 0))
+}
+
+func TestSimplexLattice(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		objs     int
+		expected int
+	}{
+		{name: "two objectives", n: 10, objs: 2, expected: 10},
+		{name: "three objectives", n: 100, objs: 3, expected: 91},
+		{name: "five objectives", n: 100, objs: 5, expected: 70},
+		{name: "too few points uses one division", n: 1, objs: 3, expected: 3},
+		{name: "single objective", n: 10, objs: 1, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := problems.SimplexLattice(tt.n, tt.objs)
+			assert.Len(t, points, tt.expected)
+			for _, p := range points {
+				sum := 0.0
+				for _, v := range p {
+					assert.GreaterOrEqual(t, v, 0.0)
+					sum += v
+				}
+				assert.InDelta(t, 1, sum, 1e-12)
+			}
+		})
+	}
+}
+
+func TestGrid(t *testing.T) {
+	points := problems.Grid(100, 2)
+	assert.Len(t, points, 100)
+	assert.Contains(t, points, []float64{0, 0})
+	assert.Contains(t, points, []float64{1, 1})
+
+	assert.Len(t, problems.Grid(1, 3), 8, "at least two points per dimension")
+	assert.Equal(t, [][]float64{{}}, problems.Grid(10, 0))
+}
+
+func TestNonDominated(t *testing.T) {
+	vectors := problems.FromObjectives([][]float64{
+		{1, 2}, {2, 1}, {2, 2}, {1, 2}, {0.5, 3},
+	})
+
+	result := problems.NonDominated(vectors)
+
+	assert.Equal(t, problems.FromObjectives([][]float64{
+		{1, 2}, {2, 1}, {1, 2}, {0.5, 3},
+	}), result)
+}
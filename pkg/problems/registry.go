@@ -18,6 +18,9 @@ type ProblemMetadata struct {
 	MaxDim      int
 	NumObjs     int
 	Category    string // "multi" or "many"
+	// Bounds is the domain of the decision variables, nil means [0, 1] in
+	// every dimension.
+	Bounds BoundsFunc
 }
 
 // Registry manages problem registrations and creation.
@@ -58,6 +61,19 @@ func (r *Registry) Create(name string, dim, objs int) (Interface, error) {
 	return factory(dim, objs)
 }
 
+// GetFactory returns the factory of a problem, used to create an instance
+// sized for each execution.
+func (r *Registry) GetFactory(name string) (ProblemFactory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("no factory registered for problem: %s", name)
+	}
+	return factory, nil
+}
+
 // List returns all registered problem names.
 func (r *Registry) List() []string {
 	return util.SortedMapKeys(&r.mu, r.factories)
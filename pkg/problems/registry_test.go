@@ -61,6 +61,21 @@ func TestRegistry_Create_FactoryError(t *testing.T) {
 	assert.Contains(t, err.Error(), "factory error")
 }
 
+func TestRegistry_GetFactory(t *testing.T) {
+	r := newRegistry()
+	r.Register("test-problem", mockFactory("test-problem"), problems.ProblemMetadata{})
+
+	factory, err := r.GetFactory("test-problem")
+	require.NoError(t, err)
+	p, err := factory(5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "test-problem", p.Name())
+
+	_, err = r.GetFactory("nonexistent")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nonexistent")
+}
+
 func TestRegistry_List(t *testing.T) {
 	r := newRegistry()
 	meta := problems.ProblemMetadata{Category: "multi"}
@@ -168,3 +183,18 @@ func TestRegistry_ConcurrentAccess(t *testing.T) {
 	names := r.List()
 	assert.Len(t, names, 10)
 }
+
+func TestProblemMetadata_DefaultBounds(t *testing.T) {
+	t.Run("unit bounds when none are declared", func(t *testing.T) {
+		floor, ceil := problems.ProblemMetadata{}.DefaultBounds(3)
+		assert.Equal(t, []float64{0, 0, 0}, floor)
+		assert.Equal(t, []float64{1, 1, 1}, ceil)
+	})
+
+	t.Run("uses declared bounds", func(t *testing.T) {
+		meta := problems.ProblemMetadata{Bounds: problems.UniformBounds(-2, 4)}
+		floor, ceil := meta.DefaultBounds(2)
+		assert.Equal(t, []float64{-2, -2}, floor)
+		assert.Equal(t, []float64{4, 4}, ceil)
+	})
+}
@@ -0,0 +1,30 @@
+// Package repair provides boundary repair strategies for Differential
+// Evolution (DE) trial vectors.
+//
+// # Overview
+//
+// Mutation and crossover may leave trial elements outside the decision
+// variable bounds [floor, ceil]. A repair strategy brings every such element
+// back into its bounds before the trial is evaluated; elements already inside
+// them are left unchanged.
+//
+// # Available Strategies
+//
+//   - clamp:    the element is set to the bound it crossed
+//   - reflect:  the element is mirrored back into the bounds by the amount
+//     it exceeded them
+//   - reinit:   the element is drawn uniformly from its bounds
+//   - midpoint: the element is set halfway between the crossed bound and the
+//     parent's element
+//
+// Clamping is the default. It piles solutions up on the bounds, which helps
+// problems whose optimum lies there (e.g. ZDT distance variables at zero) but
+// hurts the diversity of the others. Reflection and random reinitialization
+// keep repaired elements spread, while the midpoint strategy keeps them close
+// to the parent, as in JADE.
+//
+// # Registration
+//
+// Strategies register themselves in DefaultRegistry, which backs the repair
+// field of DEConfig and the ListSupportedRepairs RPC.
+package repair
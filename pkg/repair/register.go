@@ -0,0 +1,19 @@
+package repair
+
+func init() {
+	DefaultRegistry.Register("clamp", Clamp, Metadata{
+		Description: "Clamp - Sets elements outside the bounds to the bound they crossed",
+	})
+
+	DefaultRegistry.Register("reflect", Reflect, Metadata{
+		Description: "Reflect - Mirrors elements back into the bounds by the amount they exceeded them",
+	})
+
+	DefaultRegistry.Register("reinit", Reinit, Metadata{
+		Description: "Random reinit - Draws elements outside the bounds uniformly from them",
+	})
+
+	DefaultRegistry.Register("midpoint", Midpoint, Metadata{
+		Description: "Midpoint-to-parent - Sets elements halfway between the crossed bound and the parent",
+	})
+}
@@ -0,0 +1,80 @@
+package repair
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/nicholaspcr/GoDE/pkg/util"
+)
+
+// Default is the name of the strategy used when none is requested.
+const Default = "clamp"
+
+// Factory is a function that creates a new repair strategy instance.
+type Factory func() Interface
+
+// Metadata contains information about a registered repair strategy.
+type Metadata struct {
+	Name        string
+	Description string
+}
+
+// Registry manages repair strategy registrations and creation.
+type Registry struct {
+	factories map[string]Factory
+	metadata  map[string]Metadata
+	mu        sync.RWMutex
+}
+
+// NewRegistry creates a new repair strategy registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]Factory),
+		metadata:  make(map[string]Metadata),
+	}
+}
+
+// Register adds a repair strategy factory to the registry.
+func (r *Registry) Register(name string, factory Factory, meta Metadata) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	meta.Name = name // Ensure name is set
+	r.factories[name] = factory
+	r.metadata[name] = meta
+}
+
+// Create instantiates a repair strategy by name.
+func (r *Registry) Create(name string) (Interface, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("repair does not exist: %s", name)
+	}
+
+	return factory(), nil
+}
+
+// List returns all registered repair strategy names.
+func (r *Registry) List() []string {
+	return util.SortedMapKeys(&r.mu, r.factories)
+}
+
+// Get returns metadata for a specific repair strategy.
+func (r *Registry) Get(name string) (Metadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	meta, ok := r.metadata[name]
+	return meta, ok
+}
+
+// ListMetadata returns metadata for all registered repair strategys.
+func (r *Registry) ListMetadata() []Metadata {
+	return util.SortedMapValues(&r.mu, r.metadata)
+}
+
+// DefaultRegistry is the global repair strategy registry.
+var DefaultRegistry = NewRegistry()
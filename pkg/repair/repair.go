@@ -0,0 +1,23 @@
+package repair
+
+import (
+	"math/rand"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// Parameters are the bounds trial vectors are repaired into, one entry per
+// dimension.
+type Parameters struct {
+	Floor  []float64  `json:"floor" yaml:"floor"`
+	Ceil   []float64  `json:"ceil"  yaml:"ceil"`
+	Random *rand.Rand `json:"-"     yaml:"-"`
+}
+
+// Interface defines the contract for boundary repair strategies.
+type Interface interface {
+	Name() string
+	// Repair moves the elements of trial outside the bounds back into them,
+	// in place. parent is the target vector the trial was built from.
+	Repair(trial, parent models.Vector, params Parameters)
+}
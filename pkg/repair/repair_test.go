@@ -0,0 +1,88 @@
+package repair
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategies(t *testing.T) {
+	params := Parameters{
+		Floor:  []float64{0, 0, 0, -1, 2},
+		Ceil:   []float64{1, 1, 1, 1, 2},
+		Random: rand.New(rand.NewSource(1)),
+	}
+	parent := models.Vector{Elements: []float64{0.4, 0.2, 0.5, 0, 2}}
+
+	tests := []struct {
+		name     string
+		strategy Interface
+		trial    []float64
+		want     []float64
+	}{
+		{
+			name:     "clamp",
+			strategy: Clamp(),
+			trial:    []float64{-0.5, 1.25, 0.5, -3, 5},
+			want:     []float64{0, 1, 0.5, -1, 2},
+		},
+		{
+			name:     "reflect",
+			strategy: Reflect(),
+			trial:    []float64{-0.25, 1.25, 0.5, 5.5, 5},
+			want:     []float64{0.25, 0.75, 0.5, 0.5, 2},
+		},
+		{
+			name:     "reflect bounces between the bounds",
+			strategy: Reflect(),
+			trial:    []float64{-1.5, 2.25, 3, -5, 1},
+			want:     []float64{0.5, 0.25, 1, -1, 2},
+		},
+		{
+			name:     "midpoint",
+			strategy: Midpoint(),
+			trial:    []float64{-0.5, 1.25, 0.5, -3, 5},
+			want:     []float64{0.2, 0.6, 0.5, -0.5, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trial := models.Vector{Elements: tt.trial}
+			tt.strategy.Repair(trial, parent, params)
+			assert.InDeltaSlice(t, tt.want, trial.Elements, 1e-12)
+		})
+	}
+
+	t.Run("reinit", func(t *testing.T) {
+		for range 50 {
+			trial := models.Vector{Elements: []float64{-0.5, 1.25, 0.5, -3, 5}}
+			Reinit().Repair(trial, parent, params)
+			assert.Equal(t, 0.5, trial.Elements[2], "elements inside the bounds are kept")
+			for i, e := range trial.Elements {
+				assert.GreaterOrEqual(t, e, params.Floor[i])
+				assert.LessOrEqual(t, e, params.Ceil[i])
+			}
+		}
+	})
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	assert.Equal(t, []string{"clamp", "midpoint", "reflect", "reinit"}, DefaultRegistry.List())
+
+	for _, meta := range DefaultRegistry.ListMetadata() {
+		r, err := DefaultRegistry.Create(meta.Name)
+		require.NoError(t, err)
+		assert.Equal(t, meta.Name, r.Name())
+		assert.NotEmpty(t, meta.Description)
+	}
+
+	_, ok := DefaultRegistry.Get(Default)
+	assert.True(t, ok)
+
+	_, err := DefaultRegistry.Create("unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package repair
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+)
+
+// clamp
+type clamp struct{}
+
+// Clamp returns the strategy that sets elements outside the bounds to the
+// bound they crossed.
+func Clamp() Interface {
+	return &clamp{}
+}
+
+func (c *clamp) Name() string {
+	return "clamp"
+}
+
+func (c *clamp) Repair(trial, _ models.Vector, p Parameters) {
+	for i, e := range trial.Elements {
+		trial.Elements[i] = math.Min(math.Max(e, p.Floor[i]), p.Ceil[i])
+	}
+}
+
+// reflect
+type reflect struct{}
+
+// Reflect returns the strategy that mirrors elements back into the bounds by
+// the amount they exceeded them. Elements further than the width of the
+// bounds keep bouncing between them.
+func Reflect() Interface {
+	return &reflect{}
+}
+
+func (r *reflect) Name() string {
+	return "reflect"
+}
+
+func (r *reflect) Repair(trial, _ models.Vector, p Parameters) {
+	for i, e := range trial.Elements {
+		floor, ceil := p.Floor[i], p.Ceil[i]
+		if e >= floor && e <= ceil {
+			continue
+		}
+
+		width := ceil - floor
+		if width <= 0 {
+			trial.Elements[i] = floor
+			continue
+		}
+
+		// Distance past the crossed bound, folded into a single width as it
+		// bounces between both bounds
+		d := math.Mod(math.Max(floor-e, e-ceil), 2*width)
+		if d > width {
+			d = 2*width - d
+		}
+		if e < floor {
+			trial.Elements[i] = floor + d
+		} else {
+			trial.Elements[i] = ceil - d
+		}
+	}
+}
+
+// reinit
+type reinit struct{}
+
+// Reinit returns the strategy that draws elements outside the bounds
+// uniformly from them.
+func Reinit() Interface {
+	return &reinit{}
+}
+
+func (r *reinit) Name() string {
+	return "reinit"
+}
+
+func (r *reinit) Repair(trial, _ models.Vector, p Parameters) {
+	for i, e := range trial.Elements {
+		if e < p.Floor[i] || e > p.Ceil[i] {
+			trial.Elements[i] = p.Floor[i] + p.Random.Float64()*(p.Ceil[i]-p.Floor[i])
+		}
+	}
+}
+
+// midpoint
+type midpoint struct{}
+
+// Midpoint returns the strategy that sets elements outside the bounds halfway
+// between the bound they crossed and the element of the parent, as JADE does.
+func Midpoint() Interface {
+	return &midpoint{}
+}
+
+func (m *midpoint) Name() string {
+	return "midpoint"
+}
+
+func (m *midpoint) Repair(trial, parent models.Vector, p Parameters) {
+	for i, e := range trial.Elements {
+		switch {
+		case e < p.Floor[i]:
+			trial.Elements[i] = (p.Floor[i] + parent.Elements[i]) / 2
+		case e > p.Ceil[i]:
+			trial.Elements[i] = (p.Ceil[i] + parent.Elements[i]) / 2
+		}
+	}
+}
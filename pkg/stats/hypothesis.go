@@ -0,0 +1,168 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// RankSum is the result of a Wilcoxon rank-sum test.
+type RankSum struct {
+	// W is the sum of the ranks of the first sample.
+	W float64
+	// Z is the normal approximation of W, positive when the first sample
+	// tends to larger values.
+	Z float64
+	// P is the two-sided p-value.
+	P float64
+}
+
+// WilcoxonRankSum tests whether x and y come from the same distribution,
+// against values of one of them tending to be larger. It uses the normal
+// approximation with tie and continuity corrections, which is accurate from
+// around eight values per sample.
+func WilcoxonRankSum(x, y []float64) (RankSum, error) {
+	if len(x) == 0 || len(y) == 0 {
+		return RankSum{}, errors.New("rank-sum test needs two non-empty samples")
+	}
+
+	ranks, ties := Ranks(append(append([]float64{}, x...), y...))
+	result := RankSum{P: 1}
+	for _, r := range ranks[:len(x)] {
+		result.W += r
+	}
+
+	n1, n2 := float64(len(x)), float64(len(y))
+	n := n1 + n2
+	mean := n1 * (n + 1) / 2
+	variance := n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1)))
+	if variance <= 0 {
+		// Every value is tied
+		return result, nil
+	}
+
+	diff := result.W - mean
+	switch {
+	case diff > 0.5:
+		diff -= 0.5
+	case diff < -0.5:
+		diff += 0.5
+	default:
+		diff = 0
+	}
+	result.Z = diff / math.Sqrt(variance)
+	result.P = math.Erfc(math.Abs(result.Z) / math.Sqrt2)
+	return result, nil
+}
+
+// FriedmanResult is the result of a Friedman test.
+type FriedmanResult struct {
+	// Statistic is the tie corrected Friedman chi-square statistic.
+	Statistic float64
+	// DF is the degrees of freedom of its chi-square distribution.
+	DF int
+	// P is the p-value of the chi-square approximation.
+	P float64
+	// MeanRanks holds the mean rank of each treatment within the blocks,
+	// the smallest value ranking first.
+	MeanRanks []float64
+}
+
+// Friedman tests whether the treatments, the columns of blocks, perform
+// alike over the blocks, its rows. Every block must measure every treatment.
+func Friedman(blocks [][]float64) (FriedmanResult, error) {
+	if len(blocks) < 2 {
+		return FriedmanResult{}, errors.New("friedman test needs at least two blocks")
+	}
+	k := len(blocks[0])
+	if k < 2 {
+		return FriedmanResult{}, errors.New("friedman test needs at least two treatments")
+	}
+
+	rankSums := make([]float64, k)
+	ties := 0.0
+	for _, block := range blocks {
+		if len(block) != k {
+			return FriedmanResult{}, errors.New("friedman test blocks must measure every treatment")
+		}
+		ranks, t := Ranks(block)
+		for j, r := range ranks {
+			rankSums[j] += r
+		}
+		ties += t
+	}
+
+	n, kf := float64(len(blocks)), float64(k)
+	result := FriedmanResult{DF: k - 1, P: 1, MeanRanks: make([]float64, k)}
+	sumSquares := 0.0
+	for j, r := range rankSums {
+		result.MeanRanks[j] = r / n
+		sumSquares += r * r
+	}
+
+	correction := 1 - ties/(n*(kf*kf*kf-kf))
+	if correction <= 0 {
+		// Every block ties every treatment
+		return result, nil
+	}
+	result.Statistic = (12/(n*kf*(kf+1))*sumSquares - 3*n*(kf+1)) / correction
+	result.P = ChiSquareSurvival(result.Statistic, result.DF)
+	return result, nil
+}
+
+// ChiSquareSurvival returns the probability of a chi-square distributed
+// value with df degrees of freedom being larger than x.
+func ChiSquareSurvival(x float64, df int) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return upperGamma(float64(df)/2, x/2)
+}
+
+// upperGamma returns the regularized upper incomplete gamma function
+// Q(a, x), by its series for x < a+1 and its continued fraction otherwise.
+func upperGamma(a, x float64) float64 {
+	const (
+		maxIterations = 500
+		epsilon       = 1e-15
+		tiny          = 1e-300
+	)
+	lgamma, _ := math.Lgamma(a)
+	prefix := math.Exp(a*math.Log(x) - x - lgamma)
+
+	if x < a+1 {
+		term, sum := 1/a, 1/a
+		for n := 1; n < maxIterations; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*epsilon {
+				break
+			}
+		}
+		return max(0, 1-sum*prefix)
+	}
+
+	// Modified Lentz's method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < maxIterations; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return prefix * h
+}
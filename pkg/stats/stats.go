@@ -0,0 +1,103 @@
+// Package stats provides the descriptive statistics and non-parametric tests
+// used to compare the results of repeated executions.
+//
+// Supported statistics:
+//   - Summarize: size, mean, median, standard deviation and range of a sample.
+//   - WilcoxonRankSum: two-sided Wilcoxon rank-sum (Mann-Whitney U) test between two
+//     independent samples.
+//   - Friedman: Friedman test between several treatments measured on the
+//     same blocks, e.g. algorithms measured on the same problems.
+package stats
+
+import (
+	"math"
+	"slices"
+)
+
+// Summary describes a sample.
+type Summary struct {
+	N      int
+	Mean   float64
+	Median float64
+	// StdDev is the sample standard deviation, zero for fewer than two values.
+	StdDev float64
+	Min    float64
+	Max    float64
+}
+
+// Summarize describes values, its zero value for an empty sample.
+func Summarize(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	s := Summary{
+		N:      len(sorted),
+		Median: median(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+	}
+	for _, v := range sorted {
+		s.Mean += v
+	}
+	s.Mean /= float64(s.N)
+
+	if s.N > 1 {
+		sum := 0.0
+		for _, v := range sorted {
+			sum += (v - s.Mean) * (v - s.Mean)
+		}
+		s.StdDev = math.Sqrt(sum / float64(s.N-1))
+	}
+	return s
+}
+
+// median returns the median of sorted, which must not be empty.
+func median(sorted []float64) float64 {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// Ranks returns the ranks of values starting from 1, smallest first, tied
+// values sharing the mean of their ranks. It also returns the sum of t^3 - t
+// over the groups of t tied values, used to correct the variance of rank
+// statistics.
+func Ranks(values []float64) ([]float64, float64) {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		switch {
+		case values[a] < values[b]:
+			return -1
+		case values[a] > values[b]:
+			return 1
+		}
+		return 0
+	})
+
+	ranks := make([]float64, len(values))
+	ties := 0.0
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+		// Positions start..end-1 hold ranks start+1..end
+		rank := float64(start+end+1) / 2
+		for _, i := range order[start:end] {
+			ranks[i] = rank
+		}
+		t := float64(end - start)
+		ties += t*t*t - t
+		start = end
+	}
+	return ranks, ties
+}
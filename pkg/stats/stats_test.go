@@ -0,0 +1,131 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, Summary{}, Summarize(nil))
+	})
+
+	t.Run("single value", func(t *testing.T) {
+		assert.Equal(t, Summary{N: 1, Mean: 3, Median: 3, Min: 3, Max: 3}, Summarize([]float64{3}))
+	})
+
+	t.Run("does not modify the values", func(t *testing.T) {
+		values := []float64{4, 1, 3, 2}
+		s := Summarize(values)
+		assert.Equal(t, []float64{4, 1, 3, 2}, values)
+		assert.Equal(t, 4, s.N)
+		assert.InDelta(t, 2.5, s.Mean, 1e-12)
+		assert.InDelta(t, 2.5, s.Median, 1e-12)
+		assert.InDelta(t, math.Sqrt(5.0/3), s.StdDev, 1e-12)
+		assert.Equal(t, 1.0, s.Min)
+		assert.Equal(t, 4.0, s.Max)
+	})
+
+	t.Run("odd size median", func(t *testing.T) {
+		assert.Equal(t, 5.0, Summarize([]float64{9, 1, 5}).Median)
+	})
+}
+
+func TestRanks(t *testing.T) {
+	ranks, ties := Ranks([]float64{30, 20, 10, 20})
+	assert.Equal(t, []float64{4, 2.5, 1, 2.5}, ranks)
+	assert.Equal(t, 6.0, ties)
+
+	ranks, ties = Ranks([]float64{2, 2, 2})
+	assert.Equal(t, []float64{2, 2, 2}, ranks)
+	assert.Equal(t, 24.0, ties)
+}
+
+func TestWilcoxonRankSum(t *testing.T) {
+	t.Run("separated samples", func(t *testing.T) {
+		test, err := WilcoxonRankSum([]float64{1, 2, 3, 4, 5}, []float64{6, 7, 8, 9, 10})
+		require.NoError(t, err)
+		assert.Equal(t, 15.0, test.W)
+		assert.Less(t, test.Z, 0.0)
+		// Matches scipy.stats.mannwhitneyu with the asymptotic method
+		assert.InDelta(t, 0.01219, test.P, 1e-5)
+
+		reversed, err := WilcoxonRankSum([]float64{6, 7, 8, 9, 10}, []float64{1, 2, 3, 4, 5})
+		require.NoError(t, err)
+		assert.InDelta(t, -test.Z, reversed.Z, 1e-12)
+		assert.InDelta(t, test.P, reversed.P, 1e-12)
+	})
+
+	t.Run("identical samples", func(t *testing.T) {
+		test, err := WilcoxonRankSum([]float64{1, 2, 3}, []float64{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, test.P)
+	})
+
+	t.Run("every value tied", func(t *testing.T) {
+		test, err := WilcoxonRankSum([]float64{1, 1}, []float64{1, 1, 1})
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, test.P)
+		assert.Zero(t, test.Z)
+	})
+
+	t.Run("empty sample", func(t *testing.T) {
+		_, err := WilcoxonRankSum(nil, []float64{1})
+		assert.Error(t, err)
+	})
+}
+
+func TestFriedman(t *testing.T) {
+	t.Run("consistent ordering", func(t *testing.T) {
+		result, err := Friedman([][]float64{{1, 2, 3}, {4, 5, 6}, {0.1, 0.2, 0.3}})
+		require.NoError(t, err)
+		assert.InDelta(t, 6, result.Statistic, 1e-12)
+		assert.Equal(t, 2, result.DF)
+		assert.InDelta(t, math.Exp(-3), result.P, 1e-9)
+		assert.Equal(t, []float64{1, 2, 3}, result.MeanRanks)
+	})
+
+	t.Run("ties are corrected", func(t *testing.T) {
+		result, err := Friedman([][]float64{{1, 1, 2}, {1, 2, 3}, {2, 1, 3}, {1, 2, 2}})
+		require.NoError(t, err)
+		// Rank sums 5.5, 7 and 11.5 with two pairs of ties
+		assert.InDelta(t, 39.0/7, result.Statistic, 1e-9)
+		assert.InDelta(t, math.Exp(-39.0/14), result.P, 1e-9)
+	})
+
+	t.Run("every treatment tied", func(t *testing.T) {
+		result, err := Friedman([][]float64{{1, 1}, {2, 2}})
+		require.NoError(t, err)
+		assert.Zero(t, result.Statistic)
+		assert.Equal(t, 1.0, result.P)
+	})
+
+	t.Run("invalid shapes", func(t *testing.T) {
+		_, err := Friedman([][]float64{{1, 2}})
+		assert.Error(t, err)
+		_, err = Friedman([][]float64{{1}, {2}})
+		assert.Error(t, err)
+		_, err = Friedman([][]float64{{1, 2}, {1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestChiSquareSurvival(t *testing.T) {
+	tests := []struct {
+		x    float64
+		df   int
+		want float64
+	}{
+		{x: 0, df: 3, want: 1},
+		{x: 3.841459, df: 1, want: 0.05},
+		{x: 10, df: 4, want: 0.0404276820},
+		{x: 1, df: 10, want: 0.9998278844},
+		{x: 50, df: 5, want: 1.3857979e-9},
+	}
+	for _, tt := range tests {
+		assert.InDelta(t, tt.want, ChiSquareSurvival(tt.x, tt.df), 1e-6*math.Max(tt.want, 1e-3))
+	}
+}
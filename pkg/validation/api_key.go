@@ -0,0 +1,23 @@
+package validation
+
+import "time"
+
+const maxAPIKeyNameLength = 100
+
+// ValidateAPIKey validates the user provided fields of an API key, a zero
+// expiresIn means the key does not expire.
+func ValidateAPIKey(name string, scopes []string, expiresIn time.Duration) error {
+	if err := ValidateNonEmpty(name, "name"); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(name, 1, maxAPIKeyNameLength, "name"); err != nil {
+		return err
+	}
+	if len(scopes) == 0 {
+		return NewValidationError("scopes", len(scopes), ErrEmptyField, "at least one scope is required")
+	}
+	if expiresIn < 0 {
+		return NewValidationError("expires_in", expiresIn.String(), ErrOutOfRange, "must be positive")
+	}
+	return nil
+}
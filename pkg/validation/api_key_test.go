@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAPIKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyName   string
+		scopes    []string
+		expiresIn time.Duration
+		wantErr   bool
+	}{
+		{"valid", "nightly", []string{"de:run", "de:read"}, 30 * 24 * time.Hour, false},
+		{"does not expire", "nightly", []string{"de:read"}, 0, false},
+		{"maximum name length", strings.Repeat("a", 100), []string{"de:read"}, 0, false},
+		{"empty name", "", []string{"de:read"}, 0, true},
+		{"whitespace name", "   ", []string{"de:read"}, 0, true},
+		{"name too long", strings.Repeat("a", 101), []string{"de:read"}, 0, true},
+		{"no scopes", "nightly", nil, 0, true},
+		{"negative expiry", "nightly", []string{"de:read"}, -time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAPIKey(tt.keyName, tt.scopes, tt.expiresIn)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
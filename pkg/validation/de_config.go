@@ -4,6 +4,11 @@ import (
 	"fmt"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	"github.com/nicholaspcr/GoDE/pkg/crossover"
+	"github.com/nicholaspcr/GoDE/pkg/problems"
+	"github.com/nicholaspcr/GoDE/pkg/problems/custom"
+	"github.com/nicholaspcr/GoDE/pkg/repair"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
 )
 
 // ValidateDEConfig validates differential evolution configuration.
@@ -38,8 +43,9 @@ func ValidateDEConfig(cfg *api.DEConfig) error {
 		return err
 	}
 
-	// Validate floor and ceil limiters
-	if cfg.FloorLimiter >= cfg.CeilLimiter {
+	// Validate floor and ceil limiters, both zero means they are unset
+	unsetLimiters := cfg.FloorLimiter == 0 && cfg.CeilLimiter == 0
+	if !unsetLimiters && cfg.FloorLimiter >= cfg.CeilLimiter {
 		return NewValidationError(
 			"floor_limiter",
 			cfg.FloorLimiter,
@@ -49,6 +55,11 @@ func ValidateDEConfig(cfg *api.DEConfig) error {
 		)
 	}
 
+	// Validate per-dimension bounds
+	if err := ValidateBounds(cfg.Bounds, cfg.DimensionsSize); err != nil {
+		return err
+	}
+
 	// Validate total memory allocation (dimensions × population)
 	// Prevent memory bombs from excessively large configurations
 	const maxTotalElements = int64(10_000_000) // 10 million elements max
@@ -63,6 +74,29 @@ func ValidateDEConfig(cfg *api.DEConfig) error {
 		)
 	}
 
+	// Validate the operators building trial vectors, empty uses the defaults
+	if _, ok := crossover.DefaultRegistry.Get(cfg.Crossover); cfg.Crossover != "" && !ok {
+		return NewValidationError("crossover", cfg.Crossover, ErrInvalidFormat,
+			fmt.Sprintf("unsupported crossover: %s", cfg.Crossover))
+	}
+	if _, ok := repair.DefaultRegistry.Get(cfg.Repair); cfg.Repair != "" && !ok {
+		return NewValidationError("repair", cfg.Repair, ErrInvalidFormat,
+			fmt.Sprintf("unsupported repair: %s", cfg.Repair))
+	}
+
+	// Validate the result limit and pareto archive, zero uses the defaults
+	if err := ValidateRange(cfg.ResultLimit, int64(0), int64(100000), "result_limit"); err != nil {
+		return err
+	}
+	if archive := cfg.GetParetoArchive(); archive != nil {
+		if err := ValidateRange(archive.MaxSize, int64(0), int64(100000), "pareto_archive.max_size"); err != nil {
+			return err
+		}
+		if err := ValidateRange(archive.Epsilon, 0.0, 1e6, "pareto_archive.epsilon"); err != nil {
+			return err
+		}
+	}
+
 	// Validate GDE3 config if present
 	if gde3 := cfg.GetGde3(); gde3 != nil {
 		if err := ValidateGDE3Config(gde3); err != nil {
@@ -70,6 +104,48 @@ func ValidateDEConfig(cfg *api.DEConfig) error {
 		}
 	}
 
+	if moead := cfg.GetMoead(); moead != nil {
+		if err := ValidateMOEADConfig(moead); err != nil {
+			return err
+		}
+	}
+
+	if nsga2 := cfg.GetNsga2(); nsga2 != nil {
+		if err := ValidateNSGA2Config(nsga2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBounds validates per-dimension bounds, which are optional but when
+// given must cover every dimension.
+func ValidateBounds(bounds []*api.Bounds, dimensions int64) error {
+	if len(bounds) == 0 {
+		return nil
+	}
+
+	if int64(len(bounds)) != dimensions {
+		return NewValidationError(
+			"bounds",
+			len(bounds),
+			ErrInvalidFormat,
+			fmt.Sprintf("expected one bound per dimension (%d), got %d", dimensions, len(bounds)),
+		)
+	}
+
+	for i, b := range bounds {
+		if b.GetFloor() >= b.GetCeil() {
+			return NewValidationError(
+				"bounds",
+				i,
+				ErrOutOfRange,
+				fmt.Sprintf("bounds[%d]: floor (%v) must be less than ceil (%v)", i, b.GetFloor(), b.GetCeil()),
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -94,7 +170,74 @@ func ValidateGDE3Config(cfg *api.GDE3Config) error {
 		return err
 	}
 
-	return nil
+	if _, ok := api.ParameterAdaptation_name[int32(cfg.Adaptation)]; !ok {
+		return NewValidationError("adaptation", cfg.Adaptation, ErrInvalidFormat,
+			fmt.Sprintf("unknown parameter adaptation: %d", cfg.Adaptation))
+	}
+
+	// Zero learning rate and memory size use the algorithm's defaults
+	if err := ValidateRange(cfg.LearningRate, float32(0.0), float32(1.0), "learning_rate"); err != nil {
+		return err
+	}
+
+	return ValidateRange(cfg.MemorySize, int64(0), int64(1000), "memory_size")
+}
+
+// ValidateMOEADConfig validates MOEA/D-specific parameters, zero values are
+// replaced by the algorithm's defaults.
+func ValidateMOEADConfig(cfg *api.MOEADConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if err := ValidateRange(cfg.Cr, float32(0.0), float32(1.0), "cr"); err != nil {
+		return err
+	}
+
+	if err := ValidateRange(cfg.F, float32(0.0), float32(2.0), "f"); err != nil {
+		return err
+	}
+
+	if err := ValidateRange(cfg.P, float32(0.0), float32(1.0), "p"); err != nil {
+		return err
+	}
+
+	// Neighbourhood against the population is checked in ValidateRunAsyncRequest
+	if err := ValidateRange(cfg.NeighborhoodSize, int64(0), int64(10000), "neighborhood_size"); err != nil {
+		return err
+	}
+
+	if _, ok := api.Decomposition_name[int32(cfg.Decomposition)]; !ok {
+		return NewValidationError("decomposition", cfg.Decomposition, ErrInvalidFormat,
+			fmt.Sprintf("unknown decomposition: %d", cfg.Decomposition))
+	}
+
+	if err := ValidateRange(cfg.Theta, float32(0.0), float32(1000.0), "theta"); err != nil {
+		return err
+	}
+
+	if err := ValidateRange(cfg.Delta, float32(0.0), float32(1.0), "delta"); err != nil {
+		return err
+	}
+
+	return ValidateRange(cfg.MaxReplacements, int64(0), int64(10000), "max_replacements")
+}
+
+// ValidateNSGA2Config validates NSGA-II-specific parameters.
+func ValidateNSGA2Config(cfg *api.NSGA2Config) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if err := ValidateRange(cfg.Cr, float32(0.0), float32(1.0), "cr"); err != nil {
+		return err
+	}
+
+	if err := ValidateRange(cfg.F, float32(0.0), float32(2.0), "f"); err != nil {
+		return err
+	}
+
+	return ValidateRange(cfg.P, float32(0.0), float32(1.0), "p")
 }
 
 // ValidateRunAsyncRequest validates a DE run request including variant-specific constraints.
@@ -116,31 +259,155 @@ func ValidateRunAsyncRequest(algorithm, variant, problem string, cfg *api.DEConf
 		)
 	}
 
+	if err := validateAlgorithmConfig(algorithm, minPopulation, cfg); err != nil {
+		return err
+	}
+
+	return validateProblemSize(problem, cfg)
+}
+
+// validateAlgorithmConfig checks that the algorithm configuration, when
+// given, belongs to the requested algorithm. MOEA/D breeds within a
+// neighbourhood, so it must fit in the population and hold enough vectors
+// for the variant.
+func validateAlgorithmConfig(algorithm string, minPopulation int, cfg *api.DEConfig) error {
+	var configured string
+	switch cfg.AlgorithmConfig.(type) {
+	case nil:
+		return nil
+	case *api.DEConfig_Gde3:
+		configured = "gde3"
+	case *api.DEConfig_Moead:
+		configured = "moead"
+	case *api.DEConfig_Nsga2:
+		configured = "nsga2"
+	}
+
+	if configured != algorithm {
+		return NewValidationError("algorithm_config", configured, ErrInvalidFormat,
+			fmt.Sprintf("%s configuration given for algorithm %s", configured, algorithm))
+	}
+
+	if size := cfg.GetMoead().GetNeighborhoodSize(); size != 0 {
+		if err := ValidateRange(size, int64(minPopulation), cfg.PopulationSize, "neighborhood_size"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateProblemSize checks the requested dimensions and objectives against
+// the problem's metadata, then lets its factory reject combinations the
+// metadata cannot express (e.g. DTLZ needs more dimensions than objectives).
+func validateProblemSize(problem string, cfg *api.DEConfig) error {
+	if cp := cfg.GetCustomProblem(); cp != nil {
+		return validateCustomProblem(problem, cp, cfg)
+	}
+
+	meta, ok := problems.DefaultRegistry.Get(problem)
+	if !ok {
+		return NewValidationError("problem", problem, ErrInvalidFormat,
+			fmt.Sprintf("unsupported problem: %s", problem))
+	}
+
+	if err := ValidateRange(cfg.DimensionsSize, int64(meta.MinDim), int64(meta.MaxDim), "dimensions_size"); err != nil {
+		return err
+	}
+
+	if meta.NumObjs > 0 && cfg.ObjectivesSize != int64(meta.NumObjs) {
+		return NewValidationError(
+			"objectives_size",
+			cfg.ObjectivesSize,
+			ErrOutOfRange,
+			fmt.Sprintf("problem %s has %d objectives, got %d", problem, meta.NumObjs, cfg.ObjectivesSize),
+		)
+	}
+
+	if _, err := problems.DefaultRegistry.Create(problem, int(cfg.DimensionsSize), int(cfg.ObjectivesSize)); err != nil {
+		return NewValidationError("dimensions_size", cfg.DimensionsSize, ErrOutOfRange, err.Error())
+	}
+
+	return nil
+}
+
+// validateCustomProblem checks that the custom problem compiles, does not
+// shadow a built-in problem and supports the requested dimensions and
+// objectives.
+func validateCustomProblem(problem string, cp *api.CustomProblem, cfg *api.DEConfig) error {
+	if err := ValidateStringLength(cp.Name, 1, 64, "custom_problem.name"); err != nil {
+		return err
+	}
+	if problem != cp.Name {
+		return NewValidationError("problem", problem, ErrInvalidFormat,
+			fmt.Sprintf("problem %s does not match custom problem %s", problem, cp.Name))
+	}
+	if _, ok := problems.DefaultRegistry.Get(cp.Name); ok {
+		return NewValidationError("custom_problem.name", cp.Name, ErrInvalidFormat,
+			fmt.Sprintf("custom problem %s clashes with a built-in problem", cp.Name))
+	}
+
+	def := custom.FromProto(cp)
+	meta, err := def.Metadata()
+	if err != nil {
+		return NewValidationError("custom_problem", cp.Name, ErrInvalidFormat, err.Error())
+	}
+
+	if err := ValidateRange(cfg.DimensionsSize, int64(meta.MinDim), int64(meta.MaxDim), "dimensions_size"); err != nil {
+		return err
+	}
+
+	if cfg.ObjectivesSize != int64(meta.NumObjs) {
+		return NewValidationError(
+			"objectives_size",
+			cfg.ObjectivesSize,
+			ErrOutOfRange,
+			fmt.Sprintf("problem %s has %d objectives, got %d", problem, meta.NumObjs, cfg.ObjectivesSize),
+		)
+	}
+
 	return nil
 }
 
+// ValidateReferenceFrontRequest validates the parameters used to sample a
+// reference Pareto front.
+func ValidateReferenceFrontRequest(problem string, objectives, points int64) error {
+	if err := ValidateNonEmpty(problem, "problem"); err != nil {
+		return err
+	}
+
+	if err := ValidateRange(objectives, int64(1), int64(10), "objectives_size"); err != nil {
+		return err
+	}
+
+	return ValidateRange(points, int64(1), int64(10000), "points")
+}
+
+// ValidateResumeExecutionRequest validates the parameters used to resume an
+// execution. Zero generations keep the configured ones.
+func ValidateResumeExecutionRequest(executionID string, generations int64) error {
+	if err := ValidateNonEmpty(executionID, "execution_id"); err != nil {
+		return err
+	}
+
+	if generations == 0 {
+		return nil
+	}
+	return ValidateRange(generations, int64(1), int64(10000), "generations")
+}
+
+// defaultMinPopulation is the conservative minimum population of variants
+// that are not registered or do not declare one.
+const defaultMinPopulation = 4
+
 // getMinPopulationForVariant returns the minimum population size required for a given variant.
-//
-// Different DE variants have different minimum population requirements based on the number
-// of random vectors they need to select during mutation:
-//   - rand/1: needs CurrPos + 3 random vectors = 4 minimum
-//   - rand/2: needs CurrPos + 5 random vectors = 6 minimum
-//   - best/1: needs CurrPos + 2 random vectors = 3 minimum
-//   - best/2: needs CurrPos + 4 random vectors = 5 minimum
-//   - pbest: needs CurrPos + 2 random vectors = 3 minimum
-//   - current-to-best/1: needs CurrPos + 3 random vectors = 4 minimum
+// Variants declare it in their registry metadata based on the number of
+// distinct vectors they select during mutation, e.g. rand/1 needs CurrPos + 3
+// random vectors = 4 minimum.
 func getMinPopulationForVariant(variant string) int {
-	switch variant {
-	case "rand/1", "current-to-best/1":
-		return 4
-	case "rand/2":
-		return 6
-	case "best/1", "pbest":
-		return 3
-	case "best/2":
-		return 5
-	default:
-		// Conservative fallback for unknown variants
-		return 4
+	meta, ok := variants.DefaultRegistry.Get(variant)
+	if !ok || meta.MinPopulation <= 0 {
+		return defaultMinPopulation
 	}
+	return meta.MinPopulation
 }
@@ -4,7 +4,16 @@ import (
 	"testing"
 
 	"github.com/nicholaspcr/GoDE/pkg/api/v1"
+	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/dtlz" // Register DTLZ problems
+	_ "github.com/nicholaspcr/GoDE/pkg/problems/many/wfg"  // Register WFG problems
+	_ "github.com/nicholaspcr/GoDE/pkg/problems/multi"     // Register ZDT and VNT problems
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/best"            // Register best variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best" // Register current-to-best variant
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/pbest"           // Register pbest variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand"            // Register rand variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand-to-best"    // Register rand-to-best variant
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestValidateDEConfig(t *testing.T) {
@@ -265,6 +274,43 @@ func TestValidateDEConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid crossover and repair",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				Crossover:      "exponential",
+				Repair:         "reflect",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown crossover",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				Crossover:      "uniform",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown repair",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				Repair:         "wrap",
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid with GDE3 config",
 			config: &api.DEConfig{
@@ -305,6 +351,121 @@ func TestValidateDEConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid with bad MOEA/D config",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				AlgorithmConfig: &api.DEConfig_Moead{
+					Moead: &api.MOEADConfig{Cr: 0.9, F: 0.5, Delta: 1.5},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid with bad NSGA-II config",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				AlgorithmConfig: &api.DEConfig_Nsga2{
+					Nsga2: &api.NSGA2Config{Cr: 0.9, F: 3},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid with unset limiters",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid with per-dimension bounds",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 2,
+				ObjectivesSize: 2,
+				Bounds: []*api.Bounds{
+					{Floor: 0, Ceil: 1},
+					{Floor: -5, Ceil: 5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid with result limit and pareto archive",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				ResultLimit:    500,
+				ParetoArchive:  &api.ParetoArchiveConfig{MaxSize: 200, Epsilon: 0.01},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid negative result limit",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				ResultLimit:    -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative pareto archive size",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				ParetoArchive:  &api.ParetoArchiveConfig{MaxSize: -1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative pareto archive epsilon",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				ParetoArchive:  &api.ParetoArchiveConfig{Epsilon: -0.1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid bounds length",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 100,
+				DimensionsSize: 3,
+				ObjectivesSize: 2,
+				Bounds:         []*api.Bounds{{Floor: 0, Ceil: 1}},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -411,6 +572,60 @@ func TestValidateGDE3Config(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid JADE adaptation",
+			config: &api.GDE3Config{
+				Cr:           0.5,
+				F:            0.5,
+				P:            0.5,
+				Adaptation:   api.ParameterAdaptation_PARAMETER_ADAPTATION_JADE,
+				LearningRate: 0.1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid SHADE adaptation",
+			config: &api.GDE3Config{
+				Cr:         0.5,
+				F:          0.5,
+				P:          0.5,
+				Adaptation: api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE,
+				MemorySize: 10,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid unknown adaptation",
+			config: &api.GDE3Config{
+				Cr:         0.5,
+				F:          0.5,
+				P:          0.5,
+				Adaptation: api.ParameterAdaptation(42),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid learning rate > 1",
+			config: &api.GDE3Config{
+				Cr:           0.5,
+				F:            0.5,
+				P:            0.5,
+				Adaptation:   api.ParameterAdaptation_PARAMETER_ADAPTATION_JADE,
+				LearningRate: 1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid negative memory size",
+			config: &api.GDE3Config{
+				Cr:         0.5,
+				F:          0.5,
+				P:          0.5,
+				Adaptation: api.ParameterAdaptation_PARAMETER_ADAPTATION_SHADE,
+				MemorySize: -1,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -425,6 +640,64 @@ func TestValidateGDE3Config(t *testing.T) {
 	}
 }
 
+func TestValidateMOEADConfig(t *testing.T) {
+	valid := func() *api.MOEADConfig {
+		return &api.MOEADConfig{
+			Cr:               0.9,
+			F:                0.5,
+			P:                0.1,
+			NeighborhoodSize: 20,
+			Decomposition:    api.Decomposition_DECOMPOSITION_PBI,
+			Theta:            5,
+			Delta:            0.9,
+			MaxReplacements:  2,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(c *api.MOEADConfig)
+		wantErr bool
+	}{
+		{name: "valid config", modify: func(*api.MOEADConfig) {}},
+		{name: "zero values use defaults", modify: func(c *api.MOEADConfig) {
+			c.NeighborhoodSize, c.Theta, c.Delta, c.MaxReplacements = 0, 0, 0, 0
+			c.Decomposition = api.Decomposition_DECOMPOSITION_UNSPECIFIED
+		}},
+		{name: "invalid CR", modify: func(c *api.MOEADConfig) { c.Cr = 1.5 }, wantErr: true},
+		{name: "invalid F", modify: func(c *api.MOEADConfig) { c.F = -1 }, wantErr: true},
+		{name: "invalid P", modify: func(c *api.MOEADConfig) { c.P = 2 }, wantErr: true},
+		{name: "negative neighbourhood", modify: func(c *api.MOEADConfig) { c.NeighborhoodSize = -1 }, wantErr: true},
+		{name: "unknown decomposition", modify: func(c *api.MOEADConfig) { c.Decomposition = 9 }, wantErr: true},
+		{name: "negative theta", modify: func(c *api.MOEADConfig) { c.Theta = -1 }, wantErr: true},
+		{name: "delta above one", modify: func(c *api.MOEADConfig) { c.Delta = 1.1 }, wantErr: true},
+		{name: "negative replacements", modify: func(c *api.MOEADConfig) { c.MaxReplacements = -1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(cfg)
+			err := ValidateMOEADConfig(cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	assert.NoError(t, ValidateMOEADConfig(nil))
+}
+
+func TestValidateNSGA2Config(t *testing.T) {
+	assert.NoError(t, ValidateNSGA2Config(nil))
+	assert.NoError(t, ValidateNSGA2Config(&api.NSGA2Config{Cr: 0.9, F: 0.5, P: 0.1}))
+	assert.Error(t, ValidateNSGA2Config(&api.NSGA2Config{Cr: 1.5, F: 0.5, P: 0.1}))
+	assert.Error(t, ValidateNSGA2Config(&api.NSGA2Config{Cr: 0.9, F: 2.5, P: 0.1}))
+	assert.Error(t, ValidateNSGA2Config(&api.NSGA2Config{Cr: 0.9, F: 0.5, P: -0.1}))
+}
+
 func TestValidateRange(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -503,7 +776,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "valid request with rand/1",
 			algorithm: "gde3",
-			variant:   "rand/1",
+			variant:   "rand1",
 			problem:   "zdt1",
 			config:    validConfig,
 			wantErr:   false,
@@ -511,7 +784,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "valid request with rand/2 and sufficient population",
 			algorithm: "gde3",
-			variant:   "rand/2",
+			variant:   "rand2",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -527,7 +800,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "invalid request - rand/2 requires minimum 6 population",
 			algorithm: "gde3",
-			variant:   "rand/2",
+			variant:   "rand2",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -543,7 +816,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "valid request with best/1 and population 3",
 			algorithm: "gde3",
-			variant:   "best/1",
+			variant:   "best1",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -559,7 +832,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "valid request with best/2 and population 5",
 			algorithm: "gde3",
-			variant:   "best/2",
+			variant:   "best2",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -575,7 +848,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "invalid request - best/2 requires minimum 5 population",
 			algorithm: "gde3",
-			variant:   "best/2",
+			variant:   "best2",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -604,10 +877,42 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:      "valid request with current-to-pbest/1 and population 3",
+			algorithm: "gde3",
+			variant:   "currToPBest1",
+			problem:   "zdt1",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 3, // minimum for current-to-pbest/1
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				FloorLimiter:   0.0,
+				CeilLimiter:    1.0,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "invalid request - rand-to-best/1 requires minimum 4 population",
+			algorithm: "gde3",
+			variant:   "randToBest1",
+			problem:   "zdt1",
+			config: &api.DEConfig{
+				Executions:     10,
+				Generations:    100,
+				PopulationSize: 3, // too small for rand-to-best/1
+				DimensionsSize: 30,
+				ObjectivesSize: 2,
+				FloorLimiter:   0.0,
+				CeilLimiter:    1.0,
+			},
+			wantErr: true,
+		},
 		{
 			name:      "valid request with current-to-best/1 and population 4",
 			algorithm: "gde3",
-			variant:   "current-to-best/1",
+			variant:   "currToBest1",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     10,
@@ -623,7 +928,7 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 		{
 			name:      "invalid request - DE config validation fails",
 			algorithm: "gde3",
-			variant:   "rand/1",
+			variant:   "rand1",
 			problem:   "zdt1",
 			config: &api.DEConfig{
 				Executions:     -1, // invalid
@@ -652,6 +957,138 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:      "unknown problem",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "unknown",
+			config:    validConfig,
+			wantErr:   true,
+		},
+		{
+			name:      "dimensions above the problem maximum",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "vnt1",
+			config:    sizedConfig(validConfig, 3, 3),
+			wantErr:   true,
+		},
+		{
+			name:      "dimensions below the problem minimum",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "dtlz2",
+			config:    sizedConfig(validConfig, 2, 2),
+			wantErr:   true,
+		},
+		{
+			name:      "objectives do not match the problem",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    sizedConfig(validConfig, 30, 3),
+			wantErr:   true,
+		},
+		{
+			name:      "many-objective problem with variable objectives",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "dtlz2",
+			config:    sizedConfig(validConfig, 12, 5),
+			wantErr:   false,
+		},
+		{
+			name:      "factory rejects the size",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "wfg1",
+			config:    sizedConfig(validConfig, 4, 3),
+			wantErr:   true,
+		},
+		{
+			name:      "moead with its configuration",
+			algorithm: "moead",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    withMOEAD(validConfig, &api.MOEADConfig{Cr: 0.9, F: 0.5, NeighborhoodSize: 10}),
+			wantErr:   false,
+		},
+		{
+			name:      "configuration of another algorithm",
+			algorithm: "nsga2",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    withMOEAD(validConfig, &api.MOEADConfig{Cr: 0.9, F: 0.5}),
+			wantErr:   true,
+		},
+		{
+			name:      "moead neighbourhood larger than the population",
+			algorithm: "moead",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    withMOEAD(validConfig, &api.MOEADConfig{Cr: 0.9, F: 0.5, NeighborhoodSize: 101}),
+			wantErr:   true,
+		},
+		{
+			name:      "moead neighbourhood too small for the variant",
+			algorithm: "moead",
+			variant:   "rand2",
+			problem:   "zdt1",
+			config:    withMOEAD(validConfig, &api.MOEADConfig{Cr: 0.9, F: 0.5, NeighborhoodSize: 5}),
+			wantErr:   true,
+		},
+		{
+			name:      "custom problem",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "sphere2",
+			config:    withCustom(validConfig, &api.CustomProblem{Name: "sphere2", Objectives: []string{"x[0]^2", "(x[1]-1)^2"}}),
+			wantErr:   false,
+		},
+		{
+			name:      "custom problem with another name",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    withCustom(validConfig, &api.CustomProblem{Name: "sphere2", Objectives: []string{"x[0]^2", "(x[1]-1)^2"}}),
+			wantErr:   true,
+		},
+		{
+			name:      "custom problem shadowing a built-in one",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "zdt1",
+			config:    withCustom(validConfig, &api.CustomProblem{Name: "zdt1", Objectives: []string{"x[0]", "1-x[0]"}}),
+			wantErr:   true,
+		},
+		{
+			name:      "custom problem that does not compile",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "broken",
+			config:    withCustom(validConfig, &api.CustomProblem{Name: "broken", Objectives: []string{"x[0]+", "x[1]"}}),
+			wantErr:   true,
+		},
+		{
+			name:      "custom problem with other objectives",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "single",
+			config:    withCustom(validConfig, &api.CustomProblem{Name: "single", Objectives: []string{"x[0]"}}),
+			wantErr:   true,
+		},
+		{
+			name:      "custom problem with fewer bounds than dimensions",
+			algorithm: "gde3",
+			variant:   "rand1",
+			problem:   "bounded",
+			config: withCustom(validConfig, &api.CustomProblem{
+				Name:       "bounded",
+				Objectives: []string{"x[0]", "x[1]"},
+				Bounds:     []*api.Bounds{{Floor: 0, Ceil: 1}, {Floor: 0, Ceil: 1}},
+			}),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -666,17 +1103,123 @@ func TestValidateRunAsyncRequest(t *testing.T) {
 	}
 }
 
+// sizedConfig returns a copy of cfg with the given dimensions and objectives.
+func sizedConfig(cfg *api.DEConfig, dimensions, objectives int64) *api.DEConfig {
+	sized := proto.Clone(cfg).(*api.DEConfig)
+	sized.DimensionsSize = dimensions
+	sized.ObjectivesSize = objectives
+	return sized
+}
+
+// withMOEAD returns a copy of cfg configured for MOEA/D.
+func withMOEAD(cfg *api.DEConfig, moead *api.MOEADConfig) *api.DEConfig {
+	configured := proto.Clone(cfg).(*api.DEConfig)
+	configured.AlgorithmConfig = &api.DEConfig_Moead{Moead: moead}
+	return configured
+}
+
+// withCustom returns a copy of cfg running the custom problem.
+func withCustom(cfg *api.DEConfig, cp *api.CustomProblem) *api.DEConfig {
+	configured := proto.Clone(cfg).(*api.DEConfig)
+	configured.CustomProblem = cp
+	return configured
+}
+
+func TestValidateReferenceFrontRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		problem    string
+		objectives int64
+		points     int64
+		wantErr    bool
+	}{
+		{name: "valid", problem: "zdt1", objectives: 2, points: 100},
+		{name: "empty problem", problem: "", objectives: 2, points: 100, wantErr: true},
+		{name: "zero objectives", problem: "dtlz1", objectives: 0, points: 100, wantErr: true},
+		{name: "too many objectives", problem: "dtlz1", objectives: 11, points: 100, wantErr: true},
+		{name: "zero points", problem: "zdt1", objectives: 2, points: 0, wantErr: true},
+		{name: "too many points", problem: "zdt1", objectives: 2, points: 10001, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReferenceFrontRequest(tt.problem, tt.objectives, tt.points)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateResumeExecutionRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		executionID string
+		generations int64
+		wantErr     bool
+	}{
+		{name: "keeps generations", executionID: "exec-1", generations: 0},
+		{name: "extends generations", executionID: "exec-1", generations: 500},
+		{name: "empty execution id", executionID: "", generations: 0, wantErr: true},
+		{name: "negative generations", executionID: "exec-1", generations: -1, wantErr: true},
+		{name: "too many generations", executionID: "exec-1", generations: 10001, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResumeExecutionRequest(tt.executionID, tt.generations)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		bounds     []*api.Bounds
+		dimensions int64
+		wantErr    bool
+	}{
+		{name: "no bounds", dimensions: 30},
+		{name: "one per dimension", bounds: []*api.Bounds{{Floor: 0, Ceil: 1}, {Floor: 0, Ceil: 4}}, dimensions: 2},
+		{name: "too few", bounds: []*api.Bounds{{Floor: 0, Ceil: 1}}, dimensions: 2, wantErr: true},
+		{name: "too many", bounds: []*api.Bounds{{Floor: 0, Ceil: 1}, {Floor: 0, Ceil: 1}}, dimensions: 1, wantErr: true},
+		{name: "floor equals ceil", bounds: []*api.Bounds{{Floor: 1, Ceil: 1}}, dimensions: 1, wantErr: true},
+		{name: "floor above ceil", bounds: []*api.Bounds{{Floor: 2, Ceil: -2}}, dimensions: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBounds(tt.bounds, tt.dimensions)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGetMinPopulationForVariant(t *testing.T) {
 	tests := []struct {
 		variant  string
 		expected int
 	}{
-		{"rand/1", 4},
-		{"rand/2", 6},
-		{"best/1", 3},
-		{"best/2", 5},
+		{"rand1", 4},
+		{"rand2", 6},
+		{"best1", 3},
+		{"best2", 5},
 		{"pbest", 3},
-		{"current-to-best/1", 4},
+		{"currToBest1", 4},
+		{"currToPBest1", 3},
+		{"randToBest1", 4},
+		{"rand1EitherOr", 4},
 		{"unknown-variant", 4}, // default fallback
 		{"", 4},                // empty string fallback
 	}
@@ -0,0 +1,38 @@
+package validation
+
+import "fmt"
+
+const (
+	maxExperimentNameLength        = 100
+	maxExperimentDescriptionLength = 2000
+	maxExperimentTags              = 20
+	maxExperimentTagLength         = 50
+)
+
+// ValidateExperiment validates the user provided fields of an experiment.
+func ValidateExperiment(name, description string, tags []string) error {
+	if err := ValidateNonEmpty(name, "name"); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(name, 1, maxExperimentNameLength, "name"); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(description, 0, maxExperimentDescriptionLength, "description"); err != nil {
+		return err
+	}
+
+	if len(tags) > maxExperimentTags {
+		return NewValidationError("tags", len(tags), ErrOutOfRange,
+			fmt.Sprintf("at most %d tags allowed, got %d", maxExperimentTags, len(tags)))
+	}
+	for i, tag := range tags {
+		field := fmt.Sprintf("tags[%d]", i)
+		if err := ValidateNonEmpty(tag, field); err != nil {
+			return err
+		}
+		if err := ValidateStringLength(tag, 1, maxExperimentTagLength, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
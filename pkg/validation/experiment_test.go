@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExperiment(t *testing.T) {
+	tooManyTags := make([]string, 21)
+	for i := range tooManyTags {
+		tooManyTags[i] = "tag"
+	}
+
+	tests := []struct {
+		name        string
+		expName     string
+		description string
+		tags        []string
+		wantErr     bool
+	}{
+		{"valid", "crossovers", "binomial against exponential", []string{"zdt", "gde3"}, false},
+		{"no description or tags", "crossovers", "", nil, false},
+		{"maximum name length", strings.Repeat("a", 100), "", nil, false},
+		{"empty name", "", "", nil, true},
+		{"whitespace name", "   ", "", nil, true},
+		{"name too long", strings.Repeat("a", 101), "", nil, true},
+		{"description too long", "crossovers", strings.Repeat("a", 2001), nil, true},
+		{"too many tags", "crossovers", "", tooManyTags, true},
+		{"empty tag", "crossovers", "", []string{"zdt", ""}, true},
+		{"tag too long", "crossovers", "", []string{strings.Repeat("a", 51)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExperiment(tt.expName, tt.description, tt.tags)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
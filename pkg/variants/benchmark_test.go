@@ -10,6 +10,7 @@ import (
 	currentToBest "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best"
 	"github.com/nicholaspcr/GoDE/pkg/variants/pbest"
 	variantsRand "github.com/nicholaspcr/GoDE/pkg/variants/rand"
+	randToBest "github.com/nicholaspcr/GoDE/pkg/variants/rand-to-best"
 )
 
 // Benchmark configurations
@@ -204,12 +205,15 @@ func BenchmarkPBest(b *testing.B) {
 // Benchmark memory allocations
 func BenchmarkVariantMemory(b *testing.B) {
 	variants := map[string]variants.Interface{
-		"rand/1":            variantsRand.Rand1(),
-		"rand/2":            variantsRand.Rand2(),
-		"best/1":            best.Best1(),
-		"best/2":            best.Best2(),
-		"current-to-best/1": currentToBest.CurrToBest1(),
-		"pbest":             pbest.Pbest(),
+		"rand/1":             variantsRand.Rand1(),
+		"rand/2":             variantsRand.Rand2(),
+		"best/1":             best.Best1(),
+		"best/2":             best.Best2(),
+		"current-to-best/1":  currentToBest.CurrToBest1(),
+		"pbest":              pbest.Pbest(),
+		"current-to-pbest/1": pbest.CurrToPBest1(),
+		"rand-to-best/1":     randToBest.RandToBest1(),
+		"rand/1/either-or":   variantsRand.Rand1EitherOr(),
 	}
 
 	dim := 30
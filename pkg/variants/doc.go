@@ -11,6 +11,7 @@
 // Random-based variants (exploration-focused):
 //   - rand/1: v = r1 + F(r2 - r3)                               [min pop: 4]
 //   - rand/2: v = r1 + F(r2 - r3) + F(r4 - r5)                  [min pop: 6]
+//   - rand/1/either-or: rand/1, or r1 + K(r2 + r3 - 2r1)         [min pop: 4]
 //
 // Best-based variants (exploitation-focused):
 //   - best/1: v = best + F(r1 - r2)                             [min pop: 3]
@@ -19,6 +20,12 @@
 // Hybrid variants (balanced):
 //   - pbest:            v = x + F(pbest - x) + F(r1 - r2)       [min pop: 3]
 //   - current-to-best/1: v = x + F(best - r1) + F(r2 - r3)     [min pop: 4]
+//   - current-to-pbest/1: v = x + F(pbest - x) + F(r1 - a2)    [min pop: 3]
+//   - rand-to-best/1:   v = r1 + F(best - r1) + F(r2 - r3)     [min pop: 4]
+//
+// current-to-pbest/1 draws a2 from the population and an archive of
+// replaced parents, which algorithms keep for variants that implement
+// ArchiveUser and pass in Parameters.Archive.
 //
 // # Parameters
 //
@@ -72,10 +79,11 @@
 //
 // Each variant has a minimum population size based on the number of
 // random vectors it requires:
-//   - rand/1, current-to-best/1: minimum 4 (target + 3 randoms)
-//   - best/1, pbest: minimum 3 (target + 2 randoms)
+//   - rand/1, rand/1/either-or, current-to-best/1, rand-to-best/1: minimum 4 (target + 3 randoms)
+//   - best/1, pbest, current-to-pbest/1: minimum 3 (target + 2 randoms)
 //   - rand/2: minimum 6 (target + 5 randoms)
 //   - best/2: minimum 5 (target + 4 randoms)
 //
-// These requirements are enforced by pkg/validation/de_config.go.
+// Variants declare it in VariantMetadata.MinPopulation when they register,
+// and pkg/validation/de_config.go enforces it from the registry.
 package variants
@@ -0,0 +1,84 @@
+package pbest
+
+import (
+	"math"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+)
+
+// currToPBest1
+type currToPBest1 struct{}
+
+// CurrToPBest1 returns the DE/current-to-pbest/1 mutation strategy of JADE:
+// current + F(pbest - current) + F(r1 - r2), where r2 is drawn from the
+// population together with the archive of replaced parents when the
+// algorithm keeps one.
+func CurrToPBest1() variants.Interface {
+	return &currToPBest1{}
+}
+
+func (c *currToPBest1) Name() string {
+	return "currToPBest1"
+}
+
+// UsesArchive asks algorithms to keep an archive of replaced parents.
+func (c *currToPBest1) UsesArchive() bool {
+	return true
+}
+
+func (c *currToPBest1) Mutate(
+	elems, rankZero []models.Vector,
+	params variants.Parameters,
+) (models.Vector, error) {
+	if len(rankZero) == 0 {
+		return models.Vector{}, variants.ErrEmptyRankZero
+	}
+
+	// r1 comes from the population, r2 from the population and the archive
+	ind := make([]int, 3)
+	ind[0] = params.CurrPos
+	if err := variants.GenerateIndices(1, len(elems), ind[:2], params.Random); err != nil {
+		return models.Vector{}, variants.ErrInsufficientPopulation
+	}
+	if err := variants.GenerateIndices(2, len(elems)+len(params.Archive), ind, params.Random); err != nil {
+		return models.Vector{}, variants.ErrInsufficientPopulation
+	}
+
+	indexLimit := int(math.Ceil(float64(len(rankZero)) * params.P))
+	if indexLimit <= 0 {
+		indexLimit = 1 // Ensure at least one candidate to avoid Intn(0) panic
+	}
+	bestIndex := params.Random.Intn(indexLimit)
+
+	// Validate elems vectors have non-nil elements
+	if err := variants.ValidateVectors(elems, []int{params.CurrPos, ind[1]}, params.DIM); err != nil {
+		return models.Vector{}, err
+	}
+	// Validate rankZero best vector
+	if err := variants.ValidateVectors(rankZero, []int{bestIndex}, params.DIM); err != nil {
+		return models.Vector{}, err
+	}
+
+	r2 := ind[2]
+	pool := elems
+	if r2 >= len(elems) {
+		r2, pool = r2-len(elems), params.Archive
+	}
+	if err := variants.ValidateVectors(pool, []int{r2}, params.DIM); err != nil {
+		return models.Vector{}, err
+	}
+
+	current := elems[params.CurrPos].Elements
+	arr := make([]float64, params.DIM)
+	for i := 0; i < params.DIM; i++ {
+		arr[i] = current[i] +
+			params.F*(rankZero[bestIndex].Elements[i]-current[i]) +
+			params.F*(elems[ind[1]].Elements[i]-pool[r2].Elements[i])
+	}
+
+	ret := models.Vector{
+		Elements: arr,
+	}
+	return ret, nil
+}
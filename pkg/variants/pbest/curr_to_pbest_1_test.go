@@ -0,0 +1,130 @@
+package pbest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrToPBest1_Name(t *testing.T) {
+	c := CurrToPBest1()
+	assert.Equal(t, "currToPBest1", c.Name())
+	assert.True(t, variants.UsesArchive(c))
+}
+
+func TestCurrToPBest1_Mutate_Archive(t *testing.T) {
+	// The population and pbest are all zero, so the mutant is -F * r2 and
+	// tells whether r2 came from the archive
+	elems := make([]models.Vector, 5)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{0, 0}}
+	}
+	rankZero := []models.Vector{{Elements: []float64{0, 0}}}
+	archive := []models.Vector{
+		{Elements: []float64{10, 10}},
+		{Elements: []float64{10, 10}},
+	}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     2,
+		F:       0.5,
+		P:       1,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	c := CurrToPBest1()
+
+	t.Run("without archive it is DE/pbest", func(t *testing.T) {
+		for range 50 {
+			mutant, err := c.Mutate(elems, rankZero, params)
+			require.NoError(t, err)
+			assert.Equal(t, []float64{0, 0}, mutant.Elements)
+		}
+	})
+
+	t.Run("draws r2 from the archive", func(t *testing.T) {
+		params.Archive = archive
+		var fromArchive int
+		for range 100 {
+			mutant, err := c.Mutate(elems, rankZero, params)
+			require.NoError(t, err)
+			if mutant.Elements[0] == -5 {
+				fromArchive++
+			} else {
+				assert.Equal(t, []float64{0, 0}, mutant.Elements)
+			}
+		}
+		// 2 of the 6 candidates for r2 are archived
+		assert.Greater(t, fromArchive, 10)
+		assert.Less(t, fromArchive, 60)
+	})
+
+	t.Run("the archive does not replace r1", func(t *testing.T) {
+		params.Archive = archive
+		_, err := c.Mutate(elems[:1], rankZero, params)
+		assert.Equal(t, variants.ErrInsufficientPopulation, err)
+	})
+
+	t.Run("archived vectors are validated", func(t *testing.T) {
+		params.Archive = []models.Vector{{Elements: []float64{1}}}
+		var invalid bool
+		for range 50 {
+			if _, err := c.Mutate(elems[:2], rankZero, params); err != nil {
+				assert.Equal(t, variants.ErrInvalidVector, err)
+				invalid = true
+			}
+		}
+		assert.True(t, invalid)
+	})
+}
+
+func TestCurrToPBest1_Mutate_Success(t *testing.T) {
+	elems := make([]models.Vector, 10)
+	for i := range elems {
+		elems[i] = models.Vector{
+			Elements: []float64{float64(i), float64(i + 1), float64(i + 2)},
+		}
+	}
+
+	rankZero := make([]models.Vector, 5)
+	for i := range rankZero {
+		rankZero[i] = models.Vector{
+			Elements: []float64{float64(i) * 0.1, float64(i)*0.1 + 0.1, float64(i)*0.1 + 0.2},
+		}
+	}
+
+	params := variants.Parameters{
+		CurrPos: 3,
+		DIM:     3,
+		F:       0.5,
+		P:       0.2,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	mutant, err := CurrToPBest1().Mutate(elems, rankZero, params)
+	require.NoError(t, err)
+	assert.Len(t, mutant.Elements, 3)
+}
+
+func TestCurrToPBest1_Mutate_EmptyRankZero(t *testing.T) {
+	elems := make([]models.Vector, 5)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{float64(i)}}
+	}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       0.5,
+		P:       0.1,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	_, err := CurrToPBest1().Mutate(elems, nil, params)
+	assert.Equal(t, variants.ErrEmptyRankZero, err)
+}
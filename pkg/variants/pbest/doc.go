@@ -16,6 +16,17 @@
 //
 // Minimum population: 3 (target + 2 random vectors)
 //
+// DE/current-to-pbest/1 (JADE):
+//
+//	v_i = x_i + F(pbest - x_i) + F(r1 - r2)
+//
+// where r1 is drawn from the population and r2 from the population together
+// with an external archive of parents replaced by their offspring. The
+// archive, kept by the algorithm and bounded by the population size, adds
+// diversity to the difference vectors; without it the mutation is DE/pbest.
+//
+// Minimum population: 3 (target + 2 random vectors)
+//
 // Selection Process:
 //   - Calculate indexLimit = ⌈population_size × P⌉
 //   - Select pbest randomly from rankZero[0:indexLimit]
@@ -4,7 +4,14 @@ import "github.com/nicholaspcr/GoDE/pkg/variants"
 
 func init() {
 	variants.DefaultRegistry.Register("pbest", Pbest, variants.VariantMetadata{
-		Description: "pBest/1/Bin - Uses probability-based best selection",
-		Category:    "pbest",
+		Description:   "pBest/1/Bin - Uses probability-based best selection",
+		Category:      "pbest",
+		MinPopulation: 3,
+	})
+
+	variants.DefaultRegistry.Register("currToPBest1", CurrToPBest1, variants.VariantMetadata{
+		Description:   "Current-to-pbest/1/Bin - JADE mutation with an archive of replaced parents",
+		Category:      "pbest",
+		MinPopulation: 3,
 	})
 }
@@ -0,0 +1,21 @@
+// Package randtobest implements DE/rand-to-best mutation strategies that pull a random base toward the best individuals.
+//
+// Mutation Formula:
+//
+// DE/rand-to-best/1:
+//
+//	v_i = r1 + F(best - r1) + F(r2 - r3)
+//
+// where:
+//   - best is a randomly selected individual from the Pareto front (rank 0)
+//   - r1, r2, r3 are three randomly selected individuals (different from target i)
+//   - F is the mutation scaling factor (typically 0.5-1.0)
+//   - v_i is the resulting mutant vector
+//
+// Minimum population: 4 (target + 3 random vectors)
+//
+// Characteristics:
+//   - Random base keeps the exploration of rand/1
+//   - Attraction toward the front speeds up convergence
+//   - Less greedy than current-to-best/1, which starts from the target
+package randtobest
@@ -0,0 +1,99 @@
+package randtobest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandToBest1_Name(t *testing.T) {
+	r := RandToBest1()
+	assert.Equal(t, "randToBest1", r.Name())
+}
+
+func TestRandToBest1_Mutate_Success(t *testing.T) {
+	// With F = 1 the mutant is best + r2 - r3, the random base cancels out
+	elems := []models.Vector{
+		{Elements: []float64{100}},
+		{Elements: []float64{1}},
+		{Elements: []float64{2}},
+		{Elements: []float64{4}},
+	}
+	rankZero := []models.Vector{{Elements: []float64{10}}}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       1,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	expected := map[float64]bool{}
+	for _, diff := range []float64{1 - 2, 2 - 1, 1 - 4, 4 - 1, 2 - 4, 4 - 2} {
+		expected[10+diff] = true
+	}
+
+	r := RandToBest1()
+	for range 20 {
+		mutant, err := r.Mutate(elems, rankZero, params)
+		require.NoError(t, err)
+		assert.True(t, expected[mutant.Elements[0]], "unexpected mutant %v", mutant.Elements[0])
+	}
+}
+
+func TestRandToBest1_Mutate_InsufficientPopulation(t *testing.T) {
+	elems := make([]models.Vector, 3)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{float64(i), float64(i + 1)}}
+	}
+	rankZero := []models.Vector{{Elements: []float64{0.0, 0.1}}}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     2,
+		F:       0.5,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	_, err := RandToBest1().Mutate(elems, rankZero, params)
+	assert.Equal(t, variants.ErrInsufficientPopulation, err)
+}
+
+func TestRandToBest1_Mutate_EmptyRankZero(t *testing.T) {
+	elems := make([]models.Vector, 5)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{float64(i)}}
+	}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       0.5,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	_, err := RandToBest1().Mutate(elems, nil, params)
+	assert.Equal(t, variants.ErrEmptyRankZero, err)
+}
+
+func TestRandToBest1_Mutate_InvalidVector(t *testing.T) {
+	elems := make([]models.Vector, 5)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{float64(i)}}
+	}
+	rankZero := []models.Vector{{Elements: []float64{0, 1}}}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       0.5,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	_, err := RandToBest1().Mutate(elems, rankZero, params)
+	assert.Equal(t, variants.ErrInvalidVector, err)
+}
@@ -0,0 +1,11 @@
+package randtobest
+
+import "github.com/nicholaspcr/GoDE/pkg/variants"
+
+func init() {
+	variants.DefaultRegistry.Register("randToBest1", RandToBest1, variants.VariantMetadata{
+		Description:   "Rand-to-best/1/Bin - Moves a random base vector toward the best",
+		Category:      "rand-to-best",
+		MinPopulation: 4,
+	})
+}
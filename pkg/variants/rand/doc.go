@@ -24,6 +24,18 @@
 //
 // Minimum population: 6 (target + 5 random vectors)
 //
+// DE/rand/1/either-or:
+//
+//	v_i = r1 + F(r2 - r3)               with probability 0.5
+//	v_i = r1 + K(r2 + r3 - 2·r1)        otherwise, K = 0.5(F + 1)
+//
+// where:
+//   - r1, r2, r3 are three randomly selected individuals (all different from target i)
+//   - the second step recombines the three vectors instead of mutating r1,
+//     which keeps the search rotationally invariant
+//
+// Minimum population: 4 (target + 3 random vectors)
+//
 // Characteristics:
 //   - Highly explorative due to random base vector
 //   - Good for avoiding premature convergence
@@ -0,0 +1,59 @@
+package rand
+
+import (
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+)
+
+// EitherOrProbability is the probability of the mutation step of
+// DE/rand/1/either-or, the recombination step is taken otherwise.
+const EitherOrProbability = 0.5
+
+// rand1EitherOr
+type rand1EitherOr struct{}
+
+// Rand1EitherOr variant -> a + F(b - c) with probability EitherOrProbability,
+// otherwise a + K(b + c - 2a) with K = 0.5(F + 1)
+func Rand1EitherOr() variants.Interface {
+	return &rand1EitherOr{}
+}
+
+func (r *rand1EitherOr) Name() string {
+	return "rand1EitherOr"
+}
+
+//nolint:revive // rankZero parameter required by variants.Interface
+func (r *rand1EitherOr) Mutate(
+	elems, rankZero []models.Vector,
+	p variants.Parameters,
+) (models.Vector, error) {
+	// generating random indices different from current pos
+	inds := make([]int, 4)
+	inds[0] = p.CurrPos
+	err := variants.GenerateIndices(1, len(elems), inds, p.Random)
+	if err != nil {
+		return models.Vector{}, err
+	}
+
+	// Validate vectors have non-nil elements
+	if err := variants.ValidateVectors(elems, []int{inds[1], inds[2], inds[3]}, p.DIM); err != nil {
+		return models.Vector{}, err
+	}
+
+	a, b, c := elems[inds[1]].Elements, elems[inds[2]].Elements, elems[inds[3]].Elements
+	result := models.Vector{
+		Elements: make([]float64, p.DIM),
+	}
+	if p.Random.Float64() < EitherOrProbability {
+		for i := 0; i < p.DIM; i++ {
+			result.Elements[i] = a[i] + p.F*(b[i]-c[i])
+		}
+		return result, nil
+	}
+
+	k := 0.5 * (p.F + 1)
+	for i := 0; i < p.DIM; i++ {
+		result.Elements[i] = a[i] + k*(b[i]+c[i]-2*a[i])
+	}
+	return result, nil
+}
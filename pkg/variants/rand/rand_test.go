@@ -456,3 +456,71 @@ func TestRand2_Mutate_MinimalPopulation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, mutant.Elements, 3)
 }
+
+// Tests for Rand1EitherOr
+
+func TestRand1EitherOr_Name(t *testing.T) {
+	r := Rand1EitherOr()
+	assert.Equal(t, "rand1EitherOr", r.Name())
+}
+
+func TestRand1EitherOr_Mutate_TakesBothSteps(t *testing.T) {
+	// Population of 4: the three random vectors are always 1, 2 and 3 in
+	// some order, so each step yields a known set of mutants
+	elems := []models.Vector{
+		{Elements: []float64{0}},
+		{Elements: []float64{1}},
+		{Elements: []float64{2}},
+		{Elements: []float64{4}},
+	}
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       0.5,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	// a + F(b - c) for every permutation of {1, 2, 4}
+	mutation := map[float64]bool{}
+	// a + K(b + c - 2a) with K = 0.75
+	recombination := map[float64]bool{}
+	for _, perm := range [][3]float64{{1, 2, 4}, {1, 4, 2}, {2, 1, 4}, {2, 4, 1}, {4, 1, 2}, {4, 2, 1}} {
+		a, b, c := perm[0], perm[1], perm[2]
+		mutation[a+0.5*(b-c)] = true
+		recombination[a+0.75*(b+c-2*a)] = true
+	}
+
+	r := Rand1EitherOr()
+	var mutated, recombined int
+	for range 200 {
+		mutant, err := r.Mutate(elems, nil, params)
+		assert.NoError(t, err)
+		switch v := mutant.Elements[0]; {
+		case mutation[v]:
+			mutated++
+		case recombination[v]:
+			recombined++
+		default:
+			t.Fatalf("unexpected mutant %v", v)
+		}
+	}
+	assert.Positive(t, mutated)
+	assert.Positive(t, recombined)
+}
+
+func TestRand1EitherOr_Mutate_InsufficientPopulation(t *testing.T) {
+	elems := make([]models.Vector, 3)
+	for i := range elems {
+		elems[i] = models.Vector{Elements: []float64{float64(i)}}
+	}
+
+	params := variants.Parameters{
+		CurrPos: 0,
+		DIM:     1,
+		F:       0.5,
+		Random:  rand.New(rand.NewSource(42)),
+	}
+
+	_, err := Rand1EitherOr().Mutate(elems, nil, params)
+	assert.ErrorIs(t, err, variants.ErrInsufficientPopulation)
+}
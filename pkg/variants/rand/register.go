@@ -4,12 +4,20 @@ import "github.com/nicholaspcr/GoDE/pkg/variants"
 
 func init() {
 	variants.DefaultRegistry.Register("rand1", Rand1, variants.VariantMetadata{
-		Description: "Rand/1/Bin - Uses random base vector",
-		Category:    "rand",
+		Description:   "Rand/1/Bin - Uses random base vector",
+		Category:      "rand",
+		MinPopulation: 4,
 	})
 
 	variants.DefaultRegistry.Register("rand2", Rand2, variants.VariantMetadata{
-		Description: "Rand/2/Bin - Uses random base with two difference vectors",
-		Category:    "rand",
+		Description:   "Rand/2/Bin - Uses random base with two difference vectors",
+		Category:      "rand",
+		MinPopulation: 6,
+	})
+
+	variants.DefaultRegistry.Register("rand1EitherOr", Rand1EitherOr, variants.VariantMetadata{
+		Description:   "Rand/1/Either-Or - Alternates the rand/1 mutation with a recombination of three random vectors",
+		Category:      "rand",
+		MinPopulation: 4,
 	})
 }
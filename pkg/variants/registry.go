@@ -15,6 +15,9 @@ type VariantMetadata struct {
 	Name        string
 	Description string
 	Category    string // e.g., "rand", "best", "current-to-best", "pbest"
+	// MinPopulation is the smallest population the variant can draw its
+	// distinct vectors from, the target included.
+	MinPopulation int
 }
 
 // Registry manages variant registrations and creation.
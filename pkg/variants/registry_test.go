@@ -28,7 +28,7 @@ func TestNewRegistry(t *testing.T) {
 func TestRegistry_Register(t *testing.T) {
 	r := NewRegistry()
 	factory := func() Interface { return &stubVariant{name: "rand/1"} }
-	meta := VariantMetadata{Description: "Random variant", Category: "rand"}
+	meta := VariantMetadata{Description: "Random variant", Category: "rand", MinPopulation: 4}
 
 	r.Register("rand/1", factory, meta)
 
@@ -38,6 +38,7 @@ func TestRegistry_Register(t *testing.T) {
 	assert.Equal(t, "rand/1", got.Name)
 	assert.Equal(t, "Random variant", got.Description)
 	assert.Equal(t, "rand", got.Category)
+	assert.Equal(t, 4, got.MinPopulation)
 }
 
 func TestRegistry_Register_overrides_name(t *testing.T) {
@@ -13,6 +13,10 @@ type Parameters struct {
 	CurrPos int        `json:"curr_pos" yaml:"curr_pos"`
 	F       float64    `json:"f"        yaml:"f"`
 	P       float64    `json:"p"        yaml:"p"`
+
+	// Archive holds parents replaced by their offspring in previous
+	// generations, only kept by algorithms for variants that use it.
+	Archive []models.Vector `json:"-" yaml:"-"`
 }
 
 // Interface defines the contract for Differential Evolution mutation strategies.
@@ -24,3 +28,16 @@ type Interface interface {
 		params Parameters,
 	) (models.Vector, error)
 }
+
+// ArchiveUser is implemented by variants that draw difference vectors from
+// an external archive of replaced parents, as in JADE.
+type ArchiveUser interface {
+	UsesArchive() bool
+}
+
+// UsesArchive reports whether the variant draws from Parameters.Archive, so
+// algorithms only keep an archive when it is used.
+func UsesArchive(v Interface) bool {
+	a, ok := v.(ArchiveUser)
+	return ok && a.UsesArchive()
+}
@@ -0,0 +1,68 @@
+package variants_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/nicholaspcr/GoDE/pkg/models"
+	"github.com/nicholaspcr/GoDE/pkg/variants"
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/best"            // Register best variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/current-to-best" // Register current-to-best variant
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/pbest"           // Register pbest variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand"            // Register rand variants
+	_ "github.com/nicholaspcr/GoDE/pkg/variants/rand-to-best"    // Register rand-to-best variant
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// population returns size distinct vectors of dim elements.
+func population(size, dim int) []models.Vector {
+	elems := make([]models.Vector, size)
+	for i := range elems {
+		elems[i].Elements = make([]float64, dim)
+		for j := range elems[i].Elements {
+			elems[i].Elements[j] = float64(i + j)
+		}
+	}
+	return elems
+}
+
+func TestRegisteredVariants_MinPopulation(t *testing.T) {
+	metas := variants.DefaultRegistry.ListMetadata()
+	require.Len(t, metas, 9)
+
+	for _, meta := range metas {
+		t.Run(meta.Name, func(t *testing.T) {
+			require.Positive(t, meta.MinPopulation)
+
+			variant, err := variants.DefaultRegistry.Create(meta.Name)
+			require.NoError(t, err)
+			assert.Equal(t, meta.Name, variant.Name())
+
+			params := variants.Parameters{
+				DIM:    3,
+				F:      0.5,
+				P:      0.5,
+				Random: rand.New(rand.NewSource(1)),
+			}
+			rankZero := population(2, 3)
+
+			for range 20 {
+				mutant, err := variant.Mutate(population(meta.MinPopulation, 3), rankZero, params)
+				require.NoError(t, err, "the minimum population must be enough")
+				assert.Len(t, mutant.Elements, 3)
+			}
+
+			_, err = variant.Mutate(population(meta.MinPopulation-1, 3), rankZero, params)
+			assert.ErrorIs(t, err, variants.ErrInsufficientPopulation, "smaller populations must fail")
+		})
+	}
+}
+
+func TestUsesArchive(t *testing.T) {
+	for _, name := range variants.DefaultRegistry.List() {
+		variant, err := variants.DefaultRegistry.Create(name)
+		require.NoError(t, err)
+		assert.Equal(t, name == "currToPBest1", variants.UsesArchive(variant), name)
+	}
+}
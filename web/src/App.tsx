@@ -3,6 +3,7 @@ import { ProtectedRoute, AuthProvider } from '@/components/auth'
 import {
   LoginPage,
   RegisterPage,
+  OIDCCallbackPage,
   DashboardPage,
   ExecutionsPage,
   NewExecutionPage,
@@ -17,6 +18,7 @@ function App() {
         {/* Public routes */}
         <Route path="/login" element={<LoginPage />} />
         <Route path="/register" element={<RegisterPage />} />
+        <Route path="/auth/callback" element={<OIDCCallbackPage />} />
 
         {/* Protected routes */}
         <Route element={<ProtectedRoute />}>
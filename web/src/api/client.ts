@@ -1,7 +1,11 @@
 import {
   Configuration,
+  ApiV1AdminServiceApi,
+  ApiV1APIKeyServiceApi,
   ApiV1AuthServiceApi,
   ApiV1DifferentialEvolutionServiceApi,
+  ApiV1ExperimentServiceApi,
+  ApiV1OIDCServiceApi,
   ApiV1UserServiceApi,
   ApiV1ParetoServiceApi,
 } from './generated'
@@ -24,6 +28,10 @@ export const authApi = () => new ApiV1AuthServiceApi(createApiConfig())
 export const deApi = () => new ApiV1DifferentialEvolutionServiceApi(createApiConfig())
 export const userApi = () => new ApiV1UserServiceApi(createApiConfig())
 export const paretoApi = () => new ApiV1ParetoServiceApi(createApiConfig())
+export const experimentApi = () => new ApiV1ExperimentServiceApi(createApiConfig())
+export const adminApi = () => new ApiV1AdminServiceApi(createApiConfig())
+export const apiKeyApi = () => new ApiV1APIKeyServiceApi(createApiConfig())
+export const oidcApi = () => new ApiV1OIDCServiceApi(createApiConfig())
 
 // Re-export types for convenience
 export * from './generated/models'
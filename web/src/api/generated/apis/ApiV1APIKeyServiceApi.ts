@@ -0,0 +1,150 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ApiV1CreateAPIKeyRequest,
+  ApiV1CreateAPIKeyResponse,
+  ApiV1ListAPIKeysResponse,
+  GoogleRpcStatus,
+} from '../models/index';
+import {
+    ApiV1CreateAPIKeyRequestFromJSON,
+    ApiV1CreateAPIKeyRequestToJSON,
+    ApiV1CreateAPIKeyResponseFromJSON,
+    ApiV1CreateAPIKeyResponseToJSON,
+    ApiV1ListAPIKeysResponseFromJSON,
+    ApiV1ListAPIKeysResponseToJSON,
+    GoogleRpcStatusFromJSON,
+    GoogleRpcStatusToJSON,
+} from '../models/index';
+
+export interface APIKeyServiceCreateRequest {
+    body: ApiV1CreateAPIKeyRequest;
+}
+
+export interface APIKeyServiceRevokeRequest {
+    id: string;
+}
+
+/**
+ * 
+ */
+export class ApiV1APIKeyServiceApi extends runtime.BaseAPI {
+
+    /**
+     * Create issues a key with a subset of the scopes of the caller, the key is only returned by this call.
+     */
+    async aPIKeyServiceCreateRaw(requestParameters: APIKeyServiceCreateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1CreateAPIKeyResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling aPIKeyServiceCreate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/api-keys`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1CreateAPIKeyRequestToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1CreateAPIKeyResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Create issues a key with a subset of the scopes of the caller, the key is only returned by this call.
+     */
+    async aPIKeyServiceCreate(requestParameters: APIKeyServiceCreateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1CreateAPIKeyResponse> {
+        const response = await this.aPIKeyServiceCreateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     */
+    async aPIKeyServiceListRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListAPIKeysResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/api-keys`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1ListAPIKeysResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async aPIKeyServiceList(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1ListAPIKeysResponse> {
+        const response = await this.aPIKeyServiceListRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Revoke deletes the key, requests made with it are rejected from then on.
+     */
+    async aPIKeyServiceRevokeRaw(requestParameters: APIKeyServiceRevokeRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<object>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling aPIKeyServiceRevoke().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/api-keys/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse<any>(response);
+    }
+
+    /**
+     * Revoke deletes the key, requests made with it are rejected from then on.
+     */
+    async aPIKeyServiceRevoke(requestParameters: APIKeyServiceRevokeRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<object> {
+        const response = await this.aPIKeyServiceRevokeRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
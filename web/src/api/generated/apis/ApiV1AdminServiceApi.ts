@@ -0,0 +1,324 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ApiV1AdminListExecutionsResponse,
+  ApiV1AdminListUsersResponse,
+  ApiV1AdminServiceUpdateUserScopesBody,
+  ApiV1AdminUpdateUserScopesResponse,
+  ApiV1GetWorkerPoolResponse,
+  ApiV1PurgeResultsRequest,
+  ApiV1PurgeResultsResponse,
+  GoogleRpcStatus,
+} from '../models/index';
+import {
+    ApiV1AdminListExecutionsResponseFromJSON,
+    ApiV1AdminListExecutionsResponseToJSON,
+    ApiV1AdminListUsersResponseFromJSON,
+    ApiV1AdminListUsersResponseToJSON,
+    ApiV1AdminServiceUpdateUserScopesBodyFromJSON,
+    ApiV1AdminServiceUpdateUserScopesBodyToJSON,
+    ApiV1AdminUpdateUserScopesResponseFromJSON,
+    ApiV1AdminUpdateUserScopesResponseToJSON,
+    ApiV1GetWorkerPoolResponseFromJSON,
+    ApiV1GetWorkerPoolResponseToJSON,
+    ApiV1PurgeResultsRequestFromJSON,
+    ApiV1PurgeResultsRequestToJSON,
+    ApiV1PurgeResultsResponseFromJSON,
+    ApiV1PurgeResultsResponseToJSON,
+    GoogleRpcStatusFromJSON,
+    GoogleRpcStatusToJSON,
+} from '../models/index';
+
+export interface AdminServiceCancelExecutionRequest {
+    executionId: string;
+}
+
+export interface AdminServiceListExecutionsRequest {
+    userId?: string;
+    status?: AdminServiceListExecutionsStatusEnum;
+    limit?: number;
+    offset?: number;
+    experimentId?: string;
+}
+
+export interface AdminServiceListUsersRequest {
+    limit?: number;
+    offset?: number;
+}
+
+export interface AdminServicePurgeResultsRequest {
+    body: ApiV1PurgeResultsRequest;
+}
+
+export interface AdminServiceUpdateUserScopesRequest {
+    username: string;
+    body: ApiV1AdminServiceUpdateUserScopesBody;
+}
+
+/**
+ * 
+ */
+export class ApiV1AdminServiceApi extends runtime.BaseAPI {
+
+    /**
+     * CancelExecution cancels the execution of any user.
+     */
+    async adminServiceCancelExecutionRaw(requestParameters: AdminServiceCancelExecutionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<object>> {
+        if (requestParameters['executionId'] == null) {
+            throw new runtime.RequiredError(
+                'executionId',
+                'Required parameter "executionId" was null or undefined when calling adminServiceCancelExecution().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/admin/executions/{executionId}/cancel`;
+        urlPath = urlPath.replace(`{${"executionId"}}`, encodeURIComponent(String(requestParameters['executionId'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse<any>(response);
+    }
+
+    /**
+     * CancelExecution cancels the execution of any user.
+     */
+    async adminServiceCancelExecution(requestParameters: AdminServiceCancelExecutionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<object> {
+        const response = await this.adminServiceCancelExecutionRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * GetWorkerPool describes the workers of the server answering the request, shared by every tenant, and the jobs of the tenant in the queue shared by the servers.
+     */
+    async adminServiceGetWorkerPoolRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1GetWorkerPoolResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/admin/workers`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1GetWorkerPoolResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * GetWorkerPool describes the workers of the server answering the request, shared by every tenant, and the jobs of the tenant in the queue shared by the servers.
+     */
+    async adminServiceGetWorkerPool(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1GetWorkerPoolResponse> {
+        const response = await this.adminServiceGetWorkerPoolRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     */
+    async adminServiceListExecutionsRaw(requestParameters: AdminServiceListExecutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1AdminListExecutionsResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['userId'] != null) {
+            queryParameters['userId'] = requestParameters['userId'];
+        }
+
+        if (requestParameters['status'] != null) {
+            queryParameters['status'] = requestParameters['status'];
+        }
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['offset'] != null) {
+            queryParameters['offset'] = requestParameters['offset'];
+        }
+
+        if (requestParameters['experimentId'] != null) {
+            queryParameters['experimentId'] = requestParameters['experimentId'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/admin/executions`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1AdminListExecutionsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async adminServiceListExecutions(requestParameters: AdminServiceListExecutionsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1AdminListExecutionsResponse> {
+        const response = await this.adminServiceListExecutionsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     */
+    async adminServiceListUsersRaw(requestParameters: AdminServiceListUsersRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1AdminListUsersResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['offset'] != null) {
+            queryParameters['offset'] = requestParameters['offset'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/admin/users`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1AdminListUsersResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async adminServiceListUsers(requestParameters: AdminServiceListUsersRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1AdminListUsersResponse> {
+        const response = await this.adminServiceListUsersRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * PurgeResults deletes the finished executions of every user last updated before the cutoff, with their results and checkpoints.
+     */
+    async adminServicePurgeResultsRaw(requestParameters: AdminServicePurgeResultsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1PurgeResultsResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling adminServicePurgeResults().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/admin/purge`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1PurgeResultsRequestToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1PurgeResultsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * PurgeResults deletes the finished executions of every user last updated before the cutoff, with their results and checkpoints.
+     */
+    async adminServicePurgeResults(requestParameters: AdminServicePurgeResultsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1PurgeResultsResponse> {
+        const response = await this.adminServicePurgeResultsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * UpdateUserScopes replaces the scopes of a user, they apply to the tokens issued from the next login or token refresh.
+     */
+    async adminServiceUpdateUserScopesRaw(requestParameters: AdminServiceUpdateUserScopesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1AdminUpdateUserScopesResponse>> {
+        if (requestParameters['username'] == null) {
+            throw new runtime.RequiredError(
+                'username',
+                'Required parameter "username" was null or undefined when calling adminServiceUpdateUserScopes().'
+            );
+        }
+
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling adminServiceUpdateUserScopes().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/admin/users/{username}/scopes`;
+        urlPath = urlPath.replace(`{${"username"}}`, encodeURIComponent(String(requestParameters['username'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1AdminServiceUpdateUserScopesBodyToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1AdminUpdateUserScopesResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * UpdateUserScopes replaces the scopes of a user, they apply to the tokens issued from the next login or token refresh.
+     */
+    async adminServiceUpdateUserScopes(requestParameters: AdminServiceUpdateUserScopesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1AdminUpdateUserScopesResponse> {
+        const response = await this.adminServiceUpdateUserScopesRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
+
+/**
+ * @export
+ */
+export const AdminServiceListExecutionsStatusEnum = {
+    ExecutionStatusUnspecified: 'EXECUTION_STATUS_UNSPECIFIED',
+    ExecutionStatusPending: 'EXECUTION_STATUS_PENDING',
+    ExecutionStatusRunning: 'EXECUTION_STATUS_RUNNING',
+    ExecutionStatusCompleted: 'EXECUTION_STATUS_COMPLETED',
+    ExecutionStatusFailed: 'EXECUTION_STATUS_FAILED',
+    ExecutionStatusCancelled: 'EXECUTION_STATUS_CANCELLED'
+} as const;
+export type AdminServiceListExecutionsStatusEnum = typeof AdminServiceListExecutionsStatusEnum[keyof typeof AdminServiceListExecutionsStatusEnum];
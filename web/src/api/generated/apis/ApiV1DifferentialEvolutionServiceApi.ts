@@ -15,11 +15,15 @@
 
 import * as runtime from '../runtime';
 import type {
+  ApiV1DifferentialEvolutionServiceResumeExecutionBody,
   ApiV1GetExecutionResultsResponse,
   ApiV1GetExecutionStatusResponse,
+  ApiV1GetReferenceFrontResponse,
   ApiV1ListExecutionsResponse,
   ApiV1ListSupportedAlgorithmsResponse,
+  ApiV1ListSupportedCrossoversResponse,
   ApiV1ListSupportedProblemsResponse,
+  ApiV1ListSupportedRepairsResponse,
   ApiV1ListSupportedVariantsResponse,
   ApiV1RunAsyncRequest,
   ApiV1RunAsyncResponse,
@@ -27,16 +31,24 @@ import type {
   StreamResultOfApiV1StreamProgressResponse,
 } from '../models/index';
 import {
+    ApiV1DifferentialEvolutionServiceResumeExecutionBodyFromJSON,
+    ApiV1DifferentialEvolutionServiceResumeExecutionBodyToJSON,
     ApiV1GetExecutionResultsResponseFromJSON,
     ApiV1GetExecutionResultsResponseToJSON,
     ApiV1GetExecutionStatusResponseFromJSON,
     ApiV1GetExecutionStatusResponseToJSON,
+    ApiV1GetReferenceFrontResponseFromJSON,
+    ApiV1GetReferenceFrontResponseToJSON,
     ApiV1ListExecutionsResponseFromJSON,
     ApiV1ListExecutionsResponseToJSON,
     ApiV1ListSupportedAlgorithmsResponseFromJSON,
     ApiV1ListSupportedAlgorithmsResponseToJSON,
+    ApiV1ListSupportedCrossoversResponseFromJSON,
+    ApiV1ListSupportedCrossoversResponseToJSON,
     ApiV1ListSupportedProblemsResponseFromJSON,
     ApiV1ListSupportedProblemsResponseToJSON,
+    ApiV1ListSupportedRepairsResponseFromJSON,
+    ApiV1ListSupportedRepairsResponseToJSON,
     ApiV1ListSupportedVariantsResponseFromJSON,
     ApiV1ListSupportedVariantsResponseToJSON,
     ApiV1RunAsyncRequestFromJSON,
@@ -66,10 +78,22 @@ export interface DifferentialEvolutionServiceGetExecutionStatusRequest {
     executionId: string;
 }
 
+export interface DifferentialEvolutionServiceGetReferenceFrontRequest {
+    problem: string;
+    objectivesSize?: string;
+    points?: string;
+}
+
 export interface DifferentialEvolutionServiceListExecutionsRequest {
     status?: DifferentialEvolutionServiceListExecutionsStatusEnum;
     limit?: number;
     offset?: number;
+    experimentId?: string;
+}
+
+export interface DifferentialEvolutionServiceResumeExecutionRequest {
+    executionId: string;
+    body: ApiV1DifferentialEvolutionServiceResumeExecutionBody;
 }
 
 export interface DifferentialEvolutionServiceRunAsyncRequest {
@@ -235,6 +259,49 @@ export class ApiV1DifferentialEvolutionServiceApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     */
+    async differentialEvolutionServiceGetReferenceFrontRaw(requestParameters: DifferentialEvolutionServiceGetReferenceFrontRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1GetReferenceFrontResponse>> {
+        if (requestParameters['problem'] == null) {
+            throw new runtime.RequiredError(
+                'problem',
+                'Required parameter "problem" was null or undefined when calling differentialEvolutionServiceGetReferenceFront().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['objectivesSize'] != null) {
+            queryParameters['objectivesSize'] = requestParameters['objectivesSize'];
+        }
+
+        if (requestParameters['points'] != null) {
+            queryParameters['points'] = requestParameters['points'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/de/supported/problems/{problem}/reference-front`;
+        urlPath = urlPath.replace(`{${"problem"}}`, encodeURIComponent(String(requestParameters['problem'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1GetReferenceFrontResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async differentialEvolutionServiceGetReferenceFront(requestParameters: DifferentialEvolutionServiceGetReferenceFrontRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1GetReferenceFrontResponse> {
+        const response = await this.differentialEvolutionServiceGetReferenceFrontRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      */
     async differentialEvolutionServiceListExecutionsRaw(requestParameters: DifferentialEvolutionServiceListExecutionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListExecutionsResponse>> {
@@ -252,6 +319,10 @@ export class ApiV1DifferentialEvolutionServiceApi extends runtime.BaseAPI {
             queryParameters['offset'] = requestParameters['offset'];
         }
 
+        if (requestParameters['experimentId'] != null) {
+            queryParameters['experimentId'] = requestParameters['experimentId'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
 
@@ -301,6 +372,33 @@ export class ApiV1DifferentialEvolutionServiceApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     */
+    async differentialEvolutionServiceListSupportedCrossoversRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListSupportedCrossoversResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/de/supported/crossovers`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1ListSupportedCrossoversResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async differentialEvolutionServiceListSupportedCrossovers(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1ListSupportedCrossoversResponse> {
+        const response = await this.differentialEvolutionServiceListSupportedCrossoversRaw(initOverrides);
+        return await response.value();
+    }
+
     /**
      */
     async differentialEvolutionServiceListSupportedProblemsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListSupportedProblemsResponse>> {
@@ -328,6 +426,33 @@ export class ApiV1DifferentialEvolutionServiceApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     */
+    async differentialEvolutionServiceListSupportedRepairsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListSupportedRepairsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/de/supported/repairs`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1ListSupportedRepairsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async differentialEvolutionServiceListSupportedRepairs(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1ListSupportedRepairsResponse> {
+        const response = await this.differentialEvolutionServiceListSupportedRepairsRaw(initOverrides);
+        return await response.value();
+    }
+
     /**
      */
     async differentialEvolutionServiceListSupportedVariantsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListSupportedVariantsResponse>> {
@@ -355,6 +480,53 @@ export class ApiV1DifferentialEvolutionServiceApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     * ResumeExecution continues a failed or cancelled execution from its last checkpoint, or extends the generations of a completed one.
+     */
+    async differentialEvolutionServiceResumeExecutionRaw(requestParameters: DifferentialEvolutionServiceResumeExecutionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<object>> {
+        if (requestParameters['executionId'] == null) {
+            throw new runtime.RequiredError(
+                'executionId',
+                'Required parameter "executionId" was null or undefined when calling differentialEvolutionServiceResumeExecution().'
+            );
+        }
+
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling differentialEvolutionServiceResumeExecution().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/de/executions/{executionId}/resume`;
+        urlPath = urlPath.replace(`{${"executionId"}}`, encodeURIComponent(String(requestParameters['executionId'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1DifferentialEvolutionServiceResumeExecutionBodyToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse<any>(response);
+    }
+
+    /**
+     * ResumeExecution continues a failed or cancelled execution from its last checkpoint, or extends the generations of a completed one.
+     */
+    async differentialEvolutionServiceResumeExecution(requestParameters: DifferentialEvolutionServiceResumeExecutionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<object> {
+        const response = await this.differentialEvolutionServiceResumeExecutionRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Async execution RPCs
      */
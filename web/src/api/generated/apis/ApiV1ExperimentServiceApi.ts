@@ -0,0 +1,203 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ApiV1CreateExperimentRequest,
+  ApiV1CreateExperimentResponse,
+  ApiV1GetExperimentResponse,
+  ApiV1ListExperimentsResponse,
+  GoogleRpcStatus,
+} from '../models/index';
+import {
+    ApiV1CreateExperimentRequestFromJSON,
+    ApiV1CreateExperimentRequestToJSON,
+    ApiV1CreateExperimentResponseFromJSON,
+    ApiV1CreateExperimentResponseToJSON,
+    ApiV1GetExperimentResponseFromJSON,
+    ApiV1GetExperimentResponseToJSON,
+    ApiV1ListExperimentsResponseFromJSON,
+    ApiV1ListExperimentsResponseToJSON,
+    GoogleRpcStatusFromJSON,
+    GoogleRpcStatusToJSON,
+} from '../models/index';
+
+export interface ExperimentServiceCreateRequest {
+    body: ApiV1CreateExperimentRequest;
+}
+
+export interface ExperimentServiceDeleteRequest {
+    experimentId: string;
+}
+
+export interface ExperimentServiceGetRequest {
+    experimentId: string;
+}
+
+export interface ExperimentServiceListRequest {
+    limit?: number;
+    offset?: number;
+}
+
+/**
+ * 
+ */
+export class ApiV1ExperimentServiceApi extends runtime.BaseAPI {
+
+    /**
+     */
+    async experimentServiceCreateRaw(requestParameters: ExperimentServiceCreateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1CreateExperimentResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling experimentServiceCreate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/experiments`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1CreateExperimentRequestToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1CreateExperimentResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async experimentServiceCreate(requestParameters: ExperimentServiceCreateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1CreateExperimentResponse> {
+        const response = await this.experimentServiceCreateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete removes the experiment with its executions, it fails while any of them is pending or running.
+     */
+    async experimentServiceDeleteRaw(requestParameters: ExperimentServiceDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<object>> {
+        if (requestParameters['experimentId'] == null) {
+            throw new runtime.RequiredError(
+                'experimentId',
+                'Required parameter "experimentId" was null or undefined when calling experimentServiceDelete().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/experiments/{experimentId}`;
+        urlPath = urlPath.replace(`{${"experimentId"}}`, encodeURIComponent(String(requestParameters['experimentId'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse<any>(response);
+    }
+
+    /**
+     * Delete removes the experiment with its executions, it fails while any of them is pending or running.
+     */
+    async experimentServiceDelete(requestParameters: ExperimentServiceDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<object> {
+        const response = await this.experimentServiceDeleteRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     */
+    async experimentServiceGetRaw(requestParameters: ExperimentServiceGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1GetExperimentResponse>> {
+        if (requestParameters['experimentId'] == null) {
+            throw new runtime.RequiredError(
+                'experimentId',
+                'Required parameter "experimentId" was null or undefined when calling experimentServiceGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/experiments/{experimentId}`;
+        urlPath = urlPath.replace(`{${"experimentId"}}`, encodeURIComponent(String(requestParameters['experimentId'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1GetExperimentResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async experimentServiceGet(requestParameters: ExperimentServiceGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1GetExperimentResponse> {
+        const response = await this.experimentServiceGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     */
+    async experimentServiceListRaw(requestParameters: ExperimentServiceListRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1ListExperimentsResponse>> {
+        const queryParameters: any = {};
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['offset'] != null) {
+            queryParameters['offset'] = requestParameters['offset'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/experiments`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1ListExperimentsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     */
+    async experimentServiceList(requestParameters: ExperimentServiceListRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1ListExperimentsResponse> {
+        const response = await this.experimentServiceListRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
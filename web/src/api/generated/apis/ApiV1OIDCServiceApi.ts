@@ -0,0 +1,206 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ApiV1AuthServiceLoginResponse,
+  ApiV1ExchangeOIDCCodeRequest,
+  ApiV1GetOIDCConfigResponse,
+  ApiV1PollOIDCDeviceLoginRequest,
+  ApiV1PollOIDCDeviceLoginResponse,
+  ApiV1StartOIDCDeviceLoginResponse,
+  GoogleRpcStatus,
+} from '../models/index';
+import {
+    ApiV1AuthServiceLoginResponseFromJSON,
+    ApiV1AuthServiceLoginResponseToJSON,
+    ApiV1ExchangeOIDCCodeRequestFromJSON,
+    ApiV1ExchangeOIDCCodeRequestToJSON,
+    ApiV1GetOIDCConfigResponseFromJSON,
+    ApiV1GetOIDCConfigResponseToJSON,
+    ApiV1PollOIDCDeviceLoginRequestFromJSON,
+    ApiV1PollOIDCDeviceLoginRequestToJSON,
+    ApiV1PollOIDCDeviceLoginResponseFromJSON,
+    ApiV1PollOIDCDeviceLoginResponseToJSON,
+    ApiV1StartOIDCDeviceLoginResponseFromJSON,
+    ApiV1StartOIDCDeviceLoginResponseToJSON,
+    GoogleRpcStatusFromJSON,
+    GoogleRpcStatusToJSON,
+} from '../models/index';
+
+export interface OIDCServiceExchangeCodeRequest {
+    body: ApiV1ExchangeOIDCCodeRequest;
+}
+
+export interface OIDCServicePollDeviceLoginRequest {
+    body: ApiV1PollOIDCDeviceLoginRequest;
+}
+
+export interface OIDCServiceStartDeviceLoginRequest {
+    body: object;
+}
+
+/**
+ * 
+ */
+export class ApiV1OIDCServiceApi extends runtime.BaseAPI {
+
+    /**
+     * ExchangeCode completes an authorization code login with PKCE, the code is redeemed with the provider and its ID token verified.
+     */
+    async oIDCServiceExchangeCodeRaw(requestParameters: OIDCServiceExchangeCodeRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1AuthServiceLoginResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling oIDCServiceExchangeCode().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/auth/oidc/token`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1ExchangeOIDCCodeRequestToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1AuthServiceLoginResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * ExchangeCode completes an authorization code login with PKCE, the code is redeemed with the provider and its ID token verified.
+     */
+    async oIDCServiceExchangeCode(requestParameters: OIDCServiceExchangeCodeRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1AuthServiceLoginResponse> {
+        const response = await this.oIDCServiceExchangeCodeRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * GetConfig tells clients whether single sign-on is available and where to send users for the authorization code flow.
+     */
+    async oIDCServiceGetConfigRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1GetOIDCConfigResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/v1/auth/oidc/config`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1GetOIDCConfigResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * GetConfig tells clients whether single sign-on is available and where to send users for the authorization code flow.
+     */
+    async oIDCServiceGetConfig(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1GetOIDCConfigResponse> {
+        const response = await this.oIDCServiceGetConfigRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * PollDeviceLogin checks whether the user completed the device login, clients poll it every interval seconds while pending is set.
+     */
+    async oIDCServicePollDeviceLoginRaw(requestParameters: OIDCServicePollDeviceLoginRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1PollOIDCDeviceLoginResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling oIDCServicePollDeviceLogin().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/auth/oidc/device/token`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ApiV1PollOIDCDeviceLoginRequestToJSON(requestParameters['body']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1PollOIDCDeviceLoginResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * PollDeviceLogin checks whether the user completed the device login, clients poll it every interval seconds while pending is set.
+     */
+    async oIDCServicePollDeviceLogin(requestParameters: OIDCServicePollDeviceLoginRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1PollOIDCDeviceLoginResponse> {
+        const response = await this.oIDCServicePollDeviceLoginRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * StartDeviceLogin starts a device code login for clients without a browser, the user completes it at the verification URI.
+     */
+    async oIDCServiceStartDeviceLoginRaw(requestParameters: OIDCServiceStartDeviceLoginRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ApiV1StartOIDCDeviceLoginResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling oIDCServiceStartDeviceLogin().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/v1/auth/oidc/device`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: requestParameters['body'] as any,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ApiV1StartOIDCDeviceLoginResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * StartDeviceLogin starts a device code login for clients without a browser, the user completes it at the verification URI.
+     */
+    async oIDCServiceStartDeviceLogin(requestParameters: OIDCServiceStartDeviceLoginRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ApiV1StartOIDCDeviceLoginResponse> {
+        const response = await this.oIDCServiceStartDeviceLoginRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
@@ -1,6 +1,10 @@
 /* tslint:disable */
 /* eslint-disable */
+export * from './ApiV1APIKeyServiceApi';
+export * from './ApiV1AdminServiceApi';
 export * from './ApiV1AuthServiceApi';
 export * from './ApiV1DifferentialEvolutionServiceApi';
+export * from './ApiV1ExperimentServiceApi';
+export * from './ApiV1OIDCServiceApi';
 export * from './ApiV1ParetoServiceApi';
 export * from './ApiV1UserServiceApi';
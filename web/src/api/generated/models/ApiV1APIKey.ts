@@ -0,0 +1,106 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * APIKey describes a key without its secret.
+ * @export
+ * @interface ApiV1APIKey
+ */
+export interface ApiV1APIKey {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1APIKey
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1APIKey
+     */
+    name?: string;
+    /**
+     * prefix is the start of the key, telling the keys of a user apart.
+     * @type {string}
+     * @memberof ApiV1APIKey
+     */
+    prefix?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiV1APIKey
+     */
+    scopes?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1APIKey
+     */
+    createdAt?: string;
+    /**
+     * expires_at is unset for keys that do not expire.
+     * @type {string}
+     * @memberof ApiV1APIKey
+     */
+    expiresAt?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1APIKey interface.
+ */
+export function instanceOfApiV1APIKey(value: object): value is ApiV1APIKey {
+    return true;
+}
+
+export function ApiV1APIKeyFromJSON(json: any): ApiV1APIKey {
+    return ApiV1APIKeyFromJSONTyped(json, false);
+}
+
+export function ApiV1APIKeyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1APIKey {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'prefix': json['prefix'] == null ? undefined : json['prefix'],
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
+        'createdAt': json['createdAt'] == null ? undefined : json['createdAt'],
+        'expiresAt': json['expiresAt'] == null ? undefined : json['expiresAt'],
+    };
+}
+
+export function ApiV1APIKeyToJSON(json: any): ApiV1APIKey {
+    return ApiV1APIKeyToJSONTyped(json, false);
+}
+
+export function ApiV1APIKeyToJSONTyped(value?: ApiV1APIKey | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'prefix': value['prefix'],
+        'scopes': value['scopes'],
+        'createdAt': value['createdAt'],
+        'expiresAt': value['expiresAt'],
+    };
+}
+
@@ -0,0 +1,105 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Execution } from './ApiV1Execution';
+import {
+    ApiV1ExecutionFromJSON,
+    ApiV1ExecutionFromJSONTyped,
+    ApiV1ExecutionToJSON,
+    ApiV1ExecutionToJSONTyped,
+} from './ApiV1Execution';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1AdminListExecutionsResponse
+ */
+export interface ApiV1AdminListExecutionsResponse {
+    /**
+     * 
+     * @type {Array<ApiV1Execution>}
+     * @memberof ApiV1AdminListExecutionsResponse
+     */
+    executions?: Array<ApiV1Execution>;
+    /**
+     * Total number of executions matching filter
+     * @type {number}
+     * @memberof ApiV1AdminListExecutionsResponse
+     */
+    totalCount?: number;
+    /**
+     * Echoed limit for pagination
+     * @type {number}
+     * @memberof ApiV1AdminListExecutionsResponse
+     */
+    limit?: number;
+    /**
+     * Echoed offset for pagination
+     * @type {number}
+     * @memberof ApiV1AdminListExecutionsResponse
+     */
+    offset?: number;
+    /**
+     * True if more results available
+     * @type {boolean}
+     * @memberof ApiV1AdminListExecutionsResponse
+     */
+    hasMore?: boolean;
+}
+
+/**
+ * Check if a given object implements the ApiV1AdminListExecutionsResponse interface.
+ */
+export function instanceOfApiV1AdminListExecutionsResponse(value: object): value is ApiV1AdminListExecutionsResponse {
+    return true;
+}
+
+export function ApiV1AdminListExecutionsResponseFromJSON(json: any): ApiV1AdminListExecutionsResponse {
+    return ApiV1AdminListExecutionsResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1AdminListExecutionsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1AdminListExecutionsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'executions': json['executions'] == null ? undefined : ((json['executions'] as Array<any>).map(ApiV1ExecutionFromJSON)),
+        'totalCount': json['totalCount'] == null ? undefined : json['totalCount'],
+        'limit': json['limit'] == null ? undefined : json['limit'],
+        'offset': json['offset'] == null ? undefined : json['offset'],
+        'hasMore': json['hasMore'] == null ? undefined : json['hasMore'],
+    };
+}
+
+export function ApiV1AdminListExecutionsResponseToJSON(json: any): ApiV1AdminListExecutionsResponse {
+    return ApiV1AdminListExecutionsResponseToJSONTyped(json, false);
+}
+
+export function ApiV1AdminListExecutionsResponseToJSONTyped(value?: ApiV1AdminListExecutionsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'executions': value['executions'] == null ? undefined : ((value['executions'] as Array<any>).map(ApiV1ExecutionToJSON)),
+        'totalCount': value['totalCount'],
+        'limit': value['limit'],
+        'offset': value['offset'],
+        'hasMore': value['hasMore'],
+    };
+}
+
@@ -0,0 +1,105 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1UserResponse } from './ApiV1UserResponse';
+import {
+    ApiV1UserResponseFromJSON,
+    ApiV1UserResponseFromJSONTyped,
+    ApiV1UserResponseToJSON,
+    ApiV1UserResponseToJSONTyped,
+} from './ApiV1UserResponse';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1AdminListUsersResponse
+ */
+export interface ApiV1AdminListUsersResponse {
+    /**
+     * 
+     * @type {Array<ApiV1UserResponse>}
+     * @memberof ApiV1AdminListUsersResponse
+     */
+    users?: Array<ApiV1UserResponse>;
+    /**
+     * Total number of users of the tenant
+     * @type {number}
+     * @memberof ApiV1AdminListUsersResponse
+     */
+    totalCount?: number;
+    /**
+     * Echoed limit for pagination
+     * @type {number}
+     * @memberof ApiV1AdminListUsersResponse
+     */
+    limit?: number;
+    /**
+     * Echoed offset for pagination
+     * @type {number}
+     * @memberof ApiV1AdminListUsersResponse
+     */
+    offset?: number;
+    /**
+     * True if more results available
+     * @type {boolean}
+     * @memberof ApiV1AdminListUsersResponse
+     */
+    hasMore?: boolean;
+}
+
+/**
+ * Check if a given object implements the ApiV1AdminListUsersResponse interface.
+ */
+export function instanceOfApiV1AdminListUsersResponse(value: object): value is ApiV1AdminListUsersResponse {
+    return true;
+}
+
+export function ApiV1AdminListUsersResponseFromJSON(json: any): ApiV1AdminListUsersResponse {
+    return ApiV1AdminListUsersResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1AdminListUsersResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1AdminListUsersResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'users': json['users'] == null ? undefined : ((json['users'] as Array<any>).map(ApiV1UserResponseFromJSON)),
+        'totalCount': json['totalCount'] == null ? undefined : json['totalCount'],
+        'limit': json['limit'] == null ? undefined : json['limit'],
+        'offset': json['offset'] == null ? undefined : json['offset'],
+        'hasMore': json['hasMore'] == null ? undefined : json['hasMore'],
+    };
+}
+
+export function ApiV1AdminListUsersResponseToJSON(json: any): ApiV1AdminListUsersResponse {
+    return ApiV1AdminListUsersResponseToJSONTyped(json, false);
+}
+
+export function ApiV1AdminListUsersResponseToJSONTyped(value?: ApiV1AdminListUsersResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'users': value['users'] == null ? undefined : ((value['users'] as Array<any>).map(ApiV1UserResponseToJSON)),
+        'totalCount': value['totalCount'],
+        'limit': value['limit'],
+        'offset': value['offset'],
+        'hasMore': value['hasMore'],
+    };
+}
+
@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1AdminServiceUpdateUserScopesBody
+ */
+export interface ApiV1AdminServiceUpdateUserScopesBody {
+    /**
+     * scopes replace those of the user, empty restores the default scopes.
+     * @type {Array<string>}
+     * @memberof ApiV1AdminServiceUpdateUserScopesBody
+     */
+    scopes?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiV1AdminServiceUpdateUserScopesBody interface.
+ */
+export function instanceOfApiV1AdminServiceUpdateUserScopesBody(value: object): value is ApiV1AdminServiceUpdateUserScopesBody {
+    return true;
+}
+
+export function ApiV1AdminServiceUpdateUserScopesBodyFromJSON(json: any): ApiV1AdminServiceUpdateUserScopesBody {
+    return ApiV1AdminServiceUpdateUserScopesBodyFromJSONTyped(json, false);
+}
+
+export function ApiV1AdminServiceUpdateUserScopesBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1AdminServiceUpdateUserScopesBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
+    };
+}
+
+export function ApiV1AdminServiceUpdateUserScopesBodyToJSON(json: any): ApiV1AdminServiceUpdateUserScopesBody {
+    return ApiV1AdminServiceUpdateUserScopesBodyToJSONTyped(json, false);
+}
+
+export function ApiV1AdminServiceUpdateUserScopesBodyToJSONTyped(value?: ApiV1AdminServiceUpdateUserScopesBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'scopes': value['scopes'],
+    };
+}
+
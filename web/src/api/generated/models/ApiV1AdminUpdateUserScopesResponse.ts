@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1UserResponse } from './ApiV1UserResponse';
+import {
+    ApiV1UserResponseFromJSON,
+    ApiV1UserResponseFromJSONTyped,
+    ApiV1UserResponseToJSON,
+    ApiV1UserResponseToJSONTyped,
+} from './ApiV1UserResponse';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1AdminUpdateUserScopesResponse
+ */
+export interface ApiV1AdminUpdateUserScopesResponse {
+    /**
+     * 
+     * @type {ApiV1UserResponse}
+     * @memberof ApiV1AdminUpdateUserScopesResponse
+     */
+    user?: ApiV1UserResponse;
+}
+
+/**
+ * Check if a given object implements the ApiV1AdminUpdateUserScopesResponse interface.
+ */
+export function instanceOfApiV1AdminUpdateUserScopesResponse(value: object): value is ApiV1AdminUpdateUserScopesResponse {
+    return true;
+}
+
+export function ApiV1AdminUpdateUserScopesResponseFromJSON(json: any): ApiV1AdminUpdateUserScopesResponse {
+    return ApiV1AdminUpdateUserScopesResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1AdminUpdateUserScopesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1AdminUpdateUserScopesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'user': json['user'] == null ? undefined : ApiV1UserResponseFromJSON(json['user']),
+    };
+}
+
+export function ApiV1AdminUpdateUserScopesResponseToJSON(json: any): ApiV1AdminUpdateUserScopesResponse {
+    return ApiV1AdminUpdateUserScopesResponseToJSONTyped(json, false);
+}
+
+export function ApiV1AdminUpdateUserScopesResponseToJSONTyped(value?: ApiV1AdminUpdateUserScopesResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'user': ApiV1UserResponseToJSON(value['user']),
+    };
+}
+
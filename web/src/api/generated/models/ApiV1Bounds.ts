@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Bounds is the closed interval [floor, ceil] of a decision variable.
+ * @export
+ * @interface ApiV1Bounds
+ */
+export interface ApiV1Bounds {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1Bounds
+     */
+    floor?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1Bounds
+     */
+    ceil?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1Bounds interface.
+ */
+export function instanceOfApiV1Bounds(value: object): value is ApiV1Bounds {
+    return true;
+}
+
+export function ApiV1BoundsFromJSON(json: any): ApiV1Bounds {
+    return ApiV1BoundsFromJSONTyped(json, false);
+}
+
+export function ApiV1BoundsFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1Bounds {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'floor': json['floor'] == null ? undefined : json['floor'],
+        'ceil': json['ceil'] == null ? undefined : json['ceil'],
+    };
+}
+
+export function ApiV1BoundsToJSON(json: any): ApiV1Bounds {
+    return ApiV1BoundsToJSONTyped(json, false);
+}
+
+export function ApiV1BoundsToJSONTyped(value?: ApiV1Bounds | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'floor': value['floor'],
+        'ceil': value['ceil'],
+    };
+}
+
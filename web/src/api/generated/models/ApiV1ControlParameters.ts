@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * ControlParameters are the means of the self-adapted F and CR at the
+ * reported generation.
+ * @export
+ * @interface ApiV1ControlParameters
+ */
+export interface ApiV1ControlParameters {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ControlParameters
+     */
+    meanF?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ControlParameters
+     */
+    meanCr?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1ControlParameters interface.
+ */
+export function instanceOfApiV1ControlParameters(value: object): value is ApiV1ControlParameters {
+    return true;
+}
+
+export function ApiV1ControlParametersFromJSON(json: any): ApiV1ControlParameters {
+    return ApiV1ControlParametersFromJSONTyped(json, false);
+}
+
+export function ApiV1ControlParametersFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ControlParameters {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'meanF': json['meanF'] == null ? undefined : json['meanF'],
+        'meanCr': json['meanCr'] == null ? undefined : json['meanCr'],
+    };
+}
+
+export function ApiV1ControlParametersToJSON(json: any): ApiV1ControlParameters {
+    return ApiV1ControlParametersToJSONTyped(json, false);
+}
+
+export function ApiV1ControlParametersToJSONTyped(value?: ApiV1ControlParameters | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'meanF': value['meanF'],
+        'meanCr': value['meanCr'],
+    };
+}
+
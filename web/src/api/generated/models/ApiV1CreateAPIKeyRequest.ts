@@ -0,0 +1,82 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1CreateAPIKeyRequest
+ */
+export interface ApiV1CreateAPIKeyRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1CreateAPIKeyRequest
+     */
+    name?: string;
+    /**
+     * scopes granted to the key, each one must be granted to the caller.
+     * @type {Array<string>}
+     * @memberof ApiV1CreateAPIKeyRequest
+     */
+    scopes?: Array<string>;
+    /**
+     * expires_in is the lifetime of the key, unset for keys that do not expire.
+     * @type {string}
+     * @memberof ApiV1CreateAPIKeyRequest
+     */
+    expiresIn?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1CreateAPIKeyRequest interface.
+ */
+export function instanceOfApiV1CreateAPIKeyRequest(value: object): value is ApiV1CreateAPIKeyRequest {
+    return true;
+}
+
+export function ApiV1CreateAPIKeyRequestFromJSON(json: any): ApiV1CreateAPIKeyRequest {
+    return ApiV1CreateAPIKeyRequestFromJSONTyped(json, false);
+}
+
+export function ApiV1CreateAPIKeyRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1CreateAPIKeyRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
+        'expiresIn': json['expiresIn'] == null ? undefined : json['expiresIn'],
+    };
+}
+
+export function ApiV1CreateAPIKeyRequestToJSON(json: any): ApiV1CreateAPIKeyRequest {
+    return ApiV1CreateAPIKeyRequestToJSONTyped(json, false);
+}
+
+export function ApiV1CreateAPIKeyRequestToJSONTyped(value?: ApiV1CreateAPIKeyRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'scopes': value['scopes'],
+        'expiresIn': value['expiresIn'],
+    };
+}
+
@@ -0,0 +1,81 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1APIKey } from './ApiV1APIKey';
+import {
+    ApiV1APIKeyFromJSON,
+    ApiV1APIKeyFromJSONTyped,
+    ApiV1APIKeyToJSON,
+    ApiV1APIKeyToJSONTyped,
+} from './ApiV1APIKey';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1CreateAPIKeyResponse
+ */
+export interface ApiV1CreateAPIKeyResponse {
+    /**
+     * 
+     * @type {ApiV1APIKey}
+     * @memberof ApiV1CreateAPIKeyResponse
+     */
+    apiKey?: ApiV1APIKey;
+    /**
+     * key is the secret to authenticate with, it cannot be retrieved again.
+     * @type {string}
+     * @memberof ApiV1CreateAPIKeyResponse
+     */
+    key?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1CreateAPIKeyResponse interface.
+ */
+export function instanceOfApiV1CreateAPIKeyResponse(value: object): value is ApiV1CreateAPIKeyResponse {
+    return true;
+}
+
+export function ApiV1CreateAPIKeyResponseFromJSON(json: any): ApiV1CreateAPIKeyResponse {
+    return ApiV1CreateAPIKeyResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1CreateAPIKeyResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1CreateAPIKeyResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'apiKey': json['apiKey'] == null ? undefined : ApiV1APIKeyFromJSON(json['apiKey']),
+        'key': json['key'] == null ? undefined : json['key'],
+    };
+}
+
+export function ApiV1CreateAPIKeyResponseToJSON(json: any): ApiV1CreateAPIKeyResponse {
+    return ApiV1CreateAPIKeyResponseToJSONTyped(json, false);
+}
+
+export function ApiV1CreateAPIKeyResponseToJSONTyped(value?: ApiV1CreateAPIKeyResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'apiKey': ApiV1APIKeyToJSON(value['apiKey']),
+        'key': value['key'],
+    };
+}
+
@@ -0,0 +1,82 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1CreateExperimentRequest
+ */
+export interface ApiV1CreateExperimentRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1CreateExperimentRequest
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1CreateExperimentRequest
+     */
+    description?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiV1CreateExperimentRequest
+     */
+    tags?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiV1CreateExperimentRequest interface.
+ */
+export function instanceOfApiV1CreateExperimentRequest(value: object): value is ApiV1CreateExperimentRequest {
+    return true;
+}
+
+export function ApiV1CreateExperimentRequestFromJSON(json: any): ApiV1CreateExperimentRequest {
+    return ApiV1CreateExperimentRequestFromJSONTyped(json, false);
+}
+
+export function ApiV1CreateExperimentRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1CreateExperimentRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'tags': json['tags'] == null ? undefined : json['tags'],
+    };
+}
+
+export function ApiV1CreateExperimentRequestToJSON(json: any): ApiV1CreateExperimentRequest {
+    return ApiV1CreateExperimentRequestToJSONTyped(json, false);
+}
+
+export function ApiV1CreateExperimentRequestToJSONTyped(value?: ApiV1CreateExperimentRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'tags': value['tags'],
+    };
+}
+
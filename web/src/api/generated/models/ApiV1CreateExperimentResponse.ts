@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Experiment } from './ApiV1Experiment';
+import {
+    ApiV1ExperimentFromJSON,
+    ApiV1ExperimentFromJSONTyped,
+    ApiV1ExperimentToJSON,
+    ApiV1ExperimentToJSONTyped,
+} from './ApiV1Experiment';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1CreateExperimentResponse
+ */
+export interface ApiV1CreateExperimentResponse {
+    /**
+     * 
+     * @type {ApiV1Experiment}
+     * @memberof ApiV1CreateExperimentResponse
+     */
+    experiment?: ApiV1Experiment;
+}
+
+/**
+ * Check if a given object implements the ApiV1CreateExperimentResponse interface.
+ */
+export function instanceOfApiV1CreateExperimentResponse(value: object): value is ApiV1CreateExperimentResponse {
+    return true;
+}
+
+export function ApiV1CreateExperimentResponseFromJSON(json: any): ApiV1CreateExperimentResponse {
+    return ApiV1CreateExperimentResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1CreateExperimentResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1CreateExperimentResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'experiment': json['experiment'] == null ? undefined : ApiV1ExperimentFromJSON(json['experiment']),
+    };
+}
+
+export function ApiV1CreateExperimentResponseToJSON(json: any): ApiV1CreateExperimentResponse {
+    return ApiV1CreateExperimentResponseToJSONTyped(json, false);
+}
+
+export function ApiV1CreateExperimentResponseToJSONTyped(value?: ApiV1CreateExperimentResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'experiment': ApiV1ExperimentToJSON(value['experiment']),
+    };
+}
+
@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiV1Crossover
+ */
+export interface ApiV1Crossover {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Crossover
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Crossover
+     */
+    description?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1Crossover interface.
+ */
+export function instanceOfApiV1Crossover(value: object): value is ApiV1Crossover {
+    return true;
+}
+
+export function ApiV1CrossoverFromJSON(json: any): ApiV1Crossover {
+    return ApiV1CrossoverFromJSONTyped(json, false);
+}
+
+export function ApiV1CrossoverFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1Crossover {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+    };
+}
+
+export function ApiV1CrossoverToJSON(json: any): ApiV1Crossover {
+    return ApiV1CrossoverToJSONTyped(json, false);
+}
+
+export function ApiV1CrossoverToJSONTyped(value?: ApiV1Crossover | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+    };
+}
+
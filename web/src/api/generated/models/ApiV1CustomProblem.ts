@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Bounds } from './ApiV1Bounds';
+import {
+    ApiV1BoundsFromJSON,
+    ApiV1BoundsFromJSONTyped,
+    ApiV1BoundsToJSON,
+    ApiV1BoundsToJSONTyped,
+} from './ApiV1Bounds';
+
+/**
+ * CustomProblem is a problem defined by expressions over the decision
+ * variables x[0] ... x[n-1], such as "x[0]" and
+ * "(1 + 9 * x[1]) * (1 - sqrt(x[0] / (1 + 9 * x[1])))". Expressions support
+ * numbers, pi, e, + - * / ^, parentheses and the usual math functions (abs,
+ * sqrt, exp, log, sin, cos, min, max, pow, ...).
+ * @export
+ * @interface ApiV1CustomProblem
+ */
+export interface ApiV1CustomProblem {
+    /**
+     * name identifies the problem, it cannot be the name of a built-in one.
+     * @type {string}
+     * @memberof ApiV1CustomProblem
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1CustomProblem
+     */
+    description?: string;
+    /**
+     * objectives are minimized, one expression per objective.
+     * @type {Array<string>}
+     * @memberof ApiV1CustomProblem
+     */
+    objectives?: Array<string>;
+    /**
+     * constraints are expressions g(x) satisfied when g(x) <= 0.
+     * @type {Array<string>}
+     * @memberof ApiV1CustomProblem
+     */
+    constraints?: Array<string>;
+    /**
+     * bounds sets the domain of each decision variable and fixes the
+     * dimensions of the problem. Empty means [0, 1] in any dimensions.
+     * @type {Array<ApiV1Bounds>}
+     * @memberof ApiV1CustomProblem
+     */
+    bounds?: Array<ApiV1Bounds>;
+}
+
+/**
+ * Check if a given object implements the ApiV1CustomProblem interface.
+ */
+export function instanceOfApiV1CustomProblem(value: object): value is ApiV1CustomProblem {
+    return true;
+}
+
+export function ApiV1CustomProblemFromJSON(json: any): ApiV1CustomProblem {
+    return ApiV1CustomProblemFromJSONTyped(json, false);
+}
+
+export function ApiV1CustomProblemFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1CustomProblem {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'objectives': json['objectives'] == null ? undefined : json['objectives'],
+        'constraints': json['constraints'] == null ? undefined : json['constraints'],
+        'bounds': json['bounds'] == null ? undefined : ((json['bounds'] as Array<any>).map(ApiV1BoundsFromJSON)),
+    };
+}
+
+export function ApiV1CustomProblemToJSON(json: any): ApiV1CustomProblem {
+    return ApiV1CustomProblemToJSONTyped(json, false);
+}
+
+export function ApiV1CustomProblemToJSONTyped(value?: ApiV1CustomProblem | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'objectives': value['objectives'],
+        'constraints': value['constraints'],
+        'bounds': value['bounds'] == null ? undefined : ((value['bounds'] as Array<any>).map(ApiV1BoundsToJSON)),
+    };
+}
+
@@ -13,13 +13,62 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiV1Bounds } from './ApiV1Bounds';
+import {
+    ApiV1BoundsFromJSON,
+    ApiV1BoundsFromJSONTyped,
+    ApiV1BoundsToJSON,
+    ApiV1BoundsToJSONTyped,
+} from './ApiV1Bounds';
+import type { ApiV1CustomProblem } from './ApiV1CustomProblem';
+import {
+    ApiV1CustomProblemFromJSON,
+    ApiV1CustomProblemFromJSONTyped,
+    ApiV1CustomProblemToJSON,
+    ApiV1CustomProblemToJSONTyped,
+} from './ApiV1CustomProblem';
 import type { ApiV1GDE3Config } from './ApiV1GDE3Config';
+import type { ApiV1MOEADConfig } from './ApiV1MOEADConfig';
+import {
+    ApiV1MOEADConfigFromJSON,
+    ApiV1MOEADConfigFromJSONTyped,
+    ApiV1MOEADConfigToJSON,
+    ApiV1MOEADConfigToJSONTyped,
+} from './ApiV1MOEADConfig';
+import type { ApiV1NSGA2Config } from './ApiV1NSGA2Config';
+import type { ApiV1ParetoArchiveConfig } from './ApiV1ParetoArchiveConfig';
+import {
+    ApiV1ParetoArchiveConfigFromJSON,
+    ApiV1ParetoArchiveConfigFromJSONTyped,
+    ApiV1ParetoArchiveConfigToJSON,
+    ApiV1ParetoArchiveConfigToJSONTyped,
+} from './ApiV1ParetoArchiveConfig';
+import {
+    ApiV1NSGA2ConfigFromJSON,
+    ApiV1NSGA2ConfigFromJSONTyped,
+    ApiV1NSGA2ConfigToJSON,
+    ApiV1NSGA2ConfigToJSONTyped,
+} from './ApiV1NSGA2Config';
 import {
     ApiV1GDE3ConfigFromJSON,
     ApiV1GDE3ConfigFromJSONTyped,
     ApiV1GDE3ConfigToJSON,
     ApiV1GDE3ConfigToJSONTyped,
 } from './ApiV1GDE3Config';
+import type { ApiV1MOEADConfig } from './ApiV1MOEADConfig';
+import {
+    ApiV1MOEADConfigFromJSON,
+    ApiV1MOEADConfigFromJSONTyped,
+    ApiV1MOEADConfigToJSON,
+    ApiV1MOEADConfigToJSONTyped,
+} from './ApiV1MOEADConfig';
+import type { ApiV1NSGA2Config } from './ApiV1NSGA2Config';
+import {
+    ApiV1NSGA2ConfigFromJSON,
+    ApiV1NSGA2ConfigFromJSONTyped,
+    ApiV1NSGA2ConfigToJSON,
+    ApiV1NSGA2ConfigToJSONTyped,
+} from './ApiV1NSGA2Config';
 
 /**
  * 
@@ -75,6 +124,74 @@ export interface ApiV1DEConfig {
      * @memberof ApiV1DEConfig
      */
     gde3?: ApiV1GDE3Config;
+    /**
+     * 
+     * @type {ApiV1MOEADConfig}
+     * @memberof ApiV1DEConfig
+     */
+    moead?: ApiV1MOEADConfig;
+    /**
+     * 
+     * @type {ApiV1NSGA2Config}
+     * @memberof ApiV1DEConfig
+     */
+    nsga2?: ApiV1NSGA2Config;
+    /**
+     * seed makes the execution reproducible, the same seed and configuration
+     * produce identical Pareto sets. When unset the server picks one and
+     * records it on the execution.
+     * @type {string}
+     * @memberof ApiV1DEConfig
+     */
+    seed?: string;
+    /**
+     * bounds sets the domain of each decision variable, one entry per
+     * dimension. It takes precedence over floor_limiter and ceil_limiter; when
+     * neither is given the problem's natural bounds are used.
+     * @type {Array<ApiV1Bounds>}
+     * @memberof ApiV1DEConfig
+     */
+    bounds?: Array<ApiV1Bounds>;
+    /**
+     * custom_problem is the definition of the custom problem the execution
+     * optimizes. The server records it from RunAsyncRequest so the execution
+     * runs the same definition when resumed, any value sent by clients is
+     * replaced.
+     * @type {ApiV1CustomProblem}
+     * @memberof ApiV1DEConfig
+     */
+    customProblem?: ApiV1CustomProblem;
+    /**
+     * crossover is the name of the operator building trial vectors from the
+     * mutants, as listed by ListSupportedCrossovers. Empty uses binomial.
+     * @type {string}
+     * @memberof ApiV1DEConfig
+     */
+    crossover?: string;
+    /**
+     * repair is the name of the strategy bringing trial elements outside the
+     * bounds back into them, as listed by ListSupportedRepairs. Empty uses
+     * clamp.
+     * @type {string}
+     * @memberof ApiV1DEConfig
+     */
+    repair?: string;
+    /**
+     * result_limit caps the size of the Pareto set merged from every
+     * execution, the most crowded solutions are dropped. Zero uses the server
+     * default.
+     * @type {string}
+     * @memberof ApiV1DEConfig
+     */
+    resultLimit?: string;
+    /**
+     * pareto_archive makes every execution keep an external archive of the
+     * non-dominated solutions found across its generations, returned merged
+     * with the results. Unset keeps no archive.
+     * @type {ApiV1ParetoArchiveConfig}
+     * @memberof ApiV1DEConfig
+     */
+    paretoArchive?: ApiV1ParetoArchiveConfig;
 }
 
 /**
@@ -102,6 +219,15 @@ export function ApiV1DEConfigFromJSONTyped(json: any, ignoreDiscriminator: boole
         'floorLimiter': json['floorLimiter'] == null ? undefined : json['floorLimiter'],
         'ceilLimiter': json['ceilLimiter'] == null ? undefined : json['ceilLimiter'],
         'gde3': json['gde3'] == null ? undefined : ApiV1GDE3ConfigFromJSON(json['gde3']),
+        'moead': json['moead'] == null ? undefined : ApiV1MOEADConfigFromJSON(json['moead']),
+        'nsga2': json['nsga2'] == null ? undefined : ApiV1NSGA2ConfigFromJSON(json['nsga2']),
+        'seed': json['seed'] == null ? undefined : json['seed'],
+        'bounds': json['bounds'] == null ? undefined : ((json['bounds'] as Array<any>).map(ApiV1BoundsFromJSON)),
+        'customProblem': json['customProblem'] == null ? undefined : ApiV1CustomProblemFromJSON(json['customProblem']),
+        'crossover': json['crossover'] == null ? undefined : json['crossover'],
+        'repair': json['repair'] == null ? undefined : json['repair'],
+        'resultLimit': json['resultLimit'] == null ? undefined : json['resultLimit'],
+        'paretoArchive': json['paretoArchive'] == null ? undefined : ApiV1ParetoArchiveConfigFromJSON(json['paretoArchive']),
     };
 }
 
@@ -124,6 +250,15 @@ export function ApiV1DEConfigToJSONTyped(value?: ApiV1DEConfig | null, ignoreDis
         'floorLimiter': value['floorLimiter'],
         'ceilLimiter': value['ceilLimiter'],
         'gde3': ApiV1GDE3ConfigToJSON(value['gde3']),
+        'moead': ApiV1MOEADConfigToJSON(value['moead']),
+        'nsga2': ApiV1NSGA2ConfigToJSON(value['nsga2']),
+        'seed': value['seed'],
+        'bounds': value['bounds'] == null ? undefined : ((value['bounds'] as Array<any>).map(ApiV1BoundsToJSON)),
+        'customProblem': ApiV1CustomProblemToJSON(value['customProblem']),
+        'crossover': value['crossover'],
+        'repair': value['repair'],
+        'resultLimit': value['resultLimit'],
+        'paretoArchive': ApiV1ParetoArchiveConfigToJSON(value['paretoArchive']),
     };
 }
 
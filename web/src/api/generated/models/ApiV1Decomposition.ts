@@ -0,0 +1,54 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * Decomposition is the scalarizing function used by MOEA/D.
+ * @export
+ */
+export const ApiV1Decomposition = {
+    DecompositionUnspecified: 'DECOMPOSITION_UNSPECIFIED',
+    DecompositionTchebycheff: 'DECOMPOSITION_TCHEBYCHEFF',
+    DecompositionPbi: 'DECOMPOSITION_PBI'
+} as const;
+export type ApiV1Decomposition = typeof ApiV1Decomposition[keyof typeof ApiV1Decomposition];
+
+
+export function instanceOfApiV1Decomposition(value: any): boolean {
+    for (const key in ApiV1Decomposition) {
+        if (Object.prototype.hasOwnProperty.call(ApiV1Decomposition, key)) {
+            if (ApiV1Decomposition[key as keyof typeof ApiV1Decomposition] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function ApiV1DecompositionFromJSON(json: any): ApiV1Decomposition {
+    return ApiV1DecompositionFromJSONTyped(json, false);
+}
+
+export function ApiV1DecompositionFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1Decomposition {
+    return json as ApiV1Decomposition;
+}
+
+export function ApiV1DecompositionToJSON(value?: ApiV1Decomposition | null): any {
+    return value as any;
+}
+
+export function ApiV1DecompositionToJSONTyped(value: any, ignoreDiscriminator: boolean): ApiV1Decomposition {
+    return value as ApiV1Decomposition;
+}
+
@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiV1DifferentialEvolutionServiceResumeExecutionBody
+ */
+export interface ApiV1DifferentialEvolutionServiceResumeExecutionBody {
+    /**
+     * New generation budget of the run, zero keeps the configured one. It is
+     * required to resume completed executions.
+     * @type {string}
+     * @memberof ApiV1DifferentialEvolutionServiceResumeExecutionBody
+     */
+    generations?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1DifferentialEvolutionServiceResumeExecutionBody interface.
+ */
+export function instanceOfApiV1DifferentialEvolutionServiceResumeExecutionBody(value: object): value is ApiV1DifferentialEvolutionServiceResumeExecutionBody {
+    return true;
+}
+
+export function ApiV1DifferentialEvolutionServiceResumeExecutionBodyFromJSON(json: any): ApiV1DifferentialEvolutionServiceResumeExecutionBody {
+    return ApiV1DifferentialEvolutionServiceResumeExecutionBodyFromJSONTyped(json, false);
+}
+
+export function ApiV1DifferentialEvolutionServiceResumeExecutionBodyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1DifferentialEvolutionServiceResumeExecutionBody {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'generations': json['generations'] == null ? undefined : json['generations'],
+    };
+}
+
+export function ApiV1DifferentialEvolutionServiceResumeExecutionBodyToJSON(json: any): ApiV1DifferentialEvolutionServiceResumeExecutionBody {
+    return ApiV1DifferentialEvolutionServiceResumeExecutionBodyToJSONTyped(json, false);
+}
+
+export function ApiV1DifferentialEvolutionServiceResumeExecutionBodyToJSONTyped(value?: ApiV1DifferentialEvolutionServiceResumeExecutionBody | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'generations': value['generations'],
+    };
+}
+
@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1ExchangeOIDCCodeRequest
+ */
+export interface ApiV1ExchangeOIDCCodeRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1ExchangeOIDCCodeRequest
+     */
+    code?: string;
+    /**
+     * code_verifier is the PKCE verifier of the code challenge sent to the provider.
+     * @type {string}
+     * @memberof ApiV1ExchangeOIDCCodeRequest
+     */
+    codeVerifier?: string;
+    /**
+     * redirect_uri must be the one the code was issued for.
+     * @type {string}
+     * @memberof ApiV1ExchangeOIDCCodeRequest
+     */
+    redirectUri?: string;
+    /**
+     * nonce must be the one sent to the provider, it is checked against the ID token.
+     * @type {string}
+     * @memberof ApiV1ExchangeOIDCCodeRequest
+     */
+    nonce?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1ExchangeOIDCCodeRequest interface.
+ */
+export function instanceOfApiV1ExchangeOIDCCodeRequest(value: object): value is ApiV1ExchangeOIDCCodeRequest {
+    return true;
+}
+
+export function ApiV1ExchangeOIDCCodeRequestFromJSON(json: any): ApiV1ExchangeOIDCCodeRequest {
+    return ApiV1ExchangeOIDCCodeRequestFromJSONTyped(json, false);
+}
+
+export function ApiV1ExchangeOIDCCodeRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ExchangeOIDCCodeRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'code': json['code'] == null ? undefined : json['code'],
+        'codeVerifier': json['codeVerifier'] == null ? undefined : json['codeVerifier'],
+        'redirectUri': json['redirectUri'] == null ? undefined : json['redirectUri'],
+        'nonce': json['nonce'] == null ? undefined : json['nonce'],
+    };
+}
+
+export function ApiV1ExchangeOIDCCodeRequestToJSON(json: any): ApiV1ExchangeOIDCCodeRequest {
+    return ApiV1ExchangeOIDCCodeRequestToJSONTyped(json, false);
+}
+
+export function ApiV1ExchangeOIDCCodeRequestToJSONTyped(value?: ApiV1ExchangeOIDCCodeRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'code': value['code'],
+        'codeVerifier': value['codeVerifier'],
+        'redirectUri': value['redirectUri'],
+        'nonce': value['nonce'],
+    };
+}
+
@@ -106,6 +106,12 @@ export interface ApiV1Execution {
      * @memberof ApiV1Execution
      */
     problem?: string;
+    /**
+     * experiment_id is the experiment the execution belongs to, if any.
+     * @type {string}
+     * @memberof ApiV1Execution
+     */
+    experimentId?: string;
 }
 
 
@@ -139,6 +145,7 @@ export function ApiV1ExecutionFromJSONTyped(json: any, ignoreDiscriminator: bool
         'algorithm': json['algorithm'] == null ? undefined : json['algorithm'],
         'variant': json['variant'] == null ? undefined : json['variant'],
         'problem': json['problem'] == null ? undefined : json['problem'],
+        'experimentId': json['experimentId'] == null ? undefined : json['experimentId'],
     };
 }
 
@@ -165,6 +172,7 @@ export function ApiV1ExecutionToJSONTyped(value?: ApiV1Execution | null, ignoreD
         'algorithm': value['algorithm'],
         'variant': value['variant'],
         'problem': value['problem'],
+        'experimentId': value['experimentId'],
     };
 }
 
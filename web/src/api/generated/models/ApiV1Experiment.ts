@@ -0,0 +1,129 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1ExperimentSummary } from './ApiV1ExperimentSummary';
+import {
+    ApiV1ExperimentSummaryFromJSON,
+    ApiV1ExperimentSummaryFromJSONTyped,
+    ApiV1ExperimentSummaryToJSON,
+    ApiV1ExperimentSummaryToJSONTyped,
+} from './ApiV1ExperimentSummary';
+
+/**
+ * Experiment owns the executions submitted with its id.
+ * @export
+ * @interface ApiV1Experiment
+ */
+export interface ApiV1Experiment {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Experiment
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Experiment
+     */
+    userId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Experiment
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Experiment
+     */
+    description?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiV1Experiment
+     */
+    tags?: Array<string>;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiV1Experiment
+     */
+    createdAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ApiV1Experiment
+     */
+    updatedAt?: Date;
+    /**
+     * 
+     * @type {ApiV1ExperimentSummary}
+     * @memberof ApiV1Experiment
+     */
+    summary?: ApiV1ExperimentSummary;
+}
+
+/**
+ * Check if a given object implements the ApiV1Experiment interface.
+ */
+export function instanceOfApiV1Experiment(value: object): value is ApiV1Experiment {
+    return true;
+}
+
+export function ApiV1ExperimentFromJSON(json: any): ApiV1Experiment {
+    return ApiV1ExperimentFromJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1Experiment {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'userId': json['userId'] == null ? undefined : json['userId'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'tags': json['tags'] == null ? undefined : json['tags'],
+        'createdAt': json['createdAt'] == null ? undefined : (new Date(json['createdAt'])),
+        'updatedAt': json['updatedAt'] == null ? undefined : (new Date(json['updatedAt'])),
+        'summary': json['summary'] == null ? undefined : ApiV1ExperimentSummaryFromJSON(json['summary']),
+    };
+}
+
+export function ApiV1ExperimentToJSON(json: any): ApiV1Experiment {
+    return ApiV1ExperimentToJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentToJSONTyped(value?: ApiV1Experiment | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'userId': value['userId'],
+        'name': value['name'],
+        'description': value['description'],
+        'tags': value['tags'],
+        'createdAt': value['createdAt'] == null ? value['createdAt'] : value['createdAt'].toISOString(),
+        'updatedAt': value['updatedAt'] == null ? value['updatedAt'] : value['updatedAt'].toISOString(),
+        'summary': ApiV1ExperimentSummaryToJSON(value['summary']),
+    };
+}
+
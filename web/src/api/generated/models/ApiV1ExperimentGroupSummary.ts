@@ -0,0 +1,147 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1IndicatorSummary } from './ApiV1IndicatorSummary';
+import {
+    ApiV1IndicatorSummaryFromJSON,
+    ApiV1IndicatorSummaryFromJSONTyped,
+    ApiV1IndicatorSummaryToJSON,
+    ApiV1IndicatorSummaryToJSONTyped,
+} from './ApiV1IndicatorSummary';
+
+/**
+ * ExperimentGroupSummary summarizes the indicators of the completed
+ * executions sharing an algorithm, variant and problem. The hypervolume of
+ * each execution is measured from its own reference point.
+ * @export
+ * @interface ApiV1ExperimentGroupSummary
+ */
+export interface ApiV1ExperimentGroupSummary {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    algorithm?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    variant?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    problem?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    completedExecutions?: number;
+    /**
+     * 
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    hypervolume?: ApiV1IndicatorSummary;
+    /**
+     * igd, igd_plus and gd are only set when the problem has a reference front.
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    igd?: ApiV1IndicatorSummary;
+    /**
+     * 
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    igdPlus?: ApiV1IndicatorSummary;
+    /**
+     * 
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    gd?: ApiV1IndicatorSummary;
+    /**
+     * 
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    spread?: ApiV1IndicatorSummary;
+    /**
+     * 
+     * @type {ApiV1IndicatorSummary}
+     * @memberof ApiV1ExperimentGroupSummary
+     */
+    spacing?: ApiV1IndicatorSummary;
+}
+
+/**
+ * Check if a given object implements the ApiV1ExperimentGroupSummary interface.
+ */
+export function instanceOfApiV1ExperimentGroupSummary(value: object): value is ApiV1ExperimentGroupSummary {
+    return true;
+}
+
+export function ApiV1ExperimentGroupSummaryFromJSON(json: any): ApiV1ExperimentGroupSummary {
+    return ApiV1ExperimentGroupSummaryFromJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentGroupSummaryFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ExperimentGroupSummary {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'algorithm': json['algorithm'] == null ? undefined : json['algorithm'],
+        'variant': json['variant'] == null ? undefined : json['variant'],
+        'problem': json['problem'] == null ? undefined : json['problem'],
+        'completedExecutions': json['completedExecutions'] == null ? undefined : json['completedExecutions'],
+        'hypervolume': json['hypervolume'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['hypervolume']),
+        'igd': json['igd'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['igd']),
+        'igdPlus': json['igdPlus'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['igdPlus']),
+        'gd': json['gd'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['gd']),
+        'spread': json['spread'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['spread']),
+        'spacing': json['spacing'] == null ? undefined : ApiV1IndicatorSummaryFromJSON(json['spacing']),
+    };
+}
+
+export function ApiV1ExperimentGroupSummaryToJSON(json: any): ApiV1ExperimentGroupSummary {
+    return ApiV1ExperimentGroupSummaryToJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentGroupSummaryToJSONTyped(value?: ApiV1ExperimentGroupSummary | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'algorithm': value['algorithm'],
+        'variant': value['variant'],
+        'problem': value['problem'],
+        'completedExecutions': value['completedExecutions'],
+        'hypervolume': ApiV1IndicatorSummaryToJSON(value['hypervolume']),
+        'igd': ApiV1IndicatorSummaryToJSON(value['igd']),
+        'igdPlus': ApiV1IndicatorSummaryToJSON(value['igdPlus']),
+        'gd': ApiV1IndicatorSummaryToJSON(value['gd']),
+        'spread': ApiV1IndicatorSummaryToJSON(value['spread']),
+        'spacing': ApiV1IndicatorSummaryToJSON(value['spacing']),
+    };
+}
+
@@ -0,0 +1,122 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1ExperimentGroupSummary } from './ApiV1ExperimentGroupSummary';
+import {
+    ApiV1ExperimentGroupSummaryFromJSON,
+    ApiV1ExperimentGroupSummaryFromJSONTyped,
+    ApiV1ExperimentGroupSummaryToJSON,
+    ApiV1ExperimentGroupSummaryToJSONTyped,
+} from './ApiV1ExperimentGroupSummary';
+
+/**
+ * ExperimentSummary aggregates the executions of an experiment.
+ * @export
+ * @interface ApiV1ExperimentSummary
+ */
+export interface ApiV1ExperimentSummary {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    totalExecutions?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    pendingExecutions?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    runningExecutions?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    completedExecutions?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    failedExecutions?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1ExperimentSummary
+     */
+    cancelledExecutions?: number;
+    /**
+     * groups summarize the indicators of the completed executions of each
+     * algorithm, variant and problem, only set by Get.
+     * @type {Array<ApiV1ExperimentGroupSummary>}
+     * @memberof ApiV1ExperimentSummary
+     */
+    groups?: Array<ApiV1ExperimentGroupSummary>;
+}
+
+/**
+ * Check if a given object implements the ApiV1ExperimentSummary interface.
+ */
+export function instanceOfApiV1ExperimentSummary(value: object): value is ApiV1ExperimentSummary {
+    return true;
+}
+
+export function ApiV1ExperimentSummaryFromJSON(json: any): ApiV1ExperimentSummary {
+    return ApiV1ExperimentSummaryFromJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentSummaryFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ExperimentSummary {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'totalExecutions': json['totalExecutions'] == null ? undefined : json['totalExecutions'],
+        'pendingExecutions': json['pendingExecutions'] == null ? undefined : json['pendingExecutions'],
+        'runningExecutions': json['runningExecutions'] == null ? undefined : json['runningExecutions'],
+        'completedExecutions': json['completedExecutions'] == null ? undefined : json['completedExecutions'],
+        'failedExecutions': json['failedExecutions'] == null ? undefined : json['failedExecutions'],
+        'cancelledExecutions': json['cancelledExecutions'] == null ? undefined : json['cancelledExecutions'],
+        'groups': json['groups'] == null ? undefined : ((json['groups'] as Array<any>).map(ApiV1ExperimentGroupSummaryFromJSON)),
+    };
+}
+
+export function ApiV1ExperimentSummaryToJSON(json: any): ApiV1ExperimentSummary {
+    return ApiV1ExperimentSummaryToJSONTyped(json, false);
+}
+
+export function ApiV1ExperimentSummaryToJSONTyped(value?: ApiV1ExperimentSummary | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'totalExecutions': value['totalExecutions'],
+        'pendingExecutions': value['pendingExecutions'],
+        'runningExecutions': value['runningExecutions'],
+        'completedExecutions': value['completedExecutions'],
+        'failedExecutions': value['failedExecutions'],
+        'cancelledExecutions': value['cancelledExecutions'],
+        'groups': value['groups'] == null ? undefined : ((value['groups'] as Array<any>).map(ApiV1ExperimentGroupSummaryToJSON)),
+    };
+}
+
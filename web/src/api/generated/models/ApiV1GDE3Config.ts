@@ -13,6 +13,14 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiV1ParameterAdaptation } from './ApiV1ParameterAdaptation';
+import {
+    ApiV1ParameterAdaptationFromJSON,
+    ApiV1ParameterAdaptationFromJSONTyped,
+    ApiV1ParameterAdaptationToJSON,
+    ApiV1ParameterAdaptationToJSONTyped,
+} from './ApiV1ParameterAdaptation';
+
 /**
  * 
  * @export
@@ -37,6 +45,27 @@ export interface ApiV1GDE3Config {
      * @memberof ApiV1GDE3Config
      */
     p?: number;
+    /**
+     * adaptation selects how F and CR evolve during the run. With JADE or
+     * SHADE every trial vector samples its own values and cr and f are the
+     * initial means.
+     * @type {ApiV1ParameterAdaptation}
+     * @memberof ApiV1GDE3Config
+     */
+    adaptation?: ApiV1ParameterAdaptation;
+    /**
+     * learning_rate is JADE's c, the weight given to the latest successful
+     * values when updating the means. Zero uses 0.1.
+     * @type {number}
+     * @memberof ApiV1GDE3Config
+     */
+    learningRate?: number;
+    /**
+     * memory_size is the amount of SHADE success-history entries. Zero uses 5.
+     * @type {string}
+     * @memberof ApiV1GDE3Config
+     */
+    memorySize?: string;
 }
 
 /**
@@ -59,6 +88,9 @@ export function ApiV1GDE3ConfigFromJSONTyped(json: any, ignoreDiscriminator: boo
         'cr': json['cr'] == null ? undefined : json['cr'],
         'f': json['f'] == null ? undefined : json['f'],
         'p': json['p'] == null ? undefined : json['p'],
+        'adaptation': json['adaptation'] == null ? undefined : ApiV1ParameterAdaptationFromJSON(json['adaptation']),
+        'learningRate': json['learningRate'] == null ? undefined : json['learningRate'],
+        'memorySize': json['memorySize'] == null ? undefined : json['memorySize'],
     };
 }
 
@@ -76,6 +108,9 @@ export function ApiV1GDE3ConfigToJSONTyped(value?: ApiV1GDE3Config | null, ignor
         'cr': value['cr'],
         'f': value['f'],
         'p': value['p'],
+        'adaptation': ApiV1ParameterAdaptationToJSON(value['adaptation']),
+        'learningRate': value['learningRate'],
+        'memorySize': value['memorySize'],
     };
 }
 
@@ -33,6 +33,13 @@ export interface ApiV1GetExecutionResultsResponse {
      * @memberof ApiV1GetExecutionResultsResponse
      */
     pareto?: ApiV1Pareto;
+    /**
+     * pareto_archive is the merged external archive of the executions, only
+     * set when the execution kept one.
+     * @type {ApiV1Pareto}
+     * @memberof ApiV1GetExecutionResultsResponse
+     */
+    paretoArchive?: ApiV1Pareto;
 }
 
 /**
@@ -53,6 +60,7 @@ export function ApiV1GetExecutionResultsResponseFromJSONTyped(json: any, ignoreD
     return {
         
         'pareto': json['pareto'] == null ? undefined : ApiV1ParetoFromJSON(json['pareto']),
+        'paretoArchive': json['paretoArchive'] == null ? undefined : ApiV1ParetoFromJSON(json['paretoArchive']),
     };
 }
 
@@ -68,6 +76,7 @@ export function ApiV1GetExecutionResultsResponseToJSONTyped(value?: ApiV1GetExec
     return {
         
         'pareto': ApiV1ParetoToJSON(value['pareto']),
+        'paretoArchive': ApiV1ParetoToJSON(value['paretoArchive']),
     };
 }
 
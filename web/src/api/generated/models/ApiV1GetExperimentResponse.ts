@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Experiment } from './ApiV1Experiment';
+import {
+    ApiV1ExperimentFromJSON,
+    ApiV1ExperimentFromJSONTyped,
+    ApiV1ExperimentToJSON,
+    ApiV1ExperimentToJSONTyped,
+} from './ApiV1Experiment';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1GetExperimentResponse
+ */
+export interface ApiV1GetExperimentResponse {
+    /**
+     * 
+     * @type {ApiV1Experiment}
+     * @memberof ApiV1GetExperimentResponse
+     */
+    experiment?: ApiV1Experiment;
+}
+
+/**
+ * Check if a given object implements the ApiV1GetExperimentResponse interface.
+ */
+export function instanceOfApiV1GetExperimentResponse(value: object): value is ApiV1GetExperimentResponse {
+    return true;
+}
+
+export function ApiV1GetExperimentResponseFromJSON(json: any): ApiV1GetExperimentResponse {
+    return ApiV1GetExperimentResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1GetExperimentResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1GetExperimentResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'experiment': json['experiment'] == null ? undefined : ApiV1ExperimentFromJSON(json['experiment']),
+    };
+}
+
+export function ApiV1GetExperimentResponseToJSON(json: any): ApiV1GetExperimentResponse {
+    return ApiV1GetExperimentResponseToJSONTyped(json, false);
+}
+
+export function ApiV1GetExperimentResponseToJSONTyped(value?: ApiV1GetExperimentResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'experiment': ApiV1ExperimentToJSON(value['experiment']),
+    };
+}
+
@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1GetOIDCConfigResponse
+ */
+export interface ApiV1GetOIDCConfigResponse {
+    /**
+     * enabled is unset when single sign-on is not configured, the other fields are then empty.
+     * @type {boolean}
+     * @memberof ApiV1GetOIDCConfigResponse
+     */
+    enabled?: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1GetOIDCConfigResponse
+     */
+    authorizationEndpoint?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1GetOIDCConfigResponse
+     */
+    clientId?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiV1GetOIDCConfigResponse
+     */
+    scopes?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the ApiV1GetOIDCConfigResponse interface.
+ */
+export function instanceOfApiV1GetOIDCConfigResponse(value: object): value is ApiV1GetOIDCConfigResponse {
+    return true;
+}
+
+export function ApiV1GetOIDCConfigResponseFromJSON(json: any): ApiV1GetOIDCConfigResponse {
+    return ApiV1GetOIDCConfigResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1GetOIDCConfigResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1GetOIDCConfigResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'enabled': json['enabled'] == null ? undefined : json['enabled'],
+        'authorizationEndpoint': json['authorizationEndpoint'] == null ? undefined : json['authorizationEndpoint'],
+        'clientId': json['clientId'] == null ? undefined : json['clientId'],
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
+    };
+}
+
+export function ApiV1GetOIDCConfigResponseToJSON(json: any): ApiV1GetOIDCConfigResponse {
+    return ApiV1GetOIDCConfigResponseToJSONTyped(json, false);
+}
+
+export function ApiV1GetOIDCConfigResponseToJSONTyped(value?: ApiV1GetOIDCConfigResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'enabled': value['enabled'],
+        'authorizationEndpoint': value['authorizationEndpoint'],
+        'clientId': value['clientId'],
+        'scopes': value['scopes'],
+    };
+}
+
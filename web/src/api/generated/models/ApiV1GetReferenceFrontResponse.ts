@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Vector } from './ApiV1Vector';
+import {
+    ApiV1VectorFromJSON,
+    ApiV1VectorFromJSONTyped,
+    ApiV1VectorToJSON,
+    ApiV1VectorToJSONTyped,
+} from './ApiV1Vector';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1GetReferenceFrontResponse
+ */
+export interface ApiV1GetReferenceFrontResponse {
+    /**
+     * 
+     * @type {Array<ApiV1Vector>}
+     * @memberof ApiV1GetReferenceFrontResponse
+     */
+    vectors?: Array<ApiV1Vector>;
+}
+
+/**
+ * Check if a given object implements the ApiV1GetReferenceFrontResponse interface.
+ */
+export function instanceOfApiV1GetReferenceFrontResponse(value: object): value is ApiV1GetReferenceFrontResponse {
+    return true;
+}
+
+export function ApiV1GetReferenceFrontResponseFromJSON(json: any): ApiV1GetReferenceFrontResponse {
+    return ApiV1GetReferenceFrontResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1GetReferenceFrontResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1GetReferenceFrontResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'vectors': json['vectors'] == null ? undefined : ((json['vectors'] as Array<any>).map(ApiV1VectorFromJSON)),
+    };
+}
+
+export function ApiV1GetReferenceFrontResponseToJSON(json: any): ApiV1GetReferenceFrontResponse {
+    return ApiV1GetReferenceFrontResponseToJSONTyped(json, false);
+}
+
+export function ApiV1GetReferenceFrontResponseToJSONTyped(value?: ApiV1GetReferenceFrontResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'vectors': value['vectors'] == null ? undefined : ((value['vectors'] as Array<any>).map(ApiV1VectorToJSON)),
+    };
+}
+
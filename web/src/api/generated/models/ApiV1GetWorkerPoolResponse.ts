@@ -0,0 +1,98 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1GetWorkerPoolResponse
+ */
+export interface ApiV1GetWorkerPoolResponse {
+    /**
+     * worker_id names the server in the jobs it claims.
+     * @type {string}
+     * @memberof ApiV1GetWorkerPoolResponse
+     */
+    workerId?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1GetWorkerPoolResponse
+     */
+    maxWorkers?: number;
+    /**
+     * active_workers are the workers of the server running an execution of any tenant.
+     * @type {number}
+     * @memberof ApiV1GetWorkerPoolResponse
+     */
+    activeWorkers?: number;
+    /**
+     * queued_jobs are the jobs of the tenant waiting to be claimed by any server.
+     * @type {number}
+     * @memberof ApiV1GetWorkerPoolResponse
+     */
+    queuedJobs?: number;
+    /**
+     * claimed_jobs are the jobs of the tenant run by any server.
+     * @type {number}
+     * @memberof ApiV1GetWorkerPoolResponse
+     */
+    claimedJobs?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1GetWorkerPoolResponse interface.
+ */
+export function instanceOfApiV1GetWorkerPoolResponse(value: object): value is ApiV1GetWorkerPoolResponse {
+    return true;
+}
+
+export function ApiV1GetWorkerPoolResponseFromJSON(json: any): ApiV1GetWorkerPoolResponse {
+    return ApiV1GetWorkerPoolResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1GetWorkerPoolResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1GetWorkerPoolResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'workerId': json['workerId'] == null ? undefined : json['workerId'],
+        'maxWorkers': json['maxWorkers'] == null ? undefined : json['maxWorkers'],
+        'activeWorkers': json['activeWorkers'] == null ? undefined : json['activeWorkers'],
+        'queuedJobs': json['queuedJobs'] == null ? undefined : json['queuedJobs'],
+        'claimedJobs': json['claimedJobs'] == null ? undefined : json['claimedJobs'],
+    };
+}
+
+export function ApiV1GetWorkerPoolResponseToJSON(json: any): ApiV1GetWorkerPoolResponse {
+    return ApiV1GetWorkerPoolResponseToJSONTyped(json, false);
+}
+
+export function ApiV1GetWorkerPoolResponseToJSONTyped(value?: ApiV1GetWorkerPoolResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'workerId': value['workerId'],
+        'maxWorkers': value['maxWorkers'],
+        'activeWorkers': value['activeWorkers'],
+        'queuedJobs': value['queuedJobs'],
+        'claimedJobs': value['claimedJobs'],
+    };
+}
+
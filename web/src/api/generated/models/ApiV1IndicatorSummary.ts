@@ -0,0 +1,107 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * IndicatorSummary holds the descriptive statistics of an indicator over
+ * executions.
+ * @export
+ * @interface ApiV1IndicatorSummary
+ */
+export interface ApiV1IndicatorSummary {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    count?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    mean?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    median?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    stdDev?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    min?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1IndicatorSummary
+     */
+    max?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1IndicatorSummary interface.
+ */
+export function instanceOfApiV1IndicatorSummary(value: object): value is ApiV1IndicatorSummary {
+    return true;
+}
+
+export function ApiV1IndicatorSummaryFromJSON(json: any): ApiV1IndicatorSummary {
+    return ApiV1IndicatorSummaryFromJSONTyped(json, false);
+}
+
+export function ApiV1IndicatorSummaryFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1IndicatorSummary {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'count': json['count'] == null ? undefined : json['count'],
+        'mean': json['mean'] == null ? undefined : json['mean'],
+        'median': json['median'] == null ? undefined : json['median'],
+        'stdDev': json['stdDev'] == null ? undefined : json['stdDev'],
+        'min': json['min'] == null ? undefined : json['min'],
+        'max': json['max'] == null ? undefined : json['max'],
+    };
+}
+
+export function ApiV1IndicatorSummaryToJSON(json: any): ApiV1IndicatorSummary {
+    return ApiV1IndicatorSummaryToJSONTyped(json, false);
+}
+
+export function ApiV1IndicatorSummaryToJSONTyped(value?: ApiV1IndicatorSummary | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'count': value['count'],
+        'mean': value['mean'],
+        'median': value['median'],
+        'stdDev': value['stdDev'],
+        'min': value['min'],
+        'max': value['max'],
+    };
+}
+
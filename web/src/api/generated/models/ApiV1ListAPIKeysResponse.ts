@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1APIKey } from './ApiV1APIKey';
+import {
+    ApiV1APIKeyFromJSON,
+    ApiV1APIKeyFromJSONTyped,
+    ApiV1APIKeyToJSON,
+    ApiV1APIKeyToJSONTyped,
+} from './ApiV1APIKey';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1ListAPIKeysResponse
+ */
+export interface ApiV1ListAPIKeysResponse {
+    /**
+     * 
+     * @type {Array<ApiV1APIKey>}
+     * @memberof ApiV1ListAPIKeysResponse
+     */
+    apiKeys?: Array<ApiV1APIKey>;
+}
+
+/**
+ * Check if a given object implements the ApiV1ListAPIKeysResponse interface.
+ */
+export function instanceOfApiV1ListAPIKeysResponse(value: object): value is ApiV1ListAPIKeysResponse {
+    return true;
+}
+
+export function ApiV1ListAPIKeysResponseFromJSON(json: any): ApiV1ListAPIKeysResponse {
+    return ApiV1ListAPIKeysResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1ListAPIKeysResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ListAPIKeysResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'apiKeys': json['apiKeys'] == null ? undefined : ((json['apiKeys'] as Array<any>).map(ApiV1APIKeyFromJSON)),
+    };
+}
+
+export function ApiV1ListAPIKeysResponseToJSON(json: any): ApiV1ListAPIKeysResponse {
+    return ApiV1ListAPIKeysResponseToJSONTyped(json, false);
+}
+
+export function ApiV1ListAPIKeysResponseToJSONTyped(value?: ApiV1ListAPIKeysResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'apiKeys': value['apiKeys'] == null ? undefined : ((value['apiKeys'] as Array<any>).map(ApiV1APIKeyToJSON)),
+    };
+}
+
@@ -0,0 +1,105 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Experiment } from './ApiV1Experiment';
+import {
+    ApiV1ExperimentFromJSON,
+    ApiV1ExperimentFromJSONTyped,
+    ApiV1ExperimentToJSON,
+    ApiV1ExperimentToJSONTyped,
+} from './ApiV1Experiment';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1ListExperimentsResponse
+ */
+export interface ApiV1ListExperimentsResponse {
+    /**
+     * 
+     * @type {Array<ApiV1Experiment>}
+     * @memberof ApiV1ListExperimentsResponse
+     */
+    experiments?: Array<ApiV1Experiment>;
+    /**
+     * Total number of experiments of the user
+     * @type {number}
+     * @memberof ApiV1ListExperimentsResponse
+     */
+    totalCount?: number;
+    /**
+     * Echoed limit for pagination
+     * @type {number}
+     * @memberof ApiV1ListExperimentsResponse
+     */
+    limit?: number;
+    /**
+     * Echoed offset for pagination
+     * @type {number}
+     * @memberof ApiV1ListExperimentsResponse
+     */
+    offset?: number;
+    /**
+     * True if more results available
+     * @type {boolean}
+     * @memberof ApiV1ListExperimentsResponse
+     */
+    hasMore?: boolean;
+}
+
+/**
+ * Check if a given object implements the ApiV1ListExperimentsResponse interface.
+ */
+export function instanceOfApiV1ListExperimentsResponse(value: object): value is ApiV1ListExperimentsResponse {
+    return true;
+}
+
+export function ApiV1ListExperimentsResponseFromJSON(json: any): ApiV1ListExperimentsResponse {
+    return ApiV1ListExperimentsResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1ListExperimentsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ListExperimentsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'experiments': json['experiments'] == null ? undefined : ((json['experiments'] as Array<any>).map(ApiV1ExperimentFromJSON)),
+        'totalCount': json['totalCount'] == null ? undefined : json['totalCount'],
+        'limit': json['limit'] == null ? undefined : json['limit'],
+        'offset': json['offset'] == null ? undefined : json['offset'],
+        'hasMore': json['hasMore'] == null ? undefined : json['hasMore'],
+    };
+}
+
+export function ApiV1ListExperimentsResponseToJSON(json: any): ApiV1ListExperimentsResponse {
+    return ApiV1ListExperimentsResponseToJSONTyped(json, false);
+}
+
+export function ApiV1ListExperimentsResponseToJSONTyped(value?: ApiV1ListExperimentsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'experiments': value['experiments'] == null ? undefined : ((value['experiments'] as Array<any>).map(ApiV1ExperimentToJSON)),
+        'totalCount': value['totalCount'],
+        'limit': value['limit'],
+        'offset': value['offset'],
+        'hasMore': value['hasMore'],
+    };
+}
+
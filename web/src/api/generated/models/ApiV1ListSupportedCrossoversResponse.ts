@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Crossover } from './ApiV1Crossover';
+import {
+    ApiV1CrossoverFromJSON,
+    ApiV1CrossoverFromJSONTyped,
+    ApiV1CrossoverToJSON,
+    ApiV1CrossoverToJSONTyped,
+} from './ApiV1Crossover';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1ListSupportedCrossoversResponse
+ */
+export interface ApiV1ListSupportedCrossoversResponse {
+    /**
+     * 
+     * @type {Array<ApiV1Crossover>}
+     * @memberof ApiV1ListSupportedCrossoversResponse
+     */
+    crossovers?: Array<ApiV1Crossover>;
+}
+
+/**
+ * Check if a given object implements the ApiV1ListSupportedCrossoversResponse interface.
+ */
+export function instanceOfApiV1ListSupportedCrossoversResponse(value: object): value is ApiV1ListSupportedCrossoversResponse {
+    return true;
+}
+
+export function ApiV1ListSupportedCrossoversResponseFromJSON(json: any): ApiV1ListSupportedCrossoversResponse {
+    return ApiV1ListSupportedCrossoversResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1ListSupportedCrossoversResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ListSupportedCrossoversResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'crossovers': json['crossovers'] == null ? undefined : ((json['crossovers'] as Array<any>).map(ApiV1CrossoverFromJSON)),
+    };
+}
+
+export function ApiV1ListSupportedCrossoversResponseToJSON(json: any): ApiV1ListSupportedCrossoversResponse {
+    return ApiV1ListSupportedCrossoversResponseToJSONTyped(json, false);
+}
+
+export function ApiV1ListSupportedCrossoversResponseToJSONTyped(value?: ApiV1ListSupportedCrossoversResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'crossovers': value['crossovers'] == null ? undefined : ((value['crossovers'] as Array<any>).map(ApiV1CrossoverToJSON)),
+    };
+}
+
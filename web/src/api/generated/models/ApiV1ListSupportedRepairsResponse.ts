@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Repair } from './ApiV1Repair';
+import {
+    ApiV1RepairFromJSON,
+    ApiV1RepairFromJSONTyped,
+    ApiV1RepairToJSON,
+    ApiV1RepairToJSONTyped,
+} from './ApiV1Repair';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1ListSupportedRepairsResponse
+ */
+export interface ApiV1ListSupportedRepairsResponse {
+    /**
+     * 
+     * @type {Array<ApiV1Repair>}
+     * @memberof ApiV1ListSupportedRepairsResponse
+     */
+    repairs?: Array<ApiV1Repair>;
+}
+
+/**
+ * Check if a given object implements the ApiV1ListSupportedRepairsResponse interface.
+ */
+export function instanceOfApiV1ListSupportedRepairsResponse(value: object): value is ApiV1ListSupportedRepairsResponse {
+    return true;
+}
+
+export function ApiV1ListSupportedRepairsResponseFromJSON(json: any): ApiV1ListSupportedRepairsResponse {
+    return ApiV1ListSupportedRepairsResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1ListSupportedRepairsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ListSupportedRepairsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'repairs': json['repairs'] == null ? undefined : ((json['repairs'] as Array<any>).map(ApiV1RepairFromJSON)),
+    };
+}
+
+export function ApiV1ListSupportedRepairsResponseToJSON(json: any): ApiV1ListSupportedRepairsResponse {
+    return ApiV1ListSupportedRepairsResponseToJSONTyped(json, false);
+}
+
+export function ApiV1ListSupportedRepairsResponseToJSONTyped(value?: ApiV1ListSupportedRepairsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'repairs': value['repairs'] == null ? undefined : ((value['repairs'] as Array<any>).map(ApiV1RepairToJSON)),
+    };
+}
+
@@ -0,0 +1,133 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1Decomposition } from './ApiV1Decomposition';
+import {
+    ApiV1DecompositionFromJSON,
+    ApiV1DecompositionFromJSONTyped,
+    ApiV1DecompositionToJSON,
+    ApiV1DecompositionToJSONTyped,
+} from './ApiV1Decomposition';
+
+/**
+ * MOEADConfig configures MOEA/D with DE operators. The problem is decomposed
+ * into one scalar subproblem per individual, each with its own weight vector.
+ * @export
+ * @interface ApiV1MOEADConfig
+ */
+export interface ApiV1MOEADConfig {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1MOEADConfig
+     */
+    cr?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1MOEADConfig
+     */
+    f?: number;
+    /**
+     * p is the fraction of the neighbourhood used by pbest variants.
+     * @type {number}
+     * @memberof ApiV1MOEADConfig
+     */
+    p?: number;
+    /**
+     * neighborhood_size is the amount of closest weight vectors mating and
+     * replacement are restricted to. Zero uses 20, capped to the population.
+     * @type {string}
+     * @memberof ApiV1MOEADConfig
+     */
+    neighborhoodSize?: string;
+    /**
+     * 
+     * @type {ApiV1Decomposition}
+     * @memberof ApiV1MOEADConfig
+     */
+    decomposition?: ApiV1Decomposition;
+    /**
+     * theta is the penalty of the PBI decomposition. Zero uses 5.
+     * @type {number}
+     * @memberof ApiV1MOEADConfig
+     */
+    theta?: number;
+    /**
+     * delta is the probability of mating within the neighbourhood instead of
+     * the whole population. Zero uses 0.9.
+     * @type {number}
+     * @memberof ApiV1MOEADConfig
+     */
+    delta?: number;
+    /**
+     * max_replacements bounds how many subproblems a single offspring may
+     * take over. Zero uses 2.
+     * @type {string}
+     * @memberof ApiV1MOEADConfig
+     */
+    maxReplacements?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1MOEADConfig interface.
+ */
+export function instanceOfApiV1MOEADConfig(value: object): value is ApiV1MOEADConfig {
+    return true;
+}
+
+export function ApiV1MOEADConfigFromJSON(json: any): ApiV1MOEADConfig {
+    return ApiV1MOEADConfigFromJSONTyped(json, false);
+}
+
+export function ApiV1MOEADConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1MOEADConfig {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'cr': json['cr'] == null ? undefined : json['cr'],
+        'f': json['f'] == null ? undefined : json['f'],
+        'p': json['p'] == null ? undefined : json['p'],
+        'neighborhoodSize': json['neighborhoodSize'] == null ? undefined : json['neighborhoodSize'],
+        'decomposition': json['decomposition'] == null ? undefined : ApiV1DecompositionFromJSON(json['decomposition']),
+        'theta': json['theta'] == null ? undefined : json['theta'],
+        'delta': json['delta'] == null ? undefined : json['delta'],
+        'maxReplacements': json['maxReplacements'] == null ? undefined : json['maxReplacements'],
+    };
+}
+
+export function ApiV1MOEADConfigToJSON(json: any): ApiV1MOEADConfig {
+    return ApiV1MOEADConfigToJSONTyped(json, false);
+}
+
+export function ApiV1MOEADConfigToJSONTyped(value?: ApiV1MOEADConfig | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'cr': value['cr'],
+        'f': value['f'],
+        'p': value['p'],
+        'neighborhoodSize': value['neighborhoodSize'],
+        'decomposition': ApiV1DecompositionToJSON(value['decomposition']),
+        'theta': value['theta'],
+        'delta': value['delta'],
+        'maxReplacements': value['maxReplacements'],
+    };
+}
+
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * NSGA2Config configures the NSGA-II style DE, where offspring are merged
+ * with their parents and survivors are picked by non-dominated rank and
+ * crowding distance.
+ * @export
+ * @interface ApiV1NSGA2Config
+ */
+export interface ApiV1NSGA2Config {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1NSGA2Config
+     */
+    cr?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1NSGA2Config
+     */
+    f?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1NSGA2Config
+     */
+    p?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1NSGA2Config interface.
+ */
+export function instanceOfApiV1NSGA2Config(value: object): value is ApiV1NSGA2Config {
+    return true;
+}
+
+export function ApiV1NSGA2ConfigFromJSON(json: any): ApiV1NSGA2Config {
+    return ApiV1NSGA2ConfigFromJSONTyped(json, false);
+}
+
+export function ApiV1NSGA2ConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1NSGA2Config {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'cr': json['cr'] == null ? undefined : json['cr'],
+        'f': json['f'] == null ? undefined : json['f'],
+        'p': json['p'] == null ? undefined : json['p'],
+    };
+}
+
+export function ApiV1NSGA2ConfigToJSON(json: any): ApiV1NSGA2Config {
+    return ApiV1NSGA2ConfigToJSONTyped(json, false);
+}
+
+export function ApiV1NSGA2ConfigToJSONTyped(value?: ApiV1NSGA2Config | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'cr': value['cr'],
+        'f': value['f'],
+        'p': value['p'],
+    };
+}
+
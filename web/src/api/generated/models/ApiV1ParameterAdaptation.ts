@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * ParameterAdaptation is the control parameter scheme used by GDE3.
+ * @export
+ */
+export const ApiV1ParameterAdaptation = {
+    ParameterAdaptationUnspecified: 'PARAMETER_ADAPTATION_UNSPECIFIED',
+    ParameterAdaptationFixed: 'PARAMETER_ADAPTATION_FIXED',
+    ParameterAdaptationJade: 'PARAMETER_ADAPTATION_JADE',
+    ParameterAdaptationShade: 'PARAMETER_ADAPTATION_SHADE'
+} as const;
+export type ApiV1ParameterAdaptation = typeof ApiV1ParameterAdaptation[keyof typeof ApiV1ParameterAdaptation];
+
+
+export function instanceOfApiV1ParameterAdaptation(value: any): boolean {
+    for (const key in ApiV1ParameterAdaptation) {
+        if (Object.prototype.hasOwnProperty.call(ApiV1ParameterAdaptation, key)) {
+            if (ApiV1ParameterAdaptation[key as keyof typeof ApiV1ParameterAdaptation] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function ApiV1ParameterAdaptationFromJSON(json: any): ApiV1ParameterAdaptation {
+    return ApiV1ParameterAdaptationFromJSONTyped(json, false);
+}
+
+export function ApiV1ParameterAdaptationFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ParameterAdaptation {
+    return json as ApiV1ParameterAdaptation;
+}
+
+export function ApiV1ParameterAdaptationToJSON(value?: ApiV1ParameterAdaptation | null): any {
+    return value as any;
+}
+
+export function ApiV1ParameterAdaptationToJSONTyped(value: any, ignoreDiscriminator: boolean): ApiV1ParameterAdaptation {
+    return value as ApiV1ParameterAdaptation;
+}
+
@@ -0,0 +1,77 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * ParetoArchiveConfig configures the external archive of non-dominated
+ * solutions, by default it is unbounded.
+ * @export
+ * @interface ApiV1ParetoArchiveConfig
+ */
+export interface ApiV1ParetoArchiveConfig {
+    /**
+     * max_size bounds the archive by dropping its most crowded solutions once
+     * exceeded, zero keeps it unbounded.
+     * @type {string}
+     * @memberof ApiV1ParetoArchiveConfig
+     */
+    maxSize?: string;
+    /**
+     * epsilon compares solutions with additive epsilon-dominance, keeping at
+     * most one solution per box of side epsilon in objective space. Zero uses
+     * plain dominance.
+     * @type {number}
+     * @memberof ApiV1ParetoArchiveConfig
+     */
+    epsilon?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1ParetoArchiveConfig interface.
+ */
+export function instanceOfApiV1ParetoArchiveConfig(value: object): value is ApiV1ParetoArchiveConfig {
+    return true;
+}
+
+export function ApiV1ParetoArchiveConfigFromJSON(json: any): ApiV1ParetoArchiveConfig {
+    return ApiV1ParetoArchiveConfigFromJSONTyped(json, false);
+}
+
+export function ApiV1ParetoArchiveConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1ParetoArchiveConfig {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'maxSize': json['maxSize'] == null ? undefined : json['maxSize'],
+        'epsilon': json['epsilon'] == null ? undefined : json['epsilon'],
+    };
+}
+
+export function ApiV1ParetoArchiveConfigToJSON(json: any): ApiV1ParetoArchiveConfig {
+    return ApiV1ParetoArchiveConfigToJSONTyped(json, false);
+}
+
+export function ApiV1ParetoArchiveConfigToJSONTyped(value?: ApiV1ParetoArchiveConfig | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'maxSize': value['maxSize'],
+        'epsilon': value['epsilon'],
+    };
+}
+
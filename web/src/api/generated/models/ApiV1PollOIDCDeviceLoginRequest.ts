@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1PollOIDCDeviceLoginRequest
+ */
+export interface ApiV1PollOIDCDeviceLoginRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1PollOIDCDeviceLoginRequest
+     */
+    deviceCode?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1PollOIDCDeviceLoginRequest interface.
+ */
+export function instanceOfApiV1PollOIDCDeviceLoginRequest(value: object): value is ApiV1PollOIDCDeviceLoginRequest {
+    return true;
+}
+
+export function ApiV1PollOIDCDeviceLoginRequestFromJSON(json: any): ApiV1PollOIDCDeviceLoginRequest {
+    return ApiV1PollOIDCDeviceLoginRequestFromJSONTyped(json, false);
+}
+
+export function ApiV1PollOIDCDeviceLoginRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1PollOIDCDeviceLoginRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'deviceCode': json['deviceCode'] == null ? undefined : json['deviceCode'],
+    };
+}
+
+export function ApiV1PollOIDCDeviceLoginRequestToJSON(json: any): ApiV1PollOIDCDeviceLoginRequest {
+    return ApiV1PollOIDCDeviceLoginRequestToJSONTyped(json, false);
+}
+
+export function ApiV1PollOIDCDeviceLoginRequestToJSONTyped(value?: ApiV1PollOIDCDeviceLoginRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'deviceCode': value['deviceCode'],
+    };
+}
+
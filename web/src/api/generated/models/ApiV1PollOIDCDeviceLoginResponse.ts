@@ -0,0 +1,89 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ApiV1AuthServiceLoginResponse } from './ApiV1AuthServiceLoginResponse';
+import {
+    ApiV1AuthServiceLoginResponseFromJSON,
+    ApiV1AuthServiceLoginResponseFromJSONTyped,
+    ApiV1AuthServiceLoginResponseToJSON,
+    ApiV1AuthServiceLoginResponseToJSONTyped,
+} from './ApiV1AuthServiceLoginResponse';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1PollOIDCDeviceLoginResponse
+ */
+export interface ApiV1PollOIDCDeviceLoginResponse {
+    /**
+     * pending is set until the user completes the login.
+     * @type {boolean}
+     * @memberof ApiV1PollOIDCDeviceLoginResponse
+     */
+    pending?: boolean;
+    /**
+     * slow_down asks the client to wait 5 more seconds between polls from then on.
+     * @type {boolean}
+     * @memberof ApiV1PollOIDCDeviceLoginResponse
+     */
+    slowDown?: boolean;
+    /**
+     * login holds the tokens once the login is complete.
+     * @type {ApiV1AuthServiceLoginResponse}
+     * @memberof ApiV1PollOIDCDeviceLoginResponse
+     */
+    login?: ApiV1AuthServiceLoginResponse;
+}
+
+/**
+ * Check if a given object implements the ApiV1PollOIDCDeviceLoginResponse interface.
+ */
+export function instanceOfApiV1PollOIDCDeviceLoginResponse(value: object): value is ApiV1PollOIDCDeviceLoginResponse {
+    return true;
+}
+
+export function ApiV1PollOIDCDeviceLoginResponseFromJSON(json: any): ApiV1PollOIDCDeviceLoginResponse {
+    return ApiV1PollOIDCDeviceLoginResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1PollOIDCDeviceLoginResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1PollOIDCDeviceLoginResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'pending': json['pending'] == null ? undefined : json['pending'],
+        'slowDown': json['slowDown'] == null ? undefined : json['slowDown'],
+        'login': json['login'] == null ? undefined : ApiV1AuthServiceLoginResponseFromJSON(json['login']),
+    };
+}
+
+export function ApiV1PollOIDCDeviceLoginResponseToJSON(json: any): ApiV1PollOIDCDeviceLoginResponse {
+    return ApiV1PollOIDCDeviceLoginResponseToJSONTyped(json, false);
+}
+
+export function ApiV1PollOIDCDeviceLoginResponseToJSONTyped(value?: ApiV1PollOIDCDeviceLoginResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'pending': value['pending'],
+        'slowDown': value['slowDown'],
+        'login': ApiV1AuthServiceLoginResponseToJSON(value['login']),
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiV1CustomProblem } from './ApiV1CustomProblem';
+import {
+    ApiV1CustomProblemFromJSON,
+    ApiV1CustomProblemFromJSONTyped,
+    ApiV1CustomProblemToJSON,
+    ApiV1CustomProblemToJSONTyped,
+} from './ApiV1CustomProblem';
 /**
  * 
  * @export
@@ -31,6 +38,13 @@ export interface ApiV1Problem {
      * @memberof ApiV1Problem
      */
     description?: string;
+    /**
+     * custom_problem is the definition of a custom problem saved by the user,
+     * unset for built-in problems.
+     * @type {ApiV1CustomProblem}
+     * @memberof ApiV1Problem
+     */
+    customProblem?: ApiV1CustomProblem;
 }
 
 /**
@@ -52,6 +66,7 @@ export function ApiV1ProblemFromJSONTyped(json: any, ignoreDiscriminator: boolea
         
         'name': json['name'] == null ? undefined : json['name'],
         'description': json['description'] == null ? undefined : json['description'],
+        'customProblem': json['customProblem'] == null ? undefined : ApiV1CustomProblemFromJSON(json['customProblem']),
     };
 }
 
@@ -68,6 +83,7 @@ export function ApiV1ProblemToJSONTyped(value?: ApiV1Problem | null, ignoreDiscr
         
         'name': value['name'],
         'description': value['description'],
+        'customProblem': ApiV1CustomProblemToJSON(value['customProblem']),
     };
 }
 
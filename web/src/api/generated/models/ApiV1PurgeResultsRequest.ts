@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1PurgeResultsRequest
+ */
+export interface ApiV1PurgeResultsRequest {
+    /**
+     * older_than is the minimum age of the executions purged.
+     * @type {string}
+     * @memberof ApiV1PurgeResultsRequest
+     */
+    olderThan?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1PurgeResultsRequest interface.
+ */
+export function instanceOfApiV1PurgeResultsRequest(value: object): value is ApiV1PurgeResultsRequest {
+    return true;
+}
+
+export function ApiV1PurgeResultsRequestFromJSON(json: any): ApiV1PurgeResultsRequest {
+    return ApiV1PurgeResultsRequestFromJSONTyped(json, false);
+}
+
+export function ApiV1PurgeResultsRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1PurgeResultsRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'olderThan': json['olderThan'] == null ? undefined : json['olderThan'],
+    };
+}
+
+export function ApiV1PurgeResultsRequestToJSON(json: any): ApiV1PurgeResultsRequest {
+    return ApiV1PurgeResultsRequestToJSONTyped(json, false);
+}
+
+export function ApiV1PurgeResultsRequestToJSONTyped(value?: ApiV1PurgeResultsRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'olderThan': value['olderThan'],
+    };
+}
+
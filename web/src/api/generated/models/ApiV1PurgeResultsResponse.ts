@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1PurgeResultsResponse
+ */
+export interface ApiV1PurgeResultsResponse {
+    /**
+     * 
+     * @type {number}
+     * @memberof ApiV1PurgeResultsResponse
+     */
+    purgedExecutions?: number;
+}
+
+/**
+ * Check if a given object implements the ApiV1PurgeResultsResponse interface.
+ */
+export function instanceOfApiV1PurgeResultsResponse(value: object): value is ApiV1PurgeResultsResponse {
+    return true;
+}
+
+export function ApiV1PurgeResultsResponseFromJSON(json: any): ApiV1PurgeResultsResponse {
+    return ApiV1PurgeResultsResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1PurgeResultsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1PurgeResultsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'purgedExecutions': json['purgedExecutions'] == null ? undefined : json['purgedExecutions'],
+    };
+}
+
+export function ApiV1PurgeResultsResponseToJSON(json: any): ApiV1PurgeResultsResponse {
+    return ApiV1PurgeResultsResponseToJSONTyped(json, false);
+}
+
+export function ApiV1PurgeResultsResponseToJSONTyped(value?: ApiV1PurgeResultsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'purgedExecutions': value['purgedExecutions'],
+    };
+}
+
@@ -0,0 +1,73 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ApiV1Repair
+ */
+export interface ApiV1Repair {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Repair
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1Repair
+     */
+    description?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1Repair interface.
+ */
+export function instanceOfApiV1Repair(value: object): value is ApiV1Repair {
+    return true;
+}
+
+export function ApiV1RepairFromJSON(json: any): ApiV1Repair {
+    return ApiV1RepairFromJSONTyped(json, false);
+}
+
+export function ApiV1RepairFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1Repair {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+    };
+}
+
+export function ApiV1RepairToJSON(json: any): ApiV1Repair {
+    return ApiV1RepairToJSONTyped(json, false);
+}
+
+export function ApiV1RepairToJSONTyped(value?: ApiV1Repair | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiV1CustomProblem } from './ApiV1CustomProblem';
+import {
+    ApiV1CustomProblemFromJSON,
+    ApiV1CustomProblemFromJSONTyped,
+    ApiV1CustomProblemToJSON,
+    ApiV1CustomProblemToJSONTyped,
+} from './ApiV1CustomProblem';
 import type { ApiV1DEConfig } from './ApiV1DEConfig';
 import {
     ApiV1DEConfigFromJSON,
@@ -51,6 +58,26 @@ export interface ApiV1RunAsyncRequest {
      * @memberof ApiV1RunAsyncRequest
      */
     deConfig?: ApiV1DEConfig;
+    /**
+     * custom_problem runs a problem defined by expressions instead of a
+     * registered one, problem must then be empty or its name.
+     * @type {ApiV1CustomProblem}
+     * @memberof ApiV1RunAsyncRequest
+     */
+    customProblem?: ApiV1CustomProblem;
+    /**
+     * save_custom_problem keeps custom_problem so it can be run again by
+     * name, replacing the saved problem of the user with the same name.
+     * @type {boolean}
+     * @memberof ApiV1RunAsyncRequest
+     */
+    saveCustomProblem?: boolean;
+    /**
+     * experiment_id adds the execution to an experiment of the user.
+     * @type {string}
+     * @memberof ApiV1RunAsyncRequest
+     */
+    experimentId?: string;
 }
 
 /**
@@ -74,6 +101,9 @@ export function ApiV1RunAsyncRequestFromJSONTyped(json: any, ignoreDiscriminator
         'variant': json['variant'] == null ? undefined : json['variant'],
         'problem': json['problem'] == null ? undefined : json['problem'],
         'deConfig': json['deConfig'] == null ? undefined : ApiV1DEConfigFromJSON(json['deConfig']),
+        'customProblem': json['customProblem'] == null ? undefined : ApiV1CustomProblemFromJSON(json['customProblem']),
+        'saveCustomProblem': json['saveCustomProblem'] == null ? undefined : json['saveCustomProblem'],
+        'experimentId': json['experimentId'] == null ? undefined : json['experimentId'],
     };
 }
 
@@ -92,6 +122,9 @@ export function ApiV1RunAsyncRequestToJSONTyped(value?: ApiV1RunAsyncRequest | n
         'variant': value['variant'],
         'problem': value['problem'],
         'deConfig': ApiV1DEConfigToJSON(value['deConfig']),
+        'customProblem': ApiV1CustomProblemToJSON(value['customProblem']),
+        'saveCustomProblem': value['saveCustomProblem'],
+        'experimentId': value['experimentId'],
     };
 }
 
@@ -0,0 +1,106 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * api/v1/user.proto
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: version not set
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+
+/**
+ * 
+ * @export
+ * @interface ApiV1StartOIDCDeviceLoginResponse
+ */
+export interface ApiV1StartOIDCDeviceLoginResponse {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    deviceCode?: string;
+    /**
+     * user_code is entered by the user at verification_uri.
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    userCode?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    verificationUri?: string;
+    /**
+     * verification_uri_complete includes the user code, when the provider supports it.
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    verificationUriComplete?: string;
+    /**
+     * expires_in is the lifetime of the device code in seconds.
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    expiresIn?: string;
+    /**
+     * interval is the minimum number of seconds between polls.
+     * @type {string}
+     * @memberof ApiV1StartOIDCDeviceLoginResponse
+     */
+    interval?: string;
+}
+
+/**
+ * Check if a given object implements the ApiV1StartOIDCDeviceLoginResponse interface.
+ */
+export function instanceOfApiV1StartOIDCDeviceLoginResponse(value: object): value is ApiV1StartOIDCDeviceLoginResponse {
+    return true;
+}
+
+export function ApiV1StartOIDCDeviceLoginResponseFromJSON(json: any): ApiV1StartOIDCDeviceLoginResponse {
+    return ApiV1StartOIDCDeviceLoginResponseFromJSONTyped(json, false);
+}
+
+export function ApiV1StartOIDCDeviceLoginResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApiV1StartOIDCDeviceLoginResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'deviceCode': json['deviceCode'] == null ? undefined : json['deviceCode'],
+        'userCode': json['userCode'] == null ? undefined : json['userCode'],
+        'verificationUri': json['verificationUri'] == null ? undefined : json['verificationUri'],
+        'verificationUriComplete': json['verificationUriComplete'] == null ? undefined : json['verificationUriComplete'],
+        'expiresIn': json['expiresIn'] == null ? undefined : json['expiresIn'],
+        'interval': json['interval'] == null ? undefined : json['interval'],
+    };
+}
+
+export function ApiV1StartOIDCDeviceLoginResponseToJSON(json: any): ApiV1StartOIDCDeviceLoginResponse {
+    return ApiV1StartOIDCDeviceLoginResponseToJSONTyped(json, false);
+}
+
+export function ApiV1StartOIDCDeviceLoginResponseToJSONTyped(value?: ApiV1StartOIDCDeviceLoginResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'deviceCode': value['deviceCode'],
+        'userCode': value['userCode'],
+        'verificationUri': value['verificationUri'],
+        'verificationUriComplete': value['verificationUriComplete'],
+        'expiresIn': value['expiresIn'],
+        'interval': value['interval'],
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ApiV1ControlParameters } from './ApiV1ControlParameters';
+import {
+    ApiV1ControlParametersFromJSON,
+    ApiV1ControlParametersFromJSONTyped,
+    ApiV1ControlParametersToJSON,
+    ApiV1ControlParametersToJSONTyped,
+} from './ApiV1ControlParameters';
 import type { ApiV1Vector } from './ApiV1Vector';
 import {
     ApiV1VectorFromJSON,
@@ -69,6 +76,12 @@ export interface ApiV1StreamProgressResponse {
      * @memberof ApiV1StreamProgressResponse
      */
     updatedAt?: Date;
+    /**
+     * control_parameters is only set for executions with adaptive F and CR.
+     * @type {ApiV1ControlParameters}
+     * @memberof ApiV1StreamProgressResponse
+     */
+    controlParameters?: ApiV1ControlParameters;
 }
 
 /**
@@ -95,6 +108,7 @@ export function ApiV1StreamProgressResponseFromJSONTyped(json: any, ignoreDiscri
         'totalExecutions': json['totalExecutions'] == null ? undefined : json['totalExecutions'],
         'partialPareto': json['partialPareto'] == null ? undefined : ((json['partialPareto'] as Array<any>).map(ApiV1VectorFromJSON)),
         'updatedAt': json['updatedAt'] == null ? undefined : (new Date(json['updatedAt'])),
+        'controlParameters': json['controlParameters'] == null ? undefined : ApiV1ControlParametersFromJSON(json['controlParameters']),
     };
 }
 
@@ -116,6 +130,7 @@ export function ApiV1StreamProgressResponseToJSONTyped(value?: ApiV1StreamProgre
         'totalExecutions': value['totalExecutions'],
         'partialPareto': value['partialPareto'] == null ? undefined : ((value['partialPareto'] as Array<any>).map(ApiV1VectorToJSON)),
         'updatedAt': value['updatedAt'] == null ? value['updatedAt'] : value['updatedAt'].toISOString(),
+        'controlParameters': ApiV1ControlParametersToJSON(value['controlParameters']),
     };
 }
 
@@ -45,6 +45,12 @@ export interface ApiV1User {
      * @memberof ApiV1User
      */
     password?: string;
+    /**
+     * scopes granted to the user, empty for the default scopes. Only administrators set them.
+     * @type {Array<string>}
+     * @memberof ApiV1User
+     */
+    scopes?: Array<string>;
 }
 
 /**
@@ -67,6 +73,7 @@ export function ApiV1UserFromJSONTyped(json: any, ignoreDiscriminator: boolean):
         'ids': json['ids'] == null ? undefined : ApiV1UserIDsFromJSON(json['ids']),
         'email': json['email'] == null ? undefined : json['email'],
         'password': json['password'] == null ? undefined : json['password'],
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
     };
 }
 
@@ -84,6 +91,7 @@ export function ApiV1UserToJSONTyped(value?: ApiV1User | null, ignoreDiscriminat
         'ids': ApiV1UserIDsToJSON(value['ids']),
         'email': value['email'],
         'password': value['password'],
+        'scopes': value['scopes'],
     };
 }
 
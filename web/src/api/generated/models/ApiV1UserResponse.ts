@@ -39,6 +39,12 @@ export interface ApiV1UserResponse {
      * @memberof ApiV1UserResponse
      */
     email?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof ApiV1UserResponse
+     */
+    scopes?: Array<string>;
 }
 
 /**
@@ -60,6 +66,7 @@ export function ApiV1UserResponseFromJSONTyped(json: any, ignoreDiscriminator: b
         
         'ids': json['ids'] == null ? undefined : ApiV1UserIDsFromJSON(json['ids']),
         'email': json['email'] == null ? undefined : json['email'],
+        'scopes': json['scopes'] == null ? undefined : json['scopes'],
     };
 }
 
@@ -76,6 +83,7 @@ export function ApiV1UserResponseToJSONTyped(value?: ApiV1UserResponse | null, i
         
         'ids': ApiV1UserIDsToJSON(value['ids']),
         'email': value['email'],
+        'scopes': value['scopes'],
     };
 }
 
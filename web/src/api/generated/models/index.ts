@@ -1,28 +1,66 @@
 /* tslint:disable */
 /* eslint-disable */
+export * from './ApiV1APIKey';
+export * from './ApiV1AdminListExecutionsResponse';
+export * from './ApiV1AdminListUsersResponse';
+export * from './ApiV1AdminServiceUpdateUserScopesBody';
+export * from './ApiV1AdminUpdateUserScopesResponse';
 export * from './ApiV1AuthServiceLoginRequest';
 export * from './ApiV1AuthServiceLoginResponse';
 export * from './ApiV1AuthServiceLogoutRequest';
 export * from './ApiV1AuthServiceRefreshTokenRequest';
 export * from './ApiV1AuthServiceRefreshTokenResponse';
 export * from './ApiV1AuthServiceRegisterRequest';
+export * from './ApiV1Bounds';
+export * from './ApiV1ControlParameters';
+export * from './ApiV1CreateAPIKeyRequest';
+export * from './ApiV1CreateAPIKeyResponse';
+export * from './ApiV1CreateExperimentRequest';
+export * from './ApiV1CreateExperimentResponse';
+export * from './ApiV1Crossover';
+export * from './ApiV1CustomProblem';
 export * from './ApiV1DEConfig';
+export * from './ApiV1Decomposition';
+export * from './ApiV1DifferentialEvolutionServiceResumeExecutionBody';
+export * from './ApiV1ExchangeOIDCCodeRequest';
 export * from './ApiV1Execution';
 export * from './ApiV1ExecutionStatus';
+export * from './ApiV1Experiment';
+export * from './ApiV1ExperimentGroupSummary';
+export * from './ApiV1ExperimentSummary';
 export * from './ApiV1GDE3Config';
 export * from './ApiV1GetExecutionResultsResponse';
 export * from './ApiV1GetExecutionStatusResponse';
+export * from './ApiV1GetExperimentResponse';
+export * from './ApiV1GetOIDCConfigResponse';
+export * from './ApiV1GetReferenceFrontResponse';
+export * from './ApiV1GetWorkerPoolResponse';
+export * from './ApiV1IndicatorSummary';
+export * from './ApiV1ListAPIKeysResponse';
 export * from './ApiV1ListExecutionsResponse';
+export * from './ApiV1ListExperimentsResponse';
 export * from './ApiV1ListSupportedAlgorithmsResponse';
+export * from './ApiV1ListSupportedCrossoversResponse';
 export * from './ApiV1ListSupportedProblemsResponse';
+export * from './ApiV1ListSupportedRepairsResponse';
 export * from './ApiV1ListSupportedVariantsResponse';
+export * from './ApiV1MOEADConfig';
+export * from './ApiV1NSGA2Config';
+export * from './ApiV1ParameterAdaptation';
 export * from './ApiV1Pareto';
+export * from './ApiV1ParetoArchiveConfig';
 export * from './ApiV1ParetoIDs';
 export * from './ApiV1ParetoServiceGetResponse';
 export * from './ApiV1ParetoServiceListByUserResponse';
+export * from './ApiV1PollOIDCDeviceLoginRequest';
+export * from './ApiV1PollOIDCDeviceLoginResponse';
 export * from './ApiV1Problem';
+export * from './ApiV1PurgeResultsRequest';
+export * from './ApiV1PurgeResultsResponse';
+export * from './ApiV1Repair';
 export * from './ApiV1RunAsyncRequest';
 export * from './ApiV1RunAsyncResponse';
+export * from './ApiV1StartOIDCDeviceLoginResponse';
 export * from './ApiV1StreamProgressResponse';
 export * from './ApiV1User';
 export * from './ApiV1UserIDs';
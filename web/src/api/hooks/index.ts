@@ -1,10 +1,12 @@
 export { useLogin, useRegister, useLogout, useRefreshToken } from './useAuth'
 export { useTokenRefresh } from './useTokenRefresh'
+export { useOIDCConfig, useOIDCLogin } from './useOIDC'
 export {
   useAlgorithms,
   useVariants,
   useProblems,
   useSupportedOptions,
+  useReferenceFront,
 } from './useSupportedOptions'
 export {
   useExecutions,
@@ -12,6 +14,7 @@ export {
   useExecutionResults,
   useRunAsync,
   useCancelExecution,
+  useResumeExecution,
   useDeleteExecution,
 } from './useExecutions'
 export { useExecutionProgress, useExecutionProgressValue } from './useProgress'
@@ -86,6 +86,26 @@ export const useCancelExecution = () => {
   })
 }
 
+export const useResumeExecution = () => {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: async (params: { executionId: string; generations?: number }) => {
+      const api = deApi()
+      return api.differentialEvolutionServiceResumeExecution({
+        executionId: params.executionId,
+        body: {
+          generations: params.generations ? String(params.generations) : undefined,
+        },
+      })
+    },
+    onSuccess: (_, params) => {
+      queryClient.invalidateQueries({ queryKey: ['execution', params.executionId] })
+      queryClient.invalidateQueries({ queryKey: ['executions'] })
+    },
+  })
+}
+
 export const useDeleteExecution = () => {
   const queryClient = useQueryClient()
 
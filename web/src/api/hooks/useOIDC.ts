@@ -0,0 +1,58 @@
+import { useMutation, useQuery } from '@tanstack/react-query'
+import { oidcApi } from '../client'
+import { useAuthStore } from '@/stores/authStore'
+import { redirectUri, takePendingLogin, usernameFromToken } from '@/lib/oidc'
+
+interface OIDCCallback {
+  code: string
+  state: string
+}
+
+export const useOIDCConfig = () => {
+  return useQuery({
+    queryKey: ['oidc', 'config'],
+    queryFn: async () => {
+      const api = oidcApi()
+      return api.oIDCServiceGetConfig()
+    },
+    staleTime: Infinity,
+    retry: false,
+  })
+}
+
+export const useOIDCLogin = () => {
+  const setAuth = useAuthStore((s) => s.setAuth)
+  const setUsername = useAuthStore((s) => s.setUsername)
+
+  return useMutation({
+    mutationFn: async ({ code, state }: OIDCCallback) => {
+      const pending = takePendingLogin(state)
+      if (!pending) {
+        throw new Error('Login state mismatch')
+      }
+      const api = oidcApi()
+      return api.oIDCServiceExchangeCode({
+        body: {
+          code,
+          codeVerifier: pending.codeVerifier,
+          redirectUri: redirectUri(),
+          nonce: pending.nonce,
+        },
+      })
+    },
+    onSuccess: (data) => {
+      if (data.accessToken && data.refreshToken && data.expiresIn) {
+        const username = usernameFromToken(data.accessToken)
+        setAuth({
+          accessToken: data.accessToken,
+          refreshToken: data.refreshToken,
+          expiresIn: Number(data.expiresIn),
+          username,
+        })
+        if (username) {
+          setUsername(username)
+        }
+      }
+    },
+  })
+}
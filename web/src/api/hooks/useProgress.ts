@@ -72,6 +72,7 @@ export const useExecutionProgressValue = (executionId: string | undefined) => {
     executionPercent,
     overallPercent: Math.min(100, overallPercent),
     partialPareto: progress?.partialPareto,
+    controlParameters: progress?.controlParameters,
     isRunning: execution?.status
       ? RUNNING_STATUSES.includes(execution.status)
       : false,
@@ -25,6 +25,30 @@ export const useVariants = () => {
   })
 }
 
+export const useCrossovers = () => {
+  return useQuery({
+    queryKey: ['supported', 'crossovers'],
+    queryFn: async () => {
+      const api = deApi()
+      const response = await api.differentialEvolutionServiceListSupportedCrossovers()
+      return response.crossovers ?? []
+    },
+    staleTime: Infinity,
+  })
+}
+
+export const useRepairs = () => {
+  return useQuery({
+    queryKey: ['supported', 'repairs'],
+    queryFn: async () => {
+      const api = deApi()
+      const response = await api.differentialEvolutionServiceListSupportedRepairs()
+      return response.repairs ?? []
+    },
+    staleTime: Infinity,
+  })
+}
+
 export const useProblems = () => {
   return useQuery({
     queryKey: ['supported', 'problems'],
@@ -40,13 +64,42 @@ export const useProblems = () => {
 export const useSupportedOptions = () => {
   const algorithms = useAlgorithms()
   const variants = useVariants()
+  const crossovers = useCrossovers()
+  const repairs = useRepairs()
   const problems = useProblems()
+  const queries = [algorithms, variants, crossovers, repairs, problems]
 
   return {
     algorithms: algorithms.data ?? [],
     variants: variants.data ?? [],
+    crossovers: crossovers.data ?? [],
+    repairs: repairs.data ?? [],
     problems: problems.data ?? [],
-    isLoading: algorithms.isLoading || variants.isLoading || problems.isLoading,
-    isError: algorithms.isError || variants.isError || problems.isError,
+    isLoading: queries.some((q) => q.isLoading),
+    isError: queries.some((q) => q.isError),
   }
 }
+
+export const useReferenceFront = (
+  problem: string | undefined,
+  objectivesSize: number | undefined,
+  points = 200
+) => {
+  return useQuery({
+    queryKey: ['supported', 'problems', problem, 'reference-front', objectivesSize, points],
+    queryFn: async () => {
+      const api = deApi()
+      const response = await api.differentialEvolutionServiceGetReferenceFront({
+        problem: problem!,
+        objectivesSize: objectivesSize?.toString(),
+        points: points.toString(),
+      })
+      return response.vectors ?? []
+    },
+    enabled: !!problem,
+    staleTime: Infinity,
+    // Problems without a known front answer with an error; the plots simply
+    // omit the overlay.
+    retry: false,
+  })
+}
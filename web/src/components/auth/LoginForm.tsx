@@ -1,7 +1,8 @@
 import { useForm } from 'react-hook-form'
 import { zodResolver } from '@hookform/resolvers/zod'
 import { z } from 'zod'
-import { useLogin } from '@/api/hooks'
+import { useLogin, useOIDCConfig } from '@/api/hooks'
+import { buildAuthorizationUrl } from '@/lib/oidc'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 import { Label } from '@/components/ui/label'
@@ -22,6 +23,7 @@ interface LoginFormProps {
 
 export function LoginForm({ onSuccess }: LoginFormProps) {
   const login = useLogin()
+  const { data: oidcConfig } = useOIDCConfig()
   const { addToast } = useToast()
 
   const {
@@ -42,6 +44,21 @@ export function LoginForm({ onSuccess }: LoginFormProps) {
     }
   }
 
+  const onSSO = async () => {
+    if (!oidcConfig?.authorizationEndpoint || !oidcConfig.clientId) return
+    try {
+      window.location.assign(
+        await buildAuthorizationUrl({
+          authorizationEndpoint: oidcConfig.authorizationEndpoint,
+          clientId: oidcConfig.clientId,
+          scopes: oidcConfig.scopes ?? [],
+        })
+      )
+    } catch {
+      addToast('Failed to start single sign-on', 'error')
+    }
+  }
+
   return (
     <Card className="w-full max-w-md">
       <CardHeader>
@@ -92,6 +109,12 @@ export function LoginForm({ onSuccess }: LoginFormProps) {
             {login.isPending ? 'Signing in...' : 'Sign In'}
           </Button>
 
+          {oidcConfig?.enabled && (
+            <Button type="button" variant="outline" className="w-full" onClick={onSSO}>
+              Sign in with SSO
+            </Button>
+          )}
+
           <p className="text-muted-foreground text-center text-sm">
             Don't have an account?{' '}
             <Link to="/register" className="text-primary hover:underline">
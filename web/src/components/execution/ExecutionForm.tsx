@@ -5,6 +5,7 @@ import { useNavigate } from 'react-router-dom'
 import { useSupportedOptions } from '@/api/hooks/useSupportedOptions'
 import { useRunAsync } from '@/api/hooks/useExecutions'
 import { Button, Input, Label, Card, Select, useToast } from '@/components/ui'
+import type { ApiV1DEConfig } from '@/api/generated'
 
 const deConfigSchema = z.object({
   algorithm: z.string().min(1, 'Algorithm is required'),
@@ -15,28 +16,79 @@ const deConfigSchema = z.object({
   populationSize: z.number().int().positive(),
   dimensionsSize: z.number().int().positive(),
   objectivesSize: z.number().int().min(2),
-  floorLimiter: z.number(),
-  ceilLimiter: z.number(),
-  gde3Cr: z.number().min(0).max(1),
-  gde3F: z.number().min(0).max(2),
-  gde3P: z.number().min(0).max(1),
+  floorLimiter: z.number().optional(),
+  ceilLimiter: z.number().optional(),
+  seed: z.string().regex(/^-?\d*$/, 'Seed must be an integer').optional(),
+  cr: z.number().min(0).max(1),
+  f: z.number().min(0).max(2),
+  p: z.number().min(0).max(1),
+  crossover: z.string().optional(),
+  repair: z.string().optional(),
+  adaptation: z.enum(['', 'PARAMETER_ADAPTATION_FIXED', 'PARAMETER_ADAPTATION_JADE', 'PARAMETER_ADAPTATION_SHADE']).optional(),
+  learningRate: z.number().gt(0).max(1).optional(),
+  memorySize: z.number().int().positive().optional(),
+  neighborhoodSize: z.number().int().min(2).optional(),
+  decomposition: z.enum(['', 'DECOMPOSITION_TCHEBYCHEFF', 'DECOMPOSITION_PBI']).optional(),
+  theta: z.number().min(0).optional(),
+  delta: z.number().min(0).max(1).optional(),
+  maxReplacements: z.number().int().positive().optional(),
+  resultLimit: z.number().int().positive().optional(),
+  paretoArchive: z.enum(['', 'enabled']).optional(),
+  archiveMaxSize: z.number().int().positive().optional(),
+  archiveEpsilon: z.number().gt(0).optional(),
 })
 
 type DEConfigFormData = z.infer<typeof deConfigSchema>
 
+// Empty limiters are left unset so the server uses the problem's own bounds.
+const optionalNumber = (value: string) => (value === '' ? undefined : Number(value))
+
+// algorithmConfig builds the configuration of the selected algorithm, CR, F
+// and P are shared by all of them and unset GDE3 adaptation or MOEA/D values
+// use server defaults.
+function algorithmConfig(data: DEConfigFormData): Pick<ApiV1DEConfig, 'gde3' | 'moead' | 'nsga2'> {
+  const constants = { cr: data.cr, f: data.f, p: data.p }
+  switch (data.algorithm) {
+    case 'moead':
+      return {
+        moead: {
+          ...constants,
+          neighborhoodSize: data.neighborhoodSize === undefined ? undefined : String(data.neighborhoodSize),
+          decomposition: data.decomposition || undefined,
+          theta: data.theta,
+          delta: data.delta,
+          maxReplacements: data.maxReplacements === undefined ? undefined : String(data.maxReplacements),
+        },
+      }
+    case 'nsga2':
+      return { nsga2: constants }
+    default:
+      return {
+        gde3: {
+          ...constants,
+          adaptation: data.adaptation || undefined,
+          learningRate: data.learningRate,
+          memorySize: data.memorySize === undefined ? undefined : String(data.memorySize),
+        },
+      }
+  }
+}
+
 interface ExecutionFormProps {
   onSuccess?: (executionId: string) => void
 }
 
 export function ExecutionForm({ onSuccess }: ExecutionFormProps) {
   const navigate = useNavigate()
-  const { algorithms, variants, problems, isLoading: optionsLoading } = useSupportedOptions()
+  const { algorithms, variants, crossovers, repairs, problems, isLoading: optionsLoading } =
+    useSupportedOptions()
   const runAsync = useRunAsync()
   const { addToast } = useToast()
 
   const {
     register,
     handleSubmit,
+    watch,
     formState: { errors, isSubmitting },
   } = useForm<DEConfigFormData>({
     resolver: zodResolver(deConfigSchema),
@@ -46,13 +98,17 @@ export function ExecutionForm({ onSuccess }: ExecutionFormProps) {
       populationSize: 100,
       dimensionsSize: 30,
       objectivesSize: 2,
-      floorLimiter: 0,
-      ceilLimiter: 1,
-      gde3Cr: 0.9,
-      gde3F: 0.5,
-      gde3P: 0.1,
+      cr: 0.9,
+      f: 0.5,
+      p: 0.1,
+      decomposition: '',
+      adaptation: '',
+      paretoArchive: '',
     },
   })
+  const algorithm = watch('algorithm')
+  const adaptation = watch('adaptation')
+  const paretoArchive = watch('paretoArchive')
 
   const onSubmit = async (data: DEConfigFormData) => {
     try {
@@ -68,11 +124,17 @@ export function ExecutionForm({ onSuccess }: ExecutionFormProps) {
           objectivesSize: String(data.objectivesSize),
           floorLimiter: data.floorLimiter,
           ceilLimiter: data.ceilLimiter,
-          gde3: {
-            cr: data.gde3Cr,
-            f: data.gde3F,
-            p: data.gde3P,
-          },
+          seed: data.seed || undefined,
+          crossover: data.crossover || undefined,
+          repair: data.repair || undefined,
+          resultLimit: data.resultLimit === undefined ? undefined : String(data.resultLimit),
+          paretoArchive: data.paretoArchive
+            ? {
+                maxSize: data.archiveMaxSize === undefined ? undefined : String(data.archiveMaxSize),
+                epsilon: data.archiveEpsilon,
+              }
+            : undefined,
+          ...algorithmConfig(data),
         },
       })
       if (response.executionId) {
@@ -209,7 +271,8 @@ export function ExecutionForm({ onSuccess }: ExecutionFormProps) {
             <Input
               type="number"
               step="0.1"
-              {...register('floorLimiter', { valueAsNumber: true })}
+              placeholder="Problem default"
+              {...register('floorLimiter', { setValueAs: optionalNumber })}
             />
             {errors.floorLimiter && (
               <p className="text-destructive text-sm">{errors.floorLimiter.message}</p>
@@ -221,59 +284,268 @@ export function ExecutionForm({ onSuccess }: ExecutionFormProps) {
             <Input
               type="number"
               step="0.1"
-              {...register('ceilLimiter', { valueAsNumber: true })}
+              placeholder="Problem default"
+              {...register('ceilLimiter', { setValueAs: optionalNumber })}
             />
             {errors.ceilLimiter && (
               <p className="text-destructive text-sm">{errors.ceilLimiter.message}</p>
             )}
           </div>
+
+          <div className="space-y-2">
+            <Label htmlFor="seed">Seed</Label>
+            <Input type="text" inputMode="numeric" placeholder="Random" {...register('seed')} />
+            {errors.seed && (
+              <p className="text-destructive text-sm">{errors.seed.message}</p>
+            )}
+          </div>
         </div>
       </Card>
 
       <Card className="p-6">
-        <h3 className="mb-4 text-lg font-semibold">GDE3 Parameters</h3>
+        <h3 className="mb-4 text-lg font-semibold">DE Parameters</h3>
         <div className="grid grid-cols-1 gap-4 md:grid-cols-3">
           <div className="space-y-2">
-            <Label htmlFor="gde3Cr">CR (Crossover Rate)</Label>
+            <Label htmlFor="cr">CR (Crossover Rate)</Label>
             <Input
               type="number"
               step="0.01"
-              {...register('gde3Cr', { valueAsNumber: true })}
+              {...register('cr', { valueAsNumber: true })}
               min={0}
               max={1}
             />
-            {errors.gde3Cr && (
-              <p className="text-destructive text-sm">{errors.gde3Cr.message}</p>
+            {errors.cr && (
+              <p className="text-destructive text-sm">{errors.cr.message}</p>
             )}
           </div>
 
           <div className="space-y-2">
-            <Label htmlFor="gde3F">F (Scaling Factor)</Label>
+            <Label htmlFor="f">F (Scaling Factor)</Label>
             <Input
               type="number"
               step="0.01"
-              {...register('gde3F', { valueAsNumber: true })}
+              {...register('f', { valueAsNumber: true })}
               min={0}
               max={2}
             />
-            {errors.gde3F && (
-              <p className="text-destructive text-sm">{errors.gde3F.message}</p>
+            {errors.f && (
+              <p className="text-destructive text-sm">{errors.f.message}</p>
             )}
           </div>
 
           <div className="space-y-2">
-            <Label htmlFor="gde3P">P (Selection Parameter)</Label>
+            <Label htmlFor="p">P (Selection Parameter)</Label>
             <Input
               type="number"
               step="0.01"
-              {...register('gde3P', { valueAsNumber: true })}
+              {...register('p', { valueAsNumber: true })}
               min={0}
               max={1}
             />
-            {errors.gde3P && (
-              <p className="text-destructive text-sm">{errors.gde3P.message}</p>
+            {errors.p && (
+              <p className="text-destructive text-sm">{errors.p.message}</p>
+            )}
+          </div>
+
+          <div className="space-y-2">
+            <Label htmlFor="crossover">Crossover</Label>
+            <Select {...register('crossover')}>
+              <option value="">Default (binomial)</option>
+              {crossovers.map((c) => (
+                <option key={c.name} value={c.name} title={c.description}>
+                  {c.name}
+                </option>
+              ))}
+            </Select>
+          </div>
+
+          <div className="space-y-2">
+            <Label htmlFor="repair">Boundary Repair</Label>
+            <Select {...register('repair')}>
+              <option value="">Default (clamp)</option>
+              {repairs.map((r) => (
+                <option key={r.name} value={r.name} title={r.description}>
+                  {r.name}
+                </option>
+              ))}
+            </Select>
+          </div>
+        </div>
+      </Card>
+
+      {algorithm === 'gde3' && (
+        <Card className="p-6">
+          <h3 className="mb-4 text-lg font-semibold">Parameter Adaptation</h3>
+          <div className="grid grid-cols-2 gap-4 md:grid-cols-3">
+            <div className="space-y-2">
+              <Label htmlFor="adaptation">F and CR</Label>
+              <Select {...register('adaptation')}>
+                <option value="">Fixed</option>
+                <option value="PARAMETER_ADAPTATION_JADE">JADE</option>
+                <option value="PARAMETER_ADAPTATION_SHADE">SHADE</option>
+              </Select>
+            </div>
+
+            {adaptation === 'PARAMETER_ADAPTATION_JADE' && (
+              <div className="space-y-2">
+                <Label htmlFor="learningRate">Learning Rate</Label>
+                <Input
+                  type="number"
+                  step="0.01"
+                  placeholder="Server default"
+                  {...register('learningRate', { setValueAs: optionalNumber })}
+                  min={0}
+                  max={1}
+                />
+                {errors.learningRate && (
+                  <p className="text-destructive text-sm">{errors.learningRate.message}</p>
+                )}
+              </div>
+            )}
+
+            {adaptation === 'PARAMETER_ADAPTATION_SHADE' && (
+              <div className="space-y-2">
+                <Label htmlFor="memorySize">Memory Size</Label>
+                <Input
+                  type="number"
+                  placeholder="Server default"
+                  {...register('memorySize', { setValueAs: optionalNumber })}
+                  min={1}
+                />
+                {errors.memorySize && (
+                  <p className="text-destructive text-sm">{errors.memorySize.message}</p>
+                )}
+              </div>
+            )}
+          </div>
+        </Card>
+      )}
+
+      {algorithm === 'moead' && (
+        <Card className="p-6">
+          <h3 className="mb-4 text-lg font-semibold">MOEA/D Parameters</h3>
+          <div className="grid grid-cols-2 gap-4 md:grid-cols-5">
+            <div className="space-y-2">
+              <Label htmlFor="decomposition">Decomposition</Label>
+              <Select {...register('decomposition')}>
+                <option value="">Server default</option>
+                <option value="DECOMPOSITION_TCHEBYCHEFF">Tchebycheff</option>
+                <option value="DECOMPOSITION_PBI">PBI</option>
+              </Select>
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="neighborhoodSize">Neighborhood Size</Label>
+              <Input
+                type="number"
+                placeholder="Server default"
+                {...register('neighborhoodSize', { setValueAs: optionalNumber })}
+                min={2}
+              />
+              {errors.neighborhoodSize && (
+                <p className="text-destructive text-sm">{errors.neighborhoodSize.message}</p>
+              )}
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="theta">Theta (PBI Penalty)</Label>
+              <Input
+                type="number"
+                step="0.1"
+                placeholder="Server default"
+                {...register('theta', { setValueAs: optionalNumber })}
+                min={0}
+              />
+              {errors.theta && (
+                <p className="text-destructive text-sm">{errors.theta.message}</p>
+              )}
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="delta">Delta (Neighborhood Mating)</Label>
+              <Input
+                type="number"
+                step="0.01"
+                placeholder="Server default"
+                {...register('delta', { setValueAs: optionalNumber })}
+                min={0}
+                max={1}
+              />
+              {errors.delta && (
+                <p className="text-destructive text-sm">{errors.delta.message}</p>
+              )}
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="maxReplacements">Max Replacements</Label>
+              <Input
+                type="number"
+                placeholder="Server default"
+                {...register('maxReplacements', { setValueAs: optionalNumber })}
+                min={1}
+              />
+              {errors.maxReplacements && (
+                <p className="text-destructive text-sm">{errors.maxReplacements.message}</p>
+              )}
+            </div>
+          </div>
+        </Card>
+      )}
+
+      <Card className="p-6">
+        <h3 className="mb-4 text-lg font-semibold">Results</h3>
+        <div className="grid grid-cols-2 gap-4 md:grid-cols-4">
+          <div className="space-y-2">
+            <Label htmlFor="resultLimit">Result Limit</Label>
+            <Input
+              type="number"
+              placeholder="Server default"
+              {...register('resultLimit', { setValueAs: optionalNumber })}
+              min={1}
+            />
+            {errors.resultLimit && (
+              <p className="text-destructive text-sm">{errors.resultLimit.message}</p>
             )}
           </div>
+
+          <div className="space-y-2">
+            <Label htmlFor="paretoArchive">Pareto Archive</Label>
+            <Select {...register('paretoArchive')}>
+              <option value="">Disabled</option>
+              <option value="enabled">Enabled</option>
+            </Select>
+          </div>
+
+          {paretoArchive === 'enabled' && (
+            <>
+              <div className="space-y-2">
+                <Label htmlFor="archiveMaxSize">Archive Max Size</Label>
+                <Input
+                  type="number"
+                  placeholder="Unbounded"
+                  {...register('archiveMaxSize', { setValueAs: optionalNumber })}
+                  min={1}
+                />
+                {errors.archiveMaxSize && (
+                  <p className="text-destructive text-sm">{errors.archiveMaxSize.message}</p>
+                )}
+              </div>
+
+              <div className="space-y-2">
+                <Label htmlFor="archiveEpsilon">Archive Epsilon</Label>
+                <Input
+                  type="number"
+                  step="0.001"
+                  placeholder="Plain dominance"
+                  {...register('archiveEpsilon', { setValueAs: optionalNumber })}
+                  min={0}
+                />
+                {errors.archiveEpsilon && (
+                  <p className="text-destructive text-sm">{errors.archiveEpsilon.message}</p>
+                )}
+              </div>
+            </>
+          )}
         </div>
       </Card>
 
@@ -7,6 +7,7 @@ interface ParetoPlot2DProps {
   xAxis: number
   yAxis: number
   title?: string
+  referenceFront?: ApiV1Vector[]
 }
 
 export function ParetoPlot2D({
@@ -14,6 +15,7 @@ export function ParetoPlot2D({
   xAxis,
   yAxis,
   title = 'Pareto Front',
+  referenceFront = [],
 }: ParetoPlot2DProps) {
   const xValues = vectors.map((v) => v.objectives?.[xAxis] ?? 0)
   const yValues = vectors.map((v) => v.objectives?.[yAxis] ?? 0)
@@ -25,6 +27,7 @@ export function ParetoPlot2D({
       y: yValues,
       mode: 'markers',
       type: 'scatter',
+      name: 'Solutions',
       marker: {
         size: 8,
         color: crowdingDistances,
@@ -41,6 +44,18 @@ export function ParetoPlot2D({
     },
   ]
 
+  if (referenceFront.length > 0) {
+    data.unshift({
+      x: referenceFront.map((v) => v.objectives?.[xAxis] ?? 0),
+      y: referenceFront.map((v) => v.objectives?.[yAxis] ?? 0),
+      mode: 'markers',
+      type: 'scatter',
+      name: 'Reference front',
+      marker: { size: 3, color: 'rgba(128, 128, 128, 0.6)' },
+      hoverinfo: 'skip',
+    })
+  }
+
   const layout: Partial<Layout> = {
     title: {
       text: title,
@@ -57,6 +72,8 @@ export function ParetoPlot2D({
     paper_bgcolor: 'transparent',
     plot_bgcolor: 'transparent',
     autosize: true,
+    showlegend: referenceFront.length > 0,
+    legend: { orientation: 'h', y: -0.2 },
     margin: { l: 60, r: 40, t: 60, b: 60 },
   }
 
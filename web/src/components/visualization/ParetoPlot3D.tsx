@@ -8,6 +8,7 @@ interface ParetoPlot3DProps {
   yAxis: number
   zAxis: number
   title?: string
+  referenceFront?: ApiV1Vector[]
 }
 
 export function ParetoPlot3D({
@@ -16,6 +17,7 @@ export function ParetoPlot3D({
   yAxis,
   zAxis,
   title = 'Pareto Front (3D)',
+  referenceFront = [],
 }: ParetoPlot3DProps) {
   const xValues = vectors.map((v) => v.objectives?.[xAxis] ?? 0)
   const yValues = vectors.map((v) => v.objectives?.[yAxis] ?? 0)
@@ -29,6 +31,7 @@ export function ParetoPlot3D({
       z: zValues,
       mode: 'markers',
       type: 'scatter3d',
+      name: 'Solutions',
       marker: {
         size: 4,
         color: crowdingDistances,
@@ -46,6 +49,19 @@ export function ParetoPlot3D({
     },
   ]
 
+  if (referenceFront.length > 0) {
+    data.unshift({
+      x: referenceFront.map((v) => v.objectives?.[xAxis] ?? 0),
+      y: referenceFront.map((v) => v.objectives?.[yAxis] ?? 0),
+      z: referenceFront.map((v) => v.objectives?.[zAxis] ?? 0),
+      mode: 'markers',
+      type: 'scatter3d',
+      name: 'Reference front',
+      marker: { size: 2, color: 'rgba(128, 128, 128, 0.5)' },
+      hoverinfo: 'skip',
+    })
+  }
+
   const layout: Partial<Layout> = {
     title: {
       text: title,
@@ -61,6 +77,8 @@ export function ParetoPlot3D({
     },
     paper_bgcolor: 'transparent',
     autosize: true,
+    showlegend: referenceFront.length > 0,
+    legend: { orientation: 'h' },
     margin: { l: 0, r: 0, t: 60, b: 0 },
   }
 
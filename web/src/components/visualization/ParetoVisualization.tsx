@@ -10,9 +10,13 @@ type ViewMode = '2d' | '3d' | 'table'
 
 interface ParetoVisualizationProps {
   vectors: ApiV1Vector[]
+  referenceFront?: ApiV1Vector[]
 }
 
-export function ParetoVisualization({ vectors }: ParetoVisualizationProps) {
+export function ParetoVisualization({
+  vectors,
+  referenceFront,
+}: ParetoVisualizationProps) {
   const objectivesCount = vectors[0]?.objectives?.length ?? 2
 
   const [viewMode, setViewMode] = useState<ViewMode>('2d')
@@ -75,7 +79,12 @@ export function ParetoVisualization({ vectors }: ParetoVisualizationProps) {
 
       <Card className="p-4">
         {viewMode === '2d' && (
-          <ParetoPlot2D vectors={vectors} xAxis={xAxis} yAxis={yAxis} />
+          <ParetoPlot2D
+            vectors={vectors}
+            xAxis={xAxis}
+            yAxis={yAxis}
+            referenceFront={referenceFront}
+          />
         )}
         {viewMode === '3d' && (
           <ParetoPlot3D
@@ -83,6 +92,7 @@ export function ParetoVisualization({ vectors }: ParetoVisualizationProps) {
             xAxis={xAxis}
             yAxis={yAxis}
             zAxis={zAxis}
+            referenceFront={referenceFront}
           />
         )}
         {viewMode === 'table' && <ObjectiveTable vectors={vectors} />}
@@ -0,0 +1,89 @@
+// Helpers for the authorization code login with PKCE. The verifier, state and
+// nonce are kept in sessionStorage between the redirect to the identity
+// provider and the callback.
+
+const STORAGE_KEY = 'gode-oidc'
+
+export const OIDC_CALLBACK_PATH = '/auth/callback'
+
+interface PendingLogin {
+  state: string
+  nonce: string
+  codeVerifier: string
+}
+
+function randomString(bytes = 32): string {
+  const data = new Uint8Array(bytes)
+  crypto.getRandomValues(data)
+  return base64Url(data)
+}
+
+function base64Url(data: Uint8Array): string {
+  let binary = ''
+  data.forEach((b) => {
+    binary += String.fromCharCode(b)
+  })
+  return btoa(binary).replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '')
+}
+
+async function codeChallenge(verifier: string): Promise<string> {
+  const digest = await crypto.subtle.digest('SHA-256', new TextEncoder().encode(verifier))
+  return base64Url(new Uint8Array(digest))
+}
+
+export function redirectUri(): string {
+  return window.location.origin + OIDC_CALLBACK_PATH
+}
+
+// buildAuthorizationUrl stores a new pending login and returns the URL of the
+// identity provider to send the user to.
+export async function buildAuthorizationUrl(config: {
+  authorizationEndpoint: string
+  clientId: string
+  scopes: string[]
+}): Promise<string> {
+  const pending: PendingLogin = {
+    state: randomString(),
+    nonce: randomString(),
+    codeVerifier: randomString(),
+  }
+  sessionStorage.setItem(STORAGE_KEY, JSON.stringify(pending))
+
+  const url = new URL(config.authorizationEndpoint)
+  url.searchParams.set('response_type', 'code')
+  url.searchParams.set('client_id', config.clientId)
+  url.searchParams.set('redirect_uri', redirectUri())
+  url.searchParams.set('scope', config.scopes.join(' '))
+  url.searchParams.set('state', pending.state)
+  url.searchParams.set('nonce', pending.nonce)
+  url.searchParams.set('code_challenge', await codeChallenge(pending.codeVerifier))
+  url.searchParams.set('code_challenge_method', 'S256')
+  return url.toString()
+}
+
+// takePendingLogin returns the pending login matching state and forgets it,
+// so that a callback cannot be replayed.
+export function takePendingLogin(state: string): PendingLogin | null {
+  const raw = sessionStorage.getItem(STORAGE_KEY)
+  sessionStorage.removeItem(STORAGE_KEY)
+  if (!raw) return null
+  try {
+    const pending = JSON.parse(raw) as PendingLogin
+    return pending.state === state ? pending : null
+  } catch {
+    return null
+  }
+}
+
+// usernameFromToken reads the username claim of an access token issued by
+// the server, single sign-on users do not type their username.
+export function usernameFromToken(token: string): string | undefined {
+  const payload = token.split('.')[1]
+  if (!payload) return undefined
+  try {
+    const json = atob(payload.replace(/-/g, '+').replace(/_/g, '/'))
+    return (JSON.parse(json) as { username?: string }).username
+  } catch {
+    return undefined
+  }
+}
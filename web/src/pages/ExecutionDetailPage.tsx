@@ -3,13 +3,16 @@ import {
   useExecution,
   useExecutionResults,
   useCancelExecution,
+  useResumeExecution,
   useDeleteExecution,
 } from '@/api/hooks/useExecutions'
 import { useExecutionProgressValue } from '@/api/hooks/useProgress'
+import { useReferenceFront } from '@/api/hooks/useSupportedOptions'
 import { Card, Badge, Progress, Button } from '@/components/ui'
 import { AppShell } from '@/components/layout'
 import { ParetoVisualization } from '@/components/visualization'
 import { executionStatusLabel, executionStatusVariant } from '@/lib/status'
+import { ApiV1Decomposition, ApiV1ParameterAdaptation } from '@/api/generated'
 
 function formatDate(date: Date | undefined): string {
   if (!date) return '-'
@@ -19,13 +22,43 @@ function formatDate(date: Date | undefined): string {
   }).format(date)
 }
 
+const serverDefaultLabel = 'Server default'
+
+function decompositionLabel(decomposition: ApiV1Decomposition | undefined): string {
+  switch (decomposition) {
+    case ApiV1Decomposition.DecompositionTchebycheff:
+      return 'Tchebycheff'
+    case ApiV1Decomposition.DecompositionPbi:
+      return 'PBI'
+    default:
+      return serverDefaultLabel
+  }
+}
+
+function adaptationLabel(adaptation: ApiV1ParameterAdaptation | undefined): string {
+  switch (adaptation) {
+    case ApiV1ParameterAdaptation.ParameterAdaptationJade:
+      return 'JADE'
+    case ApiV1ParameterAdaptation.ParameterAdaptationShade:
+      return 'SHADE'
+    default:
+      return 'Fixed'
+  }
+}
+
 export function ExecutionDetailPage() {
   const { id } = useParams<{ id: string }>()
   const navigate = useNavigate()
   const { data: executionData, isLoading: executionLoading } = useExecution(id)
   const { data: resultsData, isLoading: resultsLoading } = useExecutionResults(id)
   const progress = useExecutionProgressValue(id)
+  const objectivesSize = Number(executionData?.execution?.config?.objectivesSize) || undefined
+  const { data: referenceFront } = useReferenceFront(
+    executionData?.execution?.problem,
+    objectivesSize
+  )
   const cancelExecution = useCancelExecution()
+  const resumeExecution = useResumeExecution()
   const deleteExecution = useDeleteExecution()
 
   if (executionLoading) {
@@ -50,9 +83,15 @@ export function ExecutionDetailPage() {
   const variant = executionStatusVariant[status]
   const isRunning = status === 'EXECUTION_STATUS_RUNNING' || status === 'EXECUTION_STATUS_PENDING'
   const isCompleted = status === 'EXECUTION_STATUS_COMPLETED'
-  const canDelete = isCompleted ||
-                    status === 'EXECUTION_STATUS_FAILED' ||
+  // Per-dimension bounds override the limiters, and both limiters unset means
+  // the problem's own bounds were used.
+  const hasBounds = !!execution.config?.bounds?.length
+  const limitersSet = !hasBounds && !!(execution.config?.floorLimiter || execution.config?.ceilLimiter)
+  const unsetLimiterLabel = hasBounds ? 'Per dimension' : 'Problem default'
+  const constants = execution.config?.gde3 ?? execution.config?.moead ?? execution.config?.nsga2
+  const canResume = status === 'EXECUTION_STATUS_FAILED' ||
                     status === 'EXECUTION_STATUS_CANCELLED'
+  const canDelete = isCompleted || canResume
 
   const handleCancel = () => {
     if (id) {
@@ -60,6 +99,12 @@ export function ExecutionDetailPage() {
     }
   }
 
+  const handleResume = () => {
+    if (id) {
+      resumeExecution.mutate({ executionId: id })
+    }
+  }
+
   const handleDelete = () => {
     if (id && confirm('Are you sure you want to delete this execution?')) {
       deleteExecution.mutate(id, {
@@ -96,6 +141,15 @@ export function ExecutionDetailPage() {
               Cancel
             </Button>
           )}
+          {canResume && (
+            <Button
+              variant="outline"
+              onClick={handleResume}
+              disabled={resumeExecution.isPending}
+            >
+              Resume
+            </Button>
+          )}
           {canDelete && (
             <Button
               variant="destructive"
@@ -177,25 +231,88 @@ export function ExecutionDetailPage() {
               </div>
               <div className="flex justify-between">
                 <dt className="text-muted-foreground">Floor</dt>
-                <dd>{execution.config.floorLimiter}</dd>
+                <dd>{limitersSet ? execution.config.floorLimiter ?? 0 : unsetLimiterLabel}</dd>
               </div>
               <div className="flex justify-between">
                 <dt className="text-muted-foreground">Ceiling</dt>
-                <dd>{execution.config.ceilLimiter}</dd>
+                <dd>{limitersSet ? execution.config.ceilLimiter ?? 0 : unsetLimiterLabel}</dd>
               </div>
-              {execution.config.gde3 && (
+              {execution.config.seed && (
+                <div className="flex justify-between">
+                  <dt className="text-muted-foreground">Seed</dt>
+                  <dd className="font-mono">{execution.config.seed}</dd>
+                </div>
+              )}
+              <div className="flex justify-between">
+                <dt className="text-muted-foreground">Crossover</dt>
+                <dd>{execution.config.crossover || 'binomial'}</dd>
+              </div>
+              <div className="flex justify-between">
+                <dt className="text-muted-foreground">Repair</dt>
+                <dd>{execution.config.repair || 'clamp'}</dd>
+              </div>
+              {execution.config.resultLimit && (
+                <div className="flex justify-between">
+                  <dt className="text-muted-foreground">Result Limit</dt>
+                  <dd>{execution.config.resultLimit}</dd>
+                </div>
+              )}
+              {execution.config.paretoArchive && (
+                <div className="flex justify-between">
+                  <dt className="text-muted-foreground">Pareto Archive</dt>
+                  <dd>
+                    {execution.config.paretoArchive.maxSize
+                      ? `max ${execution.config.paretoArchive.maxSize}`
+                      : 'unbounded'}
+                    {execution.config.paretoArchive.epsilon
+                      ? `, ε ${execution.config.paretoArchive.epsilon}`
+                      : ''}
+                  </dd>
+                </div>
+              )}
+              {constants && (
                 <>
                   <div className="flex justify-between">
                     <dt className="text-muted-foreground">CR</dt>
-                    <dd>{execution.config.gde3.cr}</dd>
+                    <dd>{constants.cr}</dd>
                   </div>
                   <div className="flex justify-between">
                     <dt className="text-muted-foreground">F</dt>
-                    <dd>{execution.config.gde3.f}</dd>
+                    <dd>{constants.f}</dd>
                   </div>
                   <div className="flex justify-between">
                     <dt className="text-muted-foreground">P</dt>
-                    <dd>{execution.config.gde3.p}</dd>
+                    <dd>{constants.p}</dd>
+                  </div>
+                </>
+              )}
+              {execution.config.gde3 && (
+                <div className="flex justify-between">
+                  <dt className="text-muted-foreground">Adaptation</dt>
+                  <dd>{adaptationLabel(execution.config.gde3.adaptation)}</dd>
+                </div>
+              )}
+              {execution.config.moead && (
+                <>
+                  <div className="flex justify-between">
+                    <dt className="text-muted-foreground">Decomposition</dt>
+                    <dd>{decompositionLabel(execution.config.moead.decomposition)}</dd>
+                  </div>
+                  <div className="flex justify-between">
+                    <dt className="text-muted-foreground">Neighborhood</dt>
+                    <dd>{execution.config.moead.neighborhoodSize ?? serverDefaultLabel}</dd>
+                  </div>
+                  <div className="flex justify-between">
+                    <dt className="text-muted-foreground">Theta</dt>
+                    <dd>{execution.config.moead.theta ?? serverDefaultLabel}</dd>
+                  </div>
+                  <div className="flex justify-between">
+                    <dt className="text-muted-foreground">Delta</dt>
+                    <dd>{execution.config.moead.delta ?? serverDefaultLabel}</dd>
+                  </div>
+                  <div className="flex justify-between">
+                    <dt className="text-muted-foreground">Max Replacements</dt>
+                    <dd>{execution.config.moead.maxReplacements ?? serverDefaultLabel}</dd>
                   </div>
                 </>
               )}
@@ -224,6 +341,18 @@ export function ExecutionDetailPage() {
                 <span className="text-muted-foreground">Execution: </span>
                 {progress.completedExecutions} / {progress.totalExecutions}
               </div>
+              {progress.controlParameters && (
+                <>
+                  <div>
+                    <span className="text-muted-foreground">Mean F: </span>
+                    {(progress.controlParameters.meanF ?? 0).toFixed(3)}
+                  </div>
+                  <div>
+                    <span className="text-muted-foreground">Mean CR: </span>
+                    {(progress.controlParameters.meanCr ?? 0).toFixed(3)}
+                  </div>
+                </>
+              )}
             </div>
           </div>
         </Card>
@@ -244,12 +373,24 @@ export function ExecutionDetailPage() {
               <div className="text-muted-foreground">Loading results...</div>
             </Card>
           ) : resultsData?.pareto?.vectors?.length ? (
-            <ParetoVisualization vectors={resultsData.pareto.vectors} />
+            <ParetoVisualization
+              vectors={resultsData.pareto.vectors}
+              referenceFront={referenceFront}
+            />
           ) : (
             <Card className="p-6">
               <p className="text-muted-foreground">No results available</p>
             </Card>
           )}
+          {resultsData?.paretoArchive?.vectors?.length ? (
+            <div className="mt-6">
+              <h3 className="mb-4 text-base font-semibold">Pareto Archive</h3>
+              <ParetoVisualization
+                vectors={resultsData.paretoArchive.vectors}
+                referenceFront={referenceFront}
+              />
+            </div>
+          ) : null}
         </div>
       )}
     </AppShell>
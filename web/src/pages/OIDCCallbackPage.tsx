@@ -0,0 +1,58 @@
+import { useEffect, useRef } from 'react'
+import { Link, useNavigate, useSearchParams } from 'react-router-dom'
+import { useOIDCLogin } from '@/api/hooks'
+import { Card, CardContent, CardDescription, CardHeader, CardTitle } from '@/components/ui/card'
+import { useToast } from '@/components/ui/toast'
+
+export function OIDCCallbackPage() {
+  const navigate = useNavigate()
+  const [params] = useSearchParams()
+  const login = useOIDCLogin()
+  const { addToast } = useToast()
+  // The code can only be redeemed once, even if the effect runs twice
+  const started = useRef(false)
+
+  const code = params.get('code')
+  const state = params.get('state')
+  const providerError = params.get('error_description') || params.get('error')
+
+  useEffect(() => {
+    if (started.current || providerError || !code || !state) return
+    started.current = true
+
+    login
+      .mutateAsync({ code, state })
+      .then(() => {
+        addToast('Successfully logged in!', 'success')
+        navigate('/dashboard', { replace: true })
+      })
+      .catch(() => {
+        addToast('Single sign-on failed', 'error')
+      })
+  }, [code, state, providerError, login, addToast, navigate])
+
+  const failed = providerError || !code || !state || login.isError
+
+  return (
+    <div className="bg-background flex min-h-screen items-center justify-center p-4">
+      <Card className="w-full max-w-md">
+        <CardHeader>
+          <CardTitle>Single Sign-On</CardTitle>
+          <CardDescription>
+            {failed ? 'The login could not be completed' : 'Completing your login...'}
+          </CardDescription>
+        </CardHeader>
+        {failed && (
+          <CardContent className="space-y-4">
+            <p className="text-destructive text-sm">
+              {providerError || 'The identity provider response is invalid or expired.'}
+            </p>
+            <Link to="/login" className="text-primary text-sm hover:underline">
+              Back to login
+            </Link>
+          </CardContent>
+        )}
+      </Card>
+    </div>
+  )
+}
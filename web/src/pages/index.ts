@@ -1,5 +1,6 @@
 export { LoginPage } from './LoginPage'
 export { RegisterPage } from './RegisterPage'
+export { OIDCCallbackPage } from './OIDCCallbackPage'
 export { DashboardPage } from './DashboardPage'
 export { ExecutionsPage } from './ExecutionsPage'
 export { NewExecutionPage } from './NewExecutionPage'